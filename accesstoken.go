@@ -0,0 +1,373 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	// accessTokenHeader is the metadata key carrying the access token. The
+	// REST gateway forwards the Authorization header under this key.
+	accessTokenHeader = "authorization"
+
+	// accessTokenLocation is the location of the access tokens minted by
+	// the coordinator.
+	accessTokenLocation = "ec"
+
+	// accessTokenRootKeySize is the size of the root key access tokens are
+	// derived from.
+	accessTokenRootKeySize = 32
+
+	// accessTokenIDSize is the size of the random part of the id of an
+	// access token. The id of a token of a tenant is followed by the name
+	// of the tenant.
+	accessTokenIDSize = 16
+
+	// maxTenantLength is the maximum length of the name of a tenant.
+	maxTenantLength = 64
+
+	// caveatExpires limits a token to requests before the UNIX timestamp
+	// following it.
+	caveatExpires = "expires"
+
+	// caveatReadOnly limits a token to queries.
+	caveatReadOnly = "read_only"
+
+	// caveatNodes limits the pairs returned to queries with a token to the
+	// ones involving any of the comma separated hex encoded pubkeys
+	// following it.
+	caveatNodes = "nodes"
+
+	// registerMethod is the full name of the RPC registering mission
+	// control data.
+	registerMethod = ecrpc.
+			ExternalCoordinator_RegisterMissionControl_FullMethodName
+)
+
+// readOnlyMethods are the full names of the RPCs granted by read-only tokens.
+// Any other method is denied, so that methods added later are never granted
+// by read-only tokens unless listed here.
+var readOnlyMethods = map[string]bool{
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControl_FullMethodName: true,
+	ecrpc.ExternalCoordinator_GetInfo_FullMethodName:                       true,
+	ecrpc.ExternalCoordinator_ListEpochs_FullMethodName:                    true,
+	ecrpc.ExternalCoordinator_QueryEpochHistory_FullMethodName:             true,
+	ecrpc.ExternalCoordinator_GetStats_FullMethodName:                      true,
+	ecrpc.ExternalCoordinator_QueryPrivateMissionControl_FullMethodName:    true,
+	ecrpc.ExternalCoordinator_PollMissionControl_FullMethodName:            true,
+	ecrpc.ExternalCoordinator_QueryMissionControlDelta_FullMethodName:      true,
+	ecrpc.ExternalCoordinator_SubscribeMissionControl_FullMethodName:       true,
+	ecrpc.ExternalCoordinator_QueryTopPairs_FullMethodName:                 true,
+	ecrpc.ExternalCoordinator_QueryPair_FullMethodName:                     true,
+	ecrpc.ExternalCoordinator_QueryNodeReputation_FullMethodName:           true,
+	ecrpc.ExternalCoordinator_ExportMissionControl_FullMethodName:          true,
+	healthpb.Health_Check_FullMethodName:                                   true,
+	healthpb.Health_Watch_FullMethodName:                                   true,
+}
+
+// accessTokenRootKeyKey is the key of the root key access tokens are derived
+// from within the metadata bucket. Replacing it revokes all tokens.
+var accessTokenRootKeyKey = []byte("access_token_root_key")
+
+// accessScope is what an access token grants, as limited by its caveats.
+type accessScope struct {
+	// readOnly is set if the token only grants queries.
+	readOnly bool
+
+	// nodes holds the node sets the token is limited to. Pairs must
+	// involve a node of each set, since every caveat added to a token can
+	// only narrow it down further.
+	nodes []nodeGroupMembers
+
+	// owner identifies the owner of the private pairs registered with the
+	// token. It is derived from the id of the token, which cannot be
+	// changed without invalidating the token.
+	owner string
+}
+
+// filter returns a filter accepting the pairs accepted by the given filter
+// which are within the scope.
+func (a *accessScope) filter(next func(nodeFrom, nodeTo []byte) bool) func(
+	nodeFrom, nodeTo []byte) bool {
+	if a == nil || len(a.nodes) == 0 {
+		return next
+	}
+
+	return func(nodeFrom, nodeTo []byte) bool {
+		for _, nodes := range a.nodes {
+			if !nodes.contains(nodeFrom) && !nodes.contains(nodeTo) {
+				return false
+			}
+		}
+
+		return next == nil || next(nodeFrom, nodeTo)
+	}
+}
+
+// accessScopeKey is the context key of the scope of the access token a
+// request was authorized with.
+type accessScopeKey struct{}
+
+// accessScopeFromContext returns the scope of the access token the request
+// was authorized with, or nil if it was not authorized with a token.
+func accessScopeFromContext(ctx context.Context) *accessScope {
+	scope, _ := ctx.Value(accessScopeKey{}).(*accessScope)
+	return scope
+}
+
+// accessTokenOwner returns the owner of the private pairs registered with a
+// token of the given id, which is its tenant if it has one and else the token
+// itself, including all tokens derived from it.
+func accessTokenOwner(id []byte) string {
+	if len(id) > accessTokenIDSize {
+		return "tenant:" + string(id[accessTokenIDSize:])
+	}
+
+	return "token:" + hex.EncodeToString(id)
+}
+
+// parseCaveat applies a first-party caveat of an access token to its scope.
+// Unknown or malformed caveats are rejected, so that a token is never granted
+// more than intended.
+func parseCaveat(scope *accessScope, caveat string, now time.Time) error {
+	name, value, _ := strings.Cut(caveat, " ")
+	switch name {
+	case caveatExpires:
+		expiry, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid expiry %q", value)
+		}
+		if !now.Before(time.Unix(expiry, 0)) {
+			return fmt.Errorf("token expired")
+		}
+
+	case caveatReadOnly:
+		scope.readOnly = true
+
+	case caveatNodes:
+		nodes := make(nodeGroupMembers)
+		for _, node := range strings.Split(value, ",") {
+			pubKey, err := hex.DecodeString(node)
+			if err != nil || len(pubKey) != PubKeyCompressedSize {
+				return fmt.Errorf("invalid node %q", node)
+			}
+			nodes[[PubKeyCompressedSize]byte(pubKey)] = struct{}{}
+		}
+		scope.nodes = append(scope.nodes, nodes)
+
+	default:
+		return fmt.Errorf("unknown caveat %q", name)
+	}
+
+	return nil
+}
+
+// verifyAccessToken verifies the hex encoded access token against the root
+// key and returns the scope it grants at the given time.
+func verifyAccessToken(rootKey []byte, token string,
+	now time.Time) (*accessScope, error) {
+	data, err := hex.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("token is not hex encoded")
+	}
+
+	var m macaroon.Macaroon
+	if err := m.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("malformed token: %v", err)
+	}
+
+	scope := &accessScope{owner: accessTokenOwner(m.Id())}
+	err = m.Verify(rootKey, func(caveat string) error {
+		return parseCaveat(scope, caveat, now)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return scope, nil
+}
+
+// authorize authorizes the request to the given method with the access token
+// within the metadata of the context if access tokens are required. It
+// returns the context of the request carrying the scope of the token.
+func (s *externalCoordinatorServer) authorize(ctx context.Context,
+	method string) (context.Context, error) {
+	if !s.config.Server.RequireAccessToken {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(accessTokenHeader)
+	if len(tokens) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "access token "+
+			"required")
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(tokens[0], "Bearer "))
+
+	var rootKey []byte
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		rootKey = b.Get(accessTokenRootKeyKey)
+		if rootKey == nil {
+			return fmt.Errorf("no tokens minted")
+		}
+
+		// The verification outlives the transaction.
+		rootKey = append([]byte(nil), rootKey...)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid "+
+			"access token: %v", err)
+	}
+
+	scope, err := verifyAccessToken(rootKey, token, s.clock.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid "+
+			"access token: %v", err)
+	}
+
+	if scope.readOnly && !readOnlyMethods[method] {
+		return nil, status.Error(codes.PermissionDenied, "access "+
+			"token is read-only")
+	}
+
+	return context.WithValue(ctx, accessScopeKey{}, scope), nil
+}
+
+// authorizeUnary is a unary interceptor authorizing requests with their
+// access token if access tokens are required.
+func (s *externalCoordinatorServer) authorizeUnary(ctx context.Context,
+	req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authorizeStream is a stream interceptor authorizing requests with their
+// access token if access tokens are required.
+func (s *externalCoordinatorServer) authorizeStream(srv any,
+	ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx, err := s.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// MintAccessToken mints an expiring access token for the public API, limited
+// by the caveats requested. The root key tokens are derived from is created
+// along with the first token.
+func (a *adminServer) MintAccessToken(ctx context.Context,
+	req *ecadminrpc.MintAccessTokenRequest) (
+	*ecadminrpc.MintAccessTokenResponse, error) {
+	if req.ExpirySeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expiry must "+
+			"be positive")
+	}
+
+	nodes := make([]string, 0, len(req.Nodes))
+	for _, node := range req.Nodes {
+		if len(node) != PubKeyCompressedSize {
+			return nil, status.Errorf(codes.InvalidArgument, "nodes "+
+				"must be exactly %d bytes", PubKeyCompressedSize)
+		}
+		nodes = append(nodes, hex.EncodeToString(node))
+	}
+	if len(req.Tenant) > maxTenantLength {
+		return nil, status.Errorf(codes.InvalidArgument, "tenant must "+
+			"not exceed %d bytes", maxTenantLength)
+	}
+
+	var rootKey []byte
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		if key := b.Get(accessTokenRootKeyKey); key != nil {
+			rootKey = append([]byte(nil), key...)
+			return nil
+		}
+
+		rootKey = make([]byte, accessTokenRootKeySize)
+		if _, err := rand.Read(rootKey); err != nil {
+			return err
+		}
+
+		return b.Put(accessTokenRootKeyKey, rootKey)
+	})
+	if err != nil {
+		msg := "failed to load access token root key: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	// The tenant is part of the id, so that it cannot be changed by
+	// holders of the token, unlike caveats which anyone can add.
+	id := make([]byte, accessTokenIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate "+
+			"token id: %v", err)
+	}
+	id = append(id, req.Tenant...)
+	m, err := macaroon.New(
+		rootKey, id, accessTokenLocation, macaroon.LatestVersion,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mint "+
+			"token: %v", err)
+	}
+
+	expiresAt := time.Now().Add(
+		time.Duration(req.ExpirySeconds) * time.Second,
+	).Unix()
+	caveats := []string{fmt.Sprintf("%s %d", caveatExpires, expiresAt)}
+	if req.ReadOnly {
+		caveats = append(caveats, caveatReadOnly)
+	}
+	if len(nodes) > 0 {
+		caveats = append(caveats, caveatNodes+" "+
+			strings.Join(nodes, ","))
+	}
+	for _, caveat := range caveats {
+		if err := m.AddFirstPartyCaveat([]byte(caveat)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to "+
+				"add caveat: %v", err)
+		}
+	}
+
+	data, err := m.MarshalBinary()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode "+
+			"token: %v", err)
+	}
+
+	logrus.Infof("Minted access token expiring at %s (read-only: %v, "+
+		"nodes: %d, tenant: %q)", time.Unix(expiresAt, 0).UTC(),
+		req.ReadOnly, len(nodes), req.Tenant)
+
+	return &ecadminrpc.MintAccessTokenResponse{
+		Token:     hex.EncodeToString(data),
+		ExpiresAt: expiresAt,
+	}, nil
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// TestAccessToken tests that the public API requires a valid access token if
+// configured and that the caveats of the token limit what it grants.
+func TestAccessToken(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RequireAccessToken = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+	admin := NewAdminServer(config, db)
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.authorizeUnary),
+		grpc.StreamInterceptor(server.authorizeStream),
+	)
+	ecrpc.RegisterExternalCoordinatorServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := ecrpc.NewExternalCoordinatorClient(conn)
+
+	mint := func(req *ecadminrpc.MintAccessTokenRequest) string {
+		resp, err := admin.MintAccessToken(context.Background(), req)
+		require.NoError(t, err)
+		return resp.Token
+	}
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(
+			context.Background(), accessTokenHeader, token,
+		)
+	}
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	pair := func(nodeFrom, nodeTo []byte) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	register := func(ctx context.Context) error {
+		_, err := client.RegisterMissionControl(
+			ctx, &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{
+					pair(nodeA, nodeB), pair(nodeC, nodeD),
+				},
+			},
+		)
+		return err
+	}
+	query := func(ctx context.Context) ([]*ecrpc.PairHistory, error) {
+		stream, err := client.QueryAggregatedMissionControl(
+			ctx, &ecrpc.QueryAggregatedMissionControlRequest{},
+		)
+		require.NoError(t, err)
+
+		var pairs []*ecrpc.PairHistory
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return pairs, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, resp.Pairs...)
+		}
+	}
+
+	// Requests without a valid token are rejected.
+	err = register(context.Background())
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = register(withToken("00"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A token without caveats besides its expiry grants everything.
+	full := mint(&ecadminrpc.MintAccessTokenRequest{ExpirySeconds: 60})
+	require.NoError(t, register(withToken("Bearer "+full)))
+	pairs, err := query(withToken(full))
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+
+	// Read-only tokens limited to some nodes only grant queries for the
+	// pairs involving them.
+	shared := mint(&ecadminrpc.MintAccessTokenRequest{
+		ExpirySeconds: 60,
+		ReadOnly:      true,
+		Nodes:         [][]byte{nodeB},
+	})
+	err = register(withToken(shared))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.RegisterCLNPayResults(
+		withToken(shared), &ecrpc.RegisterCLNPayResultsRequest{},
+	)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	pairs, err = query(withToken(shared))
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	require.Equal(t, nodeA, pairs[0].NodeFrom)
+
+	// Read-only tokens only grant the listed query methods, so methods
+	// unknown to them are denied.
+	incoming := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(accessTokenHeader, shared),
+	)
+	for _, method := range []string{
+		ecrpc.ExternalCoordinator_ImportMissionControl_FullMethodName,
+		ecrpc.ExternalCoordinator_SyncMissionControl_FullMethodName,
+		"/ecrpc.ExternalCoordinator/UnknownMethod",
+	} {
+		_, err := server.authorize(incoming, method)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+	_, err = server.authorize(
+		incoming, ecrpc.ExternalCoordinator_GetInfo_FullMethodName,
+	)
+	require.NoError(t, err)
+
+	// Holders can narrow down a token further, but not widen it.
+	data, err := hex.DecodeString(shared)
+	require.NoError(t, err)
+	var m macaroon.Macaroon
+	require.NoError(t, m.UnmarshalBinary(data))
+	narrowed := m.Clone()
+	caveat := caveatNodes + " " + hex.EncodeToString(nodeC)
+	require.NoError(t, narrowed.AddFirstPartyCaveat([]byte(caveat)))
+	data, err = narrowed.MarshalBinary()
+	require.NoError(t, err)
+	pairs, err = query(withToken(hex.EncodeToString(data)))
+	require.NoError(t, err)
+	require.Empty(t, pairs)
+
+	widened := m.Clone()
+	require.NoError(t, widened.AddFirstPartyCaveat([]byte("admin")))
+	data, err = widened.MarshalBinary()
+	require.NoError(t, err)
+	_, err = query(withToken(hex.EncodeToString(data)))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Tokens expire.
+	clock.Advance(2 * time.Minute)
+	_, err = query(withToken(full))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Tokens must expire.
+	_, err = admin.MintAccessToken(
+		context.Background(), &ecadminrpc.MintAccessTokenRequest{},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
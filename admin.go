@@ -0,0 +1,36 @@
+package main
+
+import (
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// adminServer implements the administrative gRPC service of the external
+// coordinator. It is only served on the dedicated admin listener.
+type adminServer struct {
+	ecadminrpc.UnimplementedExternalCoordinatorAdminServer
+	config *Config
+	db     *bbolt.DB
+
+	// talkers accounts the volumes of the requests of each client to the
+	// coordinator. It is nil if the volumes are not tracked.
+	talkers *talkerTracker
+
+	// streams tracks the streaming RPCs of the public API currently being
+	// served. It is nil if the streams are not tracked.
+	streams *streamTracker
+
+	// coordinator is the public coordinator server whose aggregation is
+	// used to recompute the stored pairs. It is nil if the pairs cannot
+	// be recomputed.
+	coordinator *externalCoordinatorServer
+
+	// macaroonRootKey is the root key the admin macaroon authenticating
+	// requests is derived from. Requests are rejected while it is nil.
+	macaroonRootKey []byte
+}
+
+// NewAdminServer creates a new instance of ExternalCoordinatorAdminServer.
+func NewAdminServer(config *Config, db *bbolt.DB) *adminServer {
+	return &adminServer{db: db, config: config}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	// adminMacaroonHeader is the metadata key carrying the hex encoded
+	// admin macaroon.
+	adminMacaroonHeader = "macaroon"
+
+	// adminMacaroonLocation is the location of the admin macaroon.
+	adminMacaroonLocation = "ec-admin"
+)
+
+// adminMacaroonRootKeyKey is the key of the root key the admin macaroon is
+// derived from within the metadata bucket. It is separate from the root key of
+// the access tokens, so that no access token ever grants the admin server.
+var adminMacaroonRootKeyKey = []byte("admin_macaroon_root_key")
+
+// setupAdminMacaroon returns the root key of the admin macaroon. If the admin
+// macaroon does not exist at the configured path, a new root key is created
+// and the admin macaroon derived from it is written to the path, which revokes
+// any previous admin macaroon.
+func setupAdminMacaroon(config *ServerConfig, db *bbolt.DB) ([]byte, error) {
+	var rootKey []byte
+	err := db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		if key := b.Get(adminMacaroonRootKeyKey); key != nil {
+			rootKey = append([]byte(nil), key...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin macaroon root "+
+			"key: %v", err)
+	}
+
+	_, err = os.Stat(config.AdminMacaroonPath)
+	switch {
+	case err == nil && rootKey != nil:
+		return rootKey, nil
+
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read admin macaroon: %v", err)
+	}
+
+	rootKey = make([]byte, accessTokenRootKeySize)
+	if _, err := rand.Read(rootKey); err != nil {
+		return nil, fmt.Errorf("failed to generate admin macaroon root "+
+			"key: %v", err)
+	}
+	id := make([]byte, accessTokenIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate admin macaroon id: "+
+			"%v", err)
+	}
+	m, err := macaroon.New(
+		rootKey, id, adminMacaroonLocation, macaroon.LatestVersion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mint admin macaroon: %v", err)
+	}
+	data, err := m.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode admin macaroon: %v",
+			err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		return b.Put(adminMacaroonRootKeyKey, rootKey)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store admin macaroon root "+
+			"key: %v", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(config.AdminMacaroonPath),
+		AppDirPermissions)
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(
+		config.AdminMacaroonPath, data, DatabaseFilePermissions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write admin macaroon: %v", err)
+	}
+	logrus.Infof("Admin macaroon written to %s", config.AdminMacaroonPath)
+
+	return rootKey, nil
+}
+
+// verifyAdminMacaroon verifies the hex encoded admin macaroon against the root
+// key at the given time. Holders may restrict the macaroon to expire, any
+// other caveat is rejected.
+func verifyAdminMacaroon(rootKey []byte, token string, now time.Time) error {
+	data, err := hex.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("macaroon is not hex encoded")
+	}
+
+	var m macaroon.Macaroon
+	if err := m.UnmarshalBinary(data); err != nil {
+		return fmt.Errorf("malformed macaroon: %v", err)
+	}
+
+	return m.Verify(rootKey, func(caveat string) error {
+		name, _, _ := strings.Cut(caveat, " ")
+		if name != caveatExpires {
+			return fmt.Errorf("unknown caveat %q", name)
+		}
+
+		return parseCaveat(&accessScope{}, caveat, now)
+	}, nil)
+}
+
+// authenticate authenticates the request with the admin macaroon within the
+// metadata of the context.
+func (a *adminServer) authenticate(ctx context.Context) error {
+	if a.macaroonRootKey == nil {
+		return status.Error(codes.Unauthenticated, "admin macaroon "+
+			"not set up")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(adminMacaroonHeader)
+	if len(tokens) == 0 {
+		return status.Error(codes.Unauthenticated, "admin macaroon "+
+			"required")
+	}
+
+	err := verifyAdminMacaroon(
+		a.macaroonRootKey, strings.TrimSpace(tokens[0]), time.Now(),
+	)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid admin "+
+			"macaroon: %v", err)
+	}
+
+	return nil
+}
+
+// authenticateUnary is a unary interceptor authenticating requests to the
+// admin server with the admin macaroon.
+func (a *adminServer) authenticateUnary(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authenticateStream is a stream interceptor authenticating requests to the
+// admin server with the admin macaroon.
+func (a *adminServer) authenticateStream(srv any, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authenticate(ss.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// adminMacaroonCredential presents an admin macaroon with every request made
+// on a connection to an admin server.
+type adminMacaroonCredential string
+
+// newAdminMacaroonCredential reads the admin macaroon at the given path.
+func newAdminMacaroonCredential(path string) (adminMacaroonCredential,
+	error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read admin macaroon: %v", err)
+	}
+
+	return adminMacaroonCredential(hex.EncodeToString(data)), nil
+}
+
+// GetRequestMetadata returns the metadata carrying the admin macaroon.
+func (c adminMacaroonCredential) GetRequestMetadata(ctx context.Context,
+	uri ...string) (map[string]string, error) {
+	return map[string]string{adminMacaroonHeader: string(c)}, nil
+}
+
+// RequireTransportSecurity returns true since the macaroon must never be sent
+// in plain text.
+func (c adminMacaroonCredential) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// startAdminTestServer starts the admin gRPC server of the given admin server
+// with its admin macaroon set up. It returns the address of the server, the
+// path of its TLS certificate and the path of its admin macaroon.
+func startAdminTestServer(t *testing.T,
+	admin *adminServer) (string, string, string) {
+	tempDir := t.TempDir()
+	certFile := filepath.Join(tempDir, "tls.cert")
+	keyFile := filepath.Join(tempDir, "tls.key")
+	require.NoError(t, generateSelfSignedTLS(certFile, keyFile))
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+
+	port, err := getFreePort()
+	require.NoError(t, err)
+	admin.config.Server.AdminGRPCServerHost = "localhost"
+	admin.config.Server.AdminGRPCServerPort = fmt.Sprintf(":%d", port)
+	admin.config.Server.AdminMacaroonPath = filepath.Join(
+		tempDir, DefaultAdminMacaroonFilename,
+	)
+
+	admin.macaroonRootKey, err = setupAdminMacaroon(
+		&admin.config.Server, admin.db,
+	)
+	require.NoError(t, err)
+
+	grpcServer, lis, err := initializeAdminGRPCServer(
+		admin.config, &tls.Config{Certificates: []tls.Certificate{cert}},
+		admin,
+	)
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	return fmt.Sprintf("localhost:%d", port), certFile,
+		admin.config.Server.AdminMacaroonPath
+}
+
+// dialAdminTestServer returns a client of the admin server started by
+// startAdminTestServer.
+func dialAdminTestServer(t *testing.T, addr,
+	certFile string) ecadminrpc.ExternalCoordinatorAdminClient {
+	creds, err := credentials.NewClientTLSFromFile(certFile, "")
+	require.NoError(t, err)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return ecadminrpc.NewExternalCoordinatorAdminClient(conn)
+}
+
+// TestAdminMacaroon tests that the admin server only serves requests carrying
+// a valid admin macaroon.
+func TestAdminMacaroon(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	addr, certFile, macaroonPath := startAdminTestServer(
+		t, NewAdminServer(config, db),
+	)
+	client := dialAdminTestServer(t, addr, certFile)
+	data, err := os.ReadFile(macaroonPath)
+	require.NoError(t, err)
+
+	mint := func(ctx context.Context) error {
+		_, err := client.MintAccessToken(
+			ctx, &ecadminrpc.MintAccessTokenRequest{ExpirySeconds: 60},
+		)
+		return err
+	}
+	withMacaroon := func(data []byte) context.Context {
+		return metadata.AppendToOutgoingContext(
+			context.Background(), adminMacaroonHeader,
+			hex.EncodeToString(data),
+		)
+	}
+	withCaveat := func(caveat string) context.Context {
+		var m macaroon.Macaroon
+		require.NoError(t, m.UnmarshalBinary(data))
+		require.NoError(t, m.AddFirstPartyCaveat([]byte(caveat)))
+		restricted, err := m.MarshalBinary()
+		require.NoError(t, err)
+
+		return withMacaroon(restricted)
+	}
+
+	// Unauthenticated requests are rejected, including streams.
+	err = mint(context.Background())
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	stream, err := client.ApplySnapshot(context.Background())
+	require.NoError(t, err)
+	_, err = stream.CloseAndRecv()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A macaroon derived from another root key is rejected.
+	other, err := macaroon.New(
+		make([]byte, accessTokenRootKeySize), []byte("id"),
+		adminMacaroonLocation, macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	otherData, err := other.MarshalBinary()
+	require.NoError(t, err)
+	err = mint(withMacaroon(otherData))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The admin macaroon is accepted, also when restricted to expire
+	// later.
+	require.NoError(t, mint(withMacaroon(data)))
+	expiry := time.Now().Add(time.Hour).Unix()
+	require.NoError(t, mint(withCaveat(
+		fmt.Sprintf("%s %d", caveatExpires, expiry),
+	)))
+
+	// Expired macaroons and unknown caveats are rejected.
+	expiry = time.Now().Add(-time.Hour).Unix()
+	err = mint(withCaveat(fmt.Sprintf("%s %d", caveatExpires, expiry)))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = mint(withCaveat(caveatReadOnly))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// TestSetupAdminMacaroon tests that the admin macaroon and its root key are
+// created once and kept afterwards.
+func TestSetupAdminMacaroon(t *testing.T) {
+	tempDir := t.TempDir()
+	config := MockConfig(tempDir)
+	config.Server.AdminMacaroonPath = filepath.Join(
+		tempDir, "admin", DefaultAdminMacaroonFilename,
+	)
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	rootKey, err := setupAdminMacaroon(&config.Server, db)
+	require.NoError(t, err)
+	data, err := os.ReadFile(config.Server.AdminMacaroonPath)
+	require.NoError(t, err)
+	require.NoError(t, verifyAdminMacaroon(
+		rootKey, hex.EncodeToString(data), time.Now(),
+	))
+
+	info, err := os.Stat(config.Server.AdminMacaroonPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(DatabaseFilePermissions), info.Mode().Perm())
+
+	// The root key is never shared with the access tokens.
+	err = db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		require.Nil(t, b.Get(accessTokenRootKeyKey))
+		return nil
+	})
+	require.NoError(t, err)
+
+	again, err := setupAdminMacaroon(&config.Server, db)
+	require.NoError(t, err)
+	require.Equal(t, rootKey, again)
+	dataAgain, err := os.ReadFile(config.Server.AdminMacaroonPath)
+	require.NoError(t, err)
+	require.Equal(t, data, dataAgain)
+
+	// Deleting the admin macaroon revokes it once a new one is created.
+	require.NoError(t, os.Remove(config.Server.AdminMacaroonPath))
+	rotated, err := setupAdminMacaroon(&config.Server, db)
+	require.NoError(t, err)
+	require.Error(t, verifyAdminMacaroon(
+		rotated, hex.EncodeToString(data), time.Now(),
+	))
+	data, err = os.ReadFile(config.Server.AdminMacaroonPath)
+	require.NoError(t, err)
+	require.NoError(t, verifyAdminMacaroon(
+		rotated, hex.EncodeToString(data), time.Now(),
+	))
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ziggie1984/Distributed-Mission-Control-for-LND/testing/fixtures"
+)
+
+// updateGolden rewrites the golden files with the current aggregation output
+// instead of comparing against them.
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// TestAggregationGolden tests the aggregation of registered pairs against the
+// golden files in testdata/aggregation. Each case directory holds the fixture
+// of the registered pairs, an optional fixture of the previously stored
+// pairs and the golden fixture of the aggregated pairs. The pairs are stored
+// without the RPC layer, so the fixed timestamps are never considered stale.
+func TestAggregationGolden(t *testing.T) {
+	cases, err := filepath.Glob(filepath.Join("testdata", "aggregation", "*"))
+	require.NoError(t, err)
+	require.NotEmpty(t, cases)
+
+	for _, dir := range cases {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			config := MockConfig(t.TempDir())
+			db, err := setupDatabase(config)
+			require.NoError(t, err)
+			defer cleanupDB(db)
+
+			stored := filepath.Join(dir, "stored.yaml")
+			if _, err := os.Stat(stored); err == nil {
+				fixture, err := fixtures.Load(stored)
+				require.NoError(t, err)
+				err = fixtures.Seed(db, DatabaseBucketName, fixture)
+				require.NoError(t, err)
+			}
+
+			registered, err := fixtures.Load(
+				filepath.Join(dir, "registered.yaml"),
+			)
+			require.NoError(t, err)
+			pairs, err := registered.PairHistories()
+			require.NoError(t, err)
+
+			server := NewExternalCoordinatorServer(config, db)
+			err = server.storeMissionControlPairs("", pairs)
+			require.NoError(t, err)
+
+			aggregated, err := fixtures.Dump(db, DatabaseBucketName)
+			require.NoError(t, err)
+			got, err := fixtures.Encode(aggregated)
+			require.NoError(t, err)
+
+			golden := filepath.Join(dir, "aggregated.golden.json")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(golden, got, 0644))
+			}
+
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(got))
+		})
+	}
+}
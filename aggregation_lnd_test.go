@@ -0,0 +1,49 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"github.com/ziggie1984/Distributed-Mission-Control-for-LND/testing/fixtures"
+)
+
+// TestAggregationLNDVectors tests that mergePairData merges payment results
+// like the in-memory mission control of lnd, as captured by the merge vectors
+// in testdata/lnd. Each result is merged as a registration of its own into
+// the data of the pair, starting from a pair without results, and the data
+// must match the pair result lnd keeps after every step. New vectors are
+// transcribed from setLastPairResult in routing/missioncontrol_state.go of the
+// lnd release named by the vector.
+func TestAggregationLNDVectors(t *testing.T) {
+	vectors, err := filepath.Glob(
+		filepath.Join("testdata", "lnd", "*.yaml"),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, vectors)
+
+	for _, path := range vectors {
+		name := strings.TrimSuffix(filepath.Base(path), ".yaml")
+		t.Run(name, func(t *testing.T) {
+			vector, err := fixtures.LoadMergeVector(path)
+			require.NoError(t, err)
+
+			history := &ecrpc.PairData{}
+			for i, step := range vector.Results {
+				mergePairData(history, step.PairData())
+
+				merged := fixtures.PairResult{
+					FailTime:       history.FailTime,
+					FailAmtMsat:    history.FailAmtMsat,
+					SuccessTime:    history.SuccessTime,
+					SuccessAmtMsat: history.SuccessAmtMsat,
+				}
+				require.Equalf(t, step.Expected, merged,
+					"result %d diverges from lnd %s", i,
+					vector.LNDVersion)
+			}
+		})
+	}
+}
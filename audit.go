@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/status"
+)
+
+// queryAuditEnabled returns true if the queries of clients are to be recorded.
+func (s *externalCoordinatorServer) queryAuditEnabled() bool {
+	return s.config.Server.QueryAudit && !s.config.Server.PrivacyMode
+}
+
+// queryAuditKey returns the key of a query audit record. Keys are ordered by
+// the time of the query, the sequence number disambiguates queries issued at
+// the same time.
+func queryAuditKey(timestamp time.Time, seq uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key[:8], uint64(timestamp.UnixNano()))
+	binary.BigEndian.PutUint64(key[8:], seq)
+
+	return key
+}
+
+// recordQueryAudit records that the client issuing the request in the given
+// context queried the pairs of the given group. Failures are only logged so
+// that they never fail the query itself.
+func (s *externalCoordinatorServer) recordQueryAudit(ctx context.Context,
+	group string, pairs int) {
+	if !s.queryAuditEnabled() {
+		return
+	}
+
+	now := time.Now()
+	record := &ecadminrpc.QueryAuditRecord{
+		Client:    clientIdentity(ctx),
+		Timestamp: now.Unix(),
+		Group:     group,
+		Pairs:     uint64(pairs),
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(QueryAuditBucketName))
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		return b.Put(queryAuditKey(now, seq), data)
+	})
+	if err != nil {
+		logrus.Errorf("Failed to record query audit: %v", err)
+	}
+}
+
+// pruneQueryAudit removes the query audit records older than the configured
+// retention and returns the number of records removed.
+func (s *externalCoordinatorServer) pruneQueryAudit() (int, error) {
+	cutoff := queryAuditKey(
+		time.Now().Add(-s.config.Server.QueryAuditRetention), 0,
+	)
+
+	removed := 0
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(QueryAuditBucketName)).Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.First() {
+			if bytes.Compare(k, cutoff) >= 0 {
+				break
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+			removed++
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
+
+// ListQueryAudit lists the recorded queries of clients, newest first.
+func (s *adminServer) ListQueryAudit(ctx context.Context,
+	req *ecadminrpc.ListQueryAuditRequest) (*ecadminrpc.ListQueryAuditResponse, error) {
+	var records []*ecadminrpc.QueryAuditRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(QueryAuditBucketName)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			record := &ecadminrpc.QueryAuditRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+
+			// The records are ordered by time, so all remaining
+			// ones are older than requested.
+			if req.GetSince() != 0 && record.Timestamp < req.GetSince() {
+				break
+			}
+			if req.GetClient() != "" && record.Client != req.GetClient() {
+				continue
+			}
+
+			records = append(records, record)
+			limit := int(req.GetLimit())
+			if limit != 0 && len(records) == limit {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		msg := "failed to list query audit: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return &ecadminrpc.ListQueryAuditResponse{Records: records}, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/peer"
+)
+
+// peerQueryStream is a query stream whose context carries the given peer
+// address.
+type peerQueryStream struct {
+	mockQueryAggregatedMissionControlServer
+	addr string
+}
+
+func (p *peerQueryStream) Context() context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(p.addr), Port: 4242},
+	})
+}
+
+// TestQueryAudit tests that queries are recorded and listed when the query
+// audit is enabled, that the privacy mode disables it and that expired
+// records are pruned.
+func TestQueryAudit(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.QueryAudit = true
+	config.Server.QueryAuditRetention = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	query := func(addr string) {
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{},
+			&peerQueryStream{addr: addr},
+		)
+		require.NoError(t, err)
+	}
+	query("203.0.113.1")
+	query("203.0.113.2")
+	query("203.0.113.1")
+
+	// All queries are listed newest first.
+	resp, err := admin.ListQueryAudit(
+		ctx, &ecadminrpc.ListQueryAuditRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 3)
+	require.Equal(t, "203.0.113.1", resp.Records[0].Client)
+	require.Equal(t, "203.0.113.2", resp.Records[1].Client)
+
+	// The records can be filtered by client and limited.
+	resp, err = admin.ListQueryAudit(ctx, &ecadminrpc.ListQueryAuditRequest{
+		Client: "203.0.113.1",
+		Limit:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	require.Equal(t, "203.0.113.1", resp.Records[0].Client)
+
+	// Nothing is recorded in privacy mode.
+	config.Server.PrivacyMode = true
+	query("203.0.113.3")
+	resp, err = admin.ListQueryAudit(ctx, &ecadminrpc.ListQueryAuditRequest{
+		Client: "203.0.113.3",
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Records)
+
+	// Records exceeding the retention are pruned.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(QueryAuditBucketName))
+		return b.Put(
+			queryAuditKey(time.Now().Add(-2*time.Hour), 0),
+			[]byte(`{"client":"old"}`),
+		)
+	})
+	require.NoError(t, err)
+
+	removed, err := server.pruneQueryAudit()
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	resp, err = admin.ListQueryAudit(
+		ctx, &ecadminrpc.ListQueryAuditRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 3)
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// authzAllowed, authzDenied and authzFailed label the decisions of
+	// the external authorization policy.
+	authzAllowed = "allowed"
+	authzDenied  = "denied"
+	authzFailed  = "failed"
+
+	// maxAuthzResponseSize is the maximum size in bytes of a decision read
+	// from the external authorization policy.
+	maxAuthzResponseSize = 64 * 1024
+)
+
+// authzDecisions counts the decisions of the external authorization policy by
+// result.
+var authzDecisions = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "authz",
+		Name:      "decisions_total",
+		Help:      "Decisions of the external authorization policy.",
+	},
+	[]string{"decision"},
+)
+
+func init() {
+	metricsRegistry.MustRegister(authzDecisions)
+}
+
+// authzIdentity identifies the client of a request towards the external
+// authorization policy.
+type authzIdentity struct {
+	// Client is the address of the client.
+	Client string `json:"client"`
+
+	// Owner identifies the access token the request was authorized with,
+	// which is its tenant if it has one. It is empty if access tokens are
+	// not required.
+	Owner string `json:"owner,omitempty"`
+
+	// ReadOnly is set if the access token only grants queries.
+	ReadOnly bool `json:"read_only,omitempty"`
+}
+
+// authzInput is the input posted to the external authorization policy for
+// each request.
+type authzInput struct {
+	Method    string         `json:"method"`
+	RequestID string         `json:"request_id,omitempty"`
+	Identity  authzIdentity  `json:"identity"`
+	Request   map[string]any `json:"request,omitempty"`
+}
+
+// authzHook asks an external policy endpoint, e.g. an Open Policy Agent,
+// whether a request is allowed. It speaks the data API of the Open Policy
+// Agent: the input is posted as a JSON object under "input", and the decision
+// is the "result", either a boolean or an object with an "allow" boolean and
+// an optional "reason".
+type authzHook struct {
+	url    string
+	client *http.Client
+
+	// failOpen is set if requests are allowed when the policy fails to
+	// decide.
+	failOpen bool
+}
+
+// decide asks the policy whether the request described by the input is
+// allowed. It returns the reason given by the policy for its decision.
+func (h *authzHook) decide(ctx context.Context, input *authzInput) (bool,
+	string, error) {
+
+	body, err := json.Marshal(map[string]any{"input": input})
+	if err != nil {
+		return false, "", err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, h.url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return false, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Errors of the client quote the URL, which may carry credentials, so
+	// only their cause is returned.
+	resp, err := h.client.Do(req)
+	if urlErr, ok := err.(*url.Error); ok {
+		return false, "", urlErr.Err
+	}
+	if err != nil {
+		return false, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return false, "", fmt.Errorf("policy responded with status %s",
+			resp.Status)
+	}
+
+	var decision struct {
+		Result json.RawMessage `json:"result"`
+	}
+	err = json.NewDecoder(
+		io.LimitReader(resp.Body, maxAuthzResponseSize),
+	).Decode(&decision)
+	if err != nil {
+		return false, "", fmt.Errorf("malformed decision: %v", err)
+	}
+
+	// The Open Policy Agent omits the result if the policy is undefined
+	// for the input, which never allows the request.
+	if len(decision.Result) == 0 {
+		return false, "policy undefined", nil
+	}
+
+	var allow bool
+	if err := json.Unmarshal(decision.Result, &allow); err == nil {
+		return allow, "", nil
+	}
+	var result struct {
+		Allow  *bool  `json:"allow"`
+		Reason string `json:"reason"`
+	}
+	err = json.Unmarshal(decision.Result, &result)
+	if err != nil || result.Allow == nil {
+		return false, "", fmt.Errorf("malformed decision: result is " +
+			"neither a boolean nor an object with an allow boolean")
+	}
+
+	return *result.Allow, result.Reason, nil
+}
+
+// StartAuthzHook starts authorizing every request to the public servers with
+// the configured external policy.
+func (s *externalCoordinatorServer) StartAuthzHook() error {
+	// The URL may carry credentials, so it is never logged.
+	u, err := url.Parse(s.config.Server.AuthzURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+		u.Host == "" {
+
+		return fmt.Errorf("authz url must be an http or https URL")
+	}
+	timeout := s.config.Server.AuthzTimeout
+	if timeout <= 0 {
+		return fmt.Errorf("authz timeout must be positive")
+	}
+
+	s.authz = &authzHook{
+		url:      s.config.Server.AuthzURL,
+		client:   &http.Client{Timeout: timeout},
+		failOpen: s.config.Server.AuthzFailOpen,
+	}
+
+	logrus.Infof("Authorizing requests with the external policy at %s "+
+		"(fail open: %v)", u.Host, s.authz.failOpen)
+
+	return nil
+}
+
+// summarizeRequest summarizes the request for the external authorization
+// policy. It holds the populated fields of the request by name, with bytes hex
+// encoded, enums by name and repeated fields by their number of entries.
+// Nested messages are left out, so that the summary stays small however many
+// pairs the request carries.
+func summarizeRequest(req any) map[string]any {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	summary := make(map[string]any)
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor,
+		v protoreflect.Value) bool {
+
+		name := string(fd.Name())
+		switch {
+		case fd.IsList():
+			summary[name] = v.List().Len()
+
+		case fd.IsMap():
+			summary[name] = v.Map().Len()
+
+		case fd.Kind() == protoreflect.MessageKind,
+			fd.Kind() == protoreflect.GroupKind:
+
+		case fd.Kind() == protoreflect.BytesKind:
+			summary[name] = hex.EncodeToString(v.Bytes())
+
+		case fd.Kind() == protoreflect.EnumKind:
+			enum := fd.Enum().Values().ByNumber(v.Enum())
+			if enum == nil {
+				summary[name] = int32(v.Enum())
+				break
+			}
+			summary[name] = string(enum.Name())
+
+		default:
+			summary[name] = v.Interface()
+		}
+
+		return true
+	})
+
+	return summary
+}
+
+// checkPolicy asks the external authorization policy whether the request to
+// the given method is allowed, if the hook is enabled.
+func (s *externalCoordinatorServer) checkPolicy(ctx context.Context,
+	method string, req any) error {
+
+	if s.authz == nil {
+		return nil
+	}
+
+	input := &authzInput{
+		Method:    method,
+		RequestID: requestIDFromContext(ctx),
+		Identity:  authzIdentity{Client: clientIdentity(ctx)},
+		Request:   summarizeRequest(req),
+	}
+	if scope := accessScopeFromContext(ctx); scope != nil {
+		input.Identity.Owner = scope.owner
+		input.Identity.ReadOnly = scope.readOnly
+	}
+
+	allow, reason, err := s.authz.decide(ctx, input)
+	switch {
+	case err != nil:
+		authzDecisions.WithLabelValues(authzFailed).Inc()
+		logrus.Warnf("Authorization policy failed to decide on %s: %v",
+			method, err)
+		if s.authz.failOpen {
+			return nil
+		}
+
+		return status.Error(codes.Unavailable, "authorization policy "+
+			"unavailable")
+
+	case !allow:
+		authzDecisions.WithLabelValues(authzDenied).Inc()
+		if reason == "" {
+			return status.Error(codes.PermissionDenied, "denied "+
+				"by authorization policy")
+		}
+
+		return status.Errorf(codes.PermissionDenied, "denied by "+
+			"authorization policy: %s", reason)
+	}
+	authzDecisions.WithLabelValues(authzAllowed).Inc()
+
+	return nil
+}
+
+// policyUnary is a unary interceptor authorizing requests with the external
+// authorization policy if enabled.
+func (s *externalCoordinatorServer) policyUnary(ctx context.Context,
+	req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	if err := s.checkPolicy(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// policyServerStream wraps a server stream to authorize the stream with the
+// external authorization policy once its request is received.
+type policyServerStream struct {
+	grpc.ServerStream
+	server  *externalCoordinatorServer
+	method  string
+	checked bool
+}
+
+// RecvMsg receives a message of the client and authorizes the stream with the
+// first one.
+func (p *policyServerStream) RecvMsg(m any) error {
+	if err := p.ServerStream.RecvMsg(m); err != nil || p.checked {
+		return err
+	}
+	p.checked = true
+
+	return p.server.checkPolicy(p.Context(), p.method, m)
+}
+
+// policyStream is a stream interceptor authorizing requests with the external
+// authorization policy if enabled. The request of a stream is only received
+// by its handler, so the stream is authorized once the handler receives it.
+func (s *externalCoordinatorServer) policyStream(srv any,
+	ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	if s.authz == nil {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &policyServerStream{
+		ServerStream: ss,
+		server:       s,
+		method:       info.FullMethod,
+	})
+}
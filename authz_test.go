@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// TestAuthzHook tests that requests are authorized by the external policy
+// with their method, identity and summary, and that failures of the policy
+// reject them unless failing open.
+func TestAuthzHook(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inputs   []authzInput
+		decision string
+		code     = http.StatusOK
+	)
+	policy := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Input authzInput `json:"input"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			inputs = append(inputs, body.Input)
+			w.WriteHeader(code)
+			_, _ = w.Write([]byte(decision))
+		},
+	))
+	defer policy.Close()
+	decide := func(body string, status int) {
+		mu.Lock()
+		defer mu.Unlock()
+		decision, code = body, status
+	}
+	lastInput := func() authzInput {
+		mu.Lock()
+		defer mu.Unlock()
+		return inputs[len(inputs)-1]
+	}
+
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.AuthzURL = policy.URL + "/v1/data/ec/allow"
+	config.Server.AuthzTimeout = time.Second
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartAuthzHook())
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.policyUnary),
+		grpc.StreamInterceptor(server.policyStream),
+	)
+	ecrpc.RegisterExternalCoordinatorServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := ecrpc.NewExternalCoordinatorClient(conn)
+
+	nodeA, nodeB := generateTestKeys(t)
+	register := func() error {
+		_, err := client.RegisterMissionControl(
+			context.Background(), &ecrpc.RegisterMissionControlRequest{
+				Network: "mainnet",
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeB,
+					History: &ecrpc.PairData{
+						SuccessTime:    time.Now().Unix(),
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+			},
+		)
+		return err
+	}
+	query := func() error {
+		stream, err := client.QueryAggregatedMissionControl(
+			context.Background(),
+			&ecrpc.QueryAggregatedMissionControlRequest{},
+		)
+		require.NoError(t, err)
+		for {
+			_, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Allowed requests are served, and the policy learns their method,
+	// client and summary.
+	decide(`{"result": true}`, http.StatusOK)
+	require.NoError(t, register())
+	input := lastInput()
+	require.Equal(t, registerMethod, input.Method)
+	require.Equal(t, "127.0.0.1", input.Identity.Client)
+	require.EqualValues(t, 1, input.Request["pairs"])
+	require.Equal(t, "mainnet", input.Request["network"])
+
+	// Streams are authorized with their request.
+	require.NoError(t, query())
+	require.Equal(
+		t, ecrpc.
+			ExternalCoordinator_QueryAggregatedMissionControl_FullMethodName,
+		lastInput().Method,
+	)
+
+	// Denied requests are rejected with the reason of the policy.
+	decide(`{"result": {"allow": false, "reason": "no writes"}}`,
+		http.StatusOK)
+	err = register()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "no writes")
+	require.Equal(t, codes.PermissionDenied, status.Code(query()))
+
+	// Requests the policy is undefined for are denied.
+	decide(`{}`, http.StatusOK)
+	require.Equal(t, codes.PermissionDenied, status.Code(register()))
+
+	// Requests are rejected if the policy fails to decide, unless failing
+	// open.
+	decide(`{"result": true}`, http.StatusInternalServerError)
+	require.Equal(t, codes.Unavailable, status.Code(register()))
+	decide(`{"result": "yes"}`, http.StatusOK)
+	require.Equal(t, codes.Unavailable, status.Code(query()))
+
+	server.authz.failOpen = true
+	require.NoError(t, register())
+}
+
+// TestStartAuthzHook tests that invalid hook configurations are rejected.
+func TestStartAuthzHook(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	server := NewExternalCoordinatorServer(config, nil)
+
+	config.Server.AuthzTimeout = time.Second
+	for _, u := range []string{"localhost:8181", "ftp://host/", "http://"} {
+		config.Server.AuthzURL = u
+		require.Error(t, server.StartAuthzHook(), u)
+	}
+
+	config.Server.AuthzURL = "https://policy.example.com/v1/data/ec"
+	config.Server.AuthzTimeout = 0
+	require.Error(t, server.StartAuthzHook())
+}
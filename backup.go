@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	logrus "github.com/sirupsen/logrus"
+	bbolt "go.etcd.io/bbolt"
+)
+
+const (
+	// backupFilePrefix is the prefix of the filenames of backups.
+	backupFilePrefix = "ec-backup-"
+
+	// backupFileSuffix is the suffix of the filenames of unencrypted
+	// backups.
+	backupFileSuffix = ".db"
+
+	// encryptedBackupSuffix is appended to the filenames of encrypted
+	// backups, following the convention of the age tool.
+	encryptedBackupSuffix = ".age"
+
+	// backupTimeFormat is the format of the time within the filenames of
+	// backups. Filenames sort in the order the backups were written.
+	backupTimeFormat = "20060102T150405Z"
+)
+
+// parseBackupRecipient parses the age X25519 recipient backups are encrypted
+// to. It returns nil if no recipient is configured, in which case backups are
+// written unencrypted.
+func parseBackupRecipient(recipient string) (age.Recipient, error) {
+	if recipient == "" {
+		return nil, nil
+	}
+
+	r, err := age.ParseX25519Recipient(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup recipient: %v", err)
+	}
+
+	return r, nil
+}
+
+// isBackupFile returns whether the filename is the one of a backup.
+func isBackupFile(name string) bool {
+	return strings.HasPrefix(name, backupFilePrefix) &&
+		(strings.HasSuffix(name, backupFileSuffix) ||
+			strings.HasSuffix(name, backupFileSuffix+
+				encryptedBackupSuffix))
+}
+
+// listBackups returns the filenames of the backups in the directory, oldest
+// first.
+func listBackups(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && isBackupFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// backupTime returns the time the backup with the given filename was written.
+func backupTime(name string) (time.Time, error) {
+	stamp := strings.TrimPrefix(name, backupFilePrefix)
+	stamp = strings.TrimSuffix(stamp, encryptedBackupSuffix)
+	stamp = strings.TrimSuffix(stamp, backupFileSuffix)
+
+	return time.Parse(backupTimeFormat, stamp)
+}
+
+// writeBackup writes a consistent copy of the database into the directory,
+// encrypted to the recipient if not nil. The backup is written to a temporary
+// file that is only renamed once complete, so an interrupted backup is never
+// mistaken for a complete one. It returns the path of the backup.
+func writeBackup(db *bbolt.DB, dir string, recipient age.Recipient,
+	now time.Time) (string, error) {
+	name := backupFilePrefix + now.UTC().Format(backupTimeFormat) +
+		backupFileSuffix
+	if recipient != nil {
+		name += encryptedBackupSuffix
+	}
+	path := filepath.Join(dir, name)
+
+	tmp, err := os.CreateTemp(dir, "."+name+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		// The temporary file no longer exists once it was renamed.
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	var w io.WriteCloser = tmp
+	if recipient != nil {
+		w, err = age.Encrypt(tmp, recipient)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = db.View(func(tx *bbolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Closing the encrypting writer flushes the last chunk to the file,
+	// which is closed separately.
+	if recipient != nil {
+		if err := w.Close(); err != nil {
+			return "", err
+		}
+	}
+	if err := tmp.Sync(); err != nil {
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// pruneBackups removes the oldest backups in the directory exceeding the
+// maximum number of backups. A maximum of zero keeps all backups.
+func pruneBackups(dir string, maxBackups int) error {
+	if maxBackups <= 0 {
+		return nil
+	}
+
+	names, err := listBackups(dir)
+	if err != nil {
+		return err
+	}
+
+	for len(names) > maxBackups {
+		if err := os.Remove(filepath.Join(dir, names[0])); err != nil {
+			return err
+		}
+		logrus.Infof("Removed old backup %s", names[0])
+		names = names[1:]
+	}
+
+	return nil
+}
+
+// backup writes a backup of the database and prunes the old ones. The
+// operator is notified if it fails.
+func (s *externalCoordinatorServer) backup(recipient age.Recipient) error {
+	config := &s.config.Database
+	path, err := writeBackup(
+		s.db, config.BackupDirPath, recipient, s.clock.Now(),
+	)
+	if err == nil {
+		logrus.Infof("Wrote backup of the database to %s", path)
+		err = pruneBackups(config.BackupDirPath, config.MaxBackups)
+	}
+	if err != nil {
+		s.notifications.notify(
+			eventBackupFailure, "Backup of the database failed: %v",
+			err,
+		)
+		return err
+	}
+	s.notifications.resolve(eventBackupFailure)
+
+	return nil
+}
+
+// RunBackupRoutine runs a routine writing a backup of the database into the
+// configured backup directory on every backup interval. A backup is written
+// right away if the latest one is older than the interval, so that frequent
+// restarts don't delay backups indefinitely. It does nothing if backups are
+// disabled.
+func (s *externalCoordinatorServer) RunBackupRoutine(
+	ctx context.Context) error {
+	config := &s.config.Database
+	if config.BackupDirPath == "" {
+		return nil
+	}
+	if config.BackupInterval <= 0 {
+		return fmt.Errorf("backup interval must be positive")
+	}
+
+	recipient, err := parseBackupRecipient(config.BackupRecipient)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(config.BackupDirPath, DatabaseDirPermissions)
+	if err != nil {
+		return err
+	}
+
+	names, err := listBackups(config.BackupDirPath)
+	if err != nil {
+		return err
+	}
+	due := len(names) == 0
+	if !due {
+		latest, err := backupTime(names[len(names)-1])
+		due = err != nil ||
+			s.clock.Now().Sub(latest) >= config.BackupInterval
+	}
+
+	logrus.Infof("Backup routine started to back up the database to %s "+
+		"every %s (encrypted: %v)", config.BackupDirPath,
+		formatDuration(config.BackupInterval), recipient != nil)
+
+	go func() {
+		if due {
+			if err := s.backup(recipient); err != nil {
+				logrus.Errorf("Failed to back up database: %v",
+					err)
+			}
+		}
+
+		ticker := time.NewTicker(config.BackupInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-ticker.C:
+				if err := s.backup(recipient); err != nil {
+					logrus.Errorf("Failed to back up "+
+						"database: %v", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestBackup tests that backups of the database are written, optionally
+// encrypted, and pruned.
+func TestBackup(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(MetadataBucketName)).Put(
+			[]byte("backup-test"), []byte("value"),
+		)
+	})
+	require.NoError(t, err)
+
+	// openBackup opens the database backed up into the given file and
+	// returns the test value stored in it.
+	openBackup := func(r io.Reader) string {
+		path := filepath.Join(t.TempDir(), "restored.db")
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0600))
+
+		restored, err := bbolt.Open(path, 0600, nil)
+		require.NoError(t, err)
+		defer restored.Close()
+
+		var value string
+		err = restored.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(MetadataBucketName))
+			value = string(b.Get([]byte("backup-test")))
+			return nil
+		})
+		require.NoError(t, err)
+
+		return value
+	}
+
+	dir := t.TempDir()
+	now := time.Unix(1700000000, 0)
+
+	// Unencrypted backups are plain database files.
+	path, err := writeBackup(db, dir, nil, now)
+	require.NoError(t, err)
+	require.Equal(t, "ec-backup-20231114T221320Z.db", filepath.Base(path))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	require.Equal(t, "value", openBackup(f))
+	f.Close()
+
+	// Encrypted backups can only be restored with the identity of the
+	// recipient.
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	recipient, err := parseBackupRecipient(
+		identity.Recipient().String(),
+	)
+	require.NoError(t, err)
+	path, err = writeBackup(db, dir, recipient, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "ec-backup-20231114T231320Z.db.age",
+		filepath.Base(path))
+
+	other, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	f, err = os.Open(path)
+	require.NoError(t, err)
+	_, err = age.Decrypt(f, other)
+	require.Error(t, err)
+	f.Close()
+
+	f, err = os.Open(path)
+	require.NoError(t, err)
+	r, err := age.Decrypt(f, identity)
+	require.NoError(t, err)
+	require.Equal(t, "value", openBackup(r))
+	f.Close()
+
+	// The oldest backups are pruned.
+	_, err = writeBackup(db, dir, nil, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, pruneBackups(dir, 2))
+	names, err := listBackups(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ec-backup-20231114T231320Z.db.age",
+		"ec-backup-20231115T001320Z.db",
+	}, names)
+
+	_, err = parseBackupRecipient("age1invalid")
+	require.Error(t, err)
+}
+
+// TestBackupRoutine tests that the backup routine writes a backup right away
+// if none is recent enough and rejects invalid recipients.
+func TestBackupRoutine(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Database.BackupDirPath = filepath.Join(t.TempDir(), "backups")
+	config.Database.BackupInterval = time.Hour
+	config.Database.BackupRecipient = "age1invalid"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.Error(t, server.RunBackupRoutine(ctx))
+
+	config.Database.BackupRecipient = ""
+	require.NoError(t, server.RunBackupRoutine(ctx))
+	require.Eventually(t, func() bool {
+		names, err := listBackups(config.Database.BackupDirPath)
+		return err == nil && len(names) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
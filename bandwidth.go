@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecerrors "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecerrors"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// retryAfterHeader is the header metadata key telling clients exceeding their
+// egress cap after how many seconds to retry. The REST gateway forwards it as
+// the Retry-After HTTP header.
+const retryAfterHeader = "retry-after"
+
+// queryEgressBytes counts the bytes of query responses served to clients.
+var queryEgressBytes = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Name:      "query_egress_bytes_total",
+	Help:      "Bytes of query responses served to clients.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(queryEgressBytes)
+}
+
+// egressTracker accounts the bytes served to each client during the current
+// UTC day. The accounting is only kept in memory, so no client data is
+// persisted.
+type egressTracker struct {
+	mu    sync.Mutex
+	day   int64
+	bytes map[string]int64
+}
+
+// newEgressTracker creates an empty egress tracker.
+func newEgressTracker() *egressTracker {
+	return &egressTracker{bytes: make(map[string]int64)}
+}
+
+// utcDay returns the number of the UTC day of the given time.
+func utcDay(now time.Time) int64 {
+	return now.Unix() / int64(24*time.Hour/time.Second)
+}
+
+// untilNextUTCDay returns the duration until the next UTC day starts.
+func untilNextUTCDay(now time.Time) time.Duration {
+	next := time.Unix((utcDay(now)+1)*int64(24*time.Hour/time.Second), 0)
+	return next.Sub(now)
+}
+
+// resetLocked starts a new accounting period if the UTC day changed. The
+// mutex must be held.
+func (t *egressTracker) resetLocked(now time.Time) {
+	if day := utcDay(now); day != t.day {
+		t.day = day
+		t.bytes = make(map[string]int64)
+	}
+}
+
+// add accounts the given number of bytes served to the client.
+func (t *egressTracker) add(client string, now time.Time, n int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.resetLocked(now)
+	t.bytes[client] += n
+}
+
+// used returns the number of bytes served to the client during the current
+// UTC day.
+func (t *egressTracker) used(client string, now time.Time) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.resetLocked(now)
+
+	return t.bytes[client]
+}
+
+// meteredQueryStream counts the bytes of the responses sent on a query
+// stream.
+type meteredQueryStream struct {
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer
+	bytes atomic.Int64
+}
+
+// Send counts the size of the response and sends it.
+func (m *meteredQueryStream) Send(
+	resp *ecrpc.QueryAggregatedMissionControlResponse) error {
+	err := m.ExternalCoordinator_QueryAggregatedMissionControlServer.Send(
+		resp,
+	)
+	if err == nil {
+		m.bytes.Add(int64(proto.Size(resp)))
+	}
+
+	return err
+}
+
+// checkEgressCap returns a ResourceExhausted error if the client already
+// reached its daily egress cap. The error is preceded by header metadata
+// telling the client when to retry.
+func (s *externalCoordinatorServer) checkEgressCap(ctx context.Context,
+	client string) error {
+	limit := s.config.Server.ClientDailyEgressCap
+	if limit <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	used := s.egress.used(client, now)
+	if used < limit {
+		return nil
+	}
+
+	// Round the retry delay up to whole seconds, so that clients never
+	// retry before the cap is reset.
+	retryAfter := (untilNextUTCDay(now) + time.Second - 1) / time.Second
+	md := metadata.Pairs(
+		retryAfterHeader, strconv.FormatInt(int64(retryAfter), 10),
+	)
+	if err := grpc.SendHeader(ctx, md); err != nil {
+		logrus.Debugf("Failed to send retry-after header: %v", err)
+	}
+
+	return ecerrors.QuotaExceeded("daily egress cap of %d bytes reached, "+
+		"retry after %d seconds", limit, retryAfter)
+}
+
+// recordEgress accounts the bytes served to the client.
+func (s *externalCoordinatorServer) recordEgress(client string, n int64) {
+	queryEgressBytes.Add(float64(n))
+	s.egress.add(client, time.Now(), n)
+}
+
+// restOutgoingHeaderMatcher maps the header metadata of responses to HTTP
+// headers of the REST gateway. The retry-after and request ID metadata are
+// forwarded as the standard Retry-After and X-Request-Id headers, all other
+// metadata keeps the default prefix.
+func restOutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case retryAfterHeader, requestIDHeader:
+		return http.CanonicalHeaderKey(key), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecerrors "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecerrors"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestEgressTracker tests that the bytes served are accounted per client and
+// reset every UTC day.
+func TestEgressTracker(t *testing.T) {
+	tracker := newEgressTracker()
+	day := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+
+	tracker.add("a", day, 100)
+	tracker.add("a", day, 50)
+	tracker.add("b", day, 10)
+	require.EqualValues(t, 150, tracker.used("a", day))
+	require.EqualValues(t, 10, tracker.used("b", day))
+
+	nextDay := day.Add(2 * time.Hour)
+	require.Zero(t, tracker.used("a", nextDay))
+
+	require.Equal(t, time.Hour, untilNextUTCDay(day))
+}
+
+// TestClientDailyEgressCap tests that clients reaching their daily egress cap
+// are rejected while other clients are still served.
+func TestClientDailyEgressCap(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(addr string) error {
+		return server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{},
+			&peerQueryStream{addr: addr},
+		)
+	}
+
+	// Without a cap the bytes served are only accounted.
+	require.NoError(t, query("203.0.113.1"))
+	used := server.egress.used("203.0.113.1", time.Now())
+	require.Positive(t, used)
+
+	// The first query reaching the cap is still served, later ones are
+	// rejected.
+	config.Server.ClientDailyEgressCap = 2 * used
+	require.NoError(t, query("203.0.113.1"))
+	err = query("203.0.113.1")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.ErrorIs(t, err, ecerrors.ErrQuotaExceeded)
+
+	require.NoError(t, query("203.0.113.2"))
+}
+
+// TestRESTOutgoingHeaderMatcher tests that the retry-after and request ID
+// metadata are forwarded as the standard headers.
+func TestRESTOutgoingHeaderMatcher(t *testing.T) {
+	header, ok := restOutgoingHeaderMatcher(retryAfterHeader)
+	require.True(t, ok)
+	require.Equal(t, "Retry-After", header)
+
+	header, ok = restOutgoingHeaderMatcher(requestIDHeader)
+	require.True(t, ok)
+	require.Equal(t, "X-Request-Id", header)
+
+	header, ok = restOutgoingHeaderMatcher("other")
+	require.True(t, ok)
+	require.Equal(t, "Grpc-Metadata-other", header)
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// bootstrapFrom is the gRPC address of the coordinator a fresh
+	// database is seeded from.
+	bootstrapFrom = flag.String("bootstrap-from", "", "The gRPC "+
+		"address (host:port) of an existing coordinator to seed a "+
+		"fresh database from before serving. It is ignored if the "+
+		"database already holds mission control data.")
+
+	// bootstrapTLSCert is the TLS certificate used to verify the
+	// coordinator a fresh database is seeded from.
+	bootstrapTLSCert = flag.String("bootstrap-tls-cert", "", "The path "+
+		"of the TLS certificate used to verify the coordinator to "+
+		"bootstrap from. Leave empty to verify it using the system "+
+		"certificate pool.")
+
+	// bootstrapTimeout is the maximum duration of seeding a fresh
+	// database.
+	bootstrapTimeout = flag.Duration("bootstrap-timeout", 30*time.Minute,
+		"The maximum duration of seeding a fresh database from another "+
+			"coordinator.")
+)
+
+// bootstrapKey marks a bootstrap in progress within the metadata bucket. A
+// database holding it is only partially seeded, so the bootstrap is started
+// over on the next start.
+var bootstrapKey = []byte("bootstrap")
+
+// needsBootstrap returns whether the database is fresh, i.e. holds no mission
+// control data, or a previous bootstrap was interrupted.
+func needsBootstrap(tx *bbolt.Tx) bool {
+	if tx.Bucket([]byte(MetadataBucketName)).Get(bootstrapKey) != nil {
+		return true
+	}
+
+	k, _ := tx.Bucket([]byte(DatabaseBucketName)).Cursor().First()
+
+	return k == nil
+}
+
+// bootstrapDatabase seeds a fresh database with the mission control data of
+// the coordinator at the given address. The data is pulled with a regular
+// compressed query and stored batch by batch as it arrives, so the whole
+// snapshot is never held in memory. It does nothing if the database already
+// holds mission control data. The number of pairs stored so far is reported to
+// the startup progress if given. It returns the number of pairs stored.
+func bootstrapDatabase(ctx context.Context, db *bbolt.DB, config *Config,
+	target, certFile string, progress *startupProgress) (int, error) {
+	var fresh bool
+	err := db.View(func(tx *bbolt.Tx) error {
+		fresh = needsBootstrap(tx)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !fresh {
+		logrus.Infof("Database already holds mission control data, " +
+			"skipping bootstrap")
+		return 0, nil
+	}
+
+	creds := credentials.NewTLS(&tls.Config{})
+	if certFile != "" {
+		creds, err = credentials.NewClientTLSFromFile(certFile, "")
+		if err != nil {
+			return 0, fmt.Errorf("failed to load bootstrap TLS "+
+				"certificate: %v", err)
+		}
+	}
+
+	conn, err := grpc.NewClient(
+		target, grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create bootstrap client: %v",
+			err)
+	}
+	defer conn.Close()
+	client := ecrpc.NewExternalCoordinatorClient(conn)
+
+	// Never mix data of different networks. Coordinators not reporting
+	// their network are trusted to collect data for the same one.
+	info, err := client.GetInfo(ctx, &ecrpc.GetInfoRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get bootstrap coordinator "+
+			"info: %v", err)
+	}
+	network, _ := parseNetwork(config.Server.Network)
+	if info.Network != "" && info.Network != network {
+		return 0, fmt.Errorf("bootstrap coordinator collects %s data, "+
+			"but this coordinator collects %s data", info.Network,
+			network)
+	}
+
+	// Mark the bootstrap as in progress and drop any data stored by an
+	// interrupted one.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket([]byte(MetadataBucketName))
+		if err := meta.Put(bootstrapKey, []byte(target)); err != nil {
+			return err
+		}
+
+		buckets := []string{
+			DatabaseBucketName, LatencySamplesBucketName,
+			UpdateIndexBucketName, NodeIndexBucketName,
+			MerkleEntriesBucketName, MerkleLeavesBucketName,
+			PairObservationsBucketName, PairSubmittersBucketName,
+			JournalBucketName,
+		}
+		for _, bucket := range buckets {
+			if err := tx.DeleteBucket([]byte(bucket)); err != nil {
+				return err
+			}
+			if _, err := tx.CreateBucket([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+
+		if err := bumpDatasetRevision(tx); err != nil {
+			return err
+		}
+		if err := resetModifiedIndex(tx); err != nil {
+			return err
+		}
+
+		return resetRevisionLog(tx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	stream, err := client.QueryAggregatedMissionControl(
+		ctx, &ecrpc.QueryAggregatedMissionControlRequest{},
+		grpc.UseCompressor(gzip.Name),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query bootstrap coordinator: "+
+			"%v", err)
+	}
+
+	stored := 0
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stored, fmt.Errorf("failed to receive pairs from "+
+				"bootstrap coordinator: %v", err)
+		}
+
+		err = db.Update(func(tx *bbolt.Tx) error {
+			return storeBootstrapPairs(tx, resp.Pairs)
+		})
+		if err != nil {
+			return stored, err
+		}
+		stored += len(resp.Pairs)
+		if progress != nil {
+			progress.advance(uint64(stored))
+		}
+
+		logrus.Infof("Bootstrapped %d pairs", stored)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket([]byte(MetadataBucketName))
+		return meta.Delete(bootstrapKey)
+	})
+	if err != nil {
+		return stored, err
+	}
+
+	return stored, nil
+}
+
+// storeBootstrapPairs stores the pairs received from the bootstrap coordinator
+// as they are, since they are already aggregated.
+func storeBootstrapPairs(tx *bbolt.Tx, pairs []*ecrpc.PairHistory) error {
+	for _, pair := range pairs {
+		if len(pair.NodeFrom) != PubKeyCompressedSize ||
+			len(pair.NodeTo) != PubKeyCompressedSize ||
+			pair.History == nil {
+
+			return fmt.Errorf("bootstrap coordinator sent an " +
+				"invalid pair")
+		}
+
+		// Observed latencies are never stored as is.
+		history := proto.Clone(pair.History).(*ecrpc.PairData)
+		history.ResolutionLatencyMs = 0
+
+		data, err := encodePairData(history)
+		if err != nil {
+			return err
+		}
+		key := pairKey(pair.NodeFrom, pair.NodeTo)
+		if err := putPair(tx, key, data); err != nil {
+			return err
+		}
+	}
+
+	return bumpDatasetRevision(tx)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TestBootstrapDatabase tests that a fresh database is seeded with the data
+// of another coordinator, and that databases holding data are left untouched.
+func TestBootstrapDatabase(t *testing.T) {
+	tempDir := t.TempDir()
+	certFile := filepath.Join(tempDir, "tls.cert")
+	keyFile := filepath.Join(tempDir, "tls.key")
+	require.NoError(t, generateSelfSignedTLS(certFile, keyFile))
+
+	// Start the source coordinator serving its data in batches of a single
+	// pair.
+	sourceConfig := MockConfig(filepath.Join(tempDir, "source"))
+	sourceConfig.Server.HistoryThresholdDuration = time.Hour
+	sourceConfig.Server.QueryMissionControlBatchSize = 1
+	sourceDB, err := setupDatabase(sourceConfig)
+	require.NoError(t, err)
+	defer cleanupDB(sourceDB)
+	source := NewExternalCoordinatorServer(sourceConfig, sourceDB)
+
+	nodeA, nodeB := generateTestKeys(t)
+	pair := func(nodeFrom, nodeTo []byte) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	_, err = source.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB), pair(nodeB, nodeA),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	port, err := getFreePort()
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(
+		&tls.Config{Certificates: []tls.Certificate{cert}},
+	)))
+	ecrpc.RegisterExternalCoordinatorServer(grpcServer, source)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+	target := fmt.Sprintf("localhost:%d", port)
+
+	config := MockConfig(filepath.Join(tempDir, "target"))
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	// The fresh database is seeded with all pairs, which are reported as
+	// startup progress.
+	var progress startupProgress
+	progress.start()
+	progress.begin(startupPhaseBootstrap, 0)
+	stored, err := bootstrapDatabase(
+		context.Background(), db, config, target, certFile, &progress,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, stored)
+	require.EqualValues(t, 2, progress.info().Completed)
+	require.Equal(t, storedPairKeys(t, sourceDB), storedPairKeys(t, db))
+
+	// Databases holding data are not seeded again.
+	stored, err = bootstrapDatabase(
+		context.Background(), db, config, target, certFile, nil,
+	)
+	require.NoError(t, err)
+	require.Zero(t, stored)
+
+	// Interrupted bootstraps are started over.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket([]byte(MetadataBucketName))
+		return meta.Put(bootstrapKey, []byte(target))
+	})
+	require.NoError(t, err)
+	stored, err = bootstrapDatabase(
+		context.Background(), db, config, target, certFile, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, stored)
+
+	// Data of another network is never bootstrapped.
+	testnetConfig := MockConfig(filepath.Join(tempDir, "testnet"))
+	testnetConfig.Server.Network = "testnet"
+	testnetDB, err := setupDatabase(testnetConfig)
+	require.NoError(t, err)
+	defer cleanupDB(testnetDB)
+	_, err = bootstrapDatabase(
+		context.Background(), testnetDB, testnetConfig, target,
+		certFile, nil,
+	)
+	require.ErrorContains(t, err, "mainnet data")
+}
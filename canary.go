@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// canaryKey is the key of the canary record within the metadata bucket. It
+// holds the seed the canary pairs are derived from followed by their
+// big-endian number.
+var canaryKey = []byte("canaries")
+
+// canarySeedSize is the size in bytes of the seed the canary pairs are derived
+// from.
+const canarySeedSize = 32
+
+// canaryViolations reports the number of canary pairs found modified,
+// deleted or unexpected by the last check.
+var canaryViolations = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: metricsNamespace,
+	Name:      "canary_violations",
+	Help: "Canary pairs found modified, deleted or unexpected by the " +
+		"last integrity check.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(canaryViolations)
+}
+
+// canaryPair derives the synthetic canary pair with the given index from the
+// seed. The pairs are made up and never served to clients, so their nodes do
+// not have to be valid pubkeys.
+func canaryPair(seed []byte, index uint64) *ecrpc.PairHistory {
+	from := sha256.Sum256(binary.BigEndian.AppendUint64(
+		bytes.Clone(seed), index,
+	))
+	to := sha256.Sum256(from[:])
+	amounts := sha256.Sum256(to[:])
+
+	number := func(offset int) int64 {
+		return int64(binary.BigEndian.Uint32(amounts[offset:]))
+	}
+	successAmtMsat := number(0)
+	failAmtMsat := successAmtMsat + number(4)%mSatScale + 1
+
+	return &ecrpc.PairHistory{
+		NodeFrom: append([]byte{0x02}, from[:]...),
+		NodeTo:   append([]byte{0x03}, to[:]...),
+		History: &ecrpc.PairData{
+			FailTime:       number(8),
+			FailAmtSat:     failAmtMsat / mSatScale,
+			FailAmtMsat:    failAmtMsat,
+			SuccessTime:    number(12),
+			SuccessAmtSat:  successAmtMsat / mSatScale,
+			SuccessAmtMsat: successAmtMsat,
+		},
+	}
+}
+
+// plantCanaries plants the given number of canary pairs with a fresh seed
+// unless the same number is planted already, so that tampering is never
+// covered up by a restart. Changing the number replants all canary pairs, and
+// a number of zero removes them.
+func plantCanaries(tx *bbolt.Tx, count int) error {
+	if count < 0 {
+		return fmt.Errorf("canary pairs must not be negative")
+	}
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	if record := meta.Get(canaryKey); len(record) == canarySeedSize+8 &&
+		binary.BigEndian.Uint64(record[canarySeedSize:]) ==
+			uint64(count) {
+
+		return nil
+	}
+
+	if err := tx.DeleteBucket([]byte(CanaryBucketName)); err != nil &&
+		err != bbolt.ErrBucketNotFound {
+
+		return err
+	}
+	if count == 0 {
+		return meta.Delete(canaryKey)
+	}
+
+	b, err := tx.CreateBucket([]byte(CanaryBucketName))
+	if err != nil {
+		return err
+	}
+	seed := make([]byte, canarySeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return err
+	}
+	for i := uint64(0); i < uint64(count); i++ {
+		data, err := proto.Marshal(canaryPair(seed, i))
+		if err != nil {
+			return err
+		}
+		if err := b.Put(encodeUint64(i), data); err != nil {
+			return err
+		}
+	}
+	logrus.Infof("Planted %d canary pairs", count)
+
+	return meta.Put(
+		canaryKey, binary.BigEndian.AppendUint64(seed, uint64(count)),
+	)
+}
+
+// verifyCanaries compares the stored canary pairs with the ones derived from
+// their seed. It returns a description of every canary pair modified, deleted
+// or unexpected.
+func verifyCanaries(tx *bbolt.Tx) ([]string, error) {
+	var violations []string
+	record := tx.Bucket([]byte(MetadataBucketName)).Get(canaryKey)
+	b := tx.Bucket([]byte(CanaryBucketName))
+	if len(record) != canarySeedSize+8 {
+		violations = append(violations, "the canary seed was "+
+			"modified or deleted")
+		return violations, nil
+	}
+	if b == nil {
+		violations = append(violations, "the canary bucket was "+
+			"deleted")
+		return violations, nil
+	}
+	seed := record[:canarySeedSize]
+	count := binary.BigEndian.Uint64(record[canarySeedSize:])
+
+	for i := uint64(0); i < count; i++ {
+		v := b.Get(encodeUint64(i))
+		if v == nil {
+			violations = append(violations, fmt.Sprintf("canary "+
+				"pair %d was deleted", i))
+			continue
+		}
+
+		stored := &ecrpc.PairHistory{}
+		err := proto.Unmarshal(v, stored)
+		if err != nil || !proto.Equal(stored, canaryPair(seed, i)) {
+			violations = append(violations, fmt.Sprintf("canary "+
+				"pair %d was modified", i))
+		}
+	}
+
+	err := b.ForEach(func(k, _ []byte) error {
+		if len(k) != 8 || decodeUint64(k) >= count {
+			violations = append(violations, fmt.Sprintf(
+				"unexpected canary pair %x was inserted", k,
+			))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return violations, nil
+}
+
+// checkCanaries notifies the operator if any canary pair was modified,
+// deleted or inserted, which the coordinator never does itself, e.g. due to
+// storage corruption or a direct manipulation of the database.
+func (s *externalCoordinatorServer) checkCanaries() {
+	var violations []string
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		violations, err = verifyCanaries(tx)
+		return err
+	})
+	if err != nil {
+		logrus.Errorf("Failed to verify canary pairs: %v", err)
+		return
+	}
+	canaryViolations.Set(float64(len(violations)))
+
+	if len(violations) == 0 {
+		s.notifications.resolve(eventCanaryViolation)
+		return
+	}
+
+	for _, violation := range violations {
+		logrus.Errorf("Integrity check failed: %s", violation)
+	}
+	s.notifications.notify(eventCanaryViolation, "%d canary pairs were "+
+		"tampered with, the database may be corrupted or manipulated: "+
+		"%s", len(violations), strings.Join(violations, "; "))
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestCanaries tests that canary pairs are planted once and that their
+// modification, deletion or insertion is notified.
+func TestCanaries(t *testing.T) {
+	tempDir := t.TempDir()
+	config := MockConfig(tempDir)
+	config.Server.CanaryPairs = 3
+	config.Notify = testNotifyConfig()
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+
+	violations := func(db *bbolt.DB) []string {
+		var violations []string
+		err := db.View(func(tx *bbolt.Tx) error {
+			var err error
+			violations, err = verifyCanaries(tx)
+			return err
+		})
+		require.NoError(t, err)
+
+		return violations
+	}
+	require.Empty(t, violations(db))
+
+	// Tampering is never covered up by replanting on a restart.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(CanaryBucketName)).Delete(
+			encodeUint64(1),
+		)
+	})
+	require.NoError(t, err)
+	cleanupDB(db)
+
+	db, err = setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+	require.Equal(t, []string{"canary pair 1 was deleted"}, violations(db))
+
+	// Modified and inserted canary pairs are detected as well, and the
+	// operator is notified about them.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(CanaryBucketName))
+		if err := b.Put(encodeUint64(0), []byte{0x0a}); err != nil {
+			return err
+		}
+
+		return b.Put(encodeUint64(7), nil)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"canary pair 0 was modified", "canary pair 1 was deleted",
+		"unexpected canary pair 0000000000000007 was inserted",
+	}, violations(db))
+
+	server := NewExternalCoordinatorServer(config, db)
+	server.notifications, err = newNotificationDispatcher(&config.Notify)
+	require.NoError(t, err)
+	recorder := &recordingNotifier{}
+	server.notifications.targets = []notificationTarget{{
+		name: "recorder", notifier: recorder,
+		minSeverity: severityInfo,
+	}}
+	server.checkCanaries()
+	require.Equal(t, []string{"canary_violation"}, recorder.events())
+
+	// Replanting a different number of canary pairs starts afresh.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return plantCanaries(tx, 5)
+	})
+	require.NoError(t, err)
+	require.Empty(t, violations(db))
+
+	// Canary pairs are removed once disabled.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if err := plantCanaries(tx, 0); err != nil {
+			return err
+		}
+		require.Nil(t, tx.Bucket([]byte(CanaryBucketName)))
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/binary"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+const (
+	// sizeSampleWindow is the period of the size samples the growth rate of
+	// the database is derived from.
+	sizeSampleWindow = 7 * 24 * time.Hour
+
+	// minSizeSampleSpan is the minimum period the size samples must span
+	// before the growth rate is forecast, so that a single burst of
+	// registrations is not extrapolated.
+	minSizeSampleSpan = time.Hour
+
+	// maxSizeSamples is the maximum number of size samples kept, which
+	// bounds their size for short check intervals.
+	maxSizeSamples = 512
+
+	// maxCapacityForecast is the period beyond which the database is not
+	// forecast to run out of space.
+	maxCapacityForecast = 100 * 365 * 24 * time.Hour
+
+	// limitMaxDatabaseSize is the limit of a forecast reaching the
+	// configured maximum database size.
+	limitMaxDatabaseSize = "max_database_size"
+
+	// limitDisk is the limit of a forecast filling the disk.
+	limitDisk = "disk"
+)
+
+// sizeSamplesKey is the key of the recent size samples of the database within
+// the metadata bucket. They are persisted, so that the growth rate survives
+// restarts.
+var sizeSamplesKey = []byte("size_samples")
+
+// sizeSample is the size of the database at a point in time.
+type sizeSample struct {
+	time int64
+	size uint64
+}
+
+// decodeSizeSamples decodes the size samples stored as consecutive pairs of
+// unix timestamps and sizes.
+func decodeSizeSamples(v []byte) []sizeSample {
+	samples := make([]sizeSample, 0, len(v)/16)
+	for ; len(v) >= 16; v = v[16:] {
+		samples = append(samples, sizeSample{
+			time: int64(binary.BigEndian.Uint64(v[:8])),
+			size: binary.BigEndian.Uint64(v[8:16]),
+		})
+	}
+
+	return samples
+}
+
+// encodeSizeSamples encodes the size samples for storage.
+func encodeSizeSamples(samples []sizeSample) []byte {
+	v := make([]byte, 0, len(samples)*16)
+	for _, sample := range samples {
+		v = binary.BigEndian.AppendUint64(v, uint64(sample.time))
+		v = binary.BigEndian.AppendUint64(v, sample.size)
+	}
+
+	return v
+}
+
+// recordSizeSample samples the current size of the database and returns the
+// samples within the sample window.
+func (s *externalCoordinatorServer) recordSizeSample(
+	now time.Time) ([]sizeSample, error) {
+
+	var samples []sizeSample
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket([]byte(MetadataBucketName))
+		cutoff := now.Add(-sizeSampleWindow).Unix()
+		for _, sample := range decodeSizeSamples(
+			meta.Get(sizeSamplesKey),
+		) {
+			if sample.time >= cutoff {
+				samples = append(samples, sample)
+			}
+		}
+		samples = append(samples, sizeSample{
+			time: now.Unix(),
+			size: uint64(tx.Size()),
+		})
+		if len(samples) > maxSizeSamples {
+			samples = samples[len(samples)-maxSizeSamples:]
+		}
+
+		return meta.Put(sizeSamplesKey, encodeSizeSamples(samples))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return samples, nil
+}
+
+// growthRate returns the growth rate of the database in bytes per second as
+// the least squares fit of the samples. It returns false if the samples do
+// not span the minimum period.
+func growthRate(samples []sizeSample) (float64, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0].time, samples[len(samples)-1].time
+	if time.Duration(last-first)*time.Second < minSizeSampleSpan {
+		return 0, false
+	}
+
+	n := float64(len(samples))
+	var sumT, sumS float64
+	for _, sample := range samples {
+		sumT += float64(sample.time - first)
+		sumS += float64(sample.size)
+	}
+	meanT, meanS := sumT/n, sumS/n
+
+	var cov, varT float64
+	for _, sample := range samples {
+		dt := float64(sample.time-first) - meanT
+		cov += dt * (float64(sample.size) - meanS)
+		varT += dt * dt
+	}
+
+	return cov / varT, true
+}
+
+// forecastCapacity forecasts when the database of the given size growing at
+// the given rate in bytes per second reaches the maximum database size or
+// fills the free space of the disk, whichever comes first. A maximum size of
+// zero is ignored.
+func forecastCapacity(now time.Time, size uint64, rate float64,
+	maxSize, freeDisk uint64) *ecrpc.CapacityForecast {
+
+	forecast := &ecrpc.CapacityForecast{
+		DatabaseSizeBytes: size,
+		GrowthBytesPerDay: rate * (24 * time.Hour).Seconds(),
+	}
+	if rate <= 0 {
+		return forecast
+	}
+
+	// The database grows into the free space of the disk.
+	remaining := float64(freeDisk)
+	limit := limitDisk
+	if maxSize > 0 && maxSize <= size {
+		remaining, limit = 0, limitMaxDatabaseSize
+	} else if maxSize > 0 && float64(maxSize-size) < remaining {
+		remaining, limit = float64(maxSize-size), limitMaxDatabaseSize
+	}
+
+	seconds := remaining / rate
+	if seconds >= maxCapacityForecast.Seconds() {
+		return forecast
+	}
+	forecast.ExhaustionTime = now.Add(
+		time.Duration(seconds * float64(time.Second)),
+	).Unix()
+	forecast.Limit = limit
+
+	return forecast
+}
+
+// checkCapacity samples the size of the database, forecasts when it runs out
+// of space at its recent growth rate and notifies the operator if that is
+// within the configured warning period. The forecast is reported by GetInfo.
+func (s *externalCoordinatorServer) checkCapacity() {
+	now := s.clock.Now()
+	samples, err := s.recordSizeSample(now)
+	if err != nil {
+		logrus.Errorf("Failed to sample database size: %v", err)
+		return
+	}
+
+	rate, ok := growthRate(samples)
+	if !ok {
+		return
+	}
+
+	freeDisk, err := freeDiskBytes(s.config.Database.DatabaseDirPath)
+	if err != nil {
+		logrus.Errorf("Failed to check free disk space: %v", err)
+		return
+	}
+
+	size := samples[len(samples)-1].size
+	maxSize := uint64(max(s.config.Database.MaxDatabaseSize, 0))
+	forecast := forecastCapacity(now, size, rate, maxSize, freeDisk)
+
+	warning := s.config.Notify.CapacityWarning
+	if forecast.ExhaustionTime == 0 ||
+		time.Unix(forecast.ExhaustionTime, 0).Sub(now) > warning {
+
+		s.capacity.Store(forecast)
+		s.notifications.resolve(eventCapacityForecast)
+		return
+	}
+	forecast.Warning = true
+	s.capacity.Store(forecast)
+
+	exhaustion := time.Unix(forecast.ExhaustionTime, 0)
+	target := "the maximum database size"
+	if forecast.Limit == limitDisk {
+		target = "the free space of the disk"
+	}
+	s.notifications.notify(eventCapacityForecast, "The database grows by "+
+		"%.0f bytes per day and is forecast to reach %s in %s on %s",
+		forecast.GrowthBytesPerDay, target,
+		formatDuration(exhaustion.Sub(now)),
+		exhaustion.Format(time.RFC3339))
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestGrowthRate tests that the growth rate is fitted to the size samples
+// once they span the minimum period.
+func TestGrowthRate(t *testing.T) {
+	_, ok := growthRate([]sizeSample{{time: 0, size: 100}})
+	require.False(t, ok)
+
+	_, ok = growthRate([]sizeSample{
+		{time: 0, size: 100}, {time: 60, size: 200},
+	})
+	require.False(t, ok)
+
+	// The noise around a growth of one byte per second is smoothed out.
+	rate, ok := growthRate([]sizeSample{
+		{time: 0, size: 1000},
+		{time: 3600, size: 4700},
+		{time: 7200, size: 8100},
+		{time: 10800, size: 11800},
+	})
+	require.True(t, ok)
+	require.InDelta(t, 1, rate, 0.05)
+}
+
+// TestForecastCapacity tests that the limit reached first is forecast.
+func TestForecastCapacity(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	day := (24 * time.Hour).Seconds()
+
+	// A shrinking database never runs out of space.
+	forecast := forecastCapacity(now, 1000, -1, 0, 1000)
+	require.Zero(t, forecast.ExhaustionTime)
+	require.Empty(t, forecast.Limit)
+
+	// The disk fills up before the maximum size is reached.
+	forecast = forecastCapacity(now, 1000, 100/day, 100_000, 1000)
+	require.Equal(t, limitDisk, forecast.Limit)
+	require.Equal(t, now.Add(10*24*time.Hour).Unix(),
+		forecast.ExhaustionTime)
+	require.InDelta(t, 100, forecast.GrowthBytesPerDay, 1e-9)
+
+	forecast = forecastCapacity(now, 1000, 100/day, 1500, 100_000)
+	require.Equal(t, limitMaxDatabaseSize, forecast.Limit)
+	require.Equal(t, now.Add(5*24*time.Hour).Unix(),
+		forecast.ExhaustionTime)
+
+	// A database beyond its maximum size runs out of space right away.
+	forecast = forecastCapacity(now, 2000, 100/day, 1500, 100_000)
+	require.Equal(t, now.Unix(), forecast.ExhaustionTime)
+
+	// Platforms not reporting the free disk space never fill the disk.
+	forecast = forecastCapacity(now, 1000, 100/day, 0, math.MaxUint64)
+	require.Zero(t, forecast.ExhaustionTime)
+}
+
+// TestCheckCapacity tests that the size of the database is sampled and its
+// forecast reported by GetInfo.
+func TestCheckCapacity(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Notify.CapacityWarning = 30 * 24 * time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Unix(1_700_000_000, 0))
+	server.clock = clock
+
+	info := func() *ecrpc.CapacityForecast {
+		resp, err := server.GetInfo(
+			context.Background(), &ecrpc.GetInfoRequest{},
+		)
+		require.NoError(t, err)
+
+		return resp.CapacityForecast
+	}
+
+	// A single sample does not tell the growth rate.
+	server.checkCapacity()
+	require.Nil(t, info())
+
+	var size uint64
+	err = db.View(func(tx *bbolt.Tx) error {
+		size = uint64(tx.Size())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Greater(t, size, uint64(20_000))
+
+	// Pretend the database grew by 10 kB per day, with samples
+	// older than the window being ignored.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		now := clock.Now()
+		samples := []sizeSample{
+			{now.Add(-30 * 24 * time.Hour).Unix(), 0},
+			{now.Add(-48 * time.Hour).Unix(), size - 20_000},
+			{now.Add(-24 * time.Hour).Unix(), size - 10_000},
+		}
+
+		return tx.Bucket([]byte(MetadataBucketName)).Put(
+			sizeSamplesKey, encodeSizeSamples(samples),
+		)
+	})
+	require.NoError(t, err)
+
+	config.Database.MaxDatabaseSize = int64(size) + 1_000_000
+	server.checkCapacity()
+	forecast := info()
+	require.NotNil(t, forecast)
+	require.Equal(t, size, forecast.DatabaseSizeBytes)
+	require.InDelta(t, 10_000, forecast.GrowthBytesPerDay, 1)
+	require.Equal(t, limitMaxDatabaseSize, forecast.Limit)
+	require.False(t, forecast.Warning)
+
+	// The operator is warned once the limit is reached within the warning
+	// period.
+	config.Database.MaxDatabaseSize = int64(size) + 100_000
+	server.checkCapacity()
+	forecast = info()
+	require.True(t, forecast.Warning)
+	require.InDelta(
+		t, clock.Now().Add(10*24*time.Hour).Unix(),
+		forecast.ExhaustionTime, 3600,
+	)
+}
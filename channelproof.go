@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// channelNodes returns the value a channel is stored with in the channel
+// graph bucket, the pubkeys of its nodes in canonical order, so that both
+// directions of a pair match the channel.
+func channelNodes(node1, node2 []byte) []byte {
+	if bytes.Compare(node1, node2) > 0 {
+		node1, node2 = node2, node1
+	}
+	nodes := make([]byte, 0, PubKeyCompressedSizeDouble)
+	nodes = append(nodes, node1...)
+
+	return append(nodes, node2...)
+}
+
+// validateChannel validates a channel of the imported channel graph.
+func validateChannel(channel *ecadminrpc.Channel) error {
+	if channel.ShortChannelId == 0 {
+		return status.Error(codes.InvalidArgument, "channel short "+
+			"channel id must be set")
+	}
+
+	if len(channel.Node1) != PubKeyCompressedSize ||
+		len(channel.Node2) != PubKeyCompressedSize {
+
+		return status.Errorf(codes.InvalidArgument, "channel nodes "+
+			"must be exactly %d bytes", PubKeyCompressedSize)
+	}
+
+	if bytes.Equal(channel.Node1, channel.Node2) {
+		return status.Error(codes.InvalidArgument, "channel nodes "+
+			"must differ")
+	}
+
+	return nil
+}
+
+// hasChannelProof returns whether the pair references a channel of the
+// channel graph between its nodes.
+func hasChannelProof(b *bbolt.Bucket, pair *ecrpc.PairHistory) bool {
+	if pair.ShortChannelId == 0 {
+		return false
+	}
+
+	nodes := b.Get(encodeUint64(pair.ShortChannelId))
+
+	return nodes != nil &&
+		bytes.Equal(nodes, channelNodes(pair.NodeFrom, pair.NodeTo))
+}
+
+// removeUnprovenPairs removes the pairs not proving their channel from the
+// request if channel proofs are required. Fabricating reports then requires
+// referencing real channels instead of pairs towards generated keys. It
+// returns the number of pairs removed.
+func (s *externalCoordinatorServer) removeUnprovenPairs(
+	req *ecrpc.RegisterMissionControlRequest) (int, error) {
+	if !s.config.Server.RequireChannelProof {
+		return 0, nil
+	}
+
+	proven := make([]*ecrpc.PairHistory, 0, len(req.Pairs))
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(ChannelGraphBucketName))
+		for _, pair := range req.Pairs {
+			if hasChannelProof(b, pair) {
+				proven = append(proven, pair)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		msg := "failed to verify channel proofs: %v"
+		logrus.Errorf(msg, err)
+		return 0, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	removed := len(req.Pairs) - len(proven)
+	req.Pairs = proven
+
+	return removed, nil
+}
+
+// ImportChannelGraph imports the channels streamed by the operator into the
+// channel graph. The whole graph is received before it is stored in a single
+// transaction, so an interrupted import never leaves a partial graph.
+func (a *adminServer) ImportChannelGraph(
+	stream ecadminrpc.ExternalCoordinatorAdmin_ImportChannelGraphServer) error {
+	var (
+		full     bool
+		first    = true
+		channels []*ecadminrpc.Channel
+		closed   []uint64
+	)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			full = chunk.Full
+			first = false
+		}
+
+		for _, channel := range chunk.Channels {
+			if err := validateChannel(channel); err != nil {
+				return err
+			}
+		}
+
+		channels = append(channels, chunk.Channels...)
+		closed = append(closed, chunk.Closed...)
+	}
+
+	var total int
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		// Track the nodes of the channels removed and added, which
+		// lost or regained their connection.
+		var removedNodes, addedNodes [][]byte
+
+		// A full import replaces all previously imported channels.
+		if full {
+			b := tx.Bucket([]byte(ChannelGraphBucketName))
+			err := b.ForEach(func(_, nodes []byte) error {
+				removedNodes = append(
+					removedNodes, bytes.Clone(nodes),
+				)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			err = tx.DeleteBucket([]byte(ChannelGraphBucketName))
+			if err != nil {
+				return err
+			}
+			_, err = tx.CreateBucket([]byte(ChannelGraphBucketName))
+			if err != nil {
+				return err
+			}
+		}
+
+		b := tx.Bucket([]byte(ChannelGraphBucketName))
+		for _, channel := range channels {
+			nodes := channelNodes(channel.Node1, channel.Node2)
+			err := b.Put(
+				encodeUint64(channel.ShortChannelId), nodes,
+			)
+			if err != nil {
+				return err
+			}
+			addedNodes = append(addedNodes, nodes)
+		}
+
+		for _, scid := range closed {
+			key := encodeUint64(scid)
+			if nodes := b.Get(key); nodes != nil {
+				removedNodes = append(
+					removedNodes, bytes.Clone(nodes),
+				)
+			}
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		// Record when nodes lost their last channel, so that their
+		// pairs can be pruned once the closure is old enough.
+		return recordChannelClosures(
+			tx, removedNodes, addedNodes, time.Now(),
+		)
+	})
+	if err == nil {
+		err = a.db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(ChannelGraphBucketName))
+			total = b.Stats().KeyN
+			return nil
+		})
+	}
+	if err != nil {
+		msg := "failed to import channel graph: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Imported %d channels and removed %d closed channels, "+
+		"%d channels known", len(channels), len(closed), total)
+
+	return stream.SendAndClose(&ecadminrpc.ImportChannelGraphResponse{
+		ChannelsStored:  uint64(len(channels)),
+		ChannelsRemoved: uint64(len(closed)),
+		TotalChannels:   uint64(total),
+	})
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockImportChannelGraphServer streams the given chunks to the server and
+// records its response.
+type mockImportChannelGraphServer struct {
+	grpc.ServerStream
+	Chunks   []*ecadminrpc.ImportChannelGraphRequest
+	Response *ecadminrpc.ImportChannelGraphResponse
+}
+
+func (m *mockImportChannelGraphServer) Recv() (
+	*ecadminrpc.ImportChannelGraphRequest, error) {
+	if len(m.Chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := m.Chunks[0]
+	m.Chunks = m.Chunks[1:]
+
+	return chunk, nil
+}
+
+func (m *mockImportChannelGraphServer) SendAndClose(
+	resp *ecadminrpc.ImportChannelGraphResponse) error {
+	m.Response = resp
+	return nil
+}
+
+// TestChannelProof tests that pairs are only accepted with a proof verifiable
+// against the imported channel graph if channel proofs are required.
+func TestChannelProof(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RequireChannelProof = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	importGraph := func(chunks ...*ecadminrpc.ImportChannelGraphRequest) (
+		*ecadminrpc.ImportChannelGraphResponse, error) {
+		stream := &mockImportChannelGraphServer{Chunks: chunks}
+		err := admin.ImportChannelGraph(stream)
+
+		return stream.Response, err
+	}
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, _ := generateTestKeys(t)
+	resp, err := importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Full: true,
+		Channels: []*ecadminrpc.Channel{
+			{ShortChannelId: 1, Node1: nodeB, Node2: nodeA},
+			{ShortChannelId: 2, Node1: nodeA, Node2: nodeC},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.TotalChannels)
+
+	pair := func(nodeFrom, nodeTo []byte, scid uint64) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+			ShortChannelId: scid,
+		}
+	}
+
+	// Only pairs referencing a channel between their nodes are accepted,
+	// in both directions of the channel.
+	reg, err := server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB, 1), pair(nodeB, nodeA, 1),
+				pair(nodeB, nodeC, 2), pair(nodeC, nodeB, 0),
+				pair(nodeA, nodeC, 3),
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, reg.UnprovenPairsRejected)
+	require.ElementsMatch(t, []string{
+		string(pairKey(nodeA, nodeB)), string(pairKey(nodeB, nodeA)),
+	}, storedPairKeys(t, db))
+
+	// Closed channels no longer prove their pairs.
+	_, err = importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Closed: []uint64{1},
+	})
+	require.NoError(t, err)
+	reg, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB, 1), pair(nodeA, nodeC, 2),
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, reg.UnprovenPairsRejected)
+
+	// A full import replaces the previous graph.
+	resp, err = importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Full: true,
+		Channels: []*ecadminrpc.Channel{
+			{ShortChannelId: 3, Node1: nodeB, Node2: nodeC},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.TotalChannels)
+
+	// Invalid channels are rejected.
+	_, err = importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Channels: []*ecadminrpc.Channel{
+			{ShortChannelId: 4, Node1: nodeA, Node2: nodeA},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Without the requirement, pairs need no proof.
+	config.Server.RequireChannelProof = false
+	reg, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{pair(nodeC, nodeB, 0)},
+		},
+	)
+	require.NoError(t, err)
+	require.Zero(t, reg.UnprovenPairsRejected)
+}
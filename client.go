@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// unknownClient is the identity used for clients whose address cannot
+	// be determined.
+	unknownClient = "unknown"
+
+	// forwardedForHeader is the metadata key carrying the address of the
+	// original client of requests forwarded by the REST gateway.
+	forwardedForHeader = "x-forwarded-for"
+)
+
+// clientIdentity returns the identity of the client issuing the request in
+// the given context, which is the IP address of the client. Requests forwarded
+// by the REST gateway originate from a loopback address, in which case the
+// address of the original client is taken from the forwarded metadata. The
+// metadata is ignored for any other peer since it could be spoofed.
+func clientIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return unknownClient
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	// The gateway appends the address of the client it received the
+	// request from as the last entry.
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := md.Get(forwardedForHeader)
+	if len(forwarded) == 0 {
+		return host
+	}
+	entries := strings.Split(forwarded[len(forwarded)-1], ",")
+	if client := strings.TrimSpace(entries[len(entries)-1]); client != "" {
+		return client
+	}
+
+	return host
+}
@@ -4,13 +4,15 @@ REST Client for Mission Control Management between EC and LND
 This script is designed to manage and integrate mission control data between an LND node and an External Coordinator (EC) server using RESTful API. It provides functionalities for secure communication, data querying, and data registration.
 """
 
+import base64
+import gzip
 import json
 import time
 from typing import Tuple
 import requests
 import codecs
 
-def get_self_signed_session(cert: str) -> requests.Session:
+def get_self_signed_session(cert: str, access_token: str = "") -> requests.Session:
     """
     Creates a requests session using a self-signed SSL certificate.
 
@@ -18,25 +20,33 @@ def get_self_signed_session(cert: str) -> requests.Session:
 
     Args:
         cert (str): Path to the self-signed SSL certificate file.
+        access_token (str): The access token to authorize requests with if the EC requires one.
 
     Returns:
         requests.Session: A requests session configured to use the provided self-signed SSL certificate.
     """
     session = requests.Session()
     session.verify = cert
+    if access_token:
+        session.headers['Authorization'] = access_token
     return session
 
-def get_trusted_ca_session() -> requests.Session:
+def get_trusted_ca_session(access_token: str = "") -> requests.Session:
     """
     Creates a requests session that trusts all CA certificates.
 
     This session is used when the server's certificate is trusted and there is no need for certificate verification.
 
+    Args:
+        access_token (str): The access token to authorize requests with if the EC requires one.
+
     Returns:
         requests.Session: A requests session that trusts all CA certificates.
     """
     session = requests.Session()
     session.verify = True
+    if access_token:
+        session.headers['Authorization'] = access_token
     return session
 
 
@@ -54,29 +64,341 @@ def get_insecure_session() -> requests.Session:
     session.verify = False
     return session
 
-def query_aggregated_mission_control(session: requests.Session, ec_rest_host: str) -> list:
+def query_aggregated_mission_control(session: requests.Session, ec_rest_host: str, source_node: bytes = b"", max_distance: int = 0, sample_size: int = 0, sort_order: str = "SORT_ORDER_NODE_PUBKEY", updated_since: int = 0, max_age_seconds: int = 0, as_of_revision: int = 0, min_amt_msat: int = 0, node_from: bytes = b"", node_to: bytes = b"", updated_until: int = 0, batch_size: int = 0) -> list:
     """
     Queries the aggregated mission control data from the External Coordinator server.
 
     Args:
         session (requests.Session): The secure requests session.
         ec_rest_host (str): The REST host address of the External Coordinator.
+        source_node (bytes): Optional public key of your node. If set, the pairs are ranked by their graph distance from your node, the most route relevant pairs first.
+        max_distance (int): Optional maximum graph distance from your node of the pairs returned.
+        sample_size (int): Optional number of pairs of a uniform random sample to return instead of all pairs.
+        sort_order (str): Optional order of the pairs, one of SORT_ORDER_NODE_PUBKEY, SORT_ORDER_FRESHNESS or SORT_ORDER_FAILURE_AMOUNT.
+        updated_since (int): Optional unix timestamp. If set, only pairs updated at or after this time are returned.
+        max_age_seconds (int): Optional maximum age of the pairs returned. Defaults to the query threshold of the EC.
+        as_of_revision (int): Optional past revision of the dataset to query, within the revision log retention of the EC.
+        min_amt_msat (int): Optional minimum amount of your payments. If set, only pairs relevant to such payments are returned.
+        node_from (bytes): Optional public key of a node. If set, only pairs from this node are returned.
+        node_to (bytes): Optional public key of a node. If set, only pairs to this node are returned.
+        updated_until (int): Optional unix timestamp. If set, only pairs updated at or before this time are returned.
+        batch_size (int): Optional number of pairs per streamed response. Defaults to the query batch size of the EC and is capped by its maximum.
 
     Returns:
         list: A list of pairs from the aggregated mission control data.
     """
     url = f"{ec_rest_host}/v1/query_aggregated_mission_control"
-    response = session.get(url, stream=True)
+    params = {}
+    if source_node:
+        params["source_node"] = base64.urlsafe_b64encode(source_node).decode()
+    if max_distance:
+        params["max_distance"] = max_distance
+    if sample_size:
+        params["sample_size"] = sample_size
+    if sort_order != "SORT_ORDER_NODE_PUBKEY":
+        params["sort_order"] = sort_order
+    if updated_since:
+        params["updated_since"] = updated_since
+    if max_age_seconds:
+        params["max_age_seconds"] = max_age_seconds
+    if as_of_revision:
+        params["as_of_revision"] = as_of_revision
+    if min_amt_msat:
+        params["min_amt_msat"] = min_amt_msat
+    if node_from:
+        params["node_from"] = base64.urlsafe_b64encode(node_from).decode()
+    if node_to:
+        params["node_to"] = base64.urlsafe_b64encode(node_to).decode()
+    if updated_until:
+        params["updated_until"] = updated_until
+    if batch_size:
+        params["batch_size"] = batch_size
+    response = session.get(url, params=params, stream=True)
     response.raise_for_status()
     
     pairs = []
     for line in response.iter_lines():
         if line:
             data = json.loads(line.decode('utf-8'))
-            pairs.extend(data["result"]["pairs"])
+            pairs.extend(data["result"].get("pairs", []))
+    return pairs
+
+def poll_mission_control(session: requests.Session, ec_rest_host: str, after_revision: int = 0, timeout_seconds: int = 0) -> dict:
+    """
+    Waits until the aggregated mission control data changes from the given revision and returns the changes.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        after_revision (int): The revision of the dataset known to the client, as returned by the previous poll. Zero fetches the entire dataset.
+        timeout_seconds (int): Optional time to wait for a change. Defaults to and is capped by the maximum poll timeout of the EC.
+
+    Returns:
+        dict: The dataset with its current revision, the changed pairs, the removed pairs and whether the pairs are the entire dataset.
+    """
+    url = f"{ec_rest_host}/v1/poll_mission_control"
+    params = {}
+    if after_revision:
+        params["after_revision"] = after_revision
+    if timeout_seconds:
+        params["timeout_seconds"] = timeout_seconds
+    response = session.get(url, params=params)
+    response.raise_for_status()
+    return response.json()
+
+def query_liquidity_bounds(session: requests.Session, ec_rest_host: str, max_age_seconds: int = 0) -> list:
+    """
+    Queries the aggregated mission control data from the External Coordinator server as the liquidity bounds of the channels as tracked by the ProbabilisticScorer of LDK.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        max_age_seconds (int): Optional maximum age of the bounds returned. Defaults to the query threshold of the EC.
+
+    Returns:
+        list: A list of liquidity bounds, one per channel and direction.
+    """
+    url = f"{ec_rest_host}/v1/query_aggregated_mission_control"
+    params = {"format": "QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS"}
+    if max_age_seconds:
+        params["max_age_seconds"] = max_age_seconds
+    response = session.get(url, params=params, stream=True)
+    response.raise_for_status()
+
+    bounds = []
+    for line in response.iter_lines():
+        if line:
+            data = json.loads(line.decode('utf-8'))
+            bounds.extend(data["result"].get("liquidityBounds", []))
+    return bounds
+
+def list_epochs(session: requests.Session, ec_rest_host: str) -> dict:
+    """
+    Lists the archived epochs of the mission control data together with the current one.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+
+    Returns:
+        dict: The archived epochs, oldest first, and the current epoch.
+    """
+    url = f"{ec_rest_host}/v1/epochs"
+    response = session.get(url)
+    response.raise_for_status()
+    return response.json()
+
+def query_epoch_history(session: requests.Session, ec_rest_host: str, epoch: int) -> list:
+    """
+    Queries the mission control data archived at the end of an epoch.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        epoch (int): The number of the archived epoch.
+
+    Returns:
+        list: A list of pairs archived at the end of the epoch.
+    """
+    url = f"{ec_rest_host}/v1/epochs/{epoch}/mission_control"
+    response = session.get(url, stream=True)
+    response.raise_for_status()
+
+    pairs = []
+    for line in response.iter_lines():
+        if line:
+            data = json.loads(line.decode('utf-8'))
+            pairs.extend(data["result"].get("pairs", []))
     return pairs
 
-def register_mission_control(session: requests.Session, ec_rest_host: str, pairs: list, batch_register: int) -> dict:
+def query_private_mission_control(session: requests.Session, ec_rest_host: str) -> list:
+    """
+    Queries the private mission control data registered with access tokens of the same tenant as the access token of the session.
+
+    Args:
+        session (requests.Session): The secure requests session carrying an access token.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+
+    Returns:
+        list: A list of the private pairs of the tenant.
+    """
+    url = f"{ec_rest_host}/v1/private_mission_control"
+    response = session.get(url, stream=True)
+    response.raise_for_status()
+
+    pairs = []
+    for line in response.iter_lines():
+        if line:
+            data = json.loads(line.decode('utf-8'))
+            pairs.extend(data["result"].get("pairs", []))
+    return pairs
+
+def get_stats(session: requests.Session, ec_rest_host: str) -> dict:
+    """
+    Gets aggregate statistics of the mission control data, which are also served by coordinators withholding the pairs themselves.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+
+    Returns:
+        dict: The pair and node counts, failure rates, freshness distribution and per node group statistics.
+    """
+    url = f"{ec_rest_host}/v1/stats"
+    response = session.get(url)
+    response.raise_for_status()
+    return response.json()
+
+def get_pathfinding_parameters(session: requests.Session, ec_rest_host: str) -> dict:
+    """
+    Gets the pathfinding parameters the operator of the External Coordinator recommends to use with its data.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+
+    Returns:
+        dict: The halfLifeSeconds, hopProbability and weight to apply to the mission control of LND, or an empty dict if the operator recommends none.
+    """
+    url = f"{ec_rest_host}/v1/info"
+    response = session.get(url)
+    response.raise_for_status()
+    return response.json().get("dataset", {}).get("pathfindingParameters", {})
+
+def dump_mission_control(session: requests.Session, ec_rest_host: str, output: str, progress: bool = True, use_proto_names: bool = False) -> int:
+    """
+    Streams the full aggregated mission control data into a gzip compressed file for offline consumers, one JSON encoded pair per line.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        output (str): The path of the file to write, e.g. pairs.json.gz.
+        progress (bool): Whether to print the progress of the download.
+        use_proto_names (bool): Whether the EC is configured with rest_use_proto_names and names fields in snake_case.
+
+    Returns:
+        int: The number of pairs written.
+    """
+    stats = get_stats(session, ec_rest_host)
+    total = stats.get("totalPairs")
+    if total is None and use_proto_names:
+        total = stats.get("total_pairs")
+    total = int(total or 0)
+
+    url = f"{ec_rest_host}/v1/query_aggregated_mission_control"
+    response = session.get(url, stream=True)
+    response.raise_for_status()
+
+    written = 0
+    with gzip.open(output, "wt", encoding="utf-8") as f:
+        for line in response.iter_lines():
+            if not line:
+                continue
+            data = json.loads(line.decode('utf-8'))
+            for pair in data["result"].get("pairs", []):
+                f.write(json.dumps(pair) + "\n")
+                written += 1
+            if progress:
+                print(f"\rDumped {written} of ~{total} pairs", end="", flush=True)
+    if progress:
+        print()
+    return written
+
+def normalize_pair(node_a: bytes, node_b: bytes) -> Tuple[bytes, bytes]:
+    """
+    Normalizes a node pair to its canonical order, the smaller public key first, so that both directions of a pair map to the same tuple.
+
+    Args:
+        node_a (bytes): The compressed public key of the first node.
+        node_b (bytes): The compressed public key of the second node.
+
+    Returns:
+        Tuple[bytes, bytes]: The public keys of the pair in canonical order.
+    """
+    if node_a > node_b:
+        return node_b, node_a
+    return node_a, node_b
+
+def group_pair_directions(pairs: list) -> dict:
+    """
+    Groups mission control pairs by their normalized node pair, so that both directions of a channel can be handled together.
+
+    Args:
+        pairs (list): A list of pairs as returned by the REST API, with base64 encoded public keys.
+
+    Returns:
+        dict: A mapping of each normalized (A, B) tuple of public keys to a (A→B, B→A) tuple of pairs. A direction without any history is None.
+    """
+    grouped = {}
+    for pair in pairs:
+        node_from = base64.b64decode(pair["node_from"])
+        node_to = base64.b64decode(pair["node_to"])
+        key = normalize_pair(node_from, node_to)
+        forward, backward = grouped.get(key, (None, None))
+        if node_from == key[0]:
+            forward = pair
+        else:
+            backward = pair
+        grouped[key] = (forward, backward)
+    return grouped
+
+def query_pair_directions(session: requests.Session, ec_rest_host: str, node_a: bytes, node_b: bytes) -> tuple:
+    """
+    Queries both directions of a node pair from the External Coordinator in a single call.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        node_a (bytes): The compressed public key of the first node.
+        node_b (bytes): The compressed public key of the second node.
+
+    Returns:
+        tuple: A (A→B, B→A) tuple of pairs. A direction without any history is None.
+    """
+    url = f"{ec_rest_host}/v1/query_aggregated_mission_control"
+    params = {
+        "node_a": base64.urlsafe_b64encode(node_a).decode(),
+        "node_b": base64.urlsafe_b64encode(node_b).decode(),
+    }
+    response = session.get(url, params=params, stream=True)
+    response.raise_for_status()
+
+    forward, backward = None, None
+    for line in response.iter_lines():
+        if line:
+            data = json.loads(line.decode('utf-8'))
+            for pair in data["result"].get("pairs", []):
+                if base64.b64decode(pair["node_from"]) == node_a:
+                    forward = pair
+                else:
+                    backward = pair
+    return forward, backward
+
+def query_pair(session: requests.Session, ec_rest_host: str, node_from: str, node_to: str, max_age_seconds: int = 0) -> dict:
+    """
+    Queries the history of a single pair from the External Coordinator by the hex encoded public keys of its nodes.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        node_from (str): The hex encoded public key of the source node.
+        node_to (str): The hex encoded public key of the destination node.
+        max_age_seconds (int): Optional maximum age of the last result of the pair. Defaults to the query threshold of the EC.
+
+    Returns:
+        dict: The pair, or None if the EC does not know it.
+    """
+    url = f"{ec_rest_host}/v1/missioncontrol/{node_from}/{node_to}"
+    params = {}
+    if max_age_seconds:
+        params["max_age_seconds"] = max_age_seconds
+    response = session.get(url, params=params)
+    if response.status_code == 404:
+        return None
+    response.raise_for_status()
+    return response.json().get("pair")
+
+def register_mission_control(session: requests.Session, ec_rest_host: str, pairs: list, batch_register: int, network: str = "", channel_ids: dict = None, private: bool = False) -> dict:
     """
     Registers mission control data with the External Coordinator.
 
@@ -85,18 +407,104 @@ def register_mission_control(session: requests.Session, ec_rest_host: str, pairs
         ec_rest_host (str): The REST host address of the External Coordinator.
         pairs (list): A list of pairs to register.
         batch_register (int):  The number of pairs to be sent in each batch.
+        network (str): Optional network the pairs were observed on, e.g. 'mainnet' or 'testnet'. The External Coordinator rejects pairs of another network than its own.
+        channel_ids (dict): Optional mapping of each normalized (A, B) tuple of public keys to the short channel id of a channel between the nodes. It proves the pairs to External Coordinators requiring channel proofs.
+        private (bool): Whether the pairs are private, e.g. because they describe unannounced channels. Private pairs are only served back to the tenant of the access token of the session and never become part of the public aggregate.
 
     Returns:
         bool: boolean flag to indicate if registration's successful.
     """
     url = f"{ec_rest_host}/v1/register_mission_control"
 
+    for pair in pairs:
+        key = normalize_pair(
+            base64.b64decode(pair["node_from"]),
+            base64.b64decode(pair["node_to"]),
+        )
+        if channel_ids and key in channel_ids:
+            pair["short_channel_id"] = str(channel_ids[key])
+
     for i in range(0, len(pairs), batch_register):
         data = {'pairs': pairs[i:i+batch_register]}
+        if network:
+            data['network'] = network
+        if private:
+            data['private'] = True
         response = session.post(url, json=data)
         response.raise_for_status()
     return True
 
+def register_cln_pay_results(session: requests.Session, ec_rest_host: str, source: bytes, attempts: list, network: str = "", private: bool = False) -> dict:
+    """
+    Registers the results of payment attempts made by a Core Lightning node with the External Coordinator.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        source (bytes): The public key of the Core Lightning node that made the attempts.
+        attempts (list): A list of attempts as returned by the listsendpays command, each extended by the 'route' it was sent along as returned by getroute and, if it failed, the 'erring_index' and 'failcode' of its error.
+        network (str): Optional network the attempts were made on, e.g. 'mainnet' or 'testnet'.
+        private (bool): Whether the resulting pairs are private, like the pairs of register_mission_control.
+
+    Returns:
+        dict: The response of the External Coordinator.
+    """
+    url = f"{ec_rest_host}/v1/cln/pay_results"
+
+    data = {
+        'source': base64.b64encode(source).decode(),
+        'attempts': [
+            {
+                'status': attempt['status'],
+                'created_at': str(attempt.get('created_at', 0)),
+                'completed_at': str(attempt.get('completed_at', 0)),
+                'route': [
+                    {
+                        'id': base64.b64encode(bytes.fromhex(hop['id'])).decode(),
+                        'channel': hop['channel'],
+                        'amount_msat': str(int(str(hop['amount_msat']).removesuffix('msat'))),
+                    }
+                    for hop in attempt.get('route', [])
+                ],
+                'erring_index': attempt.get('erring_index', 0),
+                'failcode': attempt.get('failcode', 0),
+            }
+            for attempt in attempts
+        ],
+    }
+    if network:
+        data['network'] = network
+    if private:
+        data['private'] = True
+    response = session.post(url, json=data)
+    response.raise_for_status()
+    return response.json()
+
+def import_querymc_output(session: requests.Session, ec_rest_host: str, querymc_output: str, network: str = "", private: bool = False) -> dict:
+    """
+    Registers the mission control data printed by `lncli querymc` with the External Coordinator, which converts it into pairs itself.
+
+    Args:
+        session (requests.Session): The secure requests session.
+        ec_rest_host (str): The REST host address of the External Coordinator.
+        querymc_output (str): The JSON output of `lncli querymc` as is.
+        network (str): Optional network the data was observed on, e.g. 'mainnet' or 'testnet'.
+        private (bool): Whether the pairs are private, like the pairs of register_mission_control.
+
+    Returns:
+        dict: The response of the External Coordinator.
+    """
+    url = f"{ec_rest_host}/v1/import_mission_control"
+
+    data = {'querymc_output': querymc_output}
+    if network:
+        data['network'] = network
+    if private:
+        data['private'] = True
+    response = session.post(url, json=data)
+    response.raise_for_status()
+    return response.json()
+
 def query_mission_control_data_from_lnd(macaroon_path: str, tls_cert: str, lnd_rest_host: str) -> list:
     """
     Queries mission control data from the LND node.
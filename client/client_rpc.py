@@ -5,20 +5,41 @@ This script is designed to manage and integrate mission control data between an
 """
 
 import codecs
+import gzip
+import json
 import os
 import time
 import grpc
+from google.protobuf import json_format
 import ecrpc.external_coordinator_pb2 as ecrpc
 import ecrpc.external_coordinator_pb2_grpc as ecrpcstub
 import lnrpc.router_pb2 as routerrpc, lnrpc.router_pb2_grpc as routerstub
 
-def get_self_signed_channel(target: str, cert: str):
+def with_access_token(credentials, access_token: str):
+    """
+    Adds the access token to the channel credentials if one is given.
+
+    Args:
+        credentials: The channel credentials.
+        access_token (str): The access token minted by the EC operator, empty if none is required.
+
+    Returns:
+        The channel credentials sending the access token with every call.
+    """
+    if not access_token:
+        return credentials
+    return grpc.composite_channel_credentials(
+        credentials, grpc.access_token_call_credentials(access_token),
+    )
+
+def get_self_signed_channel(target: str, cert: str, access_token: str = ""):
     """
     Creates a secure gRPC channel using a self-signed certificate.
 
     Args:
         target (str): The server address (e.g., 'localhost:50051').
         cert (str): Path to the self-signed certificate file.
+        access_token (str): The access token to authorize calls with if the EC requires one.
 
     Returns:
         grpc.Channel: A secure gRPC channel.
@@ -26,33 +47,44 @@ def get_self_signed_channel(target: str, cert: str):
     with open(cert, 'rb') as f:
         trusted_certs = f.read()
     credentials = grpc.ssl_channel_credentials(root_certificates=trusted_certs)
-    return grpc.secure_channel(target, credentials)
+    return grpc.secure_channel(target, with_access_token(credentials, access_token))
 
-def get_trusted_ca_channel(target: str):
+def get_trusted_ca_channel(target: str, access_token: str = ""):
     """
     Creates a secure gRPC channel using certificates from a trusted CA.
 
     Args:
         target (str): The server address (e.g., 'example.com:50051').
+        access_token (str): The access token to authorize calls with if the EC requires one.
 
     Returns:
         grpc.Channel: A secure gRPC channel.
     """
     # Use default system-trusted CA certificates.
     credentials = grpc.ssl_channel_credentials()
-    return grpc.secure_channel(target, credentials)
+    return grpc.secure_channel(target, with_access_token(credentials, access_token))
 
-def query_aggregated_mission_control(stub) -> list:
+def query_aggregated_mission_control(stub, source_node: bytes = b"", max_distance: int = 0, sample_size: int = 0, sort_order: str = "SORT_ORDER_NODE_PUBKEY", updated_since: int = 0, max_age_seconds: int = 0) -> list:
     """
     Queries the aggregated mission control data from the External Coordinator server using server-side streaming.
 
     Args:
         stub: The gRPC stub for the External Coordinator.
+        source_node (bytes): Optional public key of your node. If set, the pairs are ranked by their graph distance from your node, the most route relevant pairs first.
+        max_distance (int): Optional maximum graph distance from your node of the pairs returned.
+        sample_size (int): Optional number of pairs of a uniform random sample to return instead of all pairs.
+        sort_order (str): Optional order of the pairs, one of SORT_ORDER_NODE_PUBKEY, SORT_ORDER_FRESHNESS or SORT_ORDER_FAILURE_AMOUNT.
+        updated_since (int): Optional unix timestamp. If set, only pairs updated at or after this time are returned.
+        max_age_seconds (int): Optional maximum age of the pairs returned. Defaults to the query threshold of the EC.
 
     Returns:
         list: A list of pairs from the aggregated mission control data.
     """
-    request = ecrpc.QueryAggregatedMissionControlRequest()
+    request = ecrpc.QueryAggregatedMissionControlRequest(
+        source_node=source_node, max_distance=max_distance,
+        sample_size=sample_size, sort_order=sort_order,
+        updated_since=updated_since, max_age_seconds=max_age_seconds,
+    )
     pairs = []
     try:
         for response in stub.QueryAggregatedMissionControl(request):
@@ -61,7 +93,150 @@ def query_aggregated_mission_control(stub) -> list:
         print(f"Failed to process streaming response: {e}")
     return pairs
 
-def register_mission_control(stub, pairs: list[routerrpc.PairHistory], batch_register: int) -> ecrpc.RegisterMissionControlResponse:
+def list_epochs(stub) -> ecrpc.ListEpochsResponse:
+    """
+    Lists the archived epochs of the mission control data together with the current one.
+
+    Args:
+        stub: The gRPC stub for the External Coordinator.
+
+    Returns:
+        ecrpc.ListEpochsResponse: The archived epochs, oldest first, and the current epoch.
+    """
+    return stub.ListEpochs(ecrpc.ListEpochsRequest())
+
+def query_epoch_history(stub, epoch: int) -> list:
+    """
+    Queries the mission control data archived at the end of an epoch using server-side streaming.
+
+    Args:
+        stub: The gRPC stub for the External Coordinator.
+        epoch (int): The number of the archived epoch.
+
+    Returns:
+        list: A list of pairs archived at the end of the epoch.
+    """
+    request = ecrpc.QueryEpochHistoryRequest(epoch=epoch)
+    pairs = []
+    for response in stub.QueryEpochHistory(request):
+        pairs.extend(response.pairs)
+    return pairs
+
+def query_private_mission_control(stub) -> list:
+    """
+    Queries the private mission control data registered with access tokens of the same tenant as the access token of the channel using server-side streaming.
+
+    Args:
+        stub: The gRPC stub for the External Coordinator.
+
+    Returns:
+        list: A list of the private pairs of the tenant.
+    """
+    pairs = []
+    for response in stub.QueryPrivateMissionControl(ecrpc.QueryPrivateMissionControlRequest()):
+        pairs.extend(response.pairs)
+    return pairs
+
+def get_stats(stub) -> ecrpc.GetStatsResponse:
+    """
+    Gets aggregate statistics of the mission control data, which are also served by coordinators withholding the pairs themselves.
+
+    Args:
+        stub: The gRPC stub for the External Coordinator.
+
+    Returns:
+        ecrpc.GetStatsResponse: The pair and node counts, failure rates, freshness distribution and per node group statistics.
+    """
+    return stub.GetStats(ecrpc.GetStatsRequest())
+
+def dump_mission_control(stub, output: str, progress: bool = True) -> int:
+    """
+    Streams the full aggregated mission control data into a gzip compressed file for offline consumers, one JSON encoded pair per line.
+
+    Args:
+        stub: The gRPC stub for the External Coordinator.
+        output (str): The path of the file to write, e.g. pairs.json.gz.
+        progress (bool): Whether to print the progress of the download.
+
+    Returns:
+        int: The number of pairs written.
+    """
+    total = get_stats(stub).total_pairs
+
+    request = ecrpc.QueryAggregatedMissionControlRequest()
+    written = 0
+    with gzip.open(output, "wt", encoding="utf-8") as f:
+        for response in stub.QueryAggregatedMissionControl(request):
+            for pair in response.pairs:
+                f.write(json.dumps(json_format.MessageToDict(pair)) + "\n")
+                written += 1
+            if progress:
+                print(f"\rDumped {written} of ~{total} pairs", end="", flush=True)
+    if progress:
+        print()
+    return written
+
+def normalize_pair(node_a: bytes, node_b: bytes) -> tuple[bytes, bytes]:
+    """
+    Normalizes a node pair to its canonical order, the smaller public key first, so that both directions of a pair map to the same tuple.
+
+    Args:
+        node_a (bytes): The compressed public key of the first node.
+        node_b (bytes): The compressed public key of the second node.
+
+    Returns:
+        tuple[bytes, bytes]: The public keys of the pair in canonical order.
+    """
+    if node_a > node_b:
+        return node_b, node_a
+    return node_a, node_b
+
+def group_pair_directions(pairs: list) -> dict:
+    """
+    Groups mission control pairs by their normalized node pair, so that both directions of a channel can be handled together.
+
+    Args:
+        pairs (list): A list of `ecrpc.PairHistory` objects.
+
+    Returns:
+        dict: A mapping of each normalized (A, B) tuple to a (A→B, B→A) tuple of pairs. A direction without any history is None.
+    """
+    grouped = {}
+    for pair in pairs:
+        key = normalize_pair(pair.node_from, pair.node_to)
+        forward, backward = grouped.get(key, (None, None))
+        if pair.node_from == key[0]:
+            forward = pair
+        else:
+            backward = pair
+        grouped[key] = (forward, backward)
+    return grouped
+
+def query_pair_directions(stub, node_a: bytes, node_b: bytes) -> tuple:
+    """
+    Queries both directions of a node pair from the External Coordinator in a single call.
+
+    Args:
+        stub: The gRPC stub for the External Coordinator.
+        node_a (bytes): The compressed public key of the first node.
+        node_b (bytes): The compressed public key of the second node.
+
+    Returns:
+        tuple: A (A→B, B→A) tuple of `ecrpc.PairHistory` objects. A direction without any history is None.
+    """
+    request = ecrpc.QueryAggregatedMissionControlRequest(
+        node_a=node_a, node_b=node_b,
+    )
+    forward, backward = None, None
+    for response in stub.QueryAggregatedMissionControl(request):
+        for pair in response.pairs:
+            if pair.node_from == node_a:
+                forward = pair
+            else:
+                backward = pair
+    return forward, backward
+
+def register_mission_control(stub, pairs: list[routerrpc.PairHistory], batch_register: int, network: str = "", channel_ids: dict = None, private: bool = False) -> ecrpc.RegisterMissionControlResponse:
     """
     Registers mission control data with the External Coordinator.
 
@@ -69,14 +244,23 @@ def register_mission_control(stub, pairs: list[routerrpc.PairHistory], batch_reg
         stub: The gRPC stub for the External Coordinator.
         pairs (list): A list of `routerrpc.PairHistory` objects to register.
         batch_register (int):  The number of pairs to be sent in each batch.
+        network (str): Optional network the pairs were observed on, e.g. 'mainnet' or 'testnet'. The External Coordinator rejects pairs of another network than its own.
+        channel_ids (dict): Optional mapping of each normalized (A, B) tuple of public keys to the short channel id of a channel between the nodes. It proves the pairs to External Coordinators requiring channel proofs.
+        private (bool): Whether the pairs are private, e.g. because they describe unannounced channels. Private pairs are only served back to the tenant of the access token of the channel and never become part of the public aggregate.
 
     Returns:
         bool: boolean flag to indicate if registration's successful.
     """
     converted_pairs = [convert_to_ecrpc_pair_history(pair) for pair in pairs]
+    for pair in converted_pairs:
+        key = normalize_pair(pair.node_from, pair.node_to)
+        if channel_ids and key in channel_ids:
+            pair.short_channel_id = channel_ids[key]
     for i in range(0, len(converted_pairs), batch_register):
         batch_pairs = converted_pairs[i:i+batch_register]
-        request = ecrpc.RegisterMissionControlRequest(pairs=batch_pairs)
+        request = ecrpc.RegisterMissionControlRequest(
+            pairs=batch_pairs, network=network, private=private,
+        )
         _ = stub.RegisterMissionControl(request)
     return True
 
@@ -168,7 +352,7 @@ def get_lnd_router_stub(lnd_macaroon_path: str, lnd_tls_cert: str, lnd_grpc_host
     stub = routerstub.RouterStub(channel)
     return stub
 
-def register_my_lnd_mission_control_data_with_ec(lnd_router_stub, ec_stub, batch_register: int) -> list[routerrpc.PairHistory]:
+def register_my_lnd_mission_control_data_with_ec(lnd_router_stub, ec_stub, batch_register: int, network: str = "") -> list[routerrpc.PairHistory]:
     """
     Registers mission control data from the LND node with the External Coordinator.
 
@@ -176,12 +360,13 @@ def register_my_lnd_mission_control_data_with_ec(lnd_router_stub, ec_stub, batch
         lnd_router_stub: The gRPC stub for the LND router.
         ec_stub: The gRPC stub for the External Coordinator.
         batch_register (int):  The number of pairs to be sent in each batch.
+        network (str): The network the LND node runs on, empty to let the EC assume its own.
 
     Returns:
         list: A list of mission control pairs registered into the External Coordinator.
     """
     mc_pairs = query_mission_control_data_from_lnd(lnd_router_stub)
-    register_mission_control(ec_stub, mc_pairs, batch_register)
+    register_mission_control(ec_stub, mc_pairs, batch_register, network)
     return mc_pairs
 
 def import_mission_control_data_from_ec_to_my_lnd(lnd_router_stub, ec_stub,
@@ -250,23 +435,37 @@ def convert_to_routerrpc_pair_history(pair: ecrpc.PairHistory) -> routerrpc.Pair
     )
 
 if __name__ == "__main__":
-    # Define configuration variables for the LND node.
-    LND_GRPC_HOST = '<your_lnd_host>:10009'
-    LND_DIR="<your_lnd_config_and_data_dir>"
-    LND_MACAROON_PATH = f'{LND_DIR}/data/chain/bitcoin/regtest/admin.macaroon'
-    LND_TLS_CERT = f'{LND_DIR}/tls.cert'
+    # Define configuration variables for the LND node. They can be set through
+    # the environment, e.g. by the environment file generated with the
+    # -gen-sync-config flag of the EC.
+    LND_GRPC_HOST = os.environ.get("LND_GRPC_HOST", '<your_lnd_host>:10009')
+    LND_DIR = os.environ.get("LND_DIR", "<your_lnd_config_and_data_dir>")
+    LND_MACAROON_PATH = os.environ.get(
+        "LND_MACAROON_PATH",
+        f'{LND_DIR}/data/chain/bitcoin/regtest/admin.macaroon',
+    )
+    LND_TLS_CERT = os.environ.get("LND_TLS_CERT", f'{LND_DIR}/tls.cert')
 
     # Create a stub to communicate with the LND node.
     lnd_router_stub = get_lnd_router_stub(
         LND_MACAROON_PATH, LND_TLS_CERT, LND_GRPC_HOST,
     )
 
-    # Define configuration variables for the External Coordinator.
-    EC_GRPC_HOST = "<your_ec_domain>:50050"
+    # Define configuration variables for the External Coordinator. ECs with a
+    # self-signed certificate are verified with EC_TLS_CERT.
+    EC_GRPC_HOST = os.environ.get("EC_GRPC_HOST", "<your_ec_domain>:50050")
+    EC_TLS_CERT = os.environ.get("EC_TLS_CERT", "")
+    EC_ACCESS_TOKEN = os.environ.get("EC_ACCESS_TOKEN", "")
+    EC_NETWORK = os.environ.get("EC_NETWORK", "")
 
     # Create a secure channel and stub to communicate with the External
     # Coordinator.
-    ec_channel = get_trusted_ca_channel(EC_GRPC_HOST)
+    if EC_TLS_CERT:
+        ec_channel = get_self_signed_channel(
+            EC_GRPC_HOST, EC_TLS_CERT, EC_ACCESS_TOKEN,
+        )
+    else:
+        ec_channel = get_trusted_ca_channel(EC_GRPC_HOST, EC_ACCESS_TOKEN)
 
     ec_stub = ecrpcstub.ExternalCoordinatorStub(ec_channel)
 
@@ -280,7 +479,7 @@ if __name__ == "__main__":
     # Register mission control data from the LND node with the External
     # Coordinator (EC).
     mc_pairs_registered = register_my_lnd_mission_control_data_with_ec(
-        lnd_router_stub, ec_stub, BATCH_REGISTER,
+        lnd_router_stub, ec_stub, BATCH_REGISTER, EC_NETWORK,
     )
     print((
         f"{len(mc_pairs_registered)} of your LND Mission Control pairs "
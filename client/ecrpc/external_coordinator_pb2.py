@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n ecrpc/external_coordinator.proto\x12\x05\x65\x63rpc\x1a\x1cgoogle/api/annotations.proto\"I\n\x1dRegisterMissionControlRequest\x12(\n\x05pairs\x18\x01 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\"I\n\x1eRegisterMissionControlResponse\x12\'\n\x0fsuccess_message\x18\x01 \x01(\tR\x0esuccessMessage\"&\n$QueryAggregatedMissionControlRequest\"Q\n%QueryAggregatedMissionControlResponse\x12(\n\x05pairs\x18\x01 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\"n\n\x0bPairHistory\x12\x1b\n\tnode_from\x18\x01 \x01(\x0cR\x08nodeFrom\x12\x17\n\x07node_to\x18\x02 \x01(\x0cR\x06nodeTo\x12)\n\x07history\x18\x03 \x01(\x0b\x32\x0f.ecrpc.PairDataR\x07history\"\xe2\x01\n\x08PairData\x12\x1b\n\tfail_time\x18\x01 \x01(\x03R\x08\x66\x61ilTime\x12 \n\x0c\x66\x61il_amt_sat\x18\x02 \x01(\x03R\nfailAmtSat\x12\"\n\rfail_amt_msat\x18\x03 \x01(\x03R\x0b\x66\x61ilAmtMsat\x12!\n\x0csuccess_time\x18\x04 \x01(\x03R\x0bsuccessTime\x12&\n\x0fsuccess_amt_sat\x18\x05 \x01(\x03R\rsuccessAmtSat\x12(\n\x10success_amt_msat\x18\x06 \x01(\x03R\x0esuccessAmtMsat2\xce\x02\n\x13\x45xternalCoordinator\x12\x8c\x01\n\x16RegisterMissionControl\x12$.ecrpc.RegisterMissionControlRequest\x1a%.ecrpc.RegisterMissionControlResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/registermissioncontrol:\x01*\x12\xa7\x01\n\x1dQueryAggregatedMissionControl\x12+.ecrpc.QueryAggregatedMissionControlRequest\x1a,.ecrpc.QueryAggregatedMissionControlResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/queryaggregatedmissioncontrol0\x01\x42\x41Z?github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n ecrpc/external_coordinator.proto\x12\x05\x65\x63rpc\x1a\x1cgoogle/api/annotations.proto\"}\n\x1dRegisterMissionControlRequest\x12(\n\x05pairs\x18\x01 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\x12\x18\n\x07network\x18\x02 \x01(\tR\x07network\x12\x18\n\x07private\x18\x03 \x01(\x08R\x07private\"\x9c\x01\n\x1cRegisterCLNPayResultsRequest\x12\x16\n\x06source\x18\x01 \x01(\x0cR\x06source\x12\x30\n\x08\x61ttempts\x18\x02 \x03(\x0b\x32\x14.ecrpc.CLNPayAttemptR\x08\x61ttempts\x12\x18\n\x07network\x18\x03 \x01(\tR\x07network\x12\x18\n\x07private\x18\x04 \x01(\x08R\x07private\"\xd2\x01\n\rCLNPayAttempt\x12\x16\n\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n\ncreated_at\x18\x02 \x01(\x04R\tcreatedAt\x12!\n\x0c\x63ompleted_at\x18\x03 \x01(\x04R\x0b\x63ompletedAt\x12(\n\x05route\x18\x04 \x03(\x0b\x32\x12.ecrpc.CLNRouteHopR\x05route\x12!\n\x0c\x65rring_index\x18\x05 \x01(\rR\x0b\x65rringIndex\x12\x1a\n\x08\x66\x61ilcode\x18\x06 \x01(\rR\x08\x66\x61ilcode\"X\n\x0b\x43LNRouteHop\x12\x0e\n\x02id\x18\x01 \x01(\x0cR\x02id\x12\x18\n\x07\x63hannel\x18\x02 \x01(\tR\x07\x63hannel\x12\x1f\n\x0b\x61mount_msat\x18\x03 \x01(\x04R\namountMsat\"\x92\x02\n\x1eRegisterMissionControlResponse\x12\'\n\x0fsuccess_message\x18\x01 \x01(\tR\x0esuccessMessage\x12,\n\x05hints\x18\x02 \x01(\x0b\x32\x16.ecrpc.SubmissionHintsR\x05hints\x12+\n\x11\x64uplicates_merged\x18\x03 \x01(\rR\x10\x64uplicatesMerged\x12\x36\n\x17unproven_pairs_rejected\x18\x04 \x01(\rR\x15unprovenPairsRejected\x12\x34\n\x0cpair_results\x18\x05 \x03(\x0b\x32\x11.ecrpc.PairResultR\x0bpairResults\"\x9e\x01\n\nPairResult\x12\x14\n\x05index\x18\x01 \x01(\rR\x05index\x12\x1b\n\tnode_from\x18\x02 \x01(\x0cR\x08nodeFrom\x12\x17\n\x07node_to\x18\x03 \x01(\x0cR\x06nodeTo\x12,\n\x07outcome\x18\x04 \x01(\x0e\x32\x12.ecrpc.PairOutcomeR\x07outcome\x12\x16\n\x06reason\x18\x05 \x01(\tR\x06reason\"\x7f\n\x0fSubmissionHints\x12\x32\n\x15sync_interval_seconds\x18\x01 \x01(\x03R\x13syncIntervalSeconds\x12$\n\x0emax_batch_size\x18\x02 \x01(\rR\x0cmaxBatchSize\x12\x12\n\x04\x62usy\x18\x03 \x01(\x08R\x04\x62usy\"\x10\n\x0eGetInfoRequest\"\x8e\x04\n\x0fGetInfoResponse\x12\x41\n\x10submission_hints\x18\x01 \x01(\x0b\x32\x16.ecrpc.SubmissionHintsR\x0fsubmissionHints\x12\x18\n\x07network\x18\x02 \x01(\tR\x07network\x12:\n\x19history_threshold_seconds\x18\x03 \x01(\x03R\x17historyThresholdSeconds\x12\x36\n\x17query_threshold_seconds\x18\x04 \x01(\x03R\x15queryThresholdSeconds\x12\x30\n\x07startup\x18\x05 \x01(\x0b\x32\x16.ecrpc.StartupProgressR\x07startup\x12\x44\n\x11\x63\x61pacity_forecast\x18\x06 \x01(\x0b\x32\x17.ecrpc.CapacityForecastR\x10\x63\x61pacityForecast\x12\x18\n\x07version\x18\x07 \x01(\tR\x07version\x12\x1f\n\x0b\x63ommit_hash\x18\x08 \x01(\tR\ncommitHash\x12\x1d\n\nstart_time\x18\t \x01(\x03R\tstartTime\x12,\n\x07\x64\x61taset\x18\n \x01(\x0b\x32\x12.ecrpc.DatasetInfoR\x07\x64\x61taset\x12*\n\x11last_cleanup_time\x18\x0b \x01(\x03R\x0flastCleanupTime\"\xcc\x01\n\x10\x43\x61pacityForecast\x12.\n\x13\x64\x61tabase_size_bytes\x18\x01 \x01(\x04R\x11\x64\x61tabaseSizeBytes\x12/\n\x14growth_bytes_per_day\x18\x02 \x01(\x01R\x11growthBytesPerDay\x12\'\n\x0f\x65xhaustion_time\x18\x03 \x01(\x03R\x0e\x65xhaustionTime\x12\x14\n\x05limit\x18\x04 \x01(\tR\x05limit\x12\x18\n\x07warning\x18\x05 \x01(\x08R\x07warning\"[\n\x0fStartupProgress\x12\x14\n\x05phase\x18\x01 \x01(\tR\x05phase\x12\x1c\n\tcompleted\x18\x02 \x01(\x04R\tcompleted\x12\x14\n\x05total\x18\x03 \x01(\x04R\x05total\"\x11\n\x0fGetStatsRequest\"O\n\x0f\x46reshnessBucket\x12&\n\x0fmax_age_seconds\x18\x01 \x01(\x03R\rmaxAgeSeconds\x12\x14\n\x05pairs\x18\x02 \x01(\x04R\x05pairs\"\x7f\n\x0bRegionStats\x12\x14\n\x05group\x18\x01 \x01(\tR\x05group\x12\x14\n\x05pairs\x18\x02 \x01(\x04R\x05pairs\x12!\n\x0c\x66\x61iled_pairs\x18\x03 \x01(\x04R\x0b\x66\x61iledPairs\x12!\n\x0c\x66\x61ilure_rate\x18\x04 \x01(\x01R\x0b\x66\x61ilureRate\"\xd0\x02\n\x10GetStatsResponse\x12\x1f\n\x0btotal_pairs\x18\x01 \x01(\x04R\ntotalPairs\x12\x14\n\x05nodes\x18\x02 \x01(\x04R\x05nodes\x12!\n\x0c\x66\x61iled_pairs\x18\x03 \x01(\x04R\x0b\x66\x61iledPairs\x12!\n\x0c\x66\x61ilure_rate\x18\x04 \x01(\x01R\x0b\x66\x61ilureRate\x12\x34\n\tfreshness\x18\x05 \x03(\x0b\x32\x16.ecrpc.FreshnessBucketR\tfreshness\x12,\n\x07regions\x18\x06 \x03(\x0b\x32\x12.ecrpc.RegionStatsR\x07regions\x12.\n\x13nodes_seen_estimate\x18\x07 \x01(\x04R\x11nodesSeenEstimate\x12+\n\x11\x63onflicting_pairs\x18\x08 \x01(\x04R\x10\x63onflictingPairs\"\x13\n\x11ListEpochsRequest\"m\n\x05\x45poch\x12\x14\n\x05\x65poch\x18\x01 \x01(\x04R\x05\x65poch\x12\x1d\n\nstart_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n\x08\x65nd_time\x18\x03 \x01(\x03R\x07\x65ndTime\x12\x14\n\x05pairs\x18\x04 \x01(\x04R\x05pairs\"f\n\x12ListEpochsResponse\x12(\n\x08\x61rchived\x18\x01 \x03(\x0b\x32\x0c.ecrpc.EpochR\x08\x61rchived\x12&\n\x07\x63urrent\x18\x02 \x01(\x0b\x32\x0c.ecrpc.EpochR\x07\x63urrent\"0\n\x18QueryEpochHistoryRequest\x12\x14\n\x05\x65poch\x18\x01 \x01(\x04R\x05\x65poch\"#\n!QueryPrivateMissionControlRequest\"\xbb\x04\n$QueryAggregatedMissionControlRequest\x12\x14\n\x05group\x18\x01 \x01(\tR\x05group\x12\x15\n\x06node_a\x18\x02 \x01(\x0cR\x05nodeA\x12\x15\n\x06node_b\x18\x03 \x01(\x0cR\x05nodeB\x12\x1f\n\x0bsource_node\x18\x04 \x01(\x0cR\nsourceNode\x12!\n\x0cmax_distance\x18\x05 \x01(\rR\x0bmaxDistance\x12\x1f\n\x0bsample_size\x18\x06 \x01(\rR\nsampleSize\x12/\n\nsort_order\x18\x07 \x01(\x0e\x32\x10.ecrpc.SortOrderR\tsortOrder\x12#\n\rupdated_since\x18\x08 \x01(\x03R\x0cupdatedSince\x12&\n\x0fmax_age_seconds\x18\t \x01(\x03R\rmaxAgeSeconds\x12*\n\x06\x66ormat\x18\n \x01(\x0e\x32\x12.ecrpc.QueryFormatR\x06\x66ormat\x12$\n\x0e\x61s_of_revision\x18\x0b \x01(\x04R\x0c\x61sOfRevision\x12 \n\x0cmin_amt_msat\x18\x0c \x01(\x03R\nminAmtMsat\x12\x1b\n\tnode_from\x18\r \x01(\x0cR\x08nodeFrom\x12\x17\n\x07node_to\x18\x0e \x01(\x0cR\x06nodeTo\x12#\n\rupdated_until\x18\x0f \x01(\x03R\x0cupdatedUntil\x12\x1d\n\nbatch_size\x18\x10 \x01(\rR\tbatchSize\"k\n\x19PollMissionControlRequest\x12%\n\x0e\x61\x66ter_revision\x18\x01 \x01(\x04R\rafterRevision\x12\'\n\x0ftimeout_seconds\x18\x02 \x01(\rR\x0etimeoutSeconds\"G\n\x1eSubscribeMissionControlRequest\x12%\n\x0e\x61\x66ter_revision\x18\x01 \x01(\x04R\rafterRevision\"\xbe\x01\n\x1aPollMissionControlResponse\x12,\n\x07\x64\x61taset\x18\x01 \x01(\x0b\x32\x12.ecrpc.DatasetInfoR\x07\x64\x61taset\x12(\n\x05pairs\x18\x02 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\x12\x34\n\rremoved_pairs\x18\x03 \x03(\x0b\x32\x0f.ecrpc.NodePairR\x0cremovedPairs\x12\x12\n\x04\x66ull\x18\x04 \x01(\x08R\x04\x66ull\"7\n\x1fQueryMissionControlDeltaRequest\x12\x14\n\x05since\x18\x01 \x01(\x03R\x05since\"\xe2\x01\n QueryMissionControlDeltaResponse\x12,\n\x07\x64\x61taset\x18\x01 \x01(\x0b\x32\x12.ecrpc.DatasetInfoR\x07\x64\x61taset\x12(\n\x05pairs\x18\x02 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\x12\x34\n\rremoved_pairs\x18\x03 \x03(\x0b\x32\x0f.ecrpc.NodePairR\x0cremovedPairs\x12\x12\n\x04\x66ull\x18\x04 \x01(\x08R\x04\x66ull\x12\x1c\n\twatermark\x18\x05 \x01(\x03R\twatermark\"\xa0\x01\n\x19SyncMissionControlRequest\x12(\n\x05pairs\x18\x01 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\x12\x18\n\x07network\x18\x02 \x01(\tR\x07network\x12\x18\n\x07private\x18\x03 \x01(\x08R\x07private\x12%\n\x0e\x61\x66ter_revision\x18\x04 \x01(\x04R\rafterRevision\"\xa4\x01\n\x1aSyncMissionControlResponse\x12;\n\x07\x63hanges\x18\x01 \x01(\x0b\x32!.ecrpc.PollMissionControlResponseR\x07\x63hanges\x12I\n\x0cregistration\x18\x02 \x01(\x0b\x32%.ecrpc.RegisterMissionControlResponseR\x0cregistration\"h\n\x14QueryTopPairsRequest\x12\x14\n\x05limit\x18\x01 \x01(\rR\x05limit\x12\x12\n\x04node\x18\x02 \x01(\x0cR\x04node\x12&\n\x0fmax_age_seconds\x18\x03 \x01(\x03R\rmaxAgeSeconds\"o\n\x15QueryTopPairsResponse\x12,\n\x07\x64\x61taset\x18\x01 \x01(\x0b\x32\x12.ecrpc.DatasetInfoR\x07\x64\x61taset\x12(\n\x05pairs\x18\x02 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\"p\n\x10QueryPairRequest\x12\x1b\n\tnode_from\x18\x01 \x01(\tR\x08nodeFrom\x12\x17\n\x07node_to\x18\x02 \x01(\tR\x06nodeTo\x12&\n\x0fmax_age_seconds\x18\x03 \x01(\x03R\rmaxAgeSeconds\"i\n\x11QueryPairResponse\x12,\n\x07\x64\x61taset\x18\x01 \x01(\x0b\x32\x12.ecrpc.DatasetInfoR\x07\x64\x61taset\x12&\n\x04pair\x18\x02 \x01(\x0b\x32\x12.ecrpc.PairHistoryR\x04pair\"X\n\x1aQueryNodeReputationRequest\x12\x12\n\x04node\x18\x01 \x01(\x0cR\x04node\x12&\n\x0fmax_age_seconds\x18\x02 \x01(\x03R\rmaxAgeSeconds\"\xfe\x01\n\x1bQueryNodeReputationResponse\x12\x14\n\x05pairs\x18\x01 \x01(\x04R\x05pairs\x12!\n\x0c\x66\x61iled_pairs\x18\x02 \x01(\x04R\x0b\x66\x61iledPairs\x12#\n\rsuccess_ratio\x18\x03 \x01(\x01R\x0csuccessRatio\x12/\n\x14\x61vg_success_amt_msat\x18\x04 \x01(\x03R\x11\x61vgSuccessAmtMsat\x12*\n\x11last_success_time\x18\x05 \x01(\x03R\x0flastSuccessTime\x12$\n\x0elast_fail_time\x18\x06 \x01(\x03R\x0clastFailTime\"3\n\x1b\x45xportMissionControlRequest\x12\x14\n\x05\x66orce\x18\x01 \x01(\x08R\x05\x66orce\"a\n\x1c\x45xportMissionControlResponse\x12+\n\x05pairs\x18\x01 \x03(\x0b\x32\x15.ecrpc.LNDPairHistoryR\x05pairs\x12\x14\n\x05\x66orce\x18\x02 \x01(\x08R\x05\x66orce\"\x8c\x01\n\x0eLNDPairHistory\x12\x1b\n\tnode_from\x18\x01 \x01(\x0cR\x08nodeFrom\x12\x17\n\x07node_to\x18\x02 \x01(\x0cR\x06nodeTo\x12,\n\x07history\x18\x07 \x01(\x0b\x32\x12.ecrpc.LNDPairDataR\x07historyJ\x04\x08\x03\x10\x04J\x04\x08\x04\x10\x05J\x04\x08\x05\x10\x06J\x04\x08\x06\x10\x07\"\xeb\x01\n\x0bLNDPairData\x12\x1b\n\tfail_time\x18\x01 \x01(\x03R\x08\x66\x61ilTime\x12 \n\x0c\x66\x61il_amt_sat\x18\x02 \x01(\x03R\nfailAmtSat\x12\"\n\rfail_amt_msat\x18\x04 \x01(\x03R\x0b\x66\x61ilAmtMsat\x12!\n\x0csuccess_time\x18\x05 \x01(\x03R\x0bsuccessTime\x12&\n\x0fsuccess_amt_sat\x18\x06 \x01(\x03R\rsuccessAmtSat\x12(\n\x10success_amt_msat\x18\x07 \x01(\x03R\x0esuccessAmtMsatJ\x04\x08\x03\x10\x04\"x\n\x1bImportMissionControlRequest\x12%\n\x0equerymc_output\x18\x01 \x01(\tR\rquerymcOutput\x12\x18\n\x07network\x18\x02 \x01(\tR\x07network\x12\x18\n\x07private\x18\x03 \x01(\x08R\x07private\"@\n\x08NodePair\x12\x1b\n\tnode_from\x18\x01 \x01(\x0cR\x08nodeFrom\x12\x17\n\x07node_to\x18\x02 \x01(\x0cR\x06nodeTo\"\xc2\x01\n%QueryAggregatedMissionControlResponse\x12(\n\x05pairs\x18\x01 \x03(\x0b\x32\x12.ecrpc.PairHistoryR\x05pairs\x12,\n\x07\x64\x61taset\x18\x02 \x01(\x0b\x32\x12.ecrpc.DatasetInfoR\x07\x64\x61taset\x12\x41\n\x10liquidity_bounds\x18\x03 \x03(\x0b\x32\x16.ecrpc.LiquidityBoundsR\x0fliquidityBounds\"\xfc\x01\n\x0fLiquidityBounds\x12(\n\x10short_channel_id\x18\x01 \x01(\x04R\x0eshortChannelId\x12\x1f\n\x0bsource_node\x18\x02 \x01(\x0cR\nsourceNode\x12\x1f\n\x0btarget_node\x18\x03 \x01(\x0cR\ntargetNode\x12,\n\x12min_liquidity_msat\x18\x04 \x01(\x04R\x10minLiquidityMsat\x12,\n\x12max_liquidity_msat\x18\x05 \x01(\x04R\x10maxLiquidityMsat\x12!\n\x0clast_updated\x18\x06 \x01(\x03R\x0blastUpdated\"\xde\x01\n\x0b\x44\x61tasetInfo\x12\x1a\n\x08revision\x18\x01 \x01(\x04R\x08revision\x12\x1f\n\x0btotal_pairs\x18\x02 \x01(\x04R\ntotalPairs\x12\x14\n\x05\x65poch\x18\x03 \x01(\x04R\x05\x65poch\x12\'\n\x0foldest_revision\x18\x04 \x01(\x04R\x0eoldestRevision\x12S\n\x16pathfinding_parameters\x18\x05 \x01(\x0b\x32\x1c.ecrpc.PathfindingParametersR\x15pathfindingParameters\"\xa3\x01\n\x15PathfindingParameters\x12*\n\x11half_life_seconds\x18\x01 \x01(\x04R\x0fhalfLifeSeconds\x12\'\n\x0fhop_probability\x18\x02 \x01(\x01R\x0ehopProbability\x12\x16\n\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x1d\n\nupdated_at\x18\x04 \x01(\x03R\tupdatedAt\"\x98\x01\n\x0bPairHistory\x12\x1b\n\tnode_from\x18\x01 \x01(\x0cR\x08nodeFrom\x12\x17\n\x07node_to\x18\x02 \x01(\x0cR\x06nodeTo\x12)\n\x07history\x18\x03 \x01(\x0b\x32\x0f.ecrpc.PairDataR\x07history\x12(\n\x10short_channel_id\x18\x04 \x01(\x04R\x0eshortChannelId\"\xea\x03\n\x08PairData\x12\x1b\n\tfail_time\x18\x01 \x01(\x03R\x08\x66\x61ilTime\x12 \n\x0c\x66\x61il_amt_sat\x18\x02 \x01(\x03R\nfailAmtSat\x12\"\n\rfail_amt_msat\x18\x03 \x01(\x03R\x0b\x66\x61ilAmtMsat\x12!\n\x0csuccess_time\x18\x04 \x01(\x03R\x0bsuccessTime\x12&\n\x0fsuccess_amt_sat\x18\x05 \x01(\x03R\rsuccessAmtSat\x12(\n\x10success_amt_msat\x18\x06 \x01(\x03R\x0esuccessAmtMsat\x12\x32\n\x15resolution_latency_ms\x18\x07 \x01(\rR\x13resolutionLatencyMs\x12$\n\x0elatency_p50_ms\x18\x08 \x01(\rR\x0clatencyP50Ms\x12$\n\x0elatency_p95_ms\x18\t \x01(\rR\x0clatencyP95Ms\x12%\n\x0e\x66\x61ilure_streak\x18\n \x01(\rR\rfailureStreak\x12.\n\x13success_gap_seconds\x18\x0b \x01(\x03R\x11successGapSeconds\x12/\n\x13\x63onflicting_reports\x18\x0c \x01(\rR\x12\x63onflictingReports\"X\n\x0b\x45rrorDetail\x12*\n\x06reason\x18\x01 \x01(\x0e\x32\x12.ecrpc.ErrorReasonR\x06reason\x12\x1d\n\npair_index\x18\x02 \x01(\rR\tpairIndex*\x85\x01\n\x0bPairOutcome\x12\x19\n\x15PAIR_OUTCOME_ACCEPTED\x10\x00\x12\x17\n\x13PAIR_OUTCOME_MERGED\x10\x01\x12\x1f\n\x1bPAIR_OUTCOME_REJECTED_STALE\x10\x02\x12!\n\x1dPAIR_OUTCOME_REJECTED_INVALID\x10\x03*L\n\x0bQueryFormat\x12\x16\n\x12QUERY_FORMAT_PAIRS\x10\x00\x12%\n!QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS\x10\x01*`\n\tSortOrder\x12\x1a\n\x16SORT_ORDER_NODE_PUBKEY\x10\x00\x12\x18\n\x14SORT_ORDER_FRESHNESS\x10\x01\x12\x1d\n\x19SORT_ORDER_FAILURE_AMOUNT\x10\x02*\x88\x01\n\x0b\x45rrorReason\x12\x1c\n\x18\x45RROR_REASON_UNSPECIFIED\x10\x00\x12\x1b\n\x17\x45RROR_REASON_STALE_DATA\x10\x01\x12\x1f\n\x1b\x45RROR_REASON_QUOTA_EXCEEDED\x10\x02\x12\x1d\n\x19\x45RROR_REASON_INVALID_PAIR\x10\x03\x32\xa6\x12\n\x13\x45xternalCoordinator\x12\x8e\x01\n\x16RegisterMissionControl\x12$.ecrpc.RegisterMissionControlRequest\x1a%.ecrpc.RegisterMissionControlResponse\"\'\x82\xd3\xe4\x93\x02!\"\x1c/v1/register_mission_control:\x01*\x12\xaa\x01\n\x1dQueryAggregatedMissionControl\x12+.ecrpc.QueryAggregatedMissionControlRequest\x1a,.ecrpc.QueryAggregatedMissionControlResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/query_aggregated_mission_control0\x01\x12J\n\x07GetInfo\x12\x15.ecrpc.GetInfoRequest\x1a\x16.ecrpc.GetInfoResponse\"\x10\x82\xd3\xe4\x93\x02\n\x12\x08/v1/info\x12U\n\nListEpochs\x12\x18.ecrpc.ListEpochsRequest\x1a\x19.ecrpc.ListEpochsResponse\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/v1/epochs\x12\x90\x01\n\x11QueryEpochHistory\x12\x1f.ecrpc.QueryEpochHistoryRequest\x1a,.ecrpc.QueryAggregatedMissionControlResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/epochs/{epoch}/mission_control0\x01\x12N\n\x08GetStats\x12\x16.ecrpc.GetStatsRequest\x1a\x17.ecrpc.GetStatsResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/stats\x12\x9b\x01\n\x1aQueryPrivateMissionControl\x12(.ecrpc.QueryPrivateMissionControlRequest\x1a,.ecrpc.QueryAggregatedMissionControlResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/private_mission_control0\x01\x12\x83\x01\n\x15RegisterCLNPayResults\x12#.ecrpc.RegisterCLNPayResultsRequest\x1a%.ecrpc.RegisterMissionControlResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x13/v1/cln/pay_results:\x01*\x12{\n\x12PollMissionControl\x12 .ecrpc.PollMissionControlRequest\x1a!.ecrpc.PollMissionControlResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/poll_mission_control\x12\x94\x01\n\x18QueryMissionControlDelta\x12&.ecrpc.QueryMissionControlDeltaRequest\x1a\'.ecrpc.QueryMissionControlDeltaResponse\"\'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/query_mission_control_delta\x12\x8c\x01\n\x17SubscribeMissionControl\x12%.ecrpc.SubscribeMissionControlRequest\x1a!.ecrpc.PollMissionControlResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/subscribe_mission_control0\x01\x12\x9d\x01\n\x1cRegisterMissionControlStream\x12$.ecrpc.RegisterMissionControlRequest\x1a%.ecrpc.RegisterMissionControlResponse\".\x82\xd3\xe4\x93\x02(\"#/v1/register_mission_control_stream:\x01*(\x01\x12\x82\x01\n\x12SyncMissionControl\x12 .ecrpc.SyncMissionControlRequest\x1a!.ecrpc.SyncMissionControlResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/sync_mission_control:\x01*(\x01\x30\x01\x12\x61\n\rQueryTopPairs\x12\x1b.ecrpc.QueryTopPairsRequest\x1a\x1c.ecrpc.QueryTopPairsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/top_pairs\x12p\n\tQueryPair\x12\x17.ecrpc.QueryPairRequest\x1a\x18.ecrpc.QueryPairResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/missioncontrol/{node_from}/{node_to}\x12y\n\x13QueryNodeReputation\x12!.ecrpc.QueryNodeReputationRequest\x1a\".ecrpc.QueryNodeReputationResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/node_reputation\x12\x83\x01\n\x14\x45xportMissionControl\x12\".ecrpc.ExportMissionControlRequest\x1a#.ecrpc.ExportMissionControlResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/export_mission_control\x12\x88\x01\n\x14ImportMissionControl\x12\".ecrpc.ImportMissionControlRequest\x1a%.ecrpc.RegisterMissionControlResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/import_mission_control:\x01*BAZ?github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpcb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -34,21 +34,143 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z?github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc'
   _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterMissionControl']._loaded_options = None
-  _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterMissionControl']._serialized_options = b'\202\323\344\223\002\037\"\032/v1/registermissioncontrol:\001*'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterMissionControl']._serialized_options = b'\202\323\344\223\002!\"\034/v1/register_mission_control:\001*'
   _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryAggregatedMissionControl']._loaded_options = None
-  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryAggregatedMissionControl']._serialized_options = b'\202\323\344\223\002#\022!/v1/queryaggregatedmissioncontrol'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryAggregatedMissionControl']._serialized_options = b'\202\323\344\223\002&\022$/v1/query_aggregated_mission_control'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['GetInfo']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['GetInfo']._serialized_options = b'\202\323\344\223\002\n\022\010/v1/info'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['ListEpochs']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['ListEpochs']._serialized_options = b'\202\323\344\223\002\014\022\n/v1/epochs'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryEpochHistory']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryEpochHistory']._serialized_options = b'\202\323\344\223\002$\022\"/v1/epochs/{epoch}/mission_control'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['GetStats']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['GetStats']._serialized_options = b'\202\323\344\223\002\013\022\t/v1/stats'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryPrivateMissionControl']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryPrivateMissionControl']._serialized_options = b'\202\323\344\223\002\035\022\033/v1/private_mission_control'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterCLNPayResults']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterCLNPayResults']._serialized_options = b'\202\323\344\223\002\030\"\023/v1/cln/pay_results:\001*'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['PollMissionControl']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['PollMissionControl']._serialized_options = b'\202\323\344\223\002\032\022\030/v1/poll_mission_control'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryMissionControlDelta']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryMissionControlDelta']._serialized_options = b'\202\323\344\223\002!\022\037/v1/query_mission_control_delta'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['SubscribeMissionControl']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['SubscribeMissionControl']._serialized_options = b'\202\323\344\223\002\037\022\035/v1/subscribe_mission_control'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterMissionControlStream']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['RegisterMissionControlStream']._serialized_options = b'\202\323\344\223\002(\"#/v1/register_mission_control_stream:\001*'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['SyncMissionControl']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['SyncMissionControl']._serialized_options = b'\202\323\344\223\002\035\"\030/v1/sync_mission_control:\001*'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryTopPairs']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryTopPairs']._serialized_options = b'\202\323\344\223\002\017\022\r/v1/top_pairs'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryPair']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryPair']._serialized_options = b'\202\323\344\223\002*\022(/v1/missioncontrol/{node_from}/{node_to}'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryNodeReputation']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['QueryNodeReputation']._serialized_options = b'\202\323\344\223\002\025\022\023/v1/node_reputation'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['ExportMissionControl']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['ExportMissionControl']._serialized_options = b'\202\323\344\223\002\034\022\032/v1/export_mission_control'
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['ImportMissionControl']._loaded_options = None
+  _globals['_EXTERNALCOORDINATOR'].methods_by_name['ImportMissionControl']._serialized_options = b'\202\323\344\223\002\037\"\032/v1/import_mission_control:\001*'
+  _globals['_PAIROUTCOME']._serialized_start=7622
+  _globals['_PAIROUTCOME']._serialized_end=7755
+  _globals['_QUERYFORMAT']._serialized_start=7757
+  _globals['_QUERYFORMAT']._serialized_end=7833
+  _globals['_SORTORDER']._serialized_start=7835
+  _globals['_SORTORDER']._serialized_end=7931
+  _globals['_ERRORREASON']._serialized_start=7934
+  _globals['_ERRORREASON']._serialized_end=8070
   _globals['_REGISTERMISSIONCONTROLREQUEST']._serialized_start=73
-  _globals['_REGISTERMISSIONCONTROLREQUEST']._serialized_end=146
-  _globals['_REGISTERMISSIONCONTROLRESPONSE']._serialized_start=148
-  _globals['_REGISTERMISSIONCONTROLRESPONSE']._serialized_end=221
-  _globals['_QUERYAGGREGATEDMISSIONCONTROLREQUEST']._serialized_start=223
-  _globals['_QUERYAGGREGATEDMISSIONCONTROLREQUEST']._serialized_end=261
-  _globals['_QUERYAGGREGATEDMISSIONCONTROLRESPONSE']._serialized_start=263
-  _globals['_QUERYAGGREGATEDMISSIONCONTROLRESPONSE']._serialized_end=344
-  _globals['_PAIRHISTORY']._serialized_start=346
-  _globals['_PAIRHISTORY']._serialized_end=456
-  _globals['_PAIRDATA']._serialized_start=459
-  _globals['_PAIRDATA']._serialized_end=685
-  _globals['_EXTERNALCOORDINATOR']._serialized_start=688
-  _globals['_EXTERNALCOORDINATOR']._serialized_end=1022
+  _globals['_REGISTERMISSIONCONTROLREQUEST']._serialized_end=198
+  _globals['_REGISTERCLNPAYRESULTSREQUEST']._serialized_start=201
+  _globals['_REGISTERCLNPAYRESULTSREQUEST']._serialized_end=357
+  _globals['_CLNPAYATTEMPT']._serialized_start=360
+  _globals['_CLNPAYATTEMPT']._serialized_end=570
+  _globals['_CLNROUTEHOP']._serialized_start=572
+  _globals['_CLNROUTEHOP']._serialized_end=660
+  _globals['_REGISTERMISSIONCONTROLRESPONSE']._serialized_start=663
+  _globals['_REGISTERMISSIONCONTROLRESPONSE']._serialized_end=937
+  _globals['_PAIRRESULT']._serialized_start=940
+  _globals['_PAIRRESULT']._serialized_end=1098
+  _globals['_SUBMISSIONHINTS']._serialized_start=1100
+  _globals['_SUBMISSIONHINTS']._serialized_end=1227
+  _globals['_GETINFOREQUEST']._serialized_start=1229
+  _globals['_GETINFOREQUEST']._serialized_end=1245
+  _globals['_GETINFORESPONSE']._serialized_start=1248
+  _globals['_GETINFORESPONSE']._serialized_end=1774
+  _globals['_CAPACITYFORECAST']._serialized_start=1777
+  _globals['_CAPACITYFORECAST']._serialized_end=1981
+  _globals['_STARTUPPROGRESS']._serialized_start=1983
+  _globals['_STARTUPPROGRESS']._serialized_end=2074
+  _globals['_GETSTATSREQUEST']._serialized_start=2076
+  _globals['_GETSTATSREQUEST']._serialized_end=2093
+  _globals['_FRESHNESSBUCKET']._serialized_start=2095
+  _globals['_FRESHNESSBUCKET']._serialized_end=2174
+  _globals['_REGIONSTATS']._serialized_start=2176
+  _globals['_REGIONSTATS']._serialized_end=2303
+  _globals['_GETSTATSRESPONSE']._serialized_start=2306
+  _globals['_GETSTATSRESPONSE']._serialized_end=2642
+  _globals['_LISTEPOCHSREQUEST']._serialized_start=2644
+  _globals['_LISTEPOCHSREQUEST']._serialized_end=2663
+  _globals['_EPOCH']._serialized_start=2665
+  _globals['_EPOCH']._serialized_end=2774
+  _globals['_LISTEPOCHSRESPONSE']._serialized_start=2776
+  _globals['_LISTEPOCHSRESPONSE']._serialized_end=2878
+  _globals['_QUERYEPOCHHISTORYREQUEST']._serialized_start=2880
+  _globals['_QUERYEPOCHHISTORYREQUEST']._serialized_end=2928
+  _globals['_QUERYPRIVATEMISSIONCONTROLREQUEST']._serialized_start=2930
+  _globals['_QUERYPRIVATEMISSIONCONTROLREQUEST']._serialized_end=2965
+  _globals['_QUERYAGGREGATEDMISSIONCONTROLREQUEST']._serialized_start=2968
+  _globals['_QUERYAGGREGATEDMISSIONCONTROLREQUEST']._serialized_end=3539
+  _globals['_POLLMISSIONCONTROLREQUEST']._serialized_start=3541
+  _globals['_POLLMISSIONCONTROLREQUEST']._serialized_end=3648
+  _globals['_SUBSCRIBEMISSIONCONTROLREQUEST']._serialized_start=3650
+  _globals['_SUBSCRIBEMISSIONCONTROLREQUEST']._serialized_end=3721
+  _globals['_POLLMISSIONCONTROLRESPONSE']._serialized_start=3724
+  _globals['_POLLMISSIONCONTROLRESPONSE']._serialized_end=3914
+  _globals['_QUERYMISSIONCONTROLDELTAREQUEST']._serialized_start=3916
+  _globals['_QUERYMISSIONCONTROLDELTAREQUEST']._serialized_end=3971
+  _globals['_QUERYMISSIONCONTROLDELTARESPONSE']._serialized_start=3974
+  _globals['_QUERYMISSIONCONTROLDELTARESPONSE']._serialized_end=4200
+  _globals['_SYNCMISSIONCONTROLREQUEST']._serialized_start=4203
+  _globals['_SYNCMISSIONCONTROLREQUEST']._serialized_end=4363
+  _globals['_SYNCMISSIONCONTROLRESPONSE']._serialized_start=4366
+  _globals['_SYNCMISSIONCONTROLRESPONSE']._serialized_end=4530
+  _globals['_QUERYTOPPAIRSREQUEST']._serialized_start=4532
+  _globals['_QUERYTOPPAIRSREQUEST']._serialized_end=4636
+  _globals['_QUERYTOPPAIRSRESPONSE']._serialized_start=4638
+  _globals['_QUERYTOPPAIRSRESPONSE']._serialized_end=4749
+  _globals['_QUERYPAIRREQUEST']._serialized_start=4751
+  _globals['_QUERYPAIRREQUEST']._serialized_end=4863
+  _globals['_QUERYPAIRRESPONSE']._serialized_start=4865
+  _globals['_QUERYPAIRRESPONSE']._serialized_end=4970
+  _globals['_QUERYNODEREPUTATIONREQUEST']._serialized_start=4972
+  _globals['_QUERYNODEREPUTATIONREQUEST']._serialized_end=5060
+  _globals['_QUERYNODEREPUTATIONRESPONSE']._serialized_start=5063
+  _globals['_QUERYNODEREPUTATIONRESPONSE']._serialized_end=5317
+  _globals['_EXPORTMISSIONCONTROLREQUEST']._serialized_start=5319
+  _globals['_EXPORTMISSIONCONTROLREQUEST']._serialized_end=5370
+  _globals['_EXPORTMISSIONCONTROLRESPONSE']._serialized_start=5372
+  _globals['_EXPORTMISSIONCONTROLRESPONSE']._serialized_end=5469
+  _globals['_LNDPAIRHISTORY']._serialized_start=5472
+  _globals['_LNDPAIRHISTORY']._serialized_end=5612
+  _globals['_LNDPAIRDATA']._serialized_start=5615
+  _globals['_LNDPAIRDATA']._serialized_end=5850
+  _globals['_IMPORTMISSIONCONTROLREQUEST']._serialized_start=5852
+  _globals['_IMPORTMISSIONCONTROLREQUEST']._serialized_end=5972
+  _globals['_NODEPAIR']._serialized_start=5974
+  _globals['_NODEPAIR']._serialized_end=6038
+  _globals['_QUERYAGGREGATEDMISSIONCONTROLRESPONSE']._serialized_start=6041
+  _globals['_QUERYAGGREGATEDMISSIONCONTROLRESPONSE']._serialized_end=6235
+  _globals['_LIQUIDITYBOUNDS']._serialized_start=6238
+  _globals['_LIQUIDITYBOUNDS']._serialized_end=6490
+  _globals['_DATASETINFO']._serialized_start=6493
+  _globals['_DATASETINFO']._serialized_end=6715
+  _globals['_PATHFINDINGPARAMETERS']._serialized_start=6718
+  _globals['_PATHFINDINGPARAMETERS']._serialized_end=6881
+  _globals['_PAIRHISTORY']._serialized_start=6884
+  _globals['_PAIRHISTORY']._serialized_end=7036
+  _globals['_PAIRDATA']._serialized_start=7039
+  _globals['_PAIRDATA']._serialized_end=7529
+  _globals['_ERRORDETAIL']._serialized_start=7531
+  _globals['_ERRORDETAIL']._serialized_end=7619
+  _globals['_EXTERNALCOORDINATOR']._serialized_start=8073
+  _globals['_EXTERNALCOORDINATOR']._serialized_end=10415
 # @@protoc_insertion_point(module_scope)
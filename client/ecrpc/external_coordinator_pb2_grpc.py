@@ -24,6 +24,86 @@ class ExternalCoordinatorStub(object):
                 request_serializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlRequest.SerializeToString,
                 response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.FromString,
                 _registered_method=True)
+        self.GetInfo = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/GetInfo',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.GetInfoRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.GetInfoResponse.FromString,
+                _registered_method=True)
+        self.ListEpochs = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/ListEpochs',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.ListEpochsRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.ListEpochsResponse.FromString,
+                _registered_method=True)
+        self.QueryEpochHistory = channel.unary_stream(
+                '/ecrpc.ExternalCoordinator/QueryEpochHistory',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.QueryEpochHistoryRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.FromString,
+                _registered_method=True)
+        self.GetStats = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/GetStats',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.GetStatsRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.GetStatsResponse.FromString,
+                _registered_method=True)
+        self.QueryPrivateMissionControl = channel.unary_stream(
+                '/ecrpc.ExternalCoordinator/QueryPrivateMissionControl',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.QueryPrivateMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.FromString,
+                _registered_method=True)
+        self.RegisterCLNPayResults = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/RegisterCLNPayResults',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.RegisterCLNPayResultsRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.FromString,
+                _registered_method=True)
+        self.PollMissionControl = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/PollMissionControl',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.PollMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.PollMissionControlResponse.FromString,
+                _registered_method=True)
+        self.QueryMissionControlDelta = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/QueryMissionControlDelta',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.QueryMissionControlDeltaRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryMissionControlDeltaResponse.FromString,
+                _registered_method=True)
+        self.SubscribeMissionControl = channel.unary_stream(
+                '/ecrpc.ExternalCoordinator/SubscribeMissionControl',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.SubscribeMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.PollMissionControlResponse.FromString,
+                _registered_method=True)
+        self.RegisterMissionControlStream = channel.stream_unary(
+                '/ecrpc.ExternalCoordinator/RegisterMissionControlStream',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.FromString,
+                _registered_method=True)
+        self.SyncMissionControl = channel.stream_stream(
+                '/ecrpc.ExternalCoordinator/SyncMissionControl',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.SyncMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.SyncMissionControlResponse.FromString,
+                _registered_method=True)
+        self.QueryTopPairs = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/QueryTopPairs',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.QueryTopPairsRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryTopPairsResponse.FromString,
+                _registered_method=True)
+        self.QueryPair = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/QueryPair',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.QueryPairRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryPairResponse.FromString,
+                _registered_method=True)
+        self.QueryNodeReputation = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/QueryNodeReputation',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.QueryNodeReputationRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.QueryNodeReputationResponse.FromString,
+                _registered_method=True)
+        self.ExportMissionControl = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/ExportMissionControl',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.ExportMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.ExportMissionControlResponse.FromString,
+                _registered_method=True)
+        self.ImportMissionControl = channel.unary_unary(
+                '/ecrpc.ExternalCoordinator/ImportMissionControl',
+                request_serializer=ecrpc_dot_external__coordinator__pb2.ImportMissionControlRequest.SerializeToString,
+                response_deserializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.FromString,
+                _registered_method=True)
 
 
 class ExternalCoordinatorServicer(object):
@@ -43,6 +123,169 @@ class ExternalCoordinatorServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetInfo(self, request, context):
+        """GetInfo returns information about the coordinator including its
+        version, the dataset it serves and hints on how clients should pace
+        their submissions.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListEpochs(self, request, context):
+        """ListEpochs lists the archived epochs of the mission control data
+        together with the current one.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryEpochHistory(self, request, context):
+        """QueryEpochHistory queries the mission control data archived at the end
+        of an epoch.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetStats(self, request, context):
+        """GetStats returns aggregate statistics of the mission control data
+        without revealing the data of any pair. It is served even if the
+        coordinator withholds the pairs themselves.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryPrivateMissionControl(self, request, context):
+        """QueryPrivateMissionControl queries the private mission control data
+        registered with access tokens of the same owner as the access token of
+        the request. Private data is never part of the public aggregate.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RegisterCLNPayResults(self, request, context):
+        """RegisterCLNPayResults registers the results of payment attempts made
+        by a Core Lightning node, as derived from its listsendpays and
+        listpays commands. The attempts are mapped to the mission control
+        data of the pairs along their routes and registered like by
+        RegisterMissionControl.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PollMissionControl(self, request, context):
+        """PollMissionControl waits until the revision of the dataset exceeds the
+        revision known to the client or the timeout elapses, and returns the
+        pairs changed since. It serves clients keeping their data in sync
+        without holding a stream open.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryMissionControlDelta(self, request, context):
+        """QueryMissionControlDelta returns the pairs modified since the
+        watermark of the client's last sync, which turns every periodic sync
+        into a small diff instead of a full download. It does not depend on
+        the revision log.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SubscribeMissionControl(self, request, context):
+        """SubscribeMissionControl streams the pairs changed since the revision
+        known to the client, followed by the pairs changed by every later
+        revision as soon as the dataset changes. It serves clients applying
+        updates incrementally instead of polling.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RegisterMissionControlStream(self, request_iterator, context):
+        """RegisterMissionControlStream registers mission control data uploaded
+        as a stream of requests, e.g. by nodes with more pairs than fit into
+        a single request. The pairs are registered in batches while they are
+        received. All requests of a stream must share the same network and
+        private flag. Over REST, the requests are posted as newline delimited
+        JSON.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SyncMissionControl(self, request_iterator, context):
+        """SyncMissionControl synchronizes a client with the coordinator over a
+        single stream. The client uploads its pairs as a stream of requests,
+        which are registered like by RegisterMissionControlStream, while it
+        receives the pairs changed since the revision it last received, like
+        from PollMissionControl. The registration result follows once the
+        client finished the upload. It saves periodic synchronizations the
+        second round trip and connection.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryTopPairs(self, request, context):
+        """QueryTopPairs returns the pairs with the highest recent success
+        amounts, optionally limited to the pairs of a node. It serves route
+        planners looking for the most reliable corridors without downloading
+        the entire dataset.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryPair(self, request, context):
+        """QueryPair returns the history of a single pair, identified by the hex
+        encoded public keys of its nodes. Unlike the node pair query of
+        QueryAggregatedMissionControl, the public keys fit into the REST path
+        as is, so that browser and dashboard clients need not encode them in
+        base64. The pair is filtered, transformed and accounted like the pairs
+        of a query.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryNodeReputation(self, request, context):
+        """QueryNodeReputation aggregates the pairs to a node into reliability
+        metrics of the node, such as the fraction of the pairs whose most
+        recent result is a success. Like GetStats, it does not reveal the
+        data of any single pair and is served even if the coordinator
+        withholds the pairs themselves.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportMissionControl(self, request, context):
+        """ExportMissionControl exports the mission control data in the shape of
+        the XImportMissionControlRequest of the router service of LND, so that
+        it can be imported into a node as is. Over REST, the response is always
+        rendered like the REST API of LND renders its messages, regardless of
+        the REST options of the coordinator.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportMissionControl(self, request, context):
+        """ImportMissionControl registers the mission control data of an LND
+        node as printed by `lncli querymc`. The output is converted into pairs
+        by the coordinator and registered like by RegisterMissionControl, so
+        that contributors need no conversion tool of their own.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ExternalCoordinatorServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -56,6 +299,86 @@ def add_ExternalCoordinatorServicer_to_server(servicer, server):
                     request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlRequest.FromString,
                     response_serializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.SerializeToString,
             ),
+            'GetInfo': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetInfo,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.GetInfoRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.GetInfoResponse.SerializeToString,
+            ),
+            'ListEpochs': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListEpochs,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.ListEpochsRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.ListEpochsResponse.SerializeToString,
+            ),
+            'QueryEpochHistory': grpc.unary_stream_rpc_method_handler(
+                    servicer.QueryEpochHistory,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryEpochHistoryRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.SerializeToString,
+            ),
+            'GetStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetStats,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.GetStatsRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.GetStatsResponse.SerializeToString,
+            ),
+            'QueryPrivateMissionControl': grpc.unary_stream_rpc_method_handler(
+                    servicer.QueryPrivateMissionControl,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryPrivateMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.SerializeToString,
+            ),
+            'RegisterCLNPayResults': grpc.unary_unary_rpc_method_handler(
+                    servicer.RegisterCLNPayResults,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.RegisterCLNPayResultsRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.SerializeToString,
+            ),
+            'PollMissionControl': grpc.unary_unary_rpc_method_handler(
+                    servicer.PollMissionControl,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.PollMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.PollMissionControlResponse.SerializeToString,
+            ),
+            'QueryMissionControlDelta': grpc.unary_unary_rpc_method_handler(
+                    servicer.QueryMissionControlDelta,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryMissionControlDeltaRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.QueryMissionControlDeltaResponse.SerializeToString,
+            ),
+            'SubscribeMissionControl': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeMissionControl,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.SubscribeMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.PollMissionControlResponse.SerializeToString,
+            ),
+            'RegisterMissionControlStream': grpc.stream_unary_rpc_method_handler(
+                    servicer.RegisterMissionControlStream,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.SerializeToString,
+            ),
+            'SyncMissionControl': grpc.stream_stream_rpc_method_handler(
+                    servicer.SyncMissionControl,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.SyncMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.SyncMissionControlResponse.SerializeToString,
+            ),
+            'QueryTopPairs': grpc.unary_unary_rpc_method_handler(
+                    servicer.QueryTopPairs,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryTopPairsRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.QueryTopPairsResponse.SerializeToString,
+            ),
+            'QueryPair': grpc.unary_unary_rpc_method_handler(
+                    servicer.QueryPair,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryPairRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.QueryPairResponse.SerializeToString,
+            ),
+            'QueryNodeReputation': grpc.unary_unary_rpc_method_handler(
+                    servicer.QueryNodeReputation,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.QueryNodeReputationRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.QueryNodeReputationResponse.SerializeToString,
+            ),
+            'ExportMissionControl': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExportMissionControl,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.ExportMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.ExportMissionControlResponse.SerializeToString,
+            ),
+            'ImportMissionControl': grpc.unary_unary_rpc_method_handler(
+                    servicer.ImportMissionControl,
+                    request_deserializer=ecrpc_dot_external__coordinator__pb2.ImportMissionControlRequest.FromString,
+                    response_serializer=ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'ecrpc.ExternalCoordinator', rpc_method_handlers)
@@ -120,3 +443,435 @@ class ExternalCoordinator(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetInfo(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/GetInfo',
+            ecrpc_dot_external__coordinator__pb2.GetInfoRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.GetInfoResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListEpochs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/ListEpochs',
+            ecrpc_dot_external__coordinator__pb2.ListEpochsRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.ListEpochsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def QueryEpochHistory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/QueryEpochHistory',
+            ecrpc_dot_external__coordinator__pb2.QueryEpochHistoryRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/GetStats',
+            ecrpc_dot_external__coordinator__pb2.GetStatsRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.GetStatsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def QueryPrivateMissionControl(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/QueryPrivateMissionControl',
+            ecrpc_dot_external__coordinator__pb2.QueryPrivateMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.QueryAggregatedMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RegisterCLNPayResults(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/RegisterCLNPayResults',
+            ecrpc_dot_external__coordinator__pb2.RegisterCLNPayResultsRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def PollMissionControl(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/PollMissionControl',
+            ecrpc_dot_external__coordinator__pb2.PollMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.PollMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def QueryMissionControlDelta(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/QueryMissionControlDelta',
+            ecrpc_dot_external__coordinator__pb2.QueryMissionControlDeltaRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.QueryMissionControlDeltaResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SubscribeMissionControl(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/SubscribeMissionControl',
+            ecrpc_dot_external__coordinator__pb2.SubscribeMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.PollMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RegisterMissionControlStream(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/ecrpc.ExternalCoordinator/RegisterMissionControlStream',
+            ecrpc_dot_external__coordinator__pb2.RegisterMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SyncMissionControl(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(
+            request_iterator,
+            target,
+            '/ecrpc.ExternalCoordinator/SyncMissionControl',
+            ecrpc_dot_external__coordinator__pb2.SyncMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.SyncMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def QueryTopPairs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/QueryTopPairs',
+            ecrpc_dot_external__coordinator__pb2.QueryTopPairsRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.QueryTopPairsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def QueryPair(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/QueryPair',
+            ecrpc_dot_external__coordinator__pb2.QueryPairRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.QueryPairResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def QueryNodeReputation(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/QueryNodeReputation',
+            ecrpc_dot_external__coordinator__pb2.QueryNodeReputationRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.QueryNodeReputationResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExportMissionControl(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/ExportMissionControl',
+            ecrpc_dot_external__coordinator__pb2.ExportMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.ExportMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ImportMissionControl(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ecrpc.ExternalCoordinator/ImportMissionControl',
+            ecrpc_dot_external__coordinator__pb2.ImportMissionControlRequest.SerializeToString,
+            ecrpc_dot_external__coordinator__pb2.RegisterMissionControlResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
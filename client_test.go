@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// TestClientIdentity tests the identity derived for the client of a request.
+func TestClientIdentity(t *testing.T) {
+	withPeer := func(ip string, forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 4242},
+		})
+		if len(forwarded) == 0 {
+			return ctx
+		}
+
+		return metadata.NewIncomingContext(
+			ctx, metadata.Pairs(forwardedForHeader, forwarded[0]),
+		)
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name:     "NoPeer",
+			ctx:      context.Background(),
+			expected: unknownClient,
+		},
+		{
+			name:     "DirectClient",
+			ctx:      withPeer("203.0.113.1"),
+			expected: "203.0.113.1",
+		},
+		{
+			name:     "GatewayForwarded",
+			ctx:      withPeer("127.0.0.1", "198.51.100.1, 203.0.113.1"),
+			expected: "203.0.113.1",
+		},
+		{
+			name:     "GatewayWithoutForwarded",
+			ctx:      withPeer("::1"),
+			expected: "::1",
+		},
+		{
+			name:     "SpoofedForwarded",
+			ctx:      withPeer("203.0.113.1", "198.51.100.1"),
+			expected: "203.0.113.1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, clientIdentity(tc.ctx))
+		})
+	}
+}
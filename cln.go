@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// clnStatusComplete is the status of a successful Core Lightning
+	// payment attempt.
+	clnStatusComplete = "complete"
+
+	// clnStatusFailed is the status of a failed Core Lightning payment
+	// attempt.
+	clnStatusFailed = "failed"
+
+	// clnStatusPending is the status of an unresolved Core Lightning
+	// payment attempt.
+	clnStatusPending = "pending"
+
+	// clnTemporaryChannelFailure is the BOLT 4 failure code of a channel
+	// lacking the liquidity to forward an amount, the only failure telling
+	// about the amount the pair can forward.
+	clnTemporaryChannelFailure = 0x1007
+)
+
+// RegisterCLNPayResults registers the results of payment attempts made by a
+// Core Lightning node. The attempts are mapped to the mission control data of
+// the pairs along their routes, which is registered like by
+// RegisterMissionControl.
+func (s *externalCoordinatorServer) RegisterCLNPayResults(
+	ctx context.Context, req *ecrpc.RegisterCLNPayResultsRequest) (
+	*ecrpc.RegisterMissionControlResponse, error) {
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request "+
+			"cannot be nil")
+	}
+
+	pairs, err := clnPairHistories(req.Source, req.Attempts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs:   pairs,
+			Network: req.Network,
+			Private: req.Private,
+		},
+	)
+}
+
+// clnPairHistories maps the payment attempts of the given Core Lightning node
+// to the mission control data of the pairs along their routes. Unlike lnd,
+// Core Lightning reports the amounts delivered to each hop and the index of
+// the node that reported a failure, so the pairs before the erring node
+// forwarded their amounts successfully while the pair of the erring node
+// failed to forward its amount.
+func clnPairHistories(source []byte,
+	attempts []*ecrpc.CLNPayAttempt) ([]*ecrpc.PairHistory, error) {
+
+	var pairs []*ecrpc.PairHistory
+	for i, attempt := range attempts {
+		attemptPairs, err := clnAttemptPairs(source, attempt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"attempt %d: %v", i, err)
+		}
+		pairs = append(pairs, attemptPairs...)
+	}
+
+	if len(pairs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "attempts do "+
+			"not contain any pair results")
+	}
+
+	return pairs, nil
+}
+
+// clnAttemptPairs returns the pair results of a single payment attempt.
+func clnAttemptPairs(source []byte,
+	attempt *ecrpc.CLNPayAttempt) ([]*ecrpc.PairHistory, error) {
+
+	if attempt == nil {
+		return nil, fmt.Errorf("attempt cannot be nil")
+	}
+
+	// The number of pairs forwarding the amount successfully and whether
+	// the pair following them failed to.
+	var successes int
+	var failed bool
+	switch attempt.Status {
+	case clnStatusPending:
+		return nil, nil
+
+	case clnStatusComplete:
+		successes = len(attempt.Route)
+
+	case clnStatusFailed:
+		// The erring node failed to forward to the next hop, unless it
+		// is the destination, which only fails once the payment
+		// reached it.
+		erring := int(attempt.ErringIndex)
+		if erring > len(attempt.Route) {
+			return nil, fmt.Errorf("erring index %d exceeds route "+
+				"of %d hops", erring, len(attempt.Route))
+		}
+		successes = erring
+
+		// Other failures than a lack of liquidity do not depend on the
+		// amount, which the coordinator does not record.
+		failed = erring < len(attempt.Route) &&
+			attempt.Failcode == clnTemporaryChannelFailure
+
+	default:
+		return nil, fmt.Errorf("unknown status %q", attempt.Status)
+	}
+
+	// Attempts are timestamped in seconds, resolved ones by the time they
+	// were resolved at.
+	timestamp := int64(attempt.CompletedAt)
+	if timestamp == 0 {
+		timestamp = int64(attempt.CreatedAt)
+	}
+
+	hops := attempt.Route[:successes]
+	if failed {
+		hops = attempt.Route[:successes+1]
+	}
+
+	pairs := make([]*ecrpc.PairHistory, 0, len(hops))
+	nodeFrom := source
+	for i, hop := range hops {
+		scid, err := parseCLNShortChannelID(hop.Channel)
+		if err != nil {
+			return nil, fmt.Errorf("hop %d: %v", i, err)
+		}
+
+		amtMsat := int64(hop.AmountMsat)
+		history := &ecrpc.PairData{}
+		if i < successes {
+			history.SuccessTime = timestamp
+			history.SuccessAmtMsat = amtMsat
+			history.SuccessAmtSat = amtMsat / mSatScale
+		} else {
+			history.FailTime = timestamp
+			history.FailAmtMsat = amtMsat
+			history.FailAmtSat = amtMsat / mSatScale
+		}
+
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom:       nodeFrom,
+			NodeTo:         hop.Id,
+			History:        history,
+			ShortChannelId: scid,
+		})
+		nodeFrom = hop.Id
+	}
+
+	return pairs, nil
+}
+
+// parseCLNShortChannelID parses a short channel id in the BLOCKxTXxOUTPUT
+// format used by Core Lightning, optionally followed by the direction of the
+// channel. An empty short channel id is parsed as zero.
+func parseCLNShortChannelID(channel string) (uint64, error) {
+	if channel == "" {
+		return 0, nil
+	}
+
+	// The direction is not part of the short channel id.
+	channel, _, _ = strings.Cut(channel, "/")
+
+	parts := strings.Split(channel, "x")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid short channel id %q", channel)
+	}
+
+	block, err := strconv.ParseUint(parts[0], 10, 24)
+	if err != nil {
+		return 0, fmt.Errorf("invalid block of short channel id %q: %v",
+			channel, err)
+	}
+	tx, err := strconv.ParseUint(parts[1], 10, 24)
+	if err != nil {
+		return 0, fmt.Errorf("invalid transaction index of short "+
+			"channel id %q: %v", channel, err)
+	}
+	output, err := strconv.ParseUint(parts[2], 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid output index of short channel "+
+			"id %q: %v", channel, err)
+	}
+
+	return block<<40 | tx<<16 | output, nil
+}
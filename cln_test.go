@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRegisterCLNPayResults tests that the payment attempts of Core Lightning
+// nodes are mapped to the pairs along their routes and registered.
+func TestRegisterCLNPayResults(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	source, nodeA := generateTestKeys(t)
+	nodeB, nodeC := generateTestKeys(t)
+	now := uint64(time.Now().Unix())
+	route := func(amtMsat uint64) []*ecrpc.CLNRouteHop {
+		return []*ecrpc.CLNRouteHop{{
+			Id:         nodeA,
+			Channel:    "103x1x0/1",
+			AmountMsat: amtMsat + 2,
+		}, {
+			Id:         nodeB,
+			Channel:    "104x2x1",
+			AmountMsat: amtMsat + 1,
+		}, {
+			Id:         nodeC,
+			Channel:    "105x3x0",
+			AmountMsat: amtMsat,
+		}}
+	}
+	register := func(attempts ...*ecrpc.CLNPayAttempt) error {
+		req := &ecrpc.RegisterCLNPayResultsRequest{
+			Source:   source,
+			Attempts: attempts,
+		}
+		_, err := server.RegisterCLNPayResults(
+			context.Background(), req,
+		)
+		return err
+	}
+	stored := func(nodeFrom, nodeTo []byte) *ecrpc.PairData {
+		var history *ecrpc.PairData
+		err := db.View(func(tx *bbolt.Tx) error {
+			v := tx.Bucket([]byte(DatabaseBucketName)).Get(
+				pairKey(nodeFrom, nodeTo),
+			)
+			if v == nil {
+				return nil
+			}
+			var err error
+			history, err = decodePairData(v)
+
+			return err
+		})
+		require.NoError(t, err)
+
+		return history
+	}
+
+	// Pending attempts and failures not depending on the amount at the
+	// source do not tell anything about the pairs.
+	err = register(&ecrpc.CLNPayAttempt{
+		Status:    clnStatusPending,
+		CreatedAt: now,
+		Route:     route(5_000_000),
+	}, &ecrpc.CLNPayAttempt{
+		Status:      clnStatusFailed,
+		CreatedAt:   now,
+		Route:       route(5_000_000),
+		ErringIndex: 0,
+		Failcode:    0x400a,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The second node lacks the liquidity to forward 5000 sat, so the
+	// first pair succeeded while the second one failed and the third one
+	// was never tried.
+	require.NoError(t, register(&ecrpc.CLNPayAttempt{
+		Status:      clnStatusFailed,
+		CreatedAt:   now - 10,
+		CompletedAt: now,
+		Route:       route(5_000_000),
+		ErringIndex: 2,
+		Failcode:    clnTemporaryChannelFailure,
+	}))
+	history := stored(source, nodeA)
+	require.EqualValues(t, 5_000_002, history.SuccessAmtMsat)
+	require.EqualValues(t, now, history.SuccessTime)
+	history = stored(nodeA, nodeB)
+	require.EqualValues(t, 5_000_001, history.SuccessAmtMsat)
+	history = stored(nodeB, nodeC)
+	require.EqualValues(t, 5_000_000, history.FailAmtMsat)
+	require.EqualValues(t, 5_000, history.FailAmtSat)
+	require.EqualValues(t, now, history.FailTime)
+
+	// Failures reported by the destination only happen once the payment
+	// reached it, so all pairs succeeded.
+	require.NoError(t, register(&ecrpc.CLNPayAttempt{
+		Status:      clnStatusFailed,
+		CreatedAt:   now,
+		Route:       route(1_000_000),
+		ErringIndex: 3,
+		Failcode:    0x400f,
+	}))
+	history = stored(nodeB, nodeC)
+	require.EqualValues(t, 1_000_000, history.SuccessAmtMsat)
+	require.EqualValues(t, 5_000_000, history.FailAmtMsat)
+
+	// Malformed attempts are rejected.
+	err = register(&ecrpc.CLNPayAttempt{
+		Status:      clnStatusFailed,
+		CreatedAt:   now,
+		Route:       route(1_000_000),
+		ErringIndex: 4,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = register(&ecrpc.CLNPayAttempt{
+		Status:    "unknown",
+		CreatedAt: now,
+		Route:     route(1_000_000),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestParseCLNShortChannelID tests the parsing of the short channel ids of
+// Core Lightning.
+func TestParseCLNShortChannelID(t *testing.T) {
+	scid, err := parseCLNShortChannelID("103x1x0")
+	require.NoError(t, err)
+	require.EqualValues(t, 103<<40|1<<16, scid)
+
+	scid, err = parseCLNShortChannelID("756230x1481x1/0")
+	require.NoError(t, err)
+	require.EqualValues(t, 756230<<40|1481<<16|1, scid)
+
+	scid, err = parseCLNShortChannelID("")
+	require.NoError(t, err)
+	require.Zero(t, scid)
+
+	for _, channel := range []string{"103x1", "103:1:0", "103x1x70000"} {
+		_, err := parseCLNShortChannelID(channel)
+		require.Error(t, err, channel)
+	}
+}
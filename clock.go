@@ -0,0 +1,58 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// clock tells the current time. Time-dependent logic such as the staleness of
+// history data obtains the time from the clock of the server instead of the
+// system, so that it can be tested deterministically.
+type clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// systemClock is the clock following the system time.
+type systemClock struct{}
+
+// Now returns the current system time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// manualClock is a clock that only moves when it is set or advanced, e.g. to
+// replay a scenario in tests.
+type manualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// newManualClock creates a manual clock starting at the given time.
+func newManualClock(now time.Time) *manualClock {
+	return &manualClock{now: now}
+}
+
+// Now returns the time the clock was last set or advanced to.
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set sets the clock to the given time.
+func (c *manualClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// Advance moves the clock forward by the given duration.
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestClockRetention tests that registered pairs are judged stale and cleaned
+// up by the time of the server clock.
+func TestClockRetention(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	start := time.Unix(1700000000, 0)
+	clock := newManualClock(start)
+	server := NewExternalCoordinatorServer(config, db)
+	server.clock = clock
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	register := func(successTime time.Time) error {
+		_, err := server.RegisterMissionControl(
+			context.Background(), &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    successTime.Unix(),
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+			},
+		)
+
+		return err
+	}
+
+	// Pairs are stale relative to the clock, not the system time.
+	err = register(start.Add(-2 * time.Hour))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NoError(t, register(start.Add(-time.Minute)))
+
+	// The pair is retained until the threshold elapsed on the clock.
+	clock.Advance(30 * time.Minute)
+	server.cleanupStaleData()
+	require.Len(t, storedPairKeys(t, db), 1)
+
+	clock.Advance(31 * time.Minute)
+	server.cleanupStaleData()
+	require.Empty(t, storedPairKeys(t, db))
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+)
+
+// recordChannelClosures records when the nodes of the given closed channels
+// lost their last channel, unless another channel between them is left in the
+// channel graph. Nodes closed already keep the time of their first closure.
+// The closures of the nodes of the given opened channels are forgotten, since
+// they are connected again. Both are given as channelNodes values.
+func recordChannelClosures(tx *bbolt.Tx, closed, opened [][]byte,
+	now time.Time) error {
+
+	b := tx.Bucket([]byte(ClosedChannelsBucketName))
+	for _, nodes := range opened {
+		if err := b.Delete(nodes); err != nil {
+			return err
+		}
+	}
+	if len(closed) == 0 {
+		return nil
+	}
+
+	// Nodes still connected by another channel are not closed.
+	connected := make(map[string]bool)
+	graph := tx.Bucket([]byte(ChannelGraphBucketName))
+	err := graph.ForEach(func(_, nodes []byte) error {
+		connected[string(nodes)] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	closedAt := encodeUint64(uint64(now.Unix()))
+	for _, nodes := range closed {
+		if connected[string(nodes)] || b.Get(nodes) != nil {
+			continue
+		}
+		if err := b.Put(nodes, closedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneClosedChannelPairs removes both directions of the pairs between nodes
+// whose last channel was closed longer than the closed channel retention ago,
+// since their history no longer predicts anything. The closures are forgotten
+// once their pairs are removed. It returns the keys of the pairs removed.
+func (s *externalCoordinatorServer) pruneClosedChannelPairs() ([][]byte,
+	error) {
+
+	cutoff := s.clock.Now().Add(
+		-s.config.Server.ClosedChannelRetention,
+	).Unix()
+
+	var removed [][]byte
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		removed = nil
+
+		closures := tx.Bucket([]byte(ClosedChannelsBucketName))
+		var expired [][]byte
+		err := closures.ForEach(func(nodes, closedAt []byte) error {
+			if int64(decodeUint64(closedAt)) < cutoff {
+				expired = append(expired, bytes.Clone(nodes))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		for _, nodes := range expired {
+			node1 := nodes[:PubKeyCompressedSize]
+			node2 := nodes[PubKeyCompressedSize:]
+			for _, key := range [][]byte{
+				pairKey(node1, node2), pairKey(node2, node1),
+			} {
+				if b.Get(key) == nil {
+					continue
+				}
+				if err := removePairData(tx, key); err != nil {
+					return err
+				}
+				removed = append(removed, key)
+			}
+
+			if err := closures.Delete(nodes); err != nil {
+				return err
+			}
+		}
+		if len(removed) == 0 {
+			return nil
+		}
+
+		return bumpDatasetRevision(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
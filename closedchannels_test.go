@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestClosedChannelPruning tests that the pairs between nodes whose channels
+// were all closed are removed once the closure exceeds the retention, while
+// nodes with another or a reopened channel keep their pairs.
+func TestClosedChannelPruning(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = 24 * time.Hour
+	config.Server.ClosedChannelRetention = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+	admin := NewAdminServer(config, db)
+
+	importGraph := func(req *ecadminrpc.ImportChannelGraphRequest) {
+		stream := &mockImportChannelGraphServer{
+			Chunks: []*ecadminrpc.ImportChannelGraphRequest{req},
+		}
+		require.NoError(t, admin.ImportChannelGraph(stream))
+	}
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Full: true,
+		Channels: []*ecadminrpc.Channel{
+			{ShortChannelId: 1, Node1: nodeA, Node2: nodeB},
+			{ShortChannelId: 2, Node1: nodeB, Node2: nodeA},
+			{ShortChannelId: 3, Node1: nodeA, Node2: nodeC},
+			{ShortChannelId: 4, Node1: nodeC, Node2: nodeD},
+		},
+	})
+
+	var pairs []*ecrpc.PairHistory
+	for _, nodes := range [][2][]byte{
+		{nodeA, nodeB}, {nodeB, nodeA}, {nodeA, nodeC}, {nodeC, nodeA},
+		{nodeC, nodeD},
+	} {
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodes[0],
+			NodeTo:   nodes[1],
+			History: &ecrpc.PairData{
+				SuccessTime:    clock.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	// Nodes A and B keep their second channel, while nodes A and C and
+	// nodes C and D lose their only one. The channel of nodes C and D is
+	// reopened under another short channel id by a full import.
+	importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Closed: []uint64{1, 3},
+	})
+	importGraph(&ecadminrpc.ImportChannelGraphRequest{
+		Full: true,
+		Channels: []*ecadminrpc.Channel{
+			{ShortChannelId: 2, Node1: nodeB, Node2: nodeA},
+			{ShortChannelId: 5, Node1: nodeD, Node2: nodeC},
+		},
+	})
+
+	// Pairs are kept within the retention.
+	clock.Advance(30 * time.Minute)
+	removed, err := server.pruneClosedChannelPairs()
+	require.NoError(t, err)
+	require.Empty(t, removed)
+
+	clock.Advance(time.Hour)
+	removed, err = server.pruneClosedChannelPairs()
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{
+		pairKey(nodeA, nodeC), pairKey(nodeC, nodeA),
+	}, removed)
+	require.ElementsMatch(t, []string{
+		string(pairKey(nodeA, nodeB)), string(pairKey(nodeB, nodeA)),
+		string(pairKey(nodeC, nodeD)),
+	}, storedPairKeys(t, db))
+
+	// The closure is forgotten once its pairs are removed.
+	removed, err = server.pruneClosedChannelPairs()
+	require.NoError(t, err)
+	require.Empty(t, removed)
+}
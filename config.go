@@ -46,6 +46,10 @@ const (
 	// DefaultTLSDomainName is the default domain name for tls certificates.
 	DefaultTLSDomainName = "localhost"
 
+	// DefaultTLSMinVersion is the default minimum TLS version accepted by
+	// the servers.
+	DefaultTLSMinVersion = "1.2"
+
 	// DefaultLogDirname is the default directory name for storing log
 	// files.
 	DefaultLogDirname = "logs"
@@ -54,6 +58,10 @@ const (
 	// operational log.
 	DefaultLogFilename = "ec.log"
 
+	// DefaultNetwork is the default network the coordinator collects
+	// mission control data for.
+	DefaultNetwork = "mainnet"
+
 	// DefaultGrpcServerHost specifies the default host address that the
 	// gRPC server will bind to. By default, it binds to all network
 	// interfaces including both IPv4 and IPv6.
@@ -72,6 +80,19 @@ const (
 	// server will listen on.
 	DefaultRestServerPort = ":8081"
 
+	// DefaultAdminGrpcServerHost specifies the default host address that
+	// the admin gRPC server will bind to. By default it binds only to the
+	// local machine so that administrative operations are not exposed.
+	DefaultAdminGrpcServerHost = "localhost"
+
+	// DefaultAdminGrpcServerPort specifies the default port that the admin
+	// gRPC server will listen on.
+	DefaultAdminGrpcServerPort = ":50051"
+
+	// DefaultAdminMacaroonFilename is the default filename of the macaroon
+	// authenticating requests to the admin gRPC server.
+	DefaultAdminMacaroonFilename = "admin.macaroon"
+
 	// DefaultPProfServerHost specifies the default host address that the
 	// pprof server will bind to. By default it binds only to the local
 	// machine (IPv4 loopback address).
@@ -104,6 +125,110 @@ const (
 	// transmission and higher memory consumption.
 	DefaultQueryMissionControlBatchSize = 4600
 
+	// DefaultMaxQueryBatchSize specifies the default maximum number of
+	// pairs a query may request per batch. With ~114 bytes per pair, a
+	// batch stays below the default gRPC message size limit of 4MB.
+	DefaultMaxQueryBatchSize = 32000
+
+	// DefaultQueryWorkers specifies the default number of workers decoding
+	// the chunks of a query response while the previous chunks are being
+	// sent.
+	DefaultQueryWorkers = 2
+
+	// DefaultQueryAuditRetention specifies the default duration for which
+	// query audit records are kept, set to 30 days.
+	DefaultQueryAuditRetention = 30 * 24 * time.Hour
+
+	// DefaultSlowOperationThreshold specifies the default duration after
+	// which a query or registration is logged as slow.
+	DefaultSlowOperationThreshold = 5 * time.Second
+
+	// DefaultOperationTimeout specifies the default server-side execution
+	// timeout of a query or registration.
+	DefaultOperationTimeout = 5 * time.Minute
+
+	// DefaultMaxPollTimeout specifies the default maximum time a long poll
+	// waits for the mission control data to change.
+	DefaultMaxPollTimeout = time.Minute
+
+	// DefaultRESTCacheStaleTTL specifies the default duration for which
+	// expired REST responses are still served while they are regenerated.
+	DefaultRESTCacheStaleTTL = time.Minute
+
+	// DefaultRESTCacheMaxEntries specifies the default maximum number of
+	// REST responses held by the cache.
+	DefaultRESTCacheMaxEntries = 64
+
+	// DefaultRESTAmountUnits specifies the default units amounts are
+	// returned in by the REST server.
+	DefaultRESTAmountUnits = "both"
+
+	// DefaultShadowQueueSize specifies the default maximum number of
+	// registrations waiting to be mirrored to the shadow coordinator.
+	DefaultShadowQueueSize = 100
+
+	// DefaultShadowTimeout specifies the default timeout for mirroring a
+	// single registration to the shadow coordinator.
+	DefaultShadowTimeout = 30 * time.Second
+
+	// DefaultAuthzTimeout specifies the default timeout for a decision of
+	// the external authorization policy.
+	DefaultAuthzTimeout = 2 * time.Second
+
+	// DefaultSnapshotInterval specifies the default interval on which
+	// snapshots are shipped to the standby coordinator.
+	DefaultSnapshotInterval = time.Minute
+
+	// DefaultSnapshotTimeout specifies the default timeout for shipping a
+	// single snapshot to the standby coordinator.
+	DefaultSnapshotTimeout = 5 * time.Minute
+
+	// DefaultRelayInterval specifies the default interval on which the
+	// pairs registered by the local nodes are relayed to the upstream
+	// coordinator.
+	DefaultRelayInterval = 10 * time.Minute
+
+	// DefaultRelayTimeout specifies the default timeout for relaying a
+	// single upload to the upstream coordinator.
+	DefaultRelayTimeout = 5 * time.Minute
+
+	// DefaultStandbyReconcileInterval specifies the default interval on
+	// which the standby coordinator is reconciled through the Merkle tree
+	// over the pairs.
+	DefaultStandbyReconcileInterval = time.Hour
+
+	// DefaultUpgradeTimeout specifies the default maximum time a new
+	// process started for a zero-downtime upgrade takes to take over.
+	DefaultUpgradeTimeout = time.Minute
+
+	// DefaultArchivedEpochs specifies the default maximum number of
+	// archived epochs kept in the database.
+	DefaultArchivedEpochs = 12
+
+	// DefaultSyncIntervalHint specifies the default interval suggested to
+	// clients between two syncs when the coordinator is not busy.
+	DefaultSyncIntervalHint = 10 * time.Minute
+
+	// DefaultRegisterBatchSizeHint specifies the default maximum number of
+	// pairs per registration request suggested to clients when the
+	// coordinator is not busy.
+	DefaultRegisterBatchSizeHint = 10000
+
+	// DefaultBusyRegistrationThreshold specifies the default number of
+	// concurrently processed registrations above which the coordinator
+	// considers itself busy and asks clients to back off.
+	DefaultBusyRegistrationThreshold = 8
+
+	// DefaultMaxPairsPerNode specifies the default maximum number of pairs
+	// stored per source node. It is well above the number of channels of
+	// the largest nodes.
+	DefaultMaxPairsPerNode = 10000
+
+	// MaxSubmissionBackoffFactor specifies the maximum factor by which the
+	// suggested sync interval is stretched and the suggested batch size is
+	// shrunk when the coordinator is busy.
+	MaxSubmissionBackoffFactor = 8
+
 	// DefaultLogLevel specifies the default logging level used across the
 	// application.
 	DefaultLogLevel = "info"
@@ -123,10 +248,227 @@ const (
 	// batch of database write operations is committed.
 	DefaultMaxBatchDelay = 10 * time.Millisecond
 
+	// DefaultFreelistType specifies the default freelist type used by the
+	// database. The array type is bbolt's default while the map type
+	// performs better for large databases with many free pages.
+	DefaultFreelistType = "array"
+
+	// DefaultKeyScheme specifies the default encoding of the pair keys in
+	// the database.
+	DefaultKeyScheme = "compressed_pubkey"
+
+	// DefaultConflictPolicy specifies the default policy resolving
+	// conflicting reports of a pair.
+	DefaultConflictPolicy = "majority"
+
+	// DefaultStaleSubmitterPolicy specifies the default policy applied to
+	// the pairs contributed solely by stale submitters.
+	DefaultStaleSubmitterPolicy = "purge"
+
+	// DefaultStaleSubmitterMaxAge specifies the default age after which
+	// the decay policy removes the pairs contributed solely by stale
+	// submitters.
+	DefaultStaleSubmitterMaxAge = 24 * time.Hour
+
+	// DefaultSubmitterShiftWindow specifies the default window within
+	// which a single submitter may shift the amounts of a pair by at most
+	// the submitter shift cap.
+	DefaultSubmitterShiftWindow = time.Hour
+
+	// DefaultWALFilename is the default filename for the write-ahead log
+	// persisting queued registrations when async writes are enabled.
+	DefaultWALFilename = "write_queue.wal"
+
+	// DefaultWriteQueueSize specifies the default maximum number of
+	// registrations waiting to be applied when async writes are enabled.
+	DefaultWriteQueueSize = 64
+
+	// DefaultBackupInterval specifies the default interval on which backups
+	// of the database are written if enabled.
+	DefaultBackupInterval = 24 * time.Hour
+
+	// DefaultMaxBackups specifies the default maximum number of backups kept
+	// in the backup directory.
+	DefaultMaxBackups = 7
+
+	// DefaultNotifyCheckInterval specifies the default interval on which the
+	// certificate and the disk are checked for issues.
+	DefaultNotifyCheckInterval = time.Hour
+
+	// DefaultNotifyRepeatInterval specifies the default interval after
+	// which an unresolved issue is notified again.
+	DefaultNotifyRepeatInterval = 24 * time.Hour
+
+	// DefaultCertificateExpiryWarning specifies the default duration
+	// before the expiry of the TLS certificate at which the operator is
+	// notified.
+	DefaultCertificateExpiryWarning = 14 * 24 * time.Hour
+
+	// DefaultMinFreeDiskPercent specifies the default percentage of free
+	// disk space below which the operator is notified.
+	DefaultMinFreeDiskPercent = 10
+
+	// DefaultCapacityWarning specifies the default period within which the
+	// database must be forecast to run out of space for the operator to be
+	// notified.
+	DefaultCapacityWarning = 30 * 24 * time.Hour
+
+	// DefaultCleanupFailureThreshold specifies the default number of
+	// consecutive cleanup failures at which the operator is notified.
+	DefaultCleanupFailureThreshold = 3
+
+	// DefaultWebhookTimeout specifies the default timeout for delivering
+	// a notification to the webhook.
+	DefaultWebhookTimeout = 10 * time.Second
+
 	// DatabaseBucketName specifies the default name of the bucket used
 	// within the bbolt database for mission control data.
 	DatabaseBucketName = "MissionControl"
 
+	// NodeGroupsBucketName specifies the name of the bucket used within
+	// the bbolt database for operator defined node groups. Each group is
+	// stored as a nested bucket keyed by the member node pubkeys.
+	NodeGroupsBucketName = "NodeGroups"
+
+	// LatencySamplesBucketName specifies the name of the bucket used within
+	// the bbolt database to keep the most recent HTLC resolution latency
+	// observations of each pair.
+	LatencySamplesBucketName = "LatencySamples"
+
+	// QueryAuditBucketName specifies the name of the bucket used within the
+	// bbolt database to record the queries of clients when the query audit
+	// is enabled.
+	QueryAuditBucketName = "QueryAudit"
+
+	// AggregationExperimentBucketName specifies the name of the bucket used
+	// within the bbolt database for the pairs aggregated by the
+	// experimental aggregation policy.
+	AggregationExperimentBucketName = "AggregationExperiment"
+
+	// MetadataBucketName specifies the name of the bucket used within the
+	// bbolt database for metadata about the stored data such as the
+	// network it belongs to.
+	MetadataBucketName = "Metadata"
+
+	// ArchiveBucketName specifies the name of the bucket used within the
+	// bbolt database for the mission control data archived at the end of
+	// each epoch. Each epoch is stored as a nested bucket keyed by its
+	// number.
+	ArchiveBucketName = "Archive"
+
+	// UpdateIndexBucketName specifies the name of the bucket used within
+	// the bbolt database to index the mission control data by the time of
+	// its last update. Each key is the big-endian update time followed by
+	// the key of the pair.
+	UpdateIndexBucketName = "UpdateIndex"
+
+	// NodeIndexBucketName specifies the name of the bucket used within the
+	// bbolt database to index the nodes involved in the mission control
+	// data. Each node is keyed by its pubkey and holds the big-endian
+	// number of pairs involving it.
+	NodeIndexBucketName = "NodeIndex"
+
+	// ChannelGraphBucketName specifies the name of the bucket used within
+	// the bbolt database for the channel graph imported by the operator.
+	// Each channel is keyed by its big-endian short channel id and holds
+	// the pubkeys of its nodes in canonical order.
+	ChannelGraphBucketName = "ChannelGraph"
+
+	// ClosedChannelsBucketName specifies the name of the bucket used
+	// within the bbolt database to track the nodes whose channels were all
+	// closed. Each pair of nodes is keyed by their pubkeys in canonical
+	// order and holds the big-endian unix time their last channel was
+	// removed from the channel graph at.
+	ClosedChannelsBucketName = "ClosedChannels"
+
+	// PrivatePairsBucketName specifies the name of the bucket used within
+	// the bbolt database for the private mission control data. It holds a
+	// nested bucket per owner with the aggregated pairs of the owner keyed
+	// like the public ones.
+	PrivatePairsBucketName = "PrivatePairs"
+
+	// PairObservationsBucketName specifies the name of the bucket used
+	// within the bbolt database to keep the results reported for each pair
+	// within the conflict window, from which conflicting reports are
+	// resolved.
+	PairObservationsBucketName = "PairObservations"
+
+	// SubmittersBucketName specifies the name of the bucket used within the
+	// bbolt database to track the submitters of mission control data. Each
+	// submitter is keyed by its identity and holds its big-endian id
+	// followed by the big-endian unix time it was last seen at.
+	SubmittersBucketName = "Submitters"
+
+	// PairSubmittersBucketName specifies the name of the bucket used within
+	// the bbolt database to track which submitters contributed to each
+	// pair. Each pair is keyed like the mission control data and holds the
+	// big-endian ids of its most recent submitters.
+	PairSubmittersBucketName = "PairSubmitters"
+
+	// JournalBucketName specifies the name of the bucket used within the
+	// bbolt database to journal the registrations of mission control data
+	// as received. Each registration is keyed by the big-endian unix time
+	// it was stored at followed by its big-endian sequence number.
+	JournalBucketName = "RegistrationJournal"
+
+	// CanaryBucketName specifies the name of the bucket used within the
+	// bbolt database to hold the synthetic canary pairs, which are never
+	// served and tell if the database was tampered with. Each pair is keyed
+	// by its big-endian index and holds the encoded pair history.
+	CanaryBucketName = "Canaries"
+
+	// WatermarkRecipientsBucketName specifies the name of the bucket used
+	// within the bbolt database to track the recipients of watermarked
+	// data. Each recipient is keyed by the owner of its access token and
+	// holds the big-endian unix time it was last served at.
+	WatermarkRecipientsBucketName = "WatermarkRecipients"
+
+	// PairModifiedBucketName specifies the name of the bucket used within
+	// the bbolt database to track the big-endian unix time each pair was
+	// last stored or removed at.
+	PairModifiedBucketName = "PairModified"
+
+	// ModifiedIndexBucketName specifies the name of the bucket used within
+	// the bbolt database to index the pairs by the time they were last
+	// stored or removed at, so that the pairs modified since a time are
+	// found by a range scan.
+	ModifiedIndexBucketName = "ModifiedIndex"
+
+	// MerkleEntriesBucketName specifies the name of the bucket used within
+	// the bbolt database to hold the hash of each pair keyed by the leaf
+	// of the Merkle tree it falls into, followed by the pair key.
+	MerkleEntriesBucketName = "MerkleEntries"
+
+	// MerkleLeavesBucketName specifies the name of the bucket used within
+	// the bbolt database to hold the digest and number of pairs of each
+	// leaf of the Merkle tree over the pairs.
+	MerkleLeavesBucketName = "MerkleLeaves"
+
+	// RevisionLogBucketName specifies the name of the bucket used within
+	// the bbolt database to log the data pairs had at past revisions of the
+	// dataset. Each pair is keyed by the big-endian revision it was changed
+	// at followed by the key of the pair and holds its data before the
+	// change.
+	RevisionLogBucketName = "RevisionLog"
+
+	// MaxPairSubmitters specifies the maximum number of submitters
+	// tracked per pair. The least recent submitters are discarded first.
+	MaxPairSubmitters = 16
+
+	// MaxConflictObservations specifies the maximum number of observations
+	// retained per pair to resolve conflicting reports. Older observations
+	// are discarded first.
+	MaxConflictObservations = 32
+
+	// MaxLatencySamples specifies the maximum number of latency
+	// observations retained per pair to compute the latency percentiles.
+	// Older observations are discarded first.
+	MaxLatencySamples = 100
+
+	// MaxNodeGroupNameLength specifies the maximum length in bytes of a
+	// node group name.
+	MaxNodeGroupNameLength = 64
+
 	// MinFailureRelaxInterval is the minimum time that must
 	// have passed since the previously recorded failure before the failure
 	// amount may be raised in the context of mission control data.
@@ -178,27 +520,97 @@ const (
 // Config holds the overall configuration values for the server.
 type Config struct {
 	Server   ServerConfig   `mapstructure:"server" description:"Configuration settings related to server endpoints, including both gRPC and REST servers."`
-	PProf    PProfConfig    `mapstructure:"pprof" description:"Configuration for the pprof server used for monitoring and profiling the application."`
+	PProf    PProfConfig    `mapstructure:"pprof" description:"Configuration for the pprof server used for monitoring and profiling the application. It also exposes Prometheus metrics on /metrics."`
 	TLS      TLSConfig      `mapstructure:"tls" description:"Configuration related to Transport Layer Security (TLS), including settings for both self-signed and third-party certificates."`
 	Database DatabaseConfig `mapstructure:"database" description:"Database configuration settings, including the path, filename, and operational parameters like timeouts and batch sizes."`
 	Log      LogConfig      `mapstructure:"log" description:"Logging configuration, specifying the path, file, and level of logging detail."`
+	Notify   NotifyConfig   `mapstructure:"notify" description:"Operator notification settings for issues like soon-to-expire certificates, low disk space, failed backups and repeated cleanup failures. Notifications are always logged and optionally delivered to a webhook or by email. The severity of each event is one of 'off', 'info', 'warning' and 'critical'."`
 }
 
 // ServerConfig holds the server configuration values.
 type ServerConfig struct {
-	GRPCServerHost               string        `mapstructure:"grpc_server_host" description:"The host address for the gRPC server. Specify the IP address or hostname that the gRPC server will bind to. Default is '[::]', which represents all available network interfaces."`
-	GRPCServerPort               string        `mapstructure:"grpc_server_port" description:"The port number for the gRPC server. This is the port on which the gRPC server will listen for incoming connections."`
-	RESTServerHost               string        `mapstructure:"rest_server_host" description:"The host address for the RESTful server interface provided via gRPC Gateway. It determines the network address the HTTP server binds to. Default is '[::]', which represents all available network interfaces."`
-	RESTServerPort               string        `mapstructure:"rest_server_port" description:"The port number for the RESTful HTTP server. This port will be used for handling HTTP requests that are translated into gRPC calls."`
-	HistoryThresholdDuration     time.Duration `mapstructure:"history_threshold_duration" description:"The duration threshold for history data pair, by default set to 7 days. If historical data pair exceed this threshold, It is considered too old and will be removed from the database. This threshold is also used to validate and sanitize against the mission control data being registered."`
-	StaleDataCleanupInterval     time.Duration `mapstructure:"stale_data_cleanup_interval" description:"The interval for cleaning up stale mission control data from the database, by default set to 24 hours i.e. the cleanup will happen every day."`
-	QueryMissionControlBatchSize int           `mapstructure:"query_mission_control_batch_size" description:"The default number of pairs to be sent in each batch when querying the aggregated mission control data. The size of a given mission control pair is ~114 bytes as defined in the proto file. With the default value of 4600 pairs, the batch size would be approximately 512 KB (1/2 MB)."`
+	GRPCServerHost                string        `mapstructure:"grpc_server_host" description:"The host address for the gRPC server. Specify the IP address or hostname that the gRPC server will bind to. Default is '[::]', which represents all available network interfaces. IPv6 addresses may be given with or without brackets, such as '::1'."`
+	GRPCServerPort                string        `mapstructure:"grpc_server_port" description:"The port number for the gRPC server. This is the port on which the gRPC server will listen for incoming connections."`
+	RESTServerHost                string        `mapstructure:"rest_server_host" description:"The host address for the RESTful server interface provided via gRPC Gateway. It determines the network address the HTTP server binds to. Default is '[::]', which represents all available network interfaces. IPv6 addresses may be given with or without brackets, such as '::1'."`
+	RESTServerPort                string        `mapstructure:"rest_server_port" description:"The port number for the RESTful HTTP server. This port will be used for handling HTTP requests that are translated into gRPC calls."`
+	DisableREST                   bool          `mapstructure:"disable_rest" description:"Whether the REST server is not started, leaving only the gRPC servers. This cuts the attack surface and memory of deployments not needing the REST API. Binaries built with the minimal build tag never start it."`
+	AdminGRPCServerHost           string        `mapstructure:"admin_grpc_server_host" description:"The host address for the admin gRPC server serving administrative operations such as managing node groups. By default the server only binds to the localhost. IPv6 addresses may be given with or without brackets, such as '::1'. Every request to the admin server is authenticated with the admin macaroon, regardless of the address it binds to."`
+	AdminGRPCServerPort           string        `mapstructure:"admin_grpc_server_port" description:"The port number for the admin gRPC server. Administrative operations are only available on this port and never on the public gRPC and REST servers."`
+	AdminMacaroonPath             string        `mapstructure:"admin_macaroon_path" description:"The path of the macaroon authenticating requests to the admin gRPC server. It is created on startup if it does not exist, and must be sent hex encoded in the 'macaroon' metadata of every admin request. Delete the file and restart the coordinator to revoke the admin macaroon and create a new one."`
+	Network                       string        `mapstructure:"network" description:"The network the coordinator collects mission control data for, one of 'mainnet', 'testnet', 'signet' and 'regtest'. Registrations for another network are rejected. The database is labeled with the network on first use and refuses to open for a different one, so use a separate database directory per network."`
+	SlowOperationThreshold        time.Duration `mapstructure:"slow_operation_threshold" description:"The duration after which a query or registration is logged as slow together with the number of keys scanned, and counted in the metrics. Set to 0 to disable slow operation logging."`
+	OperationTimeout              time.Duration `mapstructure:"operation_timeout" description:"The server-side execution timeout of a query or registration. Scans exceeding it are aborted and fail with a deadline exceeded error. Set to 0 to disable the timeout."`
+	MaxPollTimeout                time.Duration `mapstructure:"max_poll_timeout" description:"The maximum time a PollMissionControl request waits for the mission control data to change. Requests without a timeout wait this long. Graceful shutdowns wait for pending polls, so keep it short."`
+	ClientDailyEgressCap          int64         `mapstructure:"client_daily_egress_cap" description:"The maximum number of bytes of query responses served to a single client per UTC day. Clients reaching the cap are rejected with a resource exhausted error (HTTP 429 on REST) and told when to retry. This protects public coordinators from clients pulling full snapshots in tight loops. The accounting is only kept in memory. Set to 0 to disable the cap."`
+	RESTBasePath                  string        `mapstructure:"rest_base_path" description:"The base path under which the REST API is served, e.g. '/mission-control' to serve '/mission-control/v1/info'. This allows running the coordinator behind existing ingress controllers alongside other services. A prefix announced by a reverse proxy through the X-Forwarded-Prefix header takes precedence. Leave empty to serve the API at the root."`
+	RESTCacheTTL                  time.Duration `mapstructure:"rest_cache_ttl" description:"The duration for which rendered responses to REST GET requests are cached and served without reaching the coordinator. This protects the database from thundering herds of dashboard refreshes. The cache is bypassed while client_daily_egress_cap, authz_url or query_audit is set, since cached responses are neither accounted, authorized nor audited. Set to 0 to disable the cache."`
+	RESTCacheStaleTTL             time.Duration `mapstructure:"rest_cache_stale_ttl" description:"The duration after the cache TTL for which expired REST responses are still served while they are regenerated in the background."`
+	RESTCacheMaxEntries           int           `mapstructure:"rest_cache_max_entries" description:"The maximum number of distinct REST responses held by the cache. The oldest response is evicted when the cache is full."`
+	RESTAmountUnits               string        `mapstructure:"rest_amount_units" description:"The units of the amounts returned by the REST server. With 'both' amounts are returned in sats and in millisats, e.g. as failAmtSat and failAmtMsat. With 'sat' they are only returned in sats and with 'msat' only in millisats, which spares clients from picking the right one of two fields. Amounts only tracked in millisats are converted to sats with 'sat'."`
+	RESTRFC3339Timestamps         bool          `mapstructure:"rest_rfc3339_timestamps" description:"Whether the REST server returns timestamps as RFC 3339 strings in UTC alongside the unix seconds, e.g. failTimeRfc3339 next to failTime, for clients not handling unix timestamps."`
+	RESTEmitDefaults              bool          `mapstructure:"rest_emit_defaults" description:"Whether the REST server includes fields holding their default values, such as zero amounts, empty strings and false, instead of omitting them. This suits clients requiring every field to be present."`
+	RESTUseProtoNames             bool          `mapstructure:"rest_use_proto_names" description:"Whether the REST server names fields by their proto names in snake_case, e.g. fail_amt_msat, instead of their JSON names in lowerCamelCase, e.g. failAmtMsat. Requests are accepted with either naming. The bundled Python REST client expects lowerCamelCase."`
+	RESTJSONIndent                string        `mapstructure:"rest_json_indent" description:"The indentation of the JSON responses of the REST server, consisting of spaces and tabs. Leave empty for compact responses. Indented streamed responses span multiple lines, so clients can no longer split streams by line."`
+	HistoryThresholdDuration      time.Duration `mapstructure:"history_threshold_duration" description:"The duration threshold for history data pair, by default set to 7 days. If historical data pair exceed this threshold, It is considered too old and will be removed from the database. This threshold is also used to validate and sanitize against the mission control data being registered."`
+	QueryThresholdDuration        time.Duration `mapstructure:"query_threshold_duration" description:"The age after which pairs are no longer returned by queries unless a client requests an older maximum age, e.g. 2h to serve only recent data by default while keeping the data of the last history threshold duration. It is capped by the history threshold duration. Set to 0 to use the history threshold duration."`
+	StaleDataCleanupInterval      time.Duration `mapstructure:"stale_data_cleanup_interval" description:"The interval for cleaning up stale mission control data from the database, by default set to 24 hours i.e. the cleanup will happen every day."`
+	QueryMissionControlBatchSize  int           `mapstructure:"query_mission_control_batch_size" description:"The default number of pairs to be sent in each batch when querying the aggregated mission control data. The size of a given mission control pair is ~114 bytes as defined in the proto file. With the default value of 4600 pairs, the batch size would be approximately 512 KB (1/2 MB). Queries may request another batch size up to the maximum query batch size."`
+	MaxQueryBatchSize             int           `mapstructure:"max_query_batch_size" description:"The maximum number of pairs per batch a query may request, bounding the memory used for a single response. Larger requested batch sizes are capped. Set to 0 to always send batches of the query mission control batch size."`
+	QueryWorkers                  int           `mapstructure:"query_workers" description:"The number of workers per query decoding the next batches of pairs while the current batch is being sent. This hides the database read latency behind the network transmission for big responses."`
+	QueryAudit                    bool          `mapstructure:"query_audit" description:"Whether the coordinator records which client queried which data and when. This provides accountability for private fleet coordinators and can be inspected through the admin server. It has no effect if the privacy mode is enabled."`
+	QueryAuditRetention           time.Duration `mapstructure:"query_audit_retention" description:"The duration for which query audit records are kept before they are removed by the cleanup routine."`
+	PrivacyMode                   bool          `mapstructure:"privacy_mode" description:"Whether the coordinator avoids recording anything about its clients, as recommended for public instances. It disables the query audit and omits client addresses from the REST access logs."`
+	ConflictWindow                time.Duration `mapstructure:"conflict_window" description:"The window within which a success and a failure reported for the same pair at overlapping amounts are treated as conflicting reports. The results reported for each pair within the window are kept, and pairs with conflicting reports are resolved by the conflict policy instead of by the last report and marked with the number of reports contradicting the result. Set to 0 to disable conflict resolution."`
+	ConflictPolicy                string        `mapstructure:"conflict_policy" description:"The policy resolving conflicting reports within the conflict window. With 'majority' the outcome reported most often prevails, ties are broken by recency. With 'recency' the most recently observed outcome prevails."`
+	StaleSubmitterThreshold       time.Duration `mapstructure:"stale_submitter_threshold" description:"The duration after which a submitter not seen is considered stale. The submitters of each pair are tracked by the owner of their access token or else by their client, and the pairs contributed solely by stale submitters are removed by the cleanup routine according to the stale submitter policy. Pairs stored before tracking was enabled are kept. Set to 0 to disable tracking."`
+	StaleSubmitterPolicy          string        `mapstructure:"stale_submitter_policy" description:"The policy applied to the pairs contributed solely by stale submitters. With 'purge' they are removed right away. With 'decay' they are removed once their last update is older than the stale submitter max age, which lets them expire faster than other pairs."`
+	StaleSubmitterMaxAge          time.Duration `mapstructure:"stale_submitter_max_age" description:"The age after which the pairs contributed solely by stale submitters are removed by the decay policy. It should be below the history threshold duration to have an effect."`
+	SubmitterShiftCap             float64       `mapstructure:"submitter_shift_cap" description:"The percentage by which a single submitter may shift the amounts stored for a pair within the submitter shift window. Registrations shifting them further are capped, which makes gradual poisoning attacks slower and shows them in the ec_shift_capped_pairs_total metric. Submitters are identified by the owner of their access token or else by their client. The amounts are only capped relative to the ones stored when the window of the submitter started, which are kept in memory. Set to 0 to disable the cap."`
+	SubmitterShiftWindow          time.Duration `mapstructure:"submitter_shift_window" description:"The window within which a single submitter may shift the amounts stored for a pair by at most the submitter shift cap."`
+	CanaryPairs                   int           `mapstructure:"canary_pairs" description:"The number of synthetic canary pairs planted in the database, which are never served to clients. Their integrity is verified on every notification check, and the operator is notified if any was modified, deleted or inserted, e.g. due to storage corruption or a direct manipulation of the database. Changing the number replants the canary pairs. Set to 0 to disable the canary pairs."`
+	RevisionLogRetention          int           `mapstructure:"revision_log_retention" description:"The number of past revisions of the dataset kept in the revision log, which allows queries to request the dataset as of a past revision through as_of_revision. Each change of a pair is logged with the data it replaces, so the log grows with the number of changes within the retention. Set to 0 to disable the revision log."`
+	JournalRegistrations          bool          `mapstructure:"journal_registrations" description:"Whether the coordinator journals the registrations as received for the history threshold duration. The journal allows recomputing the stored pairs through the ReaggregateMissionControl admin RPC after an upgrade changed the aggregation rules, at the cost of storing every registration."`
+	TransformScript               string        `mapstructure:"transform_script" description:"The path of a Starlark script transforming the pairs without forking the coordinator. Its ingest function is called with every registered pair and its serve function with every queried pair, each receiving the pair as a dict of node_from, node_to, fail_time, fail_amt_msat, success_time and success_amt_msat. A function returns the dict of the transformed pair or None to drop the pair, the nodes of a pair cannot be changed. Either function may be omitted. Leave empty to disable transformations."`
+	ExperimentalAggregationPolicy string        `mapstructure:"experimental_aggregation_policy" description:"The name of an aggregation policy run side by side with the primary one to validate algorithm changes on live data before switching over. The primary policy keeps serving all queries while the experimental one aggregates into a separate bucket which can be compared through the admin server. Supported policies are default and latest. Leave empty to disable the experiment."`
+	ShadowTarget                  string        `mapstructure:"shadow_target" description:"The gRPC address (host:port) of a secondary coordinator to which all registrations are mirrored asynchronously. This allows testing new aggregation algorithms or staging upgrades with production-shaped data. Leave empty to disable shadowing."`
+	ShadowTLSCertFile             string        `mapstructure:"shadow_tls_cert_file" description:"The path of the TLS certificate used to verify the shadow coordinator. Leave empty to verify it using the system certificate pool."`
+	ShadowAccessToken             string        `mapstructure:"shadow_access_token" secret:"true" description:"The hex encoded access token sent to the shadow coordinator, if it requires access tokens."`
+	ShadowQueueSize               int           `mapstructure:"shadow_queue_size" description:"The maximum number of registrations waiting to be mirrored to the shadow coordinator. Registrations are dropped from shadowing while the queue is full."`
+	ShadowTimeout                 time.Duration `mapstructure:"shadow_timeout" description:"The timeout for mirroring a single registration to the shadow coordinator."`
+	StandbyMode                   bool          `mapstructure:"standby_mode" description:"Whether the coordinator runs as a warm standby. A standby rejects registrations and only stores the snapshots shipped by its primary coordinator to its admin server, while still serving queries. It accepts registrations once promoted through the admin server. The promotion is persisted, so disable this option before the next restart to keep the coordinator a standby afterwards."`
+	StandbyTarget                 string        `mapstructure:"standby_target" description:"The gRPC address (host:port) of the admin server of a warm standby coordinator to which periodic snapshots of the mission control data are shipped. This gives a simple disaster recovery setup, the standby can take over by promoting it. Leave empty to disable snapshot shipping."`
+	StandbyTLSCertFile            string        `mapstructure:"standby_tls_cert_file" description:"The path of the TLS certificate used to verify the standby coordinator. Leave empty to verify it using the system certificate pool."`
+	StandbyAdminMacaroonPath      string        `mapstructure:"standby_admin_macaroon_path" description:"The path of a copy of the admin macaroon of the standby coordinator, which authenticates the primary to the admin server of the standby. Required if standby_target is set."`
+	SnapshotInterval              time.Duration `mapstructure:"snapshot_interval" description:"The interval on which snapshots are shipped to the standby coordinator. Each snapshot only contains the pairs changed since the previous one, the first snapshot and the one after a failed shipping also contain the pairs found to differ by reconciling the standby."`
+	StandbyReconcileInterval      time.Duration `mapstructure:"standby_reconcile_interval" description:"The interval on which the standby coordinator is reconciled by comparing the Merkle trees over the pairs of both coordinators, so that the pairs the standby missed are shipped with the next snapshot. Only the subtrees which differ are exchanged. Set to 0 to only reconcile after starting and after a failed shipping."`
+	SnapshotTimeout               time.Duration `mapstructure:"snapshot_timeout" description:"The timeout for shipping a single snapshot to the standby coordinator."`
+	RelayTarget                   string        `mapstructure:"relay_target" description:"The gRPC address (host:port) of an upstream coordinator to which the pairs registered by the local nodes of a fleet are relayed. On every relay interval, the pairs changed since the previous relay are merged into a single upload of their aggregated data, so that the upstream coordinator bears one submitter instead of many and never learns the individual nodes. Private pairs are never relayed. Leave empty to disable relaying."`
+	RelayTLSCertFile              string        `mapstructure:"relay_tls_cert_file" description:"The path of the TLS certificate used to verify the upstream coordinator. Leave empty to verify it using the system certificate pool."`
+	RelayAccessToken              string        `mapstructure:"relay_access_token" secret:"true" description:"The hex encoded access token sent to the upstream coordinator, if it requires access tokens."`
+	RelayInterval                 time.Duration `mapstructure:"relay_interval" description:"The interval on which the pairs changed since the previous relay are relayed to the upstream coordinator. Pairs of a failed relay are retried with the next one."`
+	RelayTimeout                  time.Duration `mapstructure:"relay_timeout" description:"The timeout for relaying a single upload to the upstream coordinator."`
+	UpgradeTimeout                time.Duration `mapstructure:"upgrade_timeout" description:"The maximum time a new process started for a zero-downtime upgrade through the SIGUSR2 signal may take to get ready, and to wait for the old process to release the database afterwards. The old process keeps serving if the new one is not ready in time."`
+	EpochDuration                 time.Duration `mapstructure:"epoch_duration" description:"The duration of an epoch of the mission control data. At the end of each epoch the aggregated data is archived and aggregation starts afresh, which allows long-term reliability analysis through the epoch history without unbounded growth of the live data. Set to 0 to disable epochs."`
+	ArchivedEpochs                int           `mapstructure:"archived_epochs" description:"The maximum number of archived epochs kept in the database. The oldest archive is removed once it is exceeded. Set to 0 to keep all archives."`
+	SyncIntervalHint              time.Duration `mapstructure:"sync_interval_hint" description:"The interval between two syncs suggested to clients when the coordinator is not busy. The suggestion is stretched automatically under load."`
+	RegisterBatchSizeHint         int           `mapstructure:"register_batch_size_hint" description:"The maximum number of pairs per registration request suggested to clients when the coordinator is not busy. The suggestion is shrunk automatically under load."`
+	BusyRegistrationThreshold     int           `mapstructure:"busy_registration_threshold" description:"The number of concurrently processed registrations above which the coordinator considers itself busy and asks clients to back off."`
+	MaxPairsPerNode               int           `mapstructure:"max_pairs_per_node" description:"The maximum number of distinct pairs stored per source node. Once a registration exceeds it, the stalest pairs of the node are evicted. This prevents inflating the dataset with millions of fake pairs towards generated keys. Set to 0 to disable the limit."`
+	RequireChannelProof           bool          `mapstructure:"require_channel_proof" description:"Whether registered pairs must prove their channel by referencing the short channel id of a channel between their nodes. Pairs without a proof verifiable against the channel graph imported through the admin server are rejected, which raises the cost of fabricated reports."`
+	ClosedChannelRetention        time.Duration `mapstructure:"closed_channel_retention" description:"The duration after which the pairs between two nodes whose channels were all closed are removed by the cleanup routine, since their history no longer predicts anything. Closures are learned from the channel graph imported through the admin server, once a closed channel is removed from it and no other channel between the nodes is left. Set to 0 to keep the pairs until they are stale."`
+	RequireAccessToken            bool          `mapstructure:"require_access_token" description:"Whether every request to the public gRPC and REST servers must carry an access token minted through the admin server, as authorization metadata or as the Authorization header over REST. Tokens expire and can be limited to queries and to the pairs of some nodes, which allows sharing a subset of the data with third parties."`
+	StatsOnly                     bool          `mapstructure:"stats_only" description:"Whether the public API only serves aggregate statistics of the mission control data and withholds the data of the pairs. Queries of pairs and of archived epochs are rejected, while registrations are still accepted. This allows publishing insights without publishing the dataset."`
+	WatermarkServedData           bool          `mapstructure:"watermark_served_data" description:"Whether the pairs served to each owner of an access token carry a watermark, which lets the operator find out which owner leaked a dataset through the DetectWatermark admin RPC. The watermark perturbs the millisatoshi amounts of each pair by at most one millisatoshi. It requires access tokens."`
+	AuthzURL                      string        `mapstructure:"authz_url" secret:"true" description:"The URL of an external policy endpoint authorizing every request to the public gRPC and REST servers, e.g. the data API of an Open Policy Agent such as 'http://localhost:8181/v1/data/ec/allow'. The coordinator posts the method, the identity of the client and a summary of the request as a JSON object under 'input', and expects a 'result' which is either a boolean or an object with an 'allow' boolean and an optional 'reason'. Requests not allowed are rejected as permission denied. Leave empty to disable the hook."`
+	AuthzTimeout                  time.Duration `mapstructure:"authz_timeout" description:"The timeout for a decision of the external authorization policy."`
+	AuthzFailOpen                 bool          `mapstructure:"authz_fail_open" description:"Whether requests are allowed if the external authorization policy fails to decide, e.g. because it is unreachable. By default they are rejected as unavailable."`
 }
 
 // PProfConfig holds the pprof configuration values.
 type PProfConfig struct {
-	PProfServerHost string `mapstructure:"pprof_server_host" description:"The host address for the pprof server, used for profiling and monitoring the application. By default The server only binds to the localhost."`
+	PProfServerHost string `mapstructure:"pprof_server_host" description:"The host address for the pprof server, used for profiling and monitoring the application. By default The server only binds to the localhost. IPv6 addresses may be given with or without brackets, such as '::1'."`
 	PProfServerPort string `mapstructure:"pprof_server_port" description:"The port number on which the pprof server will listen. pprof provides runtime profiling data via a web interface."`
+	DisablePProf    bool   `mapstructure:"disable_pprof" description:"Whether the pprof server is not started, which also stops exposing the Prometheus metrics. Binaries built with the minimal build tag never start it."`
 }
 
 // TLSConfig holds the TLS configuration values.
@@ -209,25 +621,67 @@ type TLSConfig struct {
 	ThirdPartyTLSDirPath  string `mapstructure:"third_party_tls_dir_path" description:"Directory path that stores third-party TLS certificates, if available. This is used when certificates are provided by an external certificate authority."`
 	ThirdPartyTLSCertFile string `mapstructure:"third_party_tls_cert_file" description:"Filename of the third-party TLS certificate. This certificate is used if available, falling back to self-signed if not."`
 	ThirdPartyTLSKeyFile  string `mapstructure:"third_party_tls_key_file" description:"Filename of the private key for the third-party TLS certificate."`
-	TLSDomainName         string `mapstructure:"tls_domain_name" description:"The domain name associated with this TLS configuration. This is used to determine the correct certificate and key for the given domain."`
+	TLSDomainName         string `mapstructure:"tls_domain_name" description:"The domain name associated with this TLS configuration. This is used to determine the correct certificate and key for the given domain. The REST gateway also dials the gRPC server by this name, so set it to an IPv6 address such as '::1' on IPv6-only hosts where the name does not resolve to an IPv6 address."`
+	MinVersion            string `mapstructure:"min_version" description:"The minimum TLS version accepted by all servers, either 1.2 or 1.3."`
+	CipherSuites          string `mapstructure:"cipher_suites" description:"The comma separated names of the cipher suites accepted by all servers for TLS 1.2, e.g. TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384. The cipher suites of TLS 1.3 are not configurable. Only suites considered secure are accepted. Leave empty to accept the default cipher suites."`
 	TLSCertFile           string `description:"This field is updated by the application to point to the specific TLS certificate file that the server should use, based on the business logic. The application might choose this certificate from the self-signed set, the third-party set, or another source." ignore:"true"`
 	TLSKeyFile            string `description:"Similar to TLSCertFile, this field is updated by the application to specify the private key file corresponding to the chosen TLS certificate. The application’s logic determines whether this should be the key for the self-signed certificate, the third-party certificate, or another key." ignore:"true"`
 }
 
 // DatabaseConfig holds the database configuration values.
 type DatabaseConfig struct {
-	DatabaseDirPath string        `mapstructure:"database_dir_path" description:"The filesystem path to the directory where the database file is stored. Ensures all database operations are confined to this directory."`
-	DatabaseFile    string        `mapstructure:"database_file" description:"The filename of the database where mission control data is persisted."`
-	FileLockTimeout time.Duration `mapstructure:"file_lock_timeout" description:"The maximum time to wait for acquiring a database file lock before the operation times out. This setting is crucial for preventing deadlocks and ensuring smooth database operation under concurrent access conditions."`
-	MaxBatchSize    int           `mapstructure:"max_batch_size" description:"The maximum number of database operations to batch together. This can improve performance by reducing the number of writes to disk."`
-	MaxBatchDelay   time.Duration `mapstructure:"max_batch_delay" description:"The maximum delay before a batch of database operations is committed. Balancing this delay can help in optimizing the responsiveness and throughput of the database."`
+	DatabaseDirPath  string        `mapstructure:"database_dir_path" description:"The filesystem path to the directory where the database file is stored. Ensures all database operations are confined to this directory."`
+	DatabaseFile     string        `mapstructure:"database_file" description:"The filename of the database where mission control data is persisted."`
+	FileLockTimeout  time.Duration `mapstructure:"file_lock_timeout" description:"The maximum time to wait for acquiring a database file lock before the operation times out. This setting is crucial for preventing deadlocks and ensuring smooth database operation under concurrent access conditions."`
+	MaxBatchSize     int           `mapstructure:"max_batch_size" description:"The maximum number of database operations to batch together. This can improve performance by reducing the number of writes to disk."`
+	MaxBatchDelay    time.Duration `mapstructure:"max_batch_delay" description:"The maximum delay before a batch of database operations is committed. Balancing this delay can help in optimizing the responsiveness and throughput of the database."`
+	NoFreelistSync   bool          `mapstructure:"no_freelist_sync" description:"Whether the freelist is not synced to disk. This improves write performance at the cost of a slower database open after an unclean shutdown, as the freelist has to be rebuilt."`
+	FreelistType     string        `mapstructure:"freelist_type" description:"The freelist type of the database. Options are 'array' and 'map'. The map type is faster for large databases with many free pages."`
+	InitialMmapSize  int           `mapstructure:"initial_mmap_size" description:"The initial size in bytes of the memory map of the database. Setting it to the expected database size avoids remapping, which blocks writers while readers are active. Zero uses the default."`
+	PageSize         int           `mapstructure:"page_size" description:"The page size in bytes used when creating a new database. Zero uses the operating system page size. It has no effect on existing databases."`
+	MaxDatabaseSize  int64         `mapstructure:"max_database_size" description:"The size in bytes the database is planned to stay below, e.g. the size of its volume or quota. It is not enforced, but the operator is notified once the database is forecast to reach it within the capacity warning period. Set to 0 to only forecast when the disk fills up."`
+	KeyScheme        string        `mapstructure:"key_scheme" description:"The encoding of the pair keys in the database, determining the kind of node identifiers accepted. The only option is 'compressed_pubkey'. The encoding is recorded in the database, which can only be opened with another encoding if migrate_key_scheme is set."`
+	MigrateKeyScheme bool          `mapstructure:"migrate_key_scheme" description:"Whether the pair keys of a database recorded with another encoding than key_scheme are migrated to key_scheme on startup. The channel graph is dropped by the migration and has to be imported again. Back up the database first, since the migration cannot be undone."`
+	AsyncWrites      bool          `mapstructure:"async_writes" description:"Whether registrations are acknowledged as soon as they are queued instead of after they are merged into the database. Queued registrations are persisted to a write-ahead log so they are not lost if the process crashes before they are applied."`
+	WriteQueueSize   int           `mapstructure:"write_queue_size" description:"The maximum number of registrations waiting to be applied when async writes are enabled. Registrations are rejected while the queue is full."`
+	WALFile          string        `mapstructure:"wal_file" description:"The filename of the write-ahead log persisting queued registrations when async writes are enabled. It is located within the directory specified in 'database_dir_path'."`
+	BackupDirPath    string        `mapstructure:"backup_dir_path" description:"The directory to which consistent backups of the database are written on the backup interval, e.g. a mounted off-site storage bucket. Leave empty to disable backups."`
+	BackupInterval   time.Duration `mapstructure:"backup_interval" description:"The interval on which backups of the database are written."`
+	MaxBackups       int           `mapstructure:"max_backups" description:"The maximum number of backups kept in the backup directory. The oldest backup is removed once it is exceeded. Set to 0 to keep all backups."`
+	BackupRecipient  string        `mapstructure:"backup_recipient" description:"The age X25519 public key (age1...) backups are encrypted to. Only the holder of the corresponding identity can restore them, so backups stored off-site don't reveal the behavior of nodes if the storage is compromised. Leave empty to write unencrypted backups."`
 }
 
 // LogConfig holds the log configuration values.
 type LogConfig struct {
-	LogDirPath string `mapstructure:"log_dir_path" description:"Directory where log files are stored. Centralizes logging output to this location for easier management and review."`
-	LogFile    string `mapstructure:"log_file" description:"Filename for the log file where runtime information and errors are recorded."`
-	LogLevel   string `mapstructure:"log_level" description:"The level of logging detail. Options are 'fatal', 'error', 'warn', 'warning', 'info', 'debug'. Lower levels provide more detailed output for troubleshooting and higher levels provide condensed output for general monitoring."`
+	LogDirPath    string `mapstructure:"log_dir_path" description:"Directory where log files are stored. Centralizes logging output to this location for easier management and review."`
+	LogFile       string `mapstructure:"log_file" description:"Filename for the log file where runtime information and errors are recorded."`
+	LogLevel      string `mapstructure:"log_level" description:"The level of logging detail. Options are 'fatal', 'error', 'warn', 'warning', 'info', 'debug'. Lower levels provide more detailed output for troubleshooting and higher levels provide condensed output for general monitoring."`
+	RESTAccessLog bool   `mapstructure:"rest_access_log" description:"Whether a structured access log entry with the method, path, status, size, duration and client is written for every REST request."`
+}
+
+// NotifyConfig holds the operator notification configuration values.
+type NotifyConfig struct {
+	CheckInterval             time.Duration `mapstructure:"check_interval" description:"The interval on which the TLS certificate, the free disk space and the growth of the database are checked."`
+	RepeatInterval            time.Duration `mapstructure:"repeat_interval" description:"The interval after which an unresolved issue is notified again."`
+	CertificateExpiryWarning  time.Duration `mapstructure:"certificate_expiry_warning" description:"The duration before the expiry of the TLS certificate at which the operator is notified."`
+	MinFreeDiskPercent        float64       `mapstructure:"min_free_disk_percent" description:"The percentage of free space on the disk holding the database below which the operator is notified."`
+	CapacityWarning           time.Duration `mapstructure:"capacity_warning" description:"The period within which the database must be forecast to reach max_database_size or to fill the disk for the operator to be notified. The forecast extrapolates the growth of the database over the last 7 days, sampled on every check."`
+	CleanupFailureThreshold   int           `mapstructure:"cleanup_failure_threshold" description:"The number of consecutive failures of the cleanup routine at which the operator is notified."`
+	CertificateExpirySeverity string        `mapstructure:"certificate_expiry_severity" description:"The severity of notifications about a soon-to-expire TLS certificate."`
+	LowDiskSeverity           string        `mapstructure:"low_disk_severity" description:"The severity of notifications about low free disk space."`
+	BackupFailureSeverity     string        `mapstructure:"backup_failure_severity" description:"The severity of notifications about failed backups."`
+	CleanupFailureSeverity    string        `mapstructure:"cleanup_failure_severity" description:"The severity of notifications about repeated cleanup failures."`
+	CapacitySeverity          string        `mapstructure:"capacity_severity" description:"The severity of notifications about the database being forecast to run out of space."`
+	CanarySeverity            string        `mapstructure:"canary_severity" description:"The severity of notifications about canary pairs found tampered with."`
+	WebhookURL                string        `mapstructure:"webhook_url" secret:"true" description:"The URL notifications are posted to as JSON. Leave empty to disable the webhook."`
+	WebhookMinSeverity        string        `mapstructure:"webhook_min_severity" description:"The minimum severity of notifications posted to the webhook."`
+	WebhookTimeout            time.Duration `mapstructure:"webhook_timeout" description:"The timeout for posting a notification to the webhook."`
+	SMTPServer                string        `mapstructure:"smtp_server" description:"The address (host:port) of the SMTP server used to send notifications by email. Leave empty to disable email notifications."`
+	SMTPUsername              string        `mapstructure:"smtp_username" description:"The username to authenticate with at the SMTP server. Leave empty to send without authentication."`
+	SMTPPassword              string        `mapstructure:"smtp_password" secret:"true" description:"The password to authenticate with at the SMTP server."`
+	EmailFrom                 string        `mapstructure:"email_from" description:"The sender address of notification emails."`
+	EmailTo                   string        `mapstructure:"email_to" description:"The comma separated recipient addresses of notification emails."`
+	EmailMinSeverity          string        `mapstructure:"email_min_severity" description:"The minimum severity of notifications sent by email."`
 }
 
 // DefaultConfig returns a Config initialized with default values.
@@ -244,9 +698,40 @@ func DefaultConfig() (Config, error) {
 			GRPCServerPort:               DefaultGrpcServerPort,
 			RESTServerHost:               DefaultRestServerHost,
 			RESTServerPort:               DefaultRestServerPort,
+			AdminGRPCServerHost:          DefaultAdminGrpcServerHost,
+			AdminGRPCServerPort:          DefaultAdminGrpcServerPort,
+			AdminMacaroonPath:            filepath.Join(appPath, DefaultAdminMacaroonFilename),
+			Network:                      DefaultNetwork,
 			HistoryThresholdDuration:     DefaultHistoryThresholdDuration,
 			StaleDataCleanupInterval:     DefaultStaleDataCleanupInterval,
 			QueryMissionControlBatchSize: DefaultQueryMissionControlBatchSize,
+			MaxQueryBatchSize:            DefaultMaxQueryBatchSize,
+			QueryWorkers:                 DefaultQueryWorkers,
+			QueryAuditRetention:          DefaultQueryAuditRetention,
+			SlowOperationThreshold:       DefaultSlowOperationThreshold,
+			OperationTimeout:             DefaultOperationTimeout,
+			MaxPollTimeout:               DefaultMaxPollTimeout,
+			RESTCacheStaleTTL:            DefaultRESTCacheStaleTTL,
+			RESTCacheMaxEntries:          DefaultRESTCacheMaxEntries,
+			RESTAmountUnits:              DefaultRESTAmountUnits,
+			ShadowQueueSize:              DefaultShadowQueueSize,
+			ShadowTimeout:                DefaultShadowTimeout,
+			SnapshotInterval:             DefaultSnapshotInterval,
+			SnapshotTimeout:              DefaultSnapshotTimeout,
+			RelayInterval:                DefaultRelayInterval,
+			RelayTimeout:                 DefaultRelayTimeout,
+			StandbyReconcileInterval:     DefaultStandbyReconcileInterval,
+			UpgradeTimeout:               DefaultUpgradeTimeout,
+			ArchivedEpochs:               DefaultArchivedEpochs,
+			SyncIntervalHint:             DefaultSyncIntervalHint,
+			RegisterBatchSizeHint:        DefaultRegisterBatchSizeHint,
+			BusyRegistrationThreshold:    DefaultBusyRegistrationThreshold,
+			MaxPairsPerNode:              DefaultMaxPairsPerNode,
+			ConflictPolicy:               DefaultConflictPolicy,
+			StaleSubmitterPolicy:         DefaultStaleSubmitterPolicy,
+			StaleSubmitterMaxAge:         DefaultStaleSubmitterMaxAge,
+			SubmitterShiftWindow:         DefaultSubmitterShiftWindow,
+			AuthzTimeout:                 DefaultAuthzTimeout,
 		},
 		PProf: PProfConfig{
 			PProfServerHost: DefaultPProfServerHost,
@@ -259,6 +744,7 @@ func DefaultConfig() (Config, error) {
 			ThirdPartyTLSDirPath: filepath.Join(appPath,
 				DefaultThirdPartyTLSDirname),
 			TLSDomainName: DefaultTLSDomainName,
+			MinVersion:    DefaultTLSMinVersion,
 		},
 		Database: DatabaseConfig{
 			DatabaseDirPath: filepath.Join(appPath,
@@ -267,11 +753,35 @@ func DefaultConfig() (Config, error) {
 			FileLockTimeout: DefaultDatabaseFileLockTimeout,
 			MaxBatchSize:    DefaultMaxBatchSize,
 			MaxBatchDelay:   DefaultMaxBatchDelay,
+			FreelistType:    DefaultFreelistType,
+			KeyScheme:       DefaultKeyScheme,
+			WriteQueueSize:  DefaultWriteQueueSize,
+			WALFile:         DefaultWALFilename,
+			BackupInterval:  DefaultBackupInterval,
+			MaxBackups:      DefaultMaxBackups,
 		},
 		Log: LogConfig{
-			LogDirPath: filepath.Join(appPath, DefaultLogDirname),
-			LogFile:    DefaultLogFilename,
-			LogLevel:   DefaultLogLevel,
+			LogDirPath:    filepath.Join(appPath, DefaultLogDirname),
+			LogFile:       DefaultLogFilename,
+			LogLevel:      DefaultLogLevel,
+			RESTAccessLog: true,
+		},
+		Notify: NotifyConfig{
+			CheckInterval:             DefaultNotifyCheckInterval,
+			RepeatInterval:            DefaultNotifyRepeatInterval,
+			CertificateExpiryWarning:  DefaultCertificateExpiryWarning,
+			MinFreeDiskPercent:        DefaultMinFreeDiskPercent,
+			CapacityWarning:           DefaultCapacityWarning,
+			CleanupFailureThreshold:   DefaultCleanupFailureThreshold,
+			CertificateExpirySeverity: "warning",
+			LowDiskSeverity:           "critical",
+			BackupFailureSeverity:     "critical",
+			CleanupFailureSeverity:    "warning",
+			CapacitySeverity:          "warning",
+			CanarySeverity:            "critical",
+			WebhookMinSeverity:        "warning",
+			WebhookTimeout:            DefaultWebhookTimeout,
+			EmailMinSeverity:          "critical",
 		},
 	}, nil
 }
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+)
+
+// configOptions returns the options of the given configuration section in the
+// order they are written to the configuration file. Nested sections are named
+// like in the file. Fields set by the application instead of the
+// configuration file are omitted, and the values of non-empty fields tagged as
+// secret are redacted.
+func configOptions(val reflect.Value, typ reflect.Type,
+	section string) []*ecadminrpc.ConfigOption {
+	var options []*ecadminrpc.ConfigOption
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		fieldType := typ.Field(i)
+		key := fieldType.Tag.Get("mapstructure")
+		if fieldType.Tag.Get("ignore") == "true" {
+			continue
+		}
+
+		if field.Kind() == reflect.Struct {
+			sectionName := key
+			if section != "" {
+				sectionName = fmt.Sprintf("%s.%s", section, key)
+			}
+
+			options = append(options, configOptions(
+				field, fieldType.Type, sectionName,
+			)...)
+			continue
+		}
+
+		option := &ecadminrpc.ConfigOption{
+			Section: section,
+			Key:     key,
+			Value:   fmt.Sprintf("%v", field.Interface()),
+		}
+		if fieldType.Tag.Get("secret") == "true" && !field.IsZero() {
+			option.Value = ""
+			option.Redacted = true
+		}
+		options = append(options, option)
+	}
+
+	return options
+}
+
+// GetConfig returns the effective configuration the coordinator runs with.
+// It allows operators to check which values were actually loaded without
+// guessing, while secrets such as passwords never leave the coordinator.
+func (a *adminServer) GetConfig(ctx context.Context,
+	req *ecadminrpc.GetConfigRequest) (*ecadminrpc.GetConfigResponse, error) {
+	options := configOptions(
+		reflect.ValueOf(*a.config), reflect.TypeOf(*a.config), "",
+	)
+
+	return &ecadminrpc.GetConfigResponse{Options: options}, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+)
+
+// TestGetConfig tests that the effective configuration is returned with its
+// secrets redacted.
+func TestGetConfig(t *testing.T) {
+	config, err := DefaultConfig()
+	require.NoError(t, err)
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Notify.SMTPUsername = "operator"
+	config.Notify.SMTPPassword = "hunter2"
+	config.Server.RelayAccessToken = "c0ffee"
+	config.TLS.TLSCertFile = "/tmp/tls.cert"
+
+	admin := NewAdminServer(&config, nil)
+	resp, err := admin.GetConfig(
+		context.Background(), &ecadminrpc.GetConfigRequest{},
+	)
+	require.NoError(t, err)
+
+	options := make(map[string]*ecadminrpc.ConfigOption)
+	for _, option := range resp.Options {
+		options[option.Section+"."+option.Key] = option
+	}
+
+	require.Equal(t, "1h0m0s",
+		options["server.history_threshold_duration"].Value)
+	require.Equal(t, "operator", options["notify.smtp_username"].Value)
+
+	// Secrets are redacted if set.
+	password := options["notify.smtp_password"]
+	require.True(t, password.Redacted)
+	require.Empty(t, password.Value)
+	require.False(t, options["notify.webhook_url"].Redacted)
+
+	token := options["server.relay_access_token"]
+	require.True(t, token.Redacted)
+	require.Empty(t, token.Value)
+
+	// Options set by the application are omitted.
+	for _, option := range resp.Options {
+		require.NotEmpty(t, option.Key)
+		require.NotContains(t, option.Value, "hunter2")
+		require.NotContains(t, option.Value, "c0ffee")
+	}
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// pairObservationSize is the size in bytes of a single encoded observation:
+// the big-endian time and amount followed by the outcome.
+const pairObservationSize = 17
+
+// pairObservation is a single result reported for a pair.
+type pairObservation struct {
+	// time is the unix time of the result.
+	time int64
+
+	// amtMsat is the amount of the result in millisatoshi.
+	amtMsat int64
+
+	// success is whether the amount was forwarded successfully.
+	success bool
+}
+
+// contradicts returns whether the two observations contradict each other,
+// meaning that one of them failed at an amount the other forwarded within the
+// given window.
+func (o pairObservation) contradicts(other pairObservation,
+	window int64) bool {
+	if o.success == other.success {
+		return false
+	}
+
+	success, failure := o, other
+	if !o.success {
+		success, failure = other, o
+	}
+	gap := success.time - failure.time
+	if gap < 0 {
+		gap = -gap
+	}
+
+	return gap <= window && failure.amtMsat <= success.amtMsat
+}
+
+// reportedObservations returns the success and the failure the pair data
+// reports, if any.
+func reportedObservations(data *ecrpc.PairData) []pairObservation {
+	var observations []pairObservation
+	if data.SuccessTime != 0 {
+		observations = append(observations, pairObservation{
+			time:    data.SuccessTime,
+			amtMsat: data.SuccessAmtMsat,
+			success: true,
+		})
+	}
+	if data.FailTime != 0 {
+		observations = append(observations, pairObservation{
+			time:    data.FailTime,
+			amtMsat: data.FailAmtMsat,
+		})
+	}
+
+	return observations
+}
+
+// encodePairObservations encodes the observations as a sequence of fixed size
+// records.
+func encodePairObservations(observations []pairObservation) []byte {
+	data := make([]byte, len(observations)*pairObservationSize)
+	for i, o := range observations {
+		record := data[i*pairObservationSize:]
+		binary.BigEndian.PutUint64(record, uint64(o.time))
+		binary.BigEndian.PutUint64(record[8:], uint64(o.amtMsat))
+		if o.success {
+			record[16] = 1
+		}
+	}
+
+	return data
+}
+
+// decodePairObservations decodes a sequence of observations encoded by
+// encodePairObservations.
+func decodePairObservations(data []byte) ([]pairObservation, error) {
+	if len(data)%pairObservationSize != 0 {
+		return nil, fmt.Errorf("invalid pair observations length: %d",
+			len(data))
+	}
+
+	observations := make(
+		[]pairObservation, 0, len(data)/pairObservationSize,
+	)
+	for i := 0; i < len(data); i += pairObservationSize {
+		observations = append(observations, pairObservation{
+			time:    int64(binary.BigEndian.Uint64(data[i:])),
+			amtMsat: int64(binary.BigEndian.Uint64(data[i+8:])),
+			success: data[i+16] == 1,
+		})
+	}
+
+	return observations, nil
+}
+
+// conflictPolicy decides which outcome prevails among conflicting
+// observations ordered by time. It returns whether the successes prevail.
+type conflictPolicy func(observations []pairObservation) bool
+
+// conflictPolicies holds the policies resolving conflicting reports by their
+// name.
+var conflictPolicies = map[string]conflictPolicy{
+	"majority": majorityConflictPolicy,
+	"recency":  recencyConflictPolicy,
+}
+
+// majorityConflictPolicy lets the outcome reported most often prevail. Ties
+// are broken by recency.
+func majorityConflictPolicy(observations []pairObservation) bool {
+	successes := 0
+	for _, o := range observations {
+		if o.success {
+			successes++
+		}
+	}
+
+	failures := len(observations) - successes
+	if successes == failures {
+		return recencyConflictPolicy(observations)
+	}
+
+	return successes > failures
+}
+
+// recencyConflictPolicy lets the most recently observed outcome prevail. If a
+// success and a failure were observed at the same time, the failure prevails,
+// since overestimating the liquidity of a channel costs more than
+// underestimating it.
+func recencyConflictPolicy(observations []pairObservation) bool {
+	latest := observations[len(observations)-1]
+	for _, o := range observations {
+		if o.time == latest.time && !o.success {
+			return false
+		}
+	}
+
+	return latest.success
+}
+
+// conflictResolver keeps the recent observations of the pairs and resolves
+// conflicting reports by its policy instead of letting the last report win.
+type conflictResolver struct {
+	// window is the number of seconds within which a success and a
+	// failure at overlapping amounts are conflicting.
+	window int64
+
+	// policy decides which outcome prevails.
+	policy conflictPolicy
+}
+
+// StartConflictResolution starts resolving conflicting reports of pairs
+// within the configured conflict window by the configured policy.
+func (s *externalCoordinatorServer) StartConflictResolution() error {
+	name := s.config.Server.ConflictPolicy
+	policy, ok := conflictPolicies[name]
+	if !ok {
+		names := make([]string, 0, len(conflictPolicies))
+		for name := range conflictPolicies {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return fmt.Errorf("unknown conflict policy %q, options are %s",
+			name, strings.Join(names, ", "))
+	}
+
+	s.conflicts = &conflictResolver{
+		window: int64(s.config.Server.ConflictWindow.Seconds()),
+		policy: policy,
+	}
+
+	logrus.Infof("Resolving conflicting reports within %v by %s",
+		s.config.Server.ConflictWindow, name)
+
+	return nil
+}
+
+// resolve records the observations reported by the new pair data together
+// with the ones kept for the pair within the window and resolves the merged
+// pair data by the policy if any of them conflict. The previous observations
+// are the ones reported by the pair data stored before the merge, which stand
+// in for the kept ones of pairs stored before conflicts were resolved.
+func (r *conflictResolver) resolve(b *bbolt.Bucket, key []byte,
+	previous []pairObservation, reported, merged *ecrpc.PairData) error {
+	observations, err := decodePairObservations(b.Get(key))
+	if err != nil {
+		return err
+	}
+	if len(observations) == 0 {
+		observations = previous
+	}
+	observations = append(observations, reportedObservations(reported)...)
+	if len(observations) == 0 {
+		return b.Delete(key)
+	}
+
+	// Only keep the most recent observations within the window of the
+	// newest one.
+	sort.SliceStable(observations, func(i, j int) bool {
+		return observations[i].time < observations[j].time
+	})
+	newest := observations[len(observations)-1].time
+	start := sort.Search(len(observations), func(i int) bool {
+		return observations[i].time >= newest-r.window
+	})
+	start = max(start, len(observations)-MaxConflictObservations)
+	observations = observations[start:]
+
+	merged.ConflictingReports = 0
+	successWins := r.policy(observations)
+	var maxSuccessAmt, minFailAmt int64 = 0, -1
+	for _, o := range observations {
+		conflicting := false
+		for _, other := range observations {
+			if o.contradicts(other, r.window) {
+				conflicting = true
+				break
+			}
+		}
+
+		switch {
+		case o.success && successWins:
+			maxSuccessAmt = max(maxSuccessAmt, o.amtMsat)
+
+		case !o.success && !successWins:
+			if minFailAmt == -1 || o.amtMsat < minFailAmt {
+				minFailAmt = o.amtMsat
+			}
+
+		// Observations contradicting the prevailing outcome are
+		// counted as conflicting reports.
+		case conflicting:
+			merged.ConflictingReports++
+		}
+	}
+
+	if err := b.Put(key, encodePairObservations(observations)); err != nil {
+		return err
+	}
+	if merged.ConflictingReports == 0 {
+		return nil
+	}
+
+	// Restore the range of the prevailing outcome the last report may have
+	// moved, resolving the overlap like mergePairData does.
+	if successWins {
+		merged.SuccessAmtMsat = max(
+			merged.SuccessAmtMsat, maxSuccessAmt,
+		)
+		if merged.FailTime != 0 &&
+			merged.FailAmtMsat <= merged.SuccessAmtMsat {
+			merged.FailAmtMsat = merged.SuccessAmtMsat + 1
+		}
+	} else {
+		merged.FailAmtMsat = min(merged.FailAmtMsat, minFailAmt)
+		if merged.SuccessAmtMsat >= merged.FailAmtMsat {
+			merged.SuccessAmtMsat = max(merged.FailAmtMsat-1, 0)
+		}
+	}
+	merged.SuccessAmtSat = merged.SuccessAmtMsat / mSatScale
+	merged.FailAmtSat = merged.FailAmtMsat / mSatScale
+
+	return nil
+}
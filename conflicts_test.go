@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestConflictResolution tests that conflicting reports of a pair are resolved
+// by the configured policy instead of by the last report, marked on the pair
+// and counted in the statistics.
+func TestConflictResolution(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.ConflictWindow = 10 * time.Minute
+	config.Server.ConflictPolicy = "bogus"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.ErrorContains(
+		t, server.StartConflictResolution(), "unknown conflict policy",
+	)
+	config.Server.ConflictPolicy = DefaultConflictPolicy
+	require.NoError(t, server.StartConflictResolution())
+
+	nodeA, nodeB := generateTestKeys(t)
+	start := time.Now().Add(-time.Hour).Unix()
+	report := func(offset int64, success bool, amtMsat int64) {
+		history := &ecrpc.PairData{}
+		if success {
+			history.SuccessTime = start + offset
+			history.SuccessAmtMsat = amtMsat
+		} else {
+			history.FailTime = start + offset
+			history.FailAmtMsat = amtMsat
+		}
+		pairs := []*ecrpc.PairHistory{{
+			NodeFrom: nodeA,
+			NodeTo:   nodeB,
+			History:  history,
+		}}
+		err := server.storeMissionControlPairs("", pairs)
+		require.NoError(t, err)
+	}
+	stored := func() *ecrpc.PairData {
+		var history *ecrpc.PairData
+		err := db.View(func(tx *bbolt.Tx) error {
+			v := tx.Bucket([]byte(DatabaseBucketName)).Get(
+				pairKey(nodeA, nodeB),
+			)
+			var err error
+			history, err = decodePairData(v)
+
+			return err
+		})
+		require.NoError(t, err)
+
+		return history
+	}
+
+	// Two submitters forward 5000 sat successfully, which does not
+	// conflict.
+	report(0, true, 5_000_000)
+	report(30, true, 5_000_000)
+	require.Zero(t, stored().ConflictingReports)
+
+	// A third one fails to forward 3000 sat shortly after. The last
+	// report would shrink the success range, but the majority keeps it
+	// and marks the failure as conflicting.
+	report(60, false, 3_000_000)
+	history := stored()
+	require.EqualValues(t, 5_000_000, history.SuccessAmtMsat)
+	require.EqualValues(t, 5_000_001, history.FailAmtMsat)
+	require.EqualValues(t, 5_000, history.SuccessAmtSat)
+	require.EqualValues(t, 1, history.ConflictingReports)
+
+	stats, err := server.GetStats(
+		context.Background(), &ecrpc.GetStatsRequest{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stats.ConflictingPairs)
+
+	// Two more failures outvote the successes.
+	report(90, false, 3_000_000)
+	report(120, false, 2_000_000)
+	history = stored()
+	require.EqualValues(t, 1_999_999, history.SuccessAmtMsat)
+	require.EqualValues(t, 2_000_000, history.FailAmtMsat)
+	require.EqualValues(t, 2, history.ConflictingReports)
+
+	// Once the successes left the window, the pair is no longer
+	// conflicting.
+	report(1200, false, 2_000_000)
+	require.Zero(t, stored().ConflictingReports)
+}
+
+// TestConflictPolicies tests which outcome prevails under each policy.
+func TestConflictPolicies(t *testing.T) {
+	success := func(time int64) pairObservation {
+		return pairObservation{time: time, amtMsat: 1000, success: true}
+	}
+	failure := func(time int64) pairObservation {
+		return pairObservation{time: time, amtMsat: 1000}
+	}
+
+	tests := []struct {
+		name         string
+		observations []pairObservation
+		majority     bool
+		recency      bool
+	}{{
+		name: "more successes, latest failure",
+		observations: []pairObservation{
+			success(1), success(2), failure(3),
+		},
+		majority: true,
+		recency:  false,
+	}, {
+		name:         "tie, latest success",
+		observations: []pairObservation{failure(1), success(2)},
+		majority:     true,
+		recency:      true,
+	}, {
+		name:         "tie at the same time",
+		observations: []pairObservation{failure(1), success(1)},
+		majority:     false,
+		recency:      false,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(
+				t, test.majority,
+				majorityConflictPolicy(test.observations),
+			)
+			require.Equal(
+				t, test.recency,
+				recencyConflictPolicy(test.observations),
+			)
+		})
+	}
+
+	// Observations round trip through their encoding.
+	observations := []pairObservation{success(1), failure(2)}
+	decoded, err := decodePairObservations(
+		encodePairObservations(observations),
+	)
+	require.NoError(t, err)
+	require.Equal(t, observations, decoded)
+}
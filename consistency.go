@@ -0,0 +1,307 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// consistencyLeafPairs is the number of pairs up to which a divergent
+	// range is reported as a whole instead of being split further.
+	consistencyLeafPairs = 64
+
+	// maxRangeDigestPrefixes is the maximum number of ranges digested by a
+	// single request.
+	maxRangeDigestPrefixes = 1024
+
+	// maxRangePrefixSize is the maximum size in bytes of the prefix of a
+	// range, which is the size of the longest pair key.
+	maxRangePrefixSize = 2 * PubKeyCompressedSize
+)
+
+// standbyDivergentRanges reports the number of key ranges found to differ
+// between the coordinator and its standby by the last consistency check.
+var standbyDivergentRanges = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: metricsNamespace,
+	Subsystem: "standby",
+	Name:      "divergent_ranges",
+	Help: "Key ranges found to differ from the standby coordinator by " +
+		"the last consistency check.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(standbyDivergentRanges)
+}
+
+// digestRange digests the pairs whose keys start with the prefix, listing
+// their keys as well if requested. Coordinators storing the same pairs within
+// the range derive the same digest.
+func digestRange(tx *bbolt.Tx, prefix []byte,
+	includeKeys bool) *ecadminrpc.RangeDigest {
+
+	digest := &ecadminrpc.RangeDigest{Prefix: bytes.Clone(prefix)}
+	h := sha256.New()
+
+	c := tx.Bucket([]byte(DatabaseBucketName)).Cursor()
+	k, v := c.Seek(prefix)
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(v)))
+		h.Write(k)
+		h.Write(size[:])
+		h.Write(v)
+		digest.Pairs++
+
+		if includeKeys {
+			nodeFrom, nodeTo := splitPairKey(k)
+			digest.Keys = append(
+				digest.Keys, &ecadminrpc.SnapshotPairKey{
+					NodeFrom: bytes.Clone(nodeFrom),
+					NodeTo:   bytes.Clone(nodeTo),
+				},
+			)
+		}
+	}
+	if digest.Pairs > 0 {
+		digest.Digest = h.Sum(nil)
+	}
+
+	return digest
+}
+
+// digestRanges digests the pairs within each of the ranges given by their
+// prefixes in a single transaction.
+func digestRanges(db *bbolt.DB, prefixes [][]byte,
+	includeKeys bool) ([]*ecadminrpc.RangeDigest, error) {
+
+	digests := make([]*ecadminrpc.RangeDigest, 0, len(prefixes))
+	err := db.View(func(tx *bbolt.Tx) error {
+		for _, prefix := range prefixes {
+			digests = append(
+				digests, digestRange(tx, prefix, includeKeys),
+			)
+		}
+
+		return nil
+	})
+
+	return digests, err
+}
+
+// GetRangeDigests digests the pairs within each of the requested key ranges.
+func (a *adminServer) GetRangeDigests(ctx context.Context,
+	req *ecadminrpc.GetRangeDigestsRequest) (
+	*ecadminrpc.GetRangeDigestsResponse, error) {
+
+	if len(req.Prefixes) > maxRangeDigestPrefixes {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d "+
+			"ranges can be digested at once",
+			maxRangeDigestPrefixes)
+	}
+	for _, prefix := range req.Prefixes {
+		if len(prefix) > maxRangePrefixSize {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"range prefixes must not exceed %d bytes",
+				maxRangePrefixSize)
+		}
+	}
+
+	digests, err := digestRanges(a.db, req.Prefixes, req.IncludeKeys)
+	if err != nil {
+		msg := "failed to digest ranges: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return &ecadminrpc.GetRangeDigestsResponse{Digests: digests}, nil
+}
+
+// standbyRangeDigests digests the ranges given by their prefixes on the
+// standby coordinator, in batches of the maximum number of ranges digested by
+// a single request.
+func (p *snapshotShipper) standbyRangeDigests(ctx context.Context,
+	prefixes [][]byte,
+	includeKeys bool) ([]*ecadminrpc.RangeDigest, error) {
+
+	var digests []*ecadminrpc.RangeDigest
+	for start := 0; start < len(prefixes); start += maxRangeDigestPrefixes {
+		end := min(start+maxRangeDigestPrefixes, len(prefixes))
+
+		resp, err := p.client.GetRangeDigests(
+			ctx, &ecadminrpc.GetRangeDigestsRequest{
+				Prefixes:    prefixes[start:end],
+				IncludeKeys: includeKeys,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Digests) != end-start {
+			return nil, fmt.Errorf("standby returned %d digests "+
+				"for %d ranges", len(resp.Digests), end-start)
+		}
+		digests = append(digests, resp.Digests...)
+	}
+
+	return digests, nil
+}
+
+// divergentRanges compares the pairs stored by the coordinator with the ones
+// of the standby. Starting with the range of all pairs, every range whose
+// digests differ is split into the ranges of the next key byte, until the
+// ranges are small enough to be reported, so that only the digests of the
+// divergent ranges and their neighbours are transferred. Ranges the standby
+// holds no pairs of are reported right away, since shipping their pairs
+// repairs them.
+func (p *snapshotShipper) divergentRanges(
+	ctx context.Context) ([]*ecadminrpc.DivergentRange, error) {
+
+	var divergent []*ecadminrpc.DivergentRange
+	pending := [][]byte{{}}
+	for len(pending) > 0 {
+		local, err := digestRanges(p.db, pending, false)
+		if err != nil {
+			return nil, err
+		}
+		standby, err := p.standbyRangeDigests(ctx, pending, false)
+		if err != nil {
+			return nil, err
+		}
+
+		var next [][]byte
+		for i, prefix := range pending {
+			localPairs := local[i].Pairs
+			standbyPairs := standby[i].Pairs
+			sameDigest := bytes.Equal(
+				local[i].Digest, standby[i].Digest,
+			)
+			if localPairs == standbyPairs && sameDigest {
+
+				continue
+			}
+
+			leaf := standbyPairs == 0 ||
+				len(prefix) == maxRangePrefixSize ||
+				(localPairs <= consistencyLeafPairs &&
+					standbyPairs <= consistencyLeafPairs)
+			if leaf {
+				divergent = append(
+					divergent, &ecadminrpc.DivergentRange{
+						Prefix:       prefix,
+						LocalPairs:   localPairs,
+						StandbyPairs: standbyPairs,
+					},
+				)
+				continue
+			}
+
+			for b := 0; b < 256; b++ {
+				child := append(bytes.Clone(prefix), byte(b))
+				next = append(next, child)
+			}
+		}
+		pending = next
+	}
+
+	sort.Slice(divergent, func(i, j int) bool {
+		return bytes.Compare(
+			divergent[i].Prefix, divergent[j].Prefix,
+		) < 0
+	})
+
+	return divergent, nil
+}
+
+// repair ships the pairs within the divergent ranges to the standby right
+// away, removing the pairs the standby holds but the coordinator does not. It
+// returns the number of pairs shipped or removed.
+func (p *snapshotShipper) repair(ctx context.Context,
+	divergent []*ecadminrpc.DivergentRange) (int, error) {
+
+	var localPrefixes, standbyPrefixes [][]byte
+	for _, r := range divergent {
+		localPrefixes = append(localPrefixes, r.Prefix)
+		if r.StandbyPairs > 0 {
+			standbyPrefixes = append(standbyPrefixes, r.Prefix)
+		}
+	}
+
+	local, err := digestRanges(p.db, localPrefixes, true)
+	if err != nil {
+		return 0, err
+	}
+	standby, err := p.standbyRangeDigests(ctx, standbyPrefixes, true)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := make(map[string][]byte)
+	for _, digest := range append(local, standby...) {
+		for _, k := range digest.Keys {
+			key := pairKey(k.NodeFrom, k.NodeTo)
+			keys[string(key)] = key
+		}
+	}
+	for _, key := range keys {
+		p.markDirty(key)
+	}
+
+	return len(keys), p.ship()
+}
+
+// CheckStandbyConsistency compares the pairs stored by the coordinator with
+// the ones of its warm standby and repairs the divergent ranges if requested.
+// Pairs changed since the last snapshot differ until the next snapshot is
+// shipped.
+func (a *adminServer) CheckStandbyConsistency(ctx context.Context,
+	req *ecadminrpc.CheckStandbyConsistencyRequest) (
+	*ecadminrpc.CheckStandbyConsistencyResponse, error) {
+
+	if a.coordinator == nil || a.coordinator.snapshots == nil {
+		return nil, status.Error(codes.FailedPrecondition, "snapshots "+
+			"are not shipped to a standby coordinator")
+	}
+	snapshots := a.coordinator.snapshots
+
+	divergent, err := snapshots.divergentRanges(ctx)
+	if err != nil {
+		msg := "failed to compare with standby: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(codes.Unavailable, msg, err)
+	}
+	standbyDivergentRanges.Set(float64(len(divergent)))
+
+	resp := &ecadminrpc.CheckStandbyConsistencyResponse{
+		Consistent:      len(divergent) == 0,
+		DivergentRanges: divergent,
+	}
+	logrus.Infof("Found %d key ranges differing from the standby "+
+		"coordinator", len(divergent))
+
+	if !req.Repair || len(divergent) == 0 {
+		return resp, nil
+	}
+
+	repaired, err := snapshots.repair(ctx, divergent)
+	if err != nil {
+		msg := "failed to repair standby: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(codes.Unavailable, msg, err)
+	}
+	resp.PairsRepaired = uint64(repaired)
+
+	logrus.Infof("Repaired %d pairs of the standby coordinator", repaired)
+
+	return resp, nil
+}
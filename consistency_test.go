@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestCheckStandbyConsistency tests that the pairs differing between the
+// coordinator and its standby are narrowed down to small ranges and repaired.
+func TestCheckStandbyConsistency(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Start the admin server of the standby coordinator.
+	standbyConfig := MockConfig(filepath.Join(tempDir, "standby"))
+	standbyConfig.Server.StandbyMode = true
+	standbyConfig.Server.HistoryThresholdDuration = time.Hour
+	standbyDB, err := setupDatabase(standbyConfig)
+	require.NoError(t, err)
+	defer cleanupDB(standbyDB)
+
+	standbyAdmin := NewAdminServer(standbyConfig, standbyDB)
+	standbyAddr, certFile, macaroonPath := startAdminTestServer(t, standbyAdmin)
+
+	// Start the primary coordinator shipping to the standby.
+	config := MockConfig(filepath.Join(tempDir, "primary"))
+	config.Server.StandbyTarget = standbyAddr
+	config.Server.StandbyTLSCertFile = certFile
+	config.Server.StandbyAdminMacaroonPath = macaroonPath
+	config.Server.SnapshotInterval = time.Hour
+	config.Server.SnapshotTimeout = 10 * time.Second
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+	req := &ecadminrpc.CheckStandbyConsistencyRequest{}
+
+	// Only coordinators shipping snapshots have a standby to compare with.
+	_, err = admin.CheckStandbyConsistency(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	admin.coordinator = server
+	require.NoError(t, server.StartSnapshotShipping())
+
+	// Register more pairs than are reported as a single range.
+	var pairs []*ecrpc.PairHistory
+	for i := 0; i < 2*consistencyLeafPairs; i++ {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+	require.NoError(t, server.snapshots.ship())
+
+	resp, err := admin.CheckStandbyConsistency(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.Consistent)
+	require.Empty(t, resp.DivergentRanges)
+
+	// Remove, modify and insert a pair behind the back of the primary.
+	extraFrom, extraTo := generateTestKeys(t)
+	removed := pairKey(pairs[0].NodeFrom, pairs[0].NodeTo)
+	modified := pairKey(pairs[1].NodeFrom, pairs[1].NodeTo)
+	err = standbyDB.Update(func(tx *bbolt.Tx) error {
+		if err := deletePair(tx, removed); err != nil {
+			return err
+		}
+
+		data, err := encodePairData(&ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtSat:  2,
+			SuccessAmtMsat: 2000,
+		})
+		if err != nil {
+			return err
+		}
+		if err := putPair(tx, modified, data); err != nil {
+			return err
+		}
+
+		return putPair(tx, pairKey(extraFrom, extraTo), data)
+	})
+	require.NoError(t, err)
+
+	// The differences are narrowed down to ranges smaller than the whole
+	// dataset.
+	resp, err = admin.CheckStandbyConsistency(ctx, req)
+	require.NoError(t, err)
+	require.False(t, resp.Consistent)
+	require.NotEmpty(t, resp.DivergentRanges)
+	var localPairs, standbyPairs uint64
+	for _, r := range resp.DivergentRanges {
+		require.NotEmpty(t, r.Prefix)
+		localPairs += r.LocalPairs
+		standbyPairs += r.StandbyPairs
+	}
+	require.Less(t, localPairs, uint64(len(pairs)))
+	require.Equal(t, localPairs, standbyPairs)
+
+	// Repairing ships the divergent ranges, including the removal of the
+	// inserted pair.
+	req.Repair = true
+	resp, err = admin.CheckStandbyConsistency(ctx, req)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, resp.PairsRepaired, uint64(3))
+	require.Equal(t, storedPairKeys(t, db), storedPairKeys(t, standbyDB))
+
+	req.Repair = false
+	resp, err = admin.CheckStandbyConsistency(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.Consistent)
+
+	// Requests digesting too many ranges at once are rejected.
+	_, err = standbyAdmin.GetRangeDigests(
+		ctx, &ecadminrpc.GetRangeDigestsRequest{
+			Prefixes: make([][]byte, maxRangeDigestPrefixes+1),
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
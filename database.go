@@ -1,8 +1,10 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	logrus "github.com/sirupsen/logrus"
 	bbolt "go.etcd.io/bbolt"
@@ -30,8 +32,29 @@ func setupDatabase(config *Config) (*bbolt.DB, error) {
 		config.Database.DatabaseDirPath, config.Database.DatabaseFile,
 	)
 
-	// Open the database with a timeout.
-	options := &bbolt.Options{Timeout: config.Database.FileLockTimeout}
+	// Ensure the network the data belongs to is supported.
+	network, err := parseNetwork(config.Server.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the pair key encoding is supported. The keys of the database
+	// are encoded with it from now on.
+	keyScheme := config.Database.KeyScheme
+	if keyScheme == "" {
+		keyScheme = DefaultKeyScheme
+	}
+	codec, err := pairKeyCodecByName(keyScheme)
+	if err != nil {
+		return nil, err
+	}
+	pairKeys = codec
+
+	// Open the database with a timeout and the configured tuning options.
+	options, err := databaseOptions(config)
+	if err != nil {
+		return nil, err
+	}
 	db, err := bbolt.Open(
 		dbFilePath, DatabaseFilePermissions, options,
 	)
@@ -39,15 +62,71 @@ func setupDatabase(config *Config) (*bbolt.DB, error) {
 		return nil, err
 	}
 
-	// Create the main bucket for mission control data if it doesn't exist.
+	// Create the main bucket for mission control data and the auxiliary
+	// buckets if they don't exist, ensure the database belongs to the
+	// configured network, record whether it runs as a warm standby and
+	// start the first epoch of a new database.
 	err = db.Update(func(tx *bbolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(
-			[]byte(DatabaseBucketName),
+		buckets := []string{
+			DatabaseBucketName, NodeGroupsBucketName,
+			LatencySamplesBucketName, QueryAuditBucketName,
+			AggregationExperimentBucketName, MetadataBucketName,
+			ArchiveBucketName, ChannelGraphBucketName,
+			ClosedChannelsBucketName,
+			PrivatePairsBucketName, PairObservationsBucketName,
+			SubmittersBucketName, PairSubmittersBucketName,
+			JournalBucketName, WatermarkRecipientsBucketName,
+		}
+		for _, bucket := range buckets {
+			_, err := tx.CreateBucketIfNotExists([]byte(bucket))
+			if err != nil {
+				return err
+			}
+		}
+		if err := checkDatabaseNetwork(tx, network); err != nil {
+			return err
+		}
+		err := checkKeyScheme(
+			tx, codec, config.Database.MigrateKeyScheme,
 		)
 		if err != nil {
 			return err
 		}
-		return nil
+
+		err = configureRevisionLog(
+			tx, config.Server.RevisionLogRetention,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = plantCanaries(tx, config.Server.CanaryPairs)
+		if err != nil {
+			return err
+		}
+
+		if err := initUpdateIndex(tx); err != nil {
+			return err
+		}
+
+		if err := initModifiedIndex(tx); err != nil {
+			return err
+		}
+
+		if err := initMerkleTree(tx); err != nil {
+			return err
+		}
+
+		if err := initNodeIndex(tx); err != nil {
+			return err
+		}
+		recordNodeMetrics(tx)
+
+		if err := initEpoch(tx, time.Now()); err != nil {
+			return err
+		}
+
+		return applyStandbyRole(tx, config.Server.StandbyMode)
 	})
 
 	if err != nil {
@@ -62,6 +141,38 @@ func setupDatabase(config *Config) (*bbolt.DB, error) {
 	return db, nil
 }
 
+// databaseOptions returns the bbolt options derived from the database
+// configuration.
+func databaseOptions(config *Config) (*bbolt.Options, error) {
+	options := &bbolt.Options{
+		Timeout:         config.Database.FileLockTimeout,
+		NoFreelistSync:  config.Database.NoFreelistSync,
+		InitialMmapSize: config.Database.InitialMmapSize,
+		PageSize:        config.Database.PageSize,
+	}
+
+	switch config.Database.FreelistType {
+	// An empty freelist type falls back to bbolt's default.
+	case "", "array":
+		options.FreelistType = bbolt.FreelistArrayType
+
+	case "map":
+		options.FreelistType = bbolt.FreelistMapType
+
+	default:
+		return nil, fmt.Errorf("invalid freelist type %q, must be "+
+			"either \"array\" or \"map\"",
+			config.Database.FreelistType)
+	}
+
+	if options.InitialMmapSize < 0 || options.PageSize < 0 {
+		return nil, fmt.Errorf("initial mmap size and page size must " +
+			"not be negative")
+	}
+
+	return options, nil
+}
+
 // cleanupDB closes the database connection and logs any errors encountered
 // during the process. It exits the program with a status code of 1 if the
 // database fails to close.
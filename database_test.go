@@ -9,6 +9,7 @@ import (
 
 	logrus "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	bbolt "go.etcd.io/bbolt"
 )
 
 // MockConfig returns a configuration suitable for testing.
@@ -120,3 +121,50 @@ func TestCleanupDB(t *testing.T) {
 		)
 	})
 }
+
+// TestDatabaseOptions tests the bbolt options derived from the database
+// configuration.
+func TestDatabaseOptions(t *testing.T) {
+	// Case 1: Tuning options are passed to bbolt and the database opens.
+	t.Run("Tuning Options Applied", func(t *testing.T) {
+		config := MockConfig(t.TempDir())
+		config.Database.NoFreelistSync = true
+		config.Database.FreelistType = "map"
+		config.Database.InitialMmapSize = 1 << 20
+		config.Database.PageSize = 8192
+
+		options, err := databaseOptions(config)
+		assert.NoError(t, err)
+		assert.True(t, options.NoFreelistSync)
+		assert.Equal(t, bbolt.FreelistMapType, options.FreelistType)
+		assert.Equal(t, 1<<20, options.InitialMmapSize)
+		assert.Equal(t, 8192, options.PageSize)
+
+		db, err := setupDatabase(config)
+		assert.NoError(t, err)
+		assert.Equal(t, 8192, db.Info().PageSize)
+		cleanupDB(db)
+	})
+
+	// Case 2: An empty freelist type falls back to the array type.
+	t.Run("Default Freelist Type", func(t *testing.T) {
+		config := MockConfig(t.TempDir())
+
+		options, err := databaseOptions(config)
+		assert.NoError(t, err)
+		assert.Equal(t, bbolt.FreelistArrayType, options.FreelistType)
+	})
+
+	// Case 3: Invalid options are rejected.
+	t.Run("Invalid Options", func(t *testing.T) {
+		config := MockConfig(t.TempDir())
+		config.Database.FreelistType = "list"
+		_, err := databaseOptions(config)
+		assert.Error(t, err)
+
+		config.Database.FreelistType = ""
+		config.Database.PageSize = -1
+		_, err = databaseOptions(config)
+		assert.Error(t, err)
+	})
+}
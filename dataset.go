@@ -0,0 +1,81 @@
+package main
+
+import (
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// datasetRevisionKey is the key of the revision of the mission control data
+// within the metadata bucket.
+var datasetRevisionKey = []byte("revision")
+
+// bumpDatasetRevision increases the revision of the mission control data,
+// updates the node metrics and wakes the long polls once the transaction is
+// committed. It must be called by every transaction storing or removing pairs.
+func bumpDatasetRevision(tx *bbolt.Tx) error {
+	recordNodeMetrics(tx)
+	tx.OnCommit(datasetChanges.notify)
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	revision := decodeUint64(meta.Get(datasetRevisionKey))
+	err := meta.Put(datasetRevisionKey, encodeUint64(revision+1))
+	if err != nil {
+		return err
+	}
+
+	return pruneRevisionLog(tx)
+}
+
+// currentDatasetInfo returns information about the current mission control
+// data, including the pathfinding parameters recommended to use with it.
+func currentDatasetInfo(tx *bbolt.Tx) *ecrpc.DatasetInfo {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	b := tx.Bucket([]byte(DatabaseBucketName))
+
+	return &ecrpc.DatasetInfo{
+		Revision:       decodeUint64(meta.Get(datasetRevisionKey)),
+		TotalPairs:     uint64(b.Stats().KeyN),
+		Epoch:          currentEpoch(tx).Epoch,
+		OldestRevision: oldestRevision(tx),
+
+		PathfindingParameters: storedPathfindingParameters(tx),
+	}
+}
+
+// sendEmptyResponse sends a single response without any pairs describing the
+// given dataset. Some clients, like gateway clients, treat a stream without
+// any message as failed, so queries without results send it instead.
+func sendEmptyResponse(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	dataset *ecrpc.DatasetInfo) error {
+	logrus.Info("Query has no results, sending empty response")
+
+	err := stream.Send(&ecrpc.QueryAggregatedMissionControlResponse{
+		Dataset: dataset,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to send empty "+
+			"response: %v", err)
+	}
+
+	return nil
+}
+
+// sendEmptyQueryResponse sends the empty response of a query without results
+// on the current mission control data.
+func (s *externalCoordinatorServer) sendEmptyQueryResponse(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer) error {
+	var dataset *ecrpc.DatasetInfo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		dataset = currentDatasetInfo(tx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendEmptyResponse(stream, dataset)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestEmptyQueryResponse tests that queries without results send a single
+// response describing the dataset instead of no response at all.
+func TestEmptyQueryResponse(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.EpochDuration = 24 * time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	query := func() *mockQueryAggregatedMissionControlServer {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{}, stream,
+		)
+		require.NoError(t, err)
+
+		return stream
+	}
+
+	// A fresh database is described by its initial revision.
+	stream := query()
+	require.Len(t, stream.Responses, 1)
+	require.Empty(t, stream.Responses[0].Pairs)
+	require.Zero(t, stream.Responses[0].Dataset.Revision)
+	require.Zero(t, stream.Responses[0].Dataset.TotalPairs)
+
+	// Responses holding pairs carry no dataset information.
+	nodeFrom, nodeTo := generateTestKeys(t)
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	stream = query()
+	require.Len(t, stream.Responses, 1)
+	require.Len(t, stream.Responses[0].Pairs, 1)
+	require.Nil(t, stream.Responses[0].Dataset)
+
+	// Rolling over to a new epoch empties the dataset and changes its
+	// revision.
+	end := time.Now().Add(config.Server.EpochDuration)
+	require.NoError(t, server.maybeRolloverEpoch(end))
+
+	stream = query()
+	require.Len(t, stream.Responses, 1)
+	dataset := stream.Responses[0].Dataset
+	require.Equal(t, uint64(2), dataset.Revision)
+	require.Zero(t, dataset.TotalPairs)
+	require.Equal(t, uint64(1), dataset.Epoch)
+
+	// Archives without any pairs are described by their epoch.
+	require.NoError(t, server.maybeRolloverEpoch(
+		end.Add(config.Server.EpochDuration),
+	))
+	stream = &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryEpochHistory(
+		&ecrpc.QueryEpochHistoryRequest{Epoch: 1}, stream,
+	)
+	require.NoError(t, err)
+	require.Len(t, stream.Responses, 1)
+	require.Empty(t, stream.Responses[0].Pairs)
+	require.Equal(t, uint64(1), stream.Responses[0].Dataset.Epoch)
+	require.Zero(t, stream.Responses[0].Dataset.Revision)
+}
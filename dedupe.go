@@ -0,0 +1,93 @@
+package main
+
+import (
+	"sort"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// latestResultTime returns the time of the most recent result of the pair
+// data.
+func latestResultTime(data *ecrpc.PairData) int64 {
+	return max(data.SuccessTime, data.FailTime)
+}
+
+// groupPairOccurrences groups the occurrences of each pair in the order the
+// pairs first occur in.
+func groupPairOccurrences(pairs []*ecrpc.PairHistory) ([]string,
+	map[string][]*ecrpc.PairHistory) {
+	var order []string
+	occurrences := make(map[string][]*ecrpc.PairHistory)
+	for _, pair := range pairs {
+		key := string(pairKey(pair.NodeFrom, pair.NodeTo))
+		if _, ok := occurrences[key]; !ok {
+			order = append(order, key)
+		}
+		occurrences[key] = append(occurrences[key], pair)
+	}
+
+	return order, occurrences
+}
+
+// countDuplicatePairs returns the number of pairs occurring more than once
+// within a single request, not counting their first occurrence.
+func countDuplicatePairs(pairs []*ecrpc.PairHistory) int {
+	order, _ := groupPairOccurrences(pairs)
+
+	return len(pairs) - len(order)
+}
+
+// mergeDuplicatePairs merges pairs occurring multiple times within a single
+// request into one pair at the position of their first occurrence. It returns
+// the merged pairs together with the resolution latencies observed for each
+// pair, so that every occurrence still contributes its latency sample. The
+// given pairs are not modified.
+//
+// Since merging pair data depends on the order of the results, the
+// occurrences of a pair are merged in the chronological order of their most
+// recent result, keeping the request order for results observed at the same
+// time. This makes the outcome independent of how the client ordered the
+// duplicates.
+func mergeDuplicatePairs(pairs []*ecrpc.PairHistory) ([]*ecrpc.PairHistory,
+	map[string][]uint32) {
+	order, occurrences := groupPairOccurrences(pairs)
+
+	merged := make([]*ecrpc.PairHistory, 0, len(order))
+	latencies := make(map[string][]uint32)
+	for _, key := range order {
+		group := occurrences[key]
+		for _, pair := range group {
+			latencyMs := pair.History.ResolutionLatencyMs
+			if latencyMs != 0 {
+				latencies[key] = append(latencies[key], latencyMs)
+			}
+		}
+
+		if len(group) == 1 {
+			merged = append(merged, group[0])
+			continue
+		}
+
+		group = append([]*ecrpc.PairHistory(nil), group...)
+		sort.SliceStable(group, func(i, j int) bool {
+			return latestResultTime(group[i].History) <
+				latestResultTime(group[j].History)
+		})
+
+		// The first occurrence in chronological order is the base the
+		// later ones are merged into.
+		history := proto.Clone(group[0].History).(*ecrpc.PairData)
+		for _, pair := range group[1:] {
+			mergePairData(history, pair.History)
+		}
+
+		merged = append(merged, &ecrpc.PairHistory{
+			NodeFrom: group[0].NodeFrom,
+			NodeTo:   group[0].NodeTo,
+			History:  history,
+		})
+	}
+
+	return merged, latencies
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestMergeDuplicatePairs tests that duplicate pairs are merged in the
+// chronological order of their results regardless of the request order.
+func TestMergeDuplicatePairs(t *testing.T) {
+	nodeA, nodeB := generateTestKeys(t)
+	now := time.Now().Unix()
+
+	success := &ecrpc.PairHistory{
+		NodeFrom: nodeA,
+		NodeTo:   nodeB,
+		History: &ecrpc.PairData{
+			SuccessTime:         now - 10,
+			SuccessAmtMsat:      5000,
+			ResolutionLatencyMs: 100,
+		},
+	}
+	failure := &ecrpc.PairHistory{
+		NodeFrom: nodeA,
+		NodeTo:   nodeB,
+		History: &ecrpc.PairData{
+			FailTime:            now,
+			FailAmtMsat:         3000,
+			ResolutionLatencyMs: 200,
+		},
+	}
+	other := &ecrpc.PairHistory{
+		NodeFrom: nodeB,
+		NodeTo:   nodeA,
+		History:  &ecrpc.PairData{SuccessTime: now},
+	}
+
+	forward := []*ecrpc.PairHistory{success, other, failure}
+	backward := []*ecrpc.PairHistory{failure, other, success}
+	require.Equal(t, 1, countDuplicatePairs(forward))
+
+	originalSuccess := proto.Clone(success)
+	merged, latencies := mergeDuplicatePairs(forward)
+	reversed, _ := mergeDuplicatePairs(backward)
+
+	// The input pairs are not modified.
+	require.True(t, proto.Equal(originalSuccess, success))
+
+	// The pair keeps the position of its first occurrence and the later
+	// failure moved the success amount below the failure amount.
+	require.Len(t, merged, 2)
+	require.Same(t, other, merged[1])
+	require.EqualValues(t, 2999, merged[0].History.SuccessAmtMsat)
+	require.EqualValues(t, 3000, merged[0].History.FailAmtMsat)
+	require.True(t, proto.Equal(merged[0].History, reversed[0].History))
+
+	// Every occurrence contributes its latency sample.
+	key := string(pairKey(nodeA, nodeB))
+	require.Equal(t, []uint32{100, 200}, latencies[key])
+}
+
+// TestRegisterDuplicatePairs tests that registrations report the number of
+// duplicate pairs merged.
+func TestRegisterDuplicatePairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	pair := &ecrpc.PairHistory{
+		NodeFrom: nodeA,
+		NodeTo:   nodeB,
+		History: &ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtSat:  1,
+			SuccessAmtMsat: 1000,
+		},
+	}
+	resp, err := server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair, proto.Clone(pair).(*ecrpc.PairHistory),
+				proto.Clone(pair).(*ecrpc.PairHistory),
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.DuplicatesMerged)
+	require.Contains(t, resp.SuccessMessage, "merged 2 duplicate pairs")
+}
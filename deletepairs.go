@@ -0,0 +1,350 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// deletePairsBatchSize is the maximum number of pairs deleted per transaction
+// by DeletePairs, which bounds the time other writers are blocked for.
+const deletePairsBatchSize = 1000
+
+// pairPredicate decides whether a stored pair matches the criteria of a
+// DeletePairs request.
+type pairPredicate func(key []byte, history *ecrpc.PairData) bool
+
+// newPairPredicate validates the criteria of the request and returns a
+// predicate matching the pairs meeting all of them.
+func newPairPredicate(req *ecadminrpc.DeletePairsRequest) (pairPredicate,
+	error) {
+	updatedBefore := req.GetUpdatedBefore()
+	node := req.GetNode()
+	failAmtAbove := req.GetFailAmtAboveMsat()
+
+	switch {
+	case updatedBefore < 0:
+		return nil, status.Error(codes.InvalidArgument,
+			"UpdatedBefore must not be negative")
+
+	case len(node) != 0 && len(node) != PubKeyCompressedSize:
+		return nil, status.Errorf(codes.InvalidArgument, "Node must "+
+			"be exactly %d bytes", PubKeyCompressedSize)
+
+	case failAmtAbove < 0:
+		return nil, status.Error(codes.InvalidArgument,
+			"FailAmtAboveMsat must not be negative")
+
+	case updatedBefore == 0 && len(node) == 0 && failAmtAbove == 0:
+		return nil, status.Error(codes.InvalidArgument, "at least one "+
+			"criterion must be set")
+	}
+
+	return func(key []byte, history *ecrpc.PairData) bool {
+		if updatedBefore != 0 {
+			updated := mostRecentUnixTimestamp(
+				history.FailTime, history.SuccessTime,
+			)
+			if updated >= updatedBefore {
+				return false
+			}
+		}
+
+		nodeFrom, nodeTo := splitPairKey(key)
+		if len(node) != 0 && !bytes.Equal(nodeFrom, node) &&
+			!bytes.Equal(nodeTo, node) {
+
+			return false
+		}
+
+		if failAmtAbove != 0 && (history.FailTime == 0 ||
+			history.FailAmtMsat <= failAmtAbove) {
+
+			return false
+		}
+
+		return true
+	}, nil
+}
+
+// matchingPairKeys returns the keys of at most limit pairs matching the
+// predicate, starting at the given key. A limit of zero returns all of them.
+// It also returns the key to continue at, or nil if all pairs were scanned.
+func matchingPairKeys(tx *bbolt.Tx, match pairPredicate, start []byte,
+	limit int) ([][]byte, []byte, error) {
+	var keys [][]byte
+	c := tx.Bucket([]byte(DatabaseBucketName)).Cursor()
+	k, v := c.First()
+	if start != nil {
+		k, v = c.Seek(start)
+	}
+	for ; k != nil; k, v = c.Next() {
+		if limit > 0 && len(keys) == limit {
+			return keys, bytes.Clone(k), nil
+		}
+
+		history, err := decodePairData(v)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.DataLoss,
+				"failed to decode history data: %v", err)
+		}
+		if !match(k, history) {
+			continue
+		}
+
+		// The key is only valid for the lifetime of the transaction,
+		// so it is copied.
+		keys = append(keys, bytes.Clone(k))
+	}
+
+	return keys, nil, nil
+}
+
+// DeletePairs deletes the pairs matching all criteria of the request in
+// batches of bounded size, each in its own transaction. A dry run only counts
+// the matching pairs.
+func (a *adminServer) DeletePairs(ctx context.Context,
+	req *ecadminrpc.DeletePairsRequest) (*ecadminrpc.DeletePairsResponse,
+	error) {
+	match, err := newPairPredicate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetDryRun() {
+		var keys [][]byte
+		err := a.db.View(func(tx *bbolt.Tx) error {
+			var err error
+			keys, _, err = matchingPairKeys(tx, match, nil, 0)
+			return err
+		})
+		if err != nil {
+			msg := "failed to match pairs: %v"
+			logrus.Errorf(msg, err)
+			return nil, status.Errorf(
+				storageErrorCode(err), msg, err,
+			)
+		}
+
+		return &ecadminrpc.DeletePairsResponse{
+			Matched: uint64(len(keys)),
+		}, nil
+	}
+
+	start := time.Now()
+	var deleted uint64
+	var next []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		err := a.db.Update(func(tx *bbolt.Tx) error {
+			keys, cont, err := matchingPairKeys(
+				tx, match, next, deletePairsBatchSize,
+			)
+			if err != nil {
+				return err
+			}
+			next = cont
+
+			if len(keys) == 0 {
+				return nil
+			}
+			for _, key := range keys {
+				if err := removePairData(tx, key); err != nil {
+					return err
+				}
+			}
+			deleted += uint64(len(keys))
+
+			return bumpDatasetRevision(tx)
+		})
+		if err != nil {
+			msg := "failed to delete pairs after deleting %d: %v"
+			logrus.Errorf(msg, deleted, err)
+			return nil, status.Errorf(
+				storageErrorCode(err), msg, deleted, err,
+			)
+		}
+
+		if next == nil {
+			break
+		}
+	}
+
+	logrus.Infof("Deleted %d pairs matching the predicate in %v",
+		deleted, time.Since(start))
+
+	return &ecadminrpc.DeletePairsResponse{
+		Matched: deleted,
+		Deleted: deleted,
+	}, nil
+}
+
+// RemoveMissionControlPairs removes the listed pairs in a single transaction.
+// Pairs which are not stored are counted as not found.
+func (a *adminServer) RemoveMissionControlPairs(ctx context.Context,
+	req *ecadminrpc.RemoveMissionControlPairsRequest) (
+	*ecadminrpc.RemoveMissionControlPairsResponse, error) {
+
+	pairs := req.GetPairs()
+	switch {
+	case len(pairs) == 0:
+		return nil, status.Error(codes.InvalidArgument, "at least one "+
+			"pair must be listed")
+
+	case len(pairs) > deletePairsBatchSize:
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d "+
+			"pairs may be listed", deletePairsBatchSize)
+	}
+
+	keys := make([][]byte, 0, len(pairs))
+	for i, pair := range pairs {
+		err := validateNodeFilter(pair.GetNodeFrom(), pair.GetNodeTo())
+		if err == nil && (len(pair.GetNodeFrom()) == 0 ||
+			len(pair.GetNodeTo()) == 0) {
+
+			err = status.Error(codes.InvalidArgument, "NodeFrom "+
+				"and NodeTo must be set")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid pair %d: %v", i,
+				status.Convert(err).Message())
+		}
+		keys = append(keys, pairKey(pair.NodeFrom, pair.NodeTo))
+	}
+
+	resp := &ecadminrpc.RemoveMissionControlPairsResponse{}
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		resp.Removed, resp.NotFound = 0, 0
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		for _, key := range keys {
+			if b.Get(key) == nil {
+				resp.NotFound++
+				continue
+			}
+			if err := removePairData(tx, key); err != nil {
+				return err
+			}
+			resp.Removed++
+		}
+		if resp.Removed == 0 {
+			return nil
+		}
+
+		return bumpDatasetRevision(tx)
+	})
+	if err != nil {
+		msg := "failed to remove pairs: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Removed %d listed pairs, %d were not found",
+		resp.Removed, resp.NotFound)
+
+	return resp, nil
+}
+
+// ResetMissionControl removes all pairs by recreating the buckets holding
+// them and the data kept per pair. Warm standby coordinators are reset by the
+// full snapshots of their primary coordinator instead.
+func (a *adminServer) ResetMissionControl(ctx context.Context,
+	req *ecadminrpc.ResetMissionControlRequest) (
+	*ecadminrpc.ResetMissionControlResponse, error) {
+
+	var removed int
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		if isStandby(tx) {
+			return status.Error(codes.FailedPrecondition, "the "+
+				"coordinator is a standby, its data is "+
+				"replaced by the snapshots of the primary "+
+				"coordinator")
+		}
+
+		removed = tx.Bucket([]byte(DatabaseBucketName)).Stats().KeyN
+		buckets := []string{
+			DatabaseBucketName, LatencySamplesBucketName,
+			UpdateIndexBucketName, NodeIndexBucketName,
+			MerkleEntriesBucketName, MerkleLeavesBucketName,
+			AggregationExperimentBucketName,
+			PairObservationsBucketName, PairSubmittersBucketName,
+			JournalBucketName,
+		}
+		for _, bucket := range buckets {
+			err := tx.DeleteBucket([]byte(bucket))
+			if err != nil {
+				return err
+			}
+			_, err = tx.CreateBucket([]byte(bucket))
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := bumpDatasetRevision(tx); err != nil {
+			return err
+		}
+
+		// Deltas since before the reset return the empty dataset.
+		if err := resetModifiedIndex(tx); err != nil {
+			return err
+		}
+
+		// The pairs of past revisions are gone, so they can no longer
+		// be queried.
+		return resetRevisionLog(tx)
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, err
+	}
+	if err != nil {
+		msg := "failed to reset mission control data: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Reset mission control data, removed %d pairs", removed)
+
+	// The standby coordinator has to drop all pairs as well, and the
+	// shifts of the submitters start from the empty dataset.
+	if a.coordinator != nil {
+		a.coordinator.snapshots.markFull()
+		a.coordinator.shiftLimiter.reset()
+	}
+
+	return &ecadminrpc.ResetMissionControlResponse{
+		PairsRemoved: uint64(removed),
+	}, nil
+}
+
+// TriggerCleanup runs the cleanup routine of the coordinator right away. The
+// cleanup is otherwise only run on startup and on every cleanup interval.
+func (a *adminServer) TriggerCleanup(ctx context.Context,
+	req *ecadminrpc.TriggerCleanupRequest) (
+	*ecadminrpc.TriggerCleanupResponse, error) {
+
+	if a.coordinator == nil {
+		return nil, status.Error(codes.FailedPrecondition, "stale "+
+			"pairs cannot be cleaned up")
+	}
+
+	removed, err := a.coordinator.cleanupStaleData()
+	if err != nil {
+		return nil, status.Errorf(storageErrorCode(err), "cleanup "+
+			"failed: %v", status.Convert(err).Message())
+	}
+
+	return &ecadminrpc.TriggerCleanupResponse{
+		PairsRemoved: uint64(removed),
+	}, nil
+}
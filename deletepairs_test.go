@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestDeletePairs tests that pairs matching all criteria are deleted across
+// batches, and that a dry run only counts them.
+func TestDeletePairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	// Store more pairs from a single node than fit into a batch, half of
+	// them with a large failed amount, and one unrelated pair.
+	node, _ := generateTestKeys(t)
+	other, otherTo := generateTestKeys(t)
+	numPairs := deletePairsBatchSize + 10
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for i := 0; i < numPairs; i++ {
+			_, to := generateTestKeys(t)
+			history := &ecrpc.PairData{
+				FailTime:    100,
+				FailAmtMsat: 1000,
+			}
+			if i%2 == 0 {
+				history.FailAmtMsat = 1000000
+			}
+			value, err := encodePairData(history)
+			if err != nil {
+				return err
+			}
+			err = putPair(tx, pairKey(node, to), value)
+			if err != nil {
+				return err
+			}
+		}
+
+		value, err := encodePairData(&ecrpc.PairData{
+			FailTime:    100,
+			FailAmtMsat: 1000000,
+		})
+		if err != nil {
+			return err
+		}
+
+		return putPair(tx, pairKey(other, otherTo), value)
+	})
+	require.NoError(t, err)
+
+	// Requests without any criterion are rejected.
+	_, err = admin.DeletePairs(ctx, &ecadminrpc.DeletePairsRequest{
+		DryRun: true,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A dry run only counts the matching pairs.
+	req := &ecadminrpc.DeletePairsRequest{
+		Node:             node,
+		FailAmtAboveMsat: 5000,
+		DryRun:           true,
+	}
+	resp, err := admin.DeletePairs(ctx, req)
+	require.NoError(t, err)
+	require.EqualValues(t, numPairs/2, resp.Matched)
+	require.Zero(t, resp.Deleted)
+	require.Len(t, storedPairKeys(t, db), numPairs+1)
+
+	// Deleting removes the matching pairs only.
+	req.DryRun = false
+	resp, err = admin.DeletePairs(ctx, req)
+	require.NoError(t, err)
+	require.EqualValues(t, numPairs/2, resp.Deleted)
+	require.Len(t, storedPairKeys(t, db), numPairs/2+1)
+
+	// Pairs updated before a time match regardless of their nodes.
+	resp, err = admin.DeletePairs(ctx, &ecadminrpc.DeletePairsRequest{
+		UpdatedBefore: 101,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, numPairs/2+1, resp.Deleted)
+	require.Empty(t, storedPairKeys(t, db))
+}
+
+// TestRemoveMissionControlPairs tests that the listed pairs are removed and
+// that pairs which are not stored are counted as not found.
+func TestRemoveMissionControlPairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, _ := generateTestKeys(t)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		value, err := encodePairData(&ecrpc.PairData{
+			FailTime:    100,
+			FailAmtMsat: 1000,
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range [][]byte{
+			pairKey(nodeA, nodeB), pairKey(nodeB, nodeA),
+			pairKey(nodeA, nodeC),
+		} {
+			if err := putPair(tx, key, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Requests without pairs or with invalid pairs are rejected.
+	_, err = admin.RemoveMissionControlPairs(
+		ctx, &ecadminrpc.RemoveMissionControlPairsRequest{},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	invalid := []*ecadminrpc.NodePair{
+		{NodeFrom: nodeA},
+		{NodeFrom: nodeA, NodeTo: nodeA},
+		{NodeFrom: nodeA[:10], NodeTo: nodeB},
+	}
+	for _, pair := range invalid {
+		_, err = admin.RemoveMissionControlPairs(
+			ctx, &ecadminrpc.RemoveMissionControlPairsRequest{
+				Pairs: []*ecadminrpc.NodePair{pair},
+			},
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	require.Len(t, storedPairKeys(t, db), 3)
+
+	// Only the listed directions are removed.
+	resp, err := admin.RemoveMissionControlPairs(
+		ctx, &ecadminrpc.RemoveMissionControlPairsRequest{
+			Pairs: []*ecadminrpc.NodePair{
+				{NodeFrom: nodeA, NodeTo: nodeB},
+				{NodeFrom: nodeC, NodeTo: nodeA},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Removed)
+	require.EqualValues(t, 1, resp.NotFound)
+	require.ElementsMatch(t, []string{
+		string(pairKey(nodeB, nodeA)), string(pairKey(nodeA, nodeC)),
+	}, storedPairKeys(t, db))
+}
+
+// TestRemoveMissionControlPairsAuthentication tests that pairs are only
+// removed for callers presenting the admin macaroon.
+func TestRemoveMissionControlPairsAuthentication(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	addr, certFile, macaroonPath := startAdminTestServer(
+		t, NewAdminServer(config, db),
+	)
+	client := dialAdminTestServer(t, addr, certFile)
+
+	nodeA, nodeB := generateTestKeys(t)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		value, err := encodePairData(&ecrpc.PairData{
+			FailTime:    100,
+			FailAmtMsat: 1000,
+		})
+		if err != nil {
+			return err
+		}
+
+		return putPair(tx, pairKey(nodeA, nodeB), value)
+	})
+	require.NoError(t, err)
+
+	req := &ecadminrpc.RemoveMissionControlPairsRequest{
+		Pairs: []*ecadminrpc.NodePair{{NodeFrom: nodeA, NodeTo: nodeB}},
+	}
+
+	// Unauthenticated callers are rejected without removing anything.
+	_, err = client.RemoveMissionControlPairs(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Len(t, storedPairKeys(t, db), 1)
+
+	data, err := os.ReadFile(macaroonPath)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(), adminMacaroonHeader, hex.EncodeToString(data),
+	)
+	resp, err := client.RemoveMissionControlPairs(ctx, req)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Removed)
+	require.Empty(t, storedPairKeys(t, db))
+}
+
+// TestResetMissionControl tests that all pairs are removed together with the
+// data kept per pair, and that standby coordinators cannot be reset.
+func TestResetMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.JournalRegistrations = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	admin.coordinator = server
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, _ := generateTestKeys(t)
+	pair := func(nodeFrom, nodeTo []byte) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB), pair(nodeA, nodeC),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	var revision uint64
+	err = db.View(func(tx *bbolt.Tx) error {
+		revision = currentDatasetInfo(tx).Revision
+		return nil
+	})
+	require.NoError(t, err)
+
+	resp, err := admin.ResetMissionControl(
+		ctx, &ecadminrpc.ResetMissionControlRequest{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.PairsRemoved)
+	require.Empty(t, storedPairKeys(t, db))
+
+	err = db.View(func(tx *bbolt.Tx) error {
+		require.Greater(t, currentDatasetInfo(tx).Revision, revision)
+		for _, bucket := range []string{
+			UpdateIndexBucketName, NodeIndexBucketName,
+			JournalBucketName,
+		} {
+			require.Zero(t, tx.Bucket([]byte(bucket)).Stats().KeyN)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Aggregation starts afresh.
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{pair(nodeB, nodeC)},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{string(pairKey(nodeB, nodeC))},
+		storedPairKeys(t, db))
+
+	// Standby coordinators are reset by their primary coordinator.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return applyStandbyRole(tx, true)
+	})
+	require.NoError(t, err)
+	_, err = admin.ResetMissionControl(
+		ctx, &ecadminrpc.ResetMissionControlRequest{},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, storedPairKeys(t, db), 1)
+}
+
+// TestTriggerCleanup tests that the cleanup triggered on demand removes the
+// pairs stale by the current threshold and reports their number.
+func TestTriggerCleanup(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = 2 * time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+	req := &ecadminrpc.TriggerCleanupRequest{}
+
+	// The cleanup runs on the coordinator.
+	_, err = admin.TriggerCleanup(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	admin.coordinator = server
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, _ := generateTestKeys(t)
+	pair := func(nodeTo []byte, age time.Duration) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeA,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Add(-age).Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeB, time.Minute),
+				pair(nodeC, time.Hour),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// No pair is stale yet.
+	resp, err := admin.TriggerCleanup(ctx, req)
+	require.NoError(t, err)
+	require.Zero(t, resp.PairsRemoved)
+
+	// Once the threshold is shortened, the older pair is removed.
+	config.Server.HistoryThresholdDuration = 30 * time.Minute
+	resp, err = admin.TriggerCleanup(ctx, req)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.PairsRemoved)
+	require.Equal(t, []string{string(pairKey(nodeA, nodeB))},
+		storedPairKeys(t, db))
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// deltaWatermarkLag is the time the watermark returned by a delta query trails
+// the query by, so that pairs stored by registrations still being written
+// while the delta was read are part of the next delta.
+const deltaWatermarkLag = time.Minute
+
+// modifiedRemoved marks an entry of the modified index of a pair that was
+// removed at the time of the entry.
+const modifiedRemoved byte = 1
+
+// deltaHorizonKey is the key of the unix time before which the modified index
+// is incomplete within the metadata bucket, e.g. since all pairs were replaced
+// at that time or the removals before it were pruned.
+var deltaHorizonKey = []byte("delta_horizon")
+
+// modifiedIndexKey returns the key of a pair within the modified index. Keys
+// are ordered by the modification time first, so that the pairs modified since
+// a time are found by a range scan.
+func modifiedIndexKey(modified int64, key []byte) []byte {
+	indexKey := make([]byte, 0, 8+len(key))
+	indexKey = append(indexKey, encodeUint64(uint64(modified))...)
+
+	return append(indexKey, key...)
+}
+
+// markPairModified records that the pair was stored or, if removed is set,
+// removed now. It must be called by every change of a single pair.
+func markPairModified(tx *bbolt.Tx, key []byte, removed bool) error {
+	modified := tx.Bucket([]byte(PairModifiedBucketName))
+	index := tx.Bucket([]byte(ModifiedIndexBucketName))
+
+	if previous := modified.Get(key); previous != nil {
+		err := index.Delete(
+			modifiedIndexKey(int64(decodeUint64(previous)), key),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var entry []byte
+	if removed {
+		entry = []byte{modifiedRemoved}
+	}
+	now := time.Now().Unix()
+	if err := index.Put(modifiedIndexKey(now, key), entry); err != nil {
+		return err
+	}
+
+	return modified.Put(key, encodeUint64(uint64(now)))
+}
+
+// resetModifiedIndex drops the modification times of all pairs, so that deltas
+// since an earlier time return the entire dataset. It must be called by every
+// transaction replacing pairs without going through putPair and deletePair.
+func resetModifiedIndex(tx *bbolt.Tx) error {
+	for _, name := range []string{
+		PairModifiedBucketName, ModifiedIndexBucketName,
+	} {
+		err := tx.DeleteBucket([]byte(name))
+		if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return err
+		}
+		if _, err := tx.CreateBucket([]byte(name)); err != nil {
+			return err
+		}
+	}
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	horizon := encodeUint64(uint64(time.Now().Unix()))
+
+	return meta.Put(deltaHorizonKey, horizon)
+}
+
+// initModifiedIndex creates the modified index if the database does not have
+// one yet, e.g. because it was created by an older version. The pairs stored
+// before are only part of deltas returning the entire dataset, unless there
+// are none.
+func initModifiedIndex(tx *bbolt.Tx) error {
+	if tx.Bucket([]byte(ModifiedIndexBucketName)) != nil &&
+		tx.Bucket([]byte(PairModifiedBucketName)) != nil {
+
+		return nil
+	}
+
+	if err := resetModifiedIndex(tx); err != nil {
+		return err
+	}
+
+	b := tx.Bucket([]byte(DatabaseBucketName))
+	if k, _ := b.Cursor().First(); k != nil {
+		return nil
+	}
+	meta := tx.Bucket([]byte(MetadataBucketName))
+
+	return meta.Delete(deltaHorizonKey)
+}
+
+// pruneModifiedIndex removes the entries of the pairs removed before the
+// cutoff, which deltas since an earlier time no longer report.
+func pruneModifiedIndex(tx *bbolt.Tx, cutoff time.Time) error {
+	modified := tx.Bucket([]byte(PairModifiedBucketName))
+	c := tx.Bucket([]byte(ModifiedIndexBucketName)).Cursor()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if int64(decodeUint64(k[:8])) >= cutoff.Unix() {
+			break
+		}
+		if len(v) == 0 || v[0] != modifiedRemoved {
+			continue
+		}
+
+		if err := modified.Delete(k[8:]); err != nil {
+			return err
+		}
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	horizon := max(
+		int64(decodeUint64(meta.Get(deltaHorizonKey))), cutoff.Unix(),
+	)
+
+	return meta.Put(deltaHorizonKey, encodeUint64(uint64(horizon)))
+}
+
+// modifiedPairs returns the changes of the pairs since the given unix time.
+// They are found by a range scan over the modified index. If the index does
+// not cover the time, all pairs are returned instead. Times are kept in
+// seconds, so the second of the horizon itself is not covered either.
+func modifiedPairs(tx *bbolt.Tx, since int64) (*pairChanges, error) {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	horizon := int64(decodeUint64(meta.Get(deltaHorizonKey)))
+	if since <= horizon {
+		return allPairs(tx)
+	}
+
+	type modifiedPair struct {
+		key     []byte
+		removed bool
+	}
+	var modified []modifiedPair
+	c := tx.Bucket([]byte(ModifiedIndexBucketName)).Cursor()
+	k, v := c.Seek(encodeUint64(uint64(since)))
+	for ; k != nil; k, v = c.Next() {
+		modified = append(modified, modifiedPair{
+			key:     bytes.Clone(k[8:]),
+			removed: len(v) > 0 && v[0] == modifiedRemoved,
+		})
+	}
+	sort.Slice(modified, func(i, j int) bool {
+		return bytes.Compare(modified[i].key, modified[j].key) < 0
+	})
+
+	changes := &pairChanges{}
+	b := tx.Bucket([]byte(DatabaseBucketName))
+	for _, pair := range modified {
+		v := b.Get(pair.key)
+		if pair.removed || v == nil {
+			changes.removed = append(changes.removed, pair.key)
+			continue
+		}
+		changes.keys = append(changes.keys, pair.key)
+		changes.values = append(changes.values, bytes.Clone(v))
+	}
+
+	return changes, nil
+}
+
+// QueryMissionControlDelta returns the pairs stored, updated or removed since
+// the watermark of the last sync of the client. The pairs are filtered,
+// transformed and accounted like the ones of a query.
+func (s *externalCoordinatorServer) QueryMissionControlDelta(
+	ctx context.Context, req *ecrpc.QueryMissionControlDeltaRequest) (
+	*ecrpc.QueryMissionControlDeltaResponse, error) {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return nil, err
+	}
+
+	since := req.GetSince()
+	if since < 0 {
+		return nil, status.Error(codes.InvalidArgument, "since must "+
+			"not be negative")
+	}
+
+	// Reject clients which already reached their daily egress cap.
+	client := clientIdentity(ctx)
+	if err := s.checkEgressCap(ctx, client); err != nil {
+		logrus.Infof("Delta query rejected: %v", err)
+		return nil, err
+	}
+
+	// The watermark is taken before reading, so that no pair modified
+	// meanwhile is missed by the next delta.
+	watermark := time.Now().Add(-deltaWatermarkLag).Unix()
+	changes, err := s.collectPairChanges(
+		ctx, func(tx *bbolt.Tx) (*pairChanges, error) {
+			return modifiedPairs(tx, since)
+		},
+	)
+	if err != nil {
+		msg := "delta query failed: %v"
+		st := status.Convert(err)
+		logrus.Errorf(msg, st.Message())
+		return nil, status.Errorf(st.Code(), msg, st.Message())
+	}
+
+	resp := &ecrpc.QueryMissionControlDeltaResponse{
+		Dataset:      changes.Dataset,
+		Pairs:        changes.Pairs,
+		RemovedPairs: changes.RemovedPairs,
+		Full:         changes.Full,
+		Watermark:    watermark,
+	}
+	s.recordEgress(client, int64(proto.Size(resp)))
+	logrus.Infof("Delta query since %d returned %d modified and %d "+
+		"removed pairs", since, len(resp.Pairs), len(resp.RemovedPairs))
+
+	return resp, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryMissionControlDelta tests that deltas return the pairs modified
+// since the given time and fall back to the entire dataset if the coordinator
+// no longer knows the changes since.
+func TestQueryMissionControlDelta(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	register := func(nodeTo []byte) {
+		history := &ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtSat:  1,
+			SuccessAmtMsat: 1000,
+		}
+		_, err := server.RegisterMissionControl(
+			ctx, &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeTo,
+					History:  history,
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+	query := func(since int64) (*ecrpc.QueryMissionControlDeltaResponse,
+		error) {
+
+		req := &ecrpc.QueryMissionControlDeltaRequest{Since: since}
+
+		return server.QueryMissionControlDelta(ctx, req)
+	}
+	delta := func(since int64) *ecrpc.QueryMissionControlDeltaResponse {
+		resp, err := query(since)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	_, err = query(-1)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Clients without a watermark receive the entire dataset.
+	register(nodeB)
+	resp := delta(0)
+	require.True(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+	require.Less(t, resp.Watermark, time.Now().Unix())
+
+	// The watermark trails the query, so the pair is returned again by
+	// the next delta. The database held no pairs before, so the delta
+	// covers the time before it was created.
+	resp = delta(resp.Watermark)
+	require.False(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+	require.Equal(t, nodeB, resp.Pairs[0].NodeTo)
+
+	// Only the pairs modified since are returned.
+	time.Sleep(time.Until(time.Unix(time.Now().Unix()+1, 0)))
+	since := time.Now().Unix()
+	register(nodeC)
+	resp = delta(since)
+	require.False(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+	require.Equal(t, nodeC, resp.Pairs[0].NodeTo)
+	require.Empty(t, resp.RemovedPairs)
+
+	// Removed pairs are reported by their nodes.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return deletePair(tx, pairKey(nodeA, nodeB))
+	})
+	require.NoError(t, err)
+	resp = delta(since)
+	require.Len(t, resp.Pairs, 1)
+	require.Len(t, resp.RemovedPairs, 1)
+	require.Equal(t, nodeA, resp.RemovedPairs[0].NodeFrom)
+	require.Equal(t, nodeB, resp.RemovedPairs[0].NodeTo)
+
+	// Deltas since before a reset return the emptied dataset.
+	_, err = admin.ResetMissionControl(
+		ctx, &ecadminrpc.ResetMissionControlRequest{},
+	)
+	require.NoError(t, err)
+	resp = delta(since)
+	require.True(t, resp.Full)
+	require.Empty(t, resp.Pairs)
+	require.Empty(t, resp.RemovedPairs)
+}
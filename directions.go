@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateNodePair validates the node pair of a bidirectional query.
+func validateNodePair(nodeA, nodeB []byte) error {
+	nodes := []struct {
+		name string
+		key  []byte
+	}{{"NodeA", nodeA}, {"NodeB", nodeB}}
+	for _, node := range nodes {
+		if err := pairKeys.validateNode(node.key); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid "+
+				"%s: %v", node.name, err)
+		}
+	}
+
+	if bytes.Equal(nodeA, nodeB) {
+		return status.Errorf(codes.InvalidArgument, "NodeA and NodeB "+
+			"must be different nodes")
+	}
+
+	return nil
+}
+
+// sendPairDirections looks up both directions of the node pair and sends the
+// ones accepted by the filter in a single response. No response is sent if
+// neither direction is known. It returns the number of pairs sent.
+func (s *externalCoordinatorServer) sendPairDirections(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	nodeA, nodeB []byte, filter func(nodeFrom, nodeTo []byte) bool) (int,
+	error) {
+	start := time.Now()
+	chunk := newQueryChunk(2)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		directions := [][2][]byte{{nodeA, nodeB}, {nodeB, nodeA}}
+		for _, direction := range directions {
+			nodeFrom, nodeTo := direction[0], direction[1]
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			key := pairKey(nodeFrom, nodeTo)
+			v := b.Get(key)
+			if v == nil {
+				continue
+			}
+
+			// The values are only valid for the lifetime of the
+			// transaction, so they are copied.
+			chunk.keys = append(chunk.keys, key)
+			chunk.values = append(chunk.values, bytes.Clone(v))
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, 2)
+	if err != nil {
+		return 0, err
+	}
+	if len(chunk.keys) == 0 {
+		return 0, nil
+	}
+
+	result := chunk.decode()
+	if result.err != nil {
+		return 0, result.err
+	}
+	if err := stream.Send(result.response); err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to send "+
+			"batch: %v", err)
+	}
+
+	return len(result.response.Pairs), nil
+}
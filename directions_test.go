@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryPairDirections tests that a query scoped to a node pair returns
+// both directions of the pair in a single response.
+func TestQueryPairDirections(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	history := func(amtMsat int64) *ecrpc.PairData {
+		return &ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtSat:  amtMsat / 1000,
+			SuccessAmtMsat: amtMsat,
+		}
+	}
+	pair := func(nodeFrom, nodeTo []byte,
+		amtMsat int64) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History:  history(amtMsat),
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB, 1000),
+				pair(nodeB, nodeA, 2000),
+				pair(nodeA, nodeC, 3000),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(nodeA, nodeB []byte) (
+		*mockQueryAggregatedMissionControlServer, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				NodeA: nodeA,
+				NodeB: nodeB,
+			}, stream,
+		)
+
+		return stream, err
+	}
+
+	// Both directions are returned in a single response.
+	stream, err := query(nodeA, nodeB)
+	require.NoError(t, err)
+	require.Len(t, stream.Responses, 1)
+	pairs := stream.Responses[0].Pairs
+	require.Len(t, pairs, 2)
+	require.Equal(t, nodeA, pairs[0].NodeFrom)
+	require.Equal(t, nodeB, pairs[0].NodeTo)
+	require.EqualValues(t, 1000, pairs[0].History.SuccessAmtMsat)
+	require.Equal(t, nodeB, pairs[1].NodeFrom)
+	require.Equal(t, nodeA, pairs[1].NodeTo)
+	require.EqualValues(t, 2000, pairs[1].History.SuccessAmtMsat)
+
+	// Only the known direction is returned.
+	stream, err = query(nodeC, nodeA)
+	require.NoError(t, err)
+	require.Len(t, stream.Responses, 1)
+	require.Len(t, stream.Responses[0].Pairs, 1)
+	require.Equal(t, nodeA, stream.Responses[0].Pairs[0].NodeFrom)
+
+	// Unknown pairs result in an empty response.
+	stream, err = query(nodeB, nodeC)
+	require.NoError(t, err)
+	require.Len(t, stream.Responses, 1)
+	require.Empty(t, stream.Responses[0].Pairs)
+	require.EqualValues(t, 3, stream.Responses[0].Dataset.TotalPairs)
+
+	// Incomplete or invalid pairs are rejected.
+	_, err = query(nodeA, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = query(nodeA, nodeA)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = query(nodeA, make([]byte, PubKeyCompressedSize))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,26 @@
+//go:build !unix
+
+package main
+
+import (
+	"errors"
+	"math"
+	"syscall"
+)
+
+// freeDiskPercent always reports the disk as free on platforms where the free
+// disk space cannot be determined.
+func freeDiskPercent(path string) (float64, error) {
+	return 100, nil
+}
+
+// freeDiskBytes always reports the disk as free on platforms where the free
+// disk space cannot be determined.
+func freeDiskBytes(path string) (uint64, error) {
+	return math.MaxUint64, nil
+}
+
+// isDiskFull returns true if the error is caused by a full disk.
+func isDiskFull(err error) bool {
+	return errors.Is(err, syscall.ENOSPC)
+}
@@ -0,0 +1,40 @@
+//go:build unix
+
+package main
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// freeDiskPercent returns the percentage of free space available to
+// unprivileged users on the disk holding the given path.
+func freeDiskPercent(path string) (float64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	if stat.Blocks == 0 {
+		return 100, nil
+	}
+
+	return float64(stat.Bavail) / float64(stat.Blocks) * 100, nil
+}
+
+// freeDiskBytes returns the bytes of free space available to unprivileged
+// users on the disk holding the given path.
+func freeDiskBytes(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
+
+// isDiskFull returns true if the error is caused by a full disk or an
+// exhausted disk quota.
+func isDiskFull(err error) bool {
+	return errors.Is(err, unix.ENOSPC) || errors.Is(err, unix.EDQUOT)
+}
@@ -0,0 +1,161 @@
+// Package ecerrors defines the errors of the external coordinator which
+// clients can match on programmatically instead of parsing error messages.
+//
+// The coordinator attaches an ecrpc.ErrorDetail to the status of such errors.
+// Go clients pass the errors returned by the gRPC client through FromError to
+// match them with errors.Is and errors.As:
+//
+//	_, err := client.RegisterMissionControl(ctx, req)
+//	err = ecerrors.FromError(err)
+//
+//	var invalid *ecerrors.InvalidPairError
+//	switch {
+//	case errors.Is(err, ecerrors.ErrStaleData):
+//		// Drop the stale pairs.
+//
+//	case errors.As(err, &invalid):
+//		// Drop the pair at invalid.Index and retry.
+//	}
+package ecerrors
+
+import (
+	"errors"
+	"fmt"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrStaleData is returned if all pairs of a registration exceed the
+	// history threshold of the coordinator.
+	ErrStaleData = errors.New("stale data")
+
+	// ErrQuotaExceeded is returned if the client exceeded a quota, e.g.
+	// its daily egress cap.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// InvalidPairError is returned if a pair of a registration is invalid.
+type InvalidPairError struct {
+	// Index is the index of the invalid pair within the registration.
+	Index int
+
+	// Message describes why the pair is invalid.
+	Message string
+}
+
+// Error returns the description of the invalid pair.
+func (e *InvalidPairError) Error() string {
+	return fmt.Sprintf("invalid pair %d: %s", e.Index, e.Message)
+}
+
+// Error is an error with a gRPC status carrying an ecrpc.ErrorDetail. It
+// unwraps to the sentinel error or the InvalidPairError of its reason.
+type Error struct {
+	status *status.Status
+	reason error
+}
+
+// Error returns the message of the status.
+func (e *Error) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus returns the status, so that gRPC sends the code, message and
+// detail of the error to the client.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// Unwrap returns the sentinel error or the InvalidPairError of the reason.
+func (e *Error) Unwrap() error {
+	return e.reason
+}
+
+// newError creates an error with the given code and message carrying the
+// detail.
+func newError(code codes.Code, detail *ecrpc.ErrorDetail, reason error,
+	format string, args ...any) *Error {
+
+	st := status.New(code, fmt.Sprintf(format, args...))
+
+	// Attaching a detail only fails if it cannot be marshaled, which
+	// never happens for our own message. The status is sent without the
+	// detail in that case.
+	if withDetail, err := st.WithDetails(detail); err == nil {
+		st = withDetail
+	}
+
+	return &Error{status: st, reason: reason}
+}
+
+// StaleData returns an InvalidArgument error matching ErrStaleData.
+func StaleData(format string, args ...any) *Error {
+	return newError(codes.InvalidArgument, &ecrpc.ErrorDetail{
+		Reason: ecrpc.ErrorReason_ERROR_REASON_STALE_DATA,
+	}, ErrStaleData, format, args...)
+}
+
+// QuotaExceeded returns a ResourceExhausted error matching ErrQuotaExceeded.
+func QuotaExceeded(format string, args ...any) *Error {
+	return newError(codes.ResourceExhausted, &ecrpc.ErrorDetail{
+		Reason: ecrpc.ErrorReason_ERROR_REASON_QUOTA_EXCEEDED,
+	}, ErrQuotaExceeded, format, args...)
+}
+
+// InvalidPair returns an InvalidArgument error matching an InvalidPairError
+// for the pair with the given index.
+func InvalidPair(index int, format string, args ...any) *Error {
+	msg := fmt.Sprintf(format, args...)
+
+	return newError(codes.InvalidArgument, &ecrpc.ErrorDetail{
+		Reason:    ecrpc.ErrorReason_ERROR_REASON_INVALID_PAIR,
+		PairIndex: uint32(index),
+	}, &InvalidPairError{Index: index, Message: msg}, "%s", msg)
+}
+
+// FromError turns an error returned by a gRPC client into an Error if its
+// status carries an ecrpc.ErrorDetail with a known reason. Other errors are
+// returned unchanged.
+func FromError(err error) error {
+	var typed *Error
+	if errors.As(err, &typed) {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		detail, ok := detail.(*ecrpc.ErrorDetail)
+		if !ok {
+			continue
+		}
+
+		var reason error
+		switch detail.Reason {
+		case ecrpc.ErrorReason_ERROR_REASON_STALE_DATA:
+			reason = ErrStaleData
+
+		case ecrpc.ErrorReason_ERROR_REASON_QUOTA_EXCEEDED:
+			reason = ErrQuotaExceeded
+
+		case ecrpc.ErrorReason_ERROR_REASON_INVALID_PAIR:
+			reason = &InvalidPairError{
+				Index:   int(detail.PairIndex),
+				Message: st.Message(),
+			}
+
+		default:
+			continue
+		}
+
+		return &Error{status: st, reason: reason}
+	}
+
+	return err
+}
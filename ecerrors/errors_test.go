@@ -0,0 +1,55 @@
+package ecerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// overTheWire returns the error a gRPC client receives for the error returned
+// by the server.
+func overTheWire(err error) error {
+	return status.ErrorProto(status.Convert(err).Proto())
+}
+
+// TestFromError tests that the errors received by clients match the sentinel
+// errors and the InvalidPairError of their reason.
+func TestFromError(t *testing.T) {
+	t.Parallel()
+
+	err := FromError(overTheWire(StaleData("all pairs stale")))
+	require.ErrorIs(t, err, ErrStaleData)
+	require.NotErrorIs(t, err, ErrQuotaExceeded)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = FromError(overTheWire(QuotaExceeded("cap of %d reached", 10)))
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, "cap of 10 reached", status.Convert(err).Message())
+
+	err = FromError(overTheWire(InvalidPair(3, "History cannot be nil")))
+	var invalid *InvalidPairError
+	require.ErrorAs(t, err, &invalid)
+	require.Equal(t, 3, invalid.Index)
+	require.Equal(t, "History cannot be nil", invalid.Message)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The errors match wrapped on the server side as well.
+	wrapped := fmt.Errorf("register: %w", InvalidPair(1, "invalid"))
+	require.ErrorAs(t, wrapped, &invalid)
+	require.Equal(t, 1, invalid.Index)
+	require.Equal(t, wrapped, FromError(wrapped))
+
+	// Errors without a detail are returned unchanged.
+	plain := status.Error(codes.Internal, "internal")
+	require.Equal(t, plain, FromError(plain))
+	require.NotErrorIs(t, FromError(plain), ErrStaleData)
+
+	other := errors.New("other")
+	require.Equal(t, other, FromError(other))
+	require.Nil(t, FromError(nil))
+}
@@ -21,6 +21,234 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PairOutcome is what a registration did with a pair.
+type PairOutcome int32
+
+const (
+	// The pair was persisted, aggregated with the data already stored for
+	// it.
+	PairOutcome_PAIR_OUTCOME_ACCEPTED PairOutcome = 0
+	// The pair occurred earlier in the request and was merged into its
+	// first occurrence, which was persisted.
+	PairOutcome_PAIR_OUTCOME_MERGED PairOutcome = 1
+	// The history of the pair exceeds the history threshold, so it was not
+	// persisted.
+	PairOutcome_PAIR_OUTCOME_REJECTED_STALE PairOutcome = 2
+	// The pair was not persisted since the coordinator does not accept it,
+	// e.g. since it did not prove its channel.
+	PairOutcome_PAIR_OUTCOME_REJECTED_INVALID PairOutcome = 3
+)
+
+// Enum value maps for PairOutcome.
+var (
+	PairOutcome_name = map[int32]string{
+		0: "PAIR_OUTCOME_ACCEPTED",
+		1: "PAIR_OUTCOME_MERGED",
+		2: "PAIR_OUTCOME_REJECTED_STALE",
+		3: "PAIR_OUTCOME_REJECTED_INVALID",
+	}
+	PairOutcome_value = map[string]int32{
+		"PAIR_OUTCOME_ACCEPTED":         0,
+		"PAIR_OUTCOME_MERGED":           1,
+		"PAIR_OUTCOME_REJECTED_STALE":   2,
+		"PAIR_OUTCOME_REJECTED_INVALID": 3,
+	}
+)
+
+func (x PairOutcome) Enum() *PairOutcome {
+	p := new(PairOutcome)
+	*p = x
+	return p
+}
+
+func (x PairOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PairOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_ecrpc_external_coordinator_proto_enumTypes[0].Descriptor()
+}
+
+func (PairOutcome) Type() protoreflect.EnumType {
+	return &file_ecrpc_external_coordinator_proto_enumTypes[0]
+}
+
+func (x PairOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PairOutcome.Descriptor instead.
+func (PairOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryFormat is the format in which the results of a query are returned.
+type QueryFormat int32
+
+const (
+	// The pairs are returned as mission control data in the pairs of the
+	// responses.
+	QueryFormat_QUERY_FORMAT_PAIRS QueryFormat = 0
+	// The pairs are returned as the liquidity bounds of their channels as
+	// estimated by the ProbabilisticScorer of LDK in the liquidity_bounds of
+	// the responses, one per channel of the channel graph of the
+	// coordinator between the nodes of a pair.
+	QueryFormat_QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS QueryFormat = 1
+)
+
+// Enum value maps for QueryFormat.
+var (
+	QueryFormat_name = map[int32]string{
+		0: "QUERY_FORMAT_PAIRS",
+		1: "QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS",
+	}
+	QueryFormat_value = map[string]int32{
+		"QUERY_FORMAT_PAIRS":                0,
+		"QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS": 1,
+	}
+)
+
+func (x QueryFormat) Enum() *QueryFormat {
+	p := new(QueryFormat)
+	*p = x
+	return p
+}
+
+func (x QueryFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ecrpc_external_coordinator_proto_enumTypes[1].Descriptor()
+}
+
+func (QueryFormat) Type() protoreflect.EnumType {
+	return &file_ecrpc_external_coordinator_proto_enumTypes[1]
+}
+
+func (x QueryFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryFormat.Descriptor instead.
+func (QueryFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{1}
+}
+
+// SortOrder is the order in which the pairs of a query are returned.
+type SortOrder int32
+
+const (
+	// Ordered by the public key of the source node, then by the public key
+	// of the destination node, both compared as bytes.
+	SortOrder_SORT_ORDER_NODE_PUBKEY SortOrder = 0
+	// Ordered by the time of the last success or failure, whichever is
+	// later, the most recently updated pairs first.
+	SortOrder_SORT_ORDER_FRESHNESS SortOrder = 1
+	// Ordered by the lowest amount that failed to forward, the lowest
+	// amounts first. Pairs without any failure are returned last.
+	SortOrder_SORT_ORDER_FAILURE_AMOUNT SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_NODE_PUBKEY",
+		1: "SORT_ORDER_FRESHNESS",
+		2: "SORT_ORDER_FAILURE_AMOUNT",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_NODE_PUBKEY":    0,
+		"SORT_ORDER_FRESHNESS":      1,
+		"SORT_ORDER_FAILURE_AMOUNT": 2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_ecrpc_external_coordinator_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_ecrpc_external_coordinator_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{2}
+}
+
+// ErrorReason tells clients why a request failed, so that they can handle the
+// failure without parsing the error message.
+type ErrorReason int32
+
+const (
+	// The failure has no specific reason.
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// All pairs of a registration exceed the history threshold.
+	ErrorReason_ERROR_REASON_STALE_DATA ErrorReason = 1
+	// The client exceeded a quota, e.g. its daily egress cap.
+	ErrorReason_ERROR_REASON_QUOTA_EXCEEDED ErrorReason = 2
+	// A pair of a registration is invalid.
+	ErrorReason_ERROR_REASON_INVALID_PAIR ErrorReason = 3
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "ERROR_REASON_UNSPECIFIED",
+		1: "ERROR_REASON_STALE_DATA",
+		2: "ERROR_REASON_QUOTA_EXCEEDED",
+		3: "ERROR_REASON_INVALID_PAIR",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":    0,
+		"ERROR_REASON_STALE_DATA":     1,
+		"ERROR_REASON_QUOTA_EXCEEDED": 2,
+		"ERROR_REASON_INVALID_PAIR":   3,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_ecrpc_external_coordinator_proto_enumTypes[3].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_ecrpc_external_coordinator_proto_enumTypes[3]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{3}
+}
+
 // RegisterMissionControlRequest is the request message for registering mission
 // control data.
 type RegisterMissionControlRequest struct {
@@ -29,6 +257,17 @@ type RegisterMissionControlRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Pairs []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// The network the pairs were observed on, one of mainnet, testnet,
+	// signet and regtest. Submissions for another network than the one of
+	// the coordinator are rejected. If empty, the pairs are assumed to be
+	// observed on the network of the coordinator.
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// Whether the pairs are private, e.g. because they describe unannounced
+	// channels. Private pairs are aggregated separately for the owner of the
+	// access token of the request, its tenant or else the token itself, and
+	// are only returned by QueryPrivateMissionControl to the same owner. They
+	// require access tokens to be enabled.
+	Private bool `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
 }
 
 func (x *RegisterMissionControlRequest) Reset() {
@@ -70,20 +309,42 @@ func (x *RegisterMissionControlRequest) GetPairs() []*PairHistory {
 	return nil
 }
 
-// RegisterMissionControlResponse is the response message for registering
-// mission control data.
-type RegisterMissionControlResponse struct {
+func (x *RegisterMissionControlRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *RegisterMissionControlRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+// RegisterCLNPayResultsRequest is the request message for registering the
+// results of payment attempts made by a Core Lightning node.
+type RegisterCLNPayResultsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Success message indicating the number of pairs successfully registered
-	// and stale pairs removed (if any).
-	SuccessMessage string `protobuf:"bytes,1,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	// The pubkey of the Core Lightning node that made the attempts, the
+	// source of the first pair of every route.
+	Source []byte `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The payment attempts to register.
+	Attempts []*CLNPayAttempt `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// The network the attempts were made on, like the network of
+	// RegisterMissionControlRequest.
+	Network string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	// Whether the resulting pairs are private, like the pairs of
+	// RegisterMissionControlRequest.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
 }
 
-func (x *RegisterMissionControlResponse) Reset() {
-	*x = RegisterMissionControlResponse{}
+func (x *RegisterCLNPayResultsRequest) Reset() {
+	*x = RegisterCLNPayResultsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ecrpc_external_coordinator_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -91,13 +352,13 @@ func (x *RegisterMissionControlResponse) Reset() {
 	}
 }
 
-func (x *RegisterMissionControlResponse) String() string {
+func (x *RegisterCLNPayResultsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterMissionControlResponse) ProtoMessage() {}
+func (*RegisterCLNPayResultsRequest) ProtoMessage() {}
 
-func (x *RegisterMissionControlResponse) ProtoReflect() protoreflect.Message {
+func (x *RegisterCLNPayResultsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ecrpc_external_coordinator_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -109,28 +370,66 @@ func (x *RegisterMissionControlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterMissionControlResponse.ProtoReflect.Descriptor instead.
-func (*RegisterMissionControlResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterCLNPayResultsRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCLNPayResultsRequest) Descriptor() ([]byte, []int) {
 	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RegisterMissionControlResponse) GetSuccessMessage() string {
+func (x *RegisterCLNPayResultsRequest) GetSource() []byte {
 	if x != nil {
-		return x.SuccessMessage
+		return x.Source
+	}
+	return nil
+}
+
+func (x *RegisterCLNPayResultsRequest) GetAttempts() []*CLNPayAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *RegisterCLNPayResultsRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
 	}
 	return ""
 }
 
-// QueryAggregatedMissionControlRequest is the request message for querying
-// aggregated mission control data.
-type QueryAggregatedMissionControlRequest struct {
+func (x *RegisterCLNPayResultsRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+// CLNPayAttempt is a single payment attempt as reported by the listsendpays
+// command of Core Lightning, together with the route it was sent along and
+// the error it failed with, if any.
+type CLNPayAttempt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The status of the attempt, one of complete, failed and pending.
+	// Pending attempts are ignored.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The unix time in seconds the attempt was created at.
+	CreatedAt uint64 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The unix time in seconds the attempt was resolved at. If zero, the
+	// creation time is used instead.
+	CompletedAt uint64 `protobuf:"varint,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// The route of the attempt, starting at the first hop after the source.
+	Route []*CLNRouteHop `protobuf:"bytes,4,rep,name=route,proto3" json:"route,omitempty"`
+	// The index of the node that reported the failure of a failed attempt,
+	// zero being the source and i being the node of the hop at index i - 1.
+	ErringIndex uint32 `protobuf:"varint,5,opt,name=erring_index,json=erringIndex,proto3" json:"erring_index,omitempty"`
+	// The BOLT 4 failure code of a failed attempt.
+	Failcode uint32 `protobuf:"varint,6,opt,name=failcode,proto3" json:"failcode,omitempty"`
 }
 
-func (x *QueryAggregatedMissionControlRequest) Reset() {
-	*x = QueryAggregatedMissionControlRequest{}
+func (x *CLNPayAttempt) Reset() {
+	*x = CLNPayAttempt{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ecrpc_external_coordinator_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -138,13 +437,13 @@ func (x *QueryAggregatedMissionControlRequest) Reset() {
 	}
 }
 
-func (x *QueryAggregatedMissionControlRequest) String() string {
+func (x *CLNPayAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAggregatedMissionControlRequest) ProtoMessage() {}
+func (*CLNPayAttempt) ProtoMessage() {}
 
-func (x *QueryAggregatedMissionControlRequest) ProtoReflect() protoreflect.Message {
+func (x *CLNPayAttempt) ProtoReflect() protoreflect.Message {
 	mi := &file_ecrpc_external_coordinator_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -156,25 +455,71 @@ func (x *QueryAggregatedMissionControlRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAggregatedMissionControlRequest.ProtoReflect.Descriptor instead.
-func (*QueryAggregatedMissionControlRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CLNPayAttempt.ProtoReflect.Descriptor instead.
+func (*CLNPayAttempt) Descriptor() ([]byte, []int) {
 	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{2}
 }
 
-// QueryAggregatedMissionControlResponse is the response message for querying
-// aggregated mission control data.
-//
-// NOTE: This is the same message that is found in LND.
-type QueryAggregatedMissionControlResponse struct {
+func (x *CLNPayAttempt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CLNPayAttempt) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CLNPayAttempt) GetCompletedAt() uint64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *CLNPayAttempt) GetRoute() []*CLNRouteHop {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *CLNPayAttempt) GetErringIndex() uint32 {
+	if x != nil {
+		return x.ErringIndex
+	}
+	return 0
+}
+
+func (x *CLNPayAttempt) GetFailcode() uint32 {
+	if x != nil {
+		return x.Failcode
+	}
+	return 0
+}
+
+// CLNRouteHop is a single hop of a route as returned by the getroute command
+// of Core Lightning.
+type CLNRouteHop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pairs []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// The pubkey of the node the hop leads to.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The short channel id of the channel of the hop in the BLOCKxTXxOUTPUT
+	// format, optionally followed by the direction, e.g. 103x1x0/1.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// The amount in millisatoshi delivered to the node of the hop.
+	AmountMsat uint64 `protobuf:"varint,3,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
 }
 
-func (x *QueryAggregatedMissionControlResponse) Reset() {
-	*x = QueryAggregatedMissionControlResponse{}
+func (x *CLNRouteHop) Reset() {
+	*x = CLNRouteHop{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ecrpc_external_coordinator_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -182,13 +527,13 @@ func (x *QueryAggregatedMissionControlResponse) Reset() {
 	}
 }
 
-func (x *QueryAggregatedMissionControlResponse) String() string {
+func (x *CLNRouteHop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAggregatedMissionControlResponse) ProtoMessage() {}
+func (*CLNRouteHop) ProtoMessage() {}
 
-func (x *QueryAggregatedMissionControlResponse) ProtoReflect() protoreflect.Message {
+func (x *CLNRouteHop) ProtoReflect() protoreflect.Message {
 	mi := &file_ecrpc_external_coordinator_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -200,34 +545,58 @@ func (x *QueryAggregatedMissionControlResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAggregatedMissionControlResponse.ProtoReflect.Descriptor instead.
-func (*QueryAggregatedMissionControlResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CLNRouteHop.ProtoReflect.Descriptor instead.
+func (*CLNRouteHop) Descriptor() ([]byte, []int) {
 	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *QueryAggregatedMissionControlResponse) GetPairs() []*PairHistory {
+func (x *CLNRouteHop) GetId() []byte {
 	if x != nil {
-		return x.Pairs
+		return x.Id
 	}
 	return nil
 }
 
-// PairHistory contains the mission control state for a particular node pair.
-type PairHistory struct {
+func (x *CLNRouteHop) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CLNRouteHop) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+// RegisterMissionControlResponse is the response message for registering
+// mission control data.
+type RegisterMissionControlResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The source node pubkey of the pair.
-	NodeFrom []byte `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
-	// The destination node pubkey of the pair.
-	NodeTo []byte `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
-	// History data for the pair.
-	History *PairData `protobuf:"bytes,3,opt,name=history,proto3" json:"history,omitempty"`
+	// Success message indicating the number of pairs successfully registered
+	// and stale pairs removed (if any).
+	SuccessMessage string `protobuf:"bytes,1,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	// Hints on how the client should pace its next submissions based on the
+	// current load of the coordinator.
+	Hints *SubmissionHints `protobuf:"bytes,2,opt,name=hints,proto3" json:"hints,omitempty"`
+	// The number of duplicate pairs in the request which were merged into
+	// their first occurrence before the registration.
+	DuplicatesMerged uint32 `protobuf:"varint,3,opt,name=duplicates_merged,json=duplicatesMerged,proto3" json:"duplicates_merged,omitempty"`
+	// The number of pairs in the request which were rejected since channel
+	// proofs are required and they did not prove their channel.
+	UnprovenPairsRejected uint32 `protobuf:"varint,4,opt,name=unproven_pairs_rejected,json=unprovenPairsRejected,proto3" json:"unproven_pairs_rejected,omitempty"`
+	// The outcome of each pair of the request, in the order of the request.
+	// Registrations uploaded as a stream do not report them.
+	PairResults []*PairResult `protobuf:"bytes,5,rep,name=pair_results,json=pairResults,proto3" json:"pair_results,omitempty"`
 }
 
-func (x *PairHistory) Reset() {
-	*x = PairHistory{}
+func (x *RegisterMissionControlResponse) Reset() {
+	*x = RegisterMissionControlResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ecrpc_external_coordinator_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,13 +604,13 @@ func (x *PairHistory) Reset() {
 	}
 }
 
-func (x *PairHistory) String() string {
+func (x *RegisterMissionControlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PairHistory) ProtoMessage() {}
+func (*RegisterMissionControlResponse) ProtoMessage() {}
 
-func (x *PairHistory) ProtoReflect() protoreflect.Message {
+func (x *RegisterMissionControlResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ecrpc_external_coordinator_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -253,56 +622,67 @@ func (x *PairHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PairHistory.ProtoReflect.Descriptor instead.
-func (*PairHistory) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*RegisterMissionControlResponse) Descriptor() ([]byte, []int) {
 	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PairHistory) GetNodeFrom() []byte {
+func (x *RegisterMissionControlResponse) GetSuccessMessage() string {
 	if x != nil {
-		return x.NodeFrom
+		return x.SuccessMessage
 	}
-	return nil
+	return ""
 }
 
-func (x *PairHistory) GetNodeTo() []byte {
+func (x *RegisterMissionControlResponse) GetHints() *SubmissionHints {
 	if x != nil {
-		return x.NodeTo
+		return x.Hints
 	}
 	return nil
 }
 
-func (x *PairHistory) GetHistory() *PairData {
+func (x *RegisterMissionControlResponse) GetDuplicatesMerged() uint32 {
 	if x != nil {
-		return x.History
+		return x.DuplicatesMerged
+	}
+	return 0
+}
+
+func (x *RegisterMissionControlResponse) GetUnprovenPairsRejected() uint32 {
+	if x != nil {
+		return x.UnprovenPairsRejected
+	}
+	return 0
+}
+
+func (x *RegisterMissionControlResponse) GetPairResults() []*PairResult {
+	if x != nil {
+		return x.PairResults
 	}
 	return nil
 }
 
-// PairData contains the detailed history data for a node pair.
-type PairData struct {
+// PairResult is the outcome of registering a single pair.
+type PairResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Time of last failure.
-	FailTime int64 `protobuf:"varint,1,opt,name=fail_time,json=failTime,proto3" json:"fail_time,omitempty"`
-	// Lowest amount that failed to forward rounded to whole sats. This may be
-	// set to zero if the failure is independent of amount.
-	FailAmtSat int64 `protobuf:"varint,2,opt,name=fail_amt_sat,json=failAmtSat,proto3" json:"fail_amt_sat,omitempty"`
-	// Lowest amount that failed to forward in millisats. This may be set to
-	// zero if the failure is independent of amount.
-	FailAmtMsat int64 `protobuf:"varint,3,opt,name=fail_amt_msat,json=failAmtMsat,proto3" json:"fail_amt_msat,omitempty"`
-	// Time of last success.
-	SuccessTime int64 `protobuf:"varint,4,opt,name=success_time,json=successTime,proto3" json:"success_time,omitempty"`
-	// Highest amount that we could successfully forward rounded to whole sats.
-	SuccessAmtSat int64 `protobuf:"varint,5,opt,name=success_amt_sat,json=successAmtSat,proto3" json:"success_amt_sat,omitempty"`
-	// Highest amount that we could successfully forward in millisats.
-	SuccessAmtMsat int64 `protobuf:"varint,6,opt,name=success_amt_msat,json=successAmtMsat,proto3" json:"success_amt_msat,omitempty"`
+	// The index of the pair within the request.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The public key of the node from which the pair originates.
+	NodeFrom []byte `protobuf:"bytes,2,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// The public key of the node to which the pair leads.
+	NodeTo []byte `protobuf:"bytes,3,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// What the registration did with the pair.
+	Outcome PairOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=ecrpc.PairOutcome" json:"outcome,omitempty"`
+	// Why the pair was merged or rejected, or how it was altered before it
+	// was accepted.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *PairData) Reset() {
-	*x = PairData{}
+func (x *PairResult) Reset() {
+	*x = PairResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ecrpc_external_coordinator_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,13 +690,13 @@ func (x *PairData) Reset() {
 	}
 }
 
-func (x *PairData) String() string {
+func (x *PairResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PairData) ProtoMessage() {}
+func (*PairResult) ProtoMessage() {}
 
-func (x *PairData) ProtoReflect() protoreflect.Message {
+func (x *PairResult) ProtoReflect() protoreflect.Message {
 	mi := &file_ecrpc_external_coordinator_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,218 +708,4551 @@ func (x *PairData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PairData.ProtoReflect.Descriptor instead.
-func (*PairData) Descriptor() ([]byte, []int) {
+// Deprecated: Use PairResult.ProtoReflect.Descriptor instead.
+func (*PairResult) Descriptor() ([]byte, []int) {
 	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PairData) GetFailTime() int64 {
+func (x *PairResult) GetIndex() uint32 {
 	if x != nil {
-		return x.FailTime
+		return x.Index
 	}
 	return 0
 }
 
-func (x *PairData) GetFailAmtSat() int64 {
+func (x *PairResult) GetNodeFrom() []byte {
 	if x != nil {
-		return x.FailAmtSat
+		return x.NodeFrom
 	}
-	return 0
+	return nil
 }
 
-func (x *PairData) GetFailAmtMsat() int64 {
+func (x *PairResult) GetNodeTo() []byte {
 	if x != nil {
-		return x.FailAmtMsat
+		return x.NodeTo
+	}
+	return nil
+}
+
+func (x *PairResult) GetOutcome() PairOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PairOutcome_PAIR_OUTCOME_ACCEPTED
+}
+
+func (x *PairResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SubmissionHints contains server-suggested pacing parameters. Well-behaved
+// clients should follow them so they automatically back off when the
+// coordinator is busy.
+type SubmissionHints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The suggested interval in seconds between two syncs of the client.
+	SyncIntervalSeconds int64 `protobuf:"varint,1,opt,name=sync_interval_seconds,json=syncIntervalSeconds,proto3" json:"sync_interval_seconds,omitempty"`
+	// The suggested maximum number of pairs per registration request.
+	MaxBatchSize uint32 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// Whether the coordinator currently considers itself busy.
+	Busy bool `protobuf:"varint,3,opt,name=busy,proto3" json:"busy,omitempty"`
+}
+
+func (x *SubmissionHints) Reset() {
+	*x = SubmissionHints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionHints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionHints) ProtoMessage() {}
+
+func (x *SubmissionHints) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionHints.ProtoReflect.Descriptor instead.
+func (*SubmissionHints) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubmissionHints) GetSyncIntervalSeconds() int64 {
+	if x != nil {
+		return x.SyncIntervalSeconds
 	}
 	return 0
 }
 
-func (x *PairData) GetSuccessTime() int64 {
+func (x *SubmissionHints) GetMaxBatchSize() uint32 {
 	if x != nil {
-		return x.SuccessTime
+		return x.MaxBatchSize
 	}
 	return 0
 }
 
-func (x *PairData) GetSuccessAmtSat() int64 {
+func (x *SubmissionHints) GetBusy() bool {
 	if x != nil {
-		return x.SuccessAmtSat
+		return x.Busy
+	}
+	return false
+}
+
+// GetInfoRequest is the request message for querying information about the
+// coordinator.
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{7}
+}
+
+// GetInfoResponse is the response message for querying information about the
+// coordinator.
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hints on how clients should pace their submissions based on the
+	// current load of the coordinator.
+	SubmissionHints *SubmissionHints `protobuf:"bytes,1,opt,name=submission_hints,json=submissionHints,proto3" json:"submission_hints,omitempty"`
+	// The network the coordinator collects mission control data for, one of
+	// mainnet, testnet, signet and regtest.
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// The age in seconds after which pairs are no longer accepted in
+	// registrations and removed from the coordinator.
+	HistoryThresholdSeconds int64 `protobuf:"varint,3,opt,name=history_threshold_seconds,json=historyThresholdSeconds,proto3" json:"history_threshold_seconds,omitempty"`
+	// The age in seconds after which pairs are no longer returned by queries
+	// by default. It never exceeds the history threshold.
+	QueryThresholdSeconds int64 `protobuf:"varint,4,opt,name=query_threshold_seconds,json=queryThresholdSeconds,proto3" json:"query_threshold_seconds,omitempty"`
+	// The progress of the recovery steps run on startup. It is only set
+	// while the coordinator is starting up, during which all other requests
+	// are rejected as unavailable.
+	Startup *StartupProgress `protobuf:"bytes,5,opt,name=startup,proto3" json:"startup,omitempty"`
+	// The forecast of when the database runs out of space at its recent
+	// growth rate. It is only set once enough growth was sampled.
+	CapacityForecast *CapacityForecast `protobuf:"bytes,6,opt,name=capacity_forecast,json=capacityForecast,proto3" json:"capacity_forecast,omitempty"`
+	// The version of the coordinator, "dev" for builds without one.
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// The hash of the commit the coordinator was built from, empty if
+	// unknown.
+	CommitHash string `protobuf:"bytes,8,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// The unix timestamp in seconds at which the coordinator was started.
+	StartTime int64 `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The dataset currently served, including its total number of pairs.
+	Dataset *DatasetInfo `protobuf:"bytes,10,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// The unix timestamp in seconds at which the cleanup routine last ran,
+	// zero if it has not run yet.
+	LastCleanupTime int64 `protobuf:"varint,11,opt,name=last_cleanup_time,json=lastCleanupTime,proto3" json:"last_cleanup_time,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetInfoResponse) GetSubmissionHints() *SubmissionHints {
+	if x != nil {
+		return x.SubmissionHints
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetHistoryThresholdSeconds() int64 {
+	if x != nil {
+		return x.HistoryThresholdSeconds
 	}
 	return 0
 }
 
-func (x *PairData) GetSuccessAmtMsat() int64 {
+func (x *GetInfoResponse) GetQueryThresholdSeconds() int64 {
 	if x != nil {
-		return x.SuccessAmtMsat
+		return x.QueryThresholdSeconds
 	}
 	return 0
 }
 
-var File_ecrpc_external_coordinator_proto protoreflect.FileDescriptor
+func (x *GetInfoResponse) GetStartup() *StartupProgress {
+	if x != nil {
+		return x.Startup
+	}
+	return nil
+}
 
-var file_ecrpc_external_coordinator_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x05, 0x65, 0x63, 0x72, 0x70, 0x63, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
-	0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69,
-	0x72, 0x73, 0x22, 0x49, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x26, 0x0a,
-	0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x6e, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x72,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x12, 0x29, 0x0a,
-	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xe2, 0x01, 0x0a, 0x08, 0x50, 0x61, 0x69,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x6d,
-	0x74, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x6d, 0x74,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x69,
-	0x6c, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x6d, 0x74,
-	0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61,
-	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x32, 0xd3, 0x02,
-	0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x8e, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x12, 0x24, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0xaa, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x30, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x7a, 0x69, 0x67, 0x67, 0x69, 0x65, 0x31, 0x39, 0x38, 0x34, 0x2f, 0x44, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x2d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x4c, 0x4e, 0x44,
-	0x2f, 0x65, 0x63, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GetInfoResponse) GetCapacityForecast() *CapacityForecast {
+	if x != nil {
+		return x.CapacityForecast
+	}
+	return nil
 }
 
-var (
-	file_ecrpc_external_coordinator_proto_rawDescOnce sync.Once
-	file_ecrpc_external_coordinator_proto_rawDescData = file_ecrpc_external_coordinator_proto_rawDesc
-)
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
 
-func file_ecrpc_external_coordinator_proto_rawDescGZIP() []byte {
-	file_ecrpc_external_coordinator_proto_rawDescOnce.Do(func() {
-		file_ecrpc_external_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(file_ecrpc_external_coordinator_proto_rawDescData)
-	})
-	return file_ecrpc_external_coordinator_proto_rawDescData
+func (x *GetInfoResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
 }
 
-var file_ecrpc_external_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_ecrpc_external_coordinator_proto_goTypes = []interface{}{
-	(*RegisterMissionControlRequest)(nil),         // 0: ecrpc.RegisterMissionControlRequest
-	(*RegisterMissionControlResponse)(nil),        // 1: ecrpc.RegisterMissionControlResponse
-	(*QueryAggregatedMissionControlRequest)(nil),  // 2: ecrpc.QueryAggregatedMissionControlRequest
-	(*QueryAggregatedMissionControlResponse)(nil), // 3: ecrpc.QueryAggregatedMissionControlResponse
-	(*PairHistory)(nil),                           // 4: ecrpc.PairHistory
-	(*PairData)(nil),                              // 5: ecrpc.PairData
+func (x *GetInfoResponse) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
 }
-var file_ecrpc_external_coordinator_proto_depIdxs = []int32{
-	4, // 0: ecrpc.RegisterMissionControlRequest.pairs:type_name -> ecrpc.PairHistory
-	4, // 1: ecrpc.QueryAggregatedMissionControlResponse.pairs:type_name -> ecrpc.PairHistory
-	5, // 2: ecrpc.PairHistory.history:type_name -> ecrpc.PairData
-	0, // 3: ecrpc.ExternalCoordinator.RegisterMissionControl:input_type -> ecrpc.RegisterMissionControlRequest
-	2, // 4: ecrpc.ExternalCoordinator.QueryAggregatedMissionControl:input_type -> ecrpc.QueryAggregatedMissionControlRequest
-	1, // 5: ecrpc.ExternalCoordinator.RegisterMissionControl:output_type -> ecrpc.RegisterMissionControlResponse
-	3, // 6: ecrpc.ExternalCoordinator.QueryAggregatedMissionControl:output_type -> ecrpc.QueryAggregatedMissionControlResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+
+func (x *GetInfoResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
 }
 
-func init() { file_ecrpc_external_coordinator_proto_init() }
-func file_ecrpc_external_coordinator_proto_init() {
-	if File_ecrpc_external_coordinator_proto != nil {
-		return
+func (x *GetInfoResponse) GetLastCleanupTime() int64 {
+	if x != nil {
+		return x.LastCleanupTime
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ecrpc_external_coordinator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterMissionControlRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ecrpc_external_coordinator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterMissionControlResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ecrpc_external_coordinator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregatedMissionControlRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+// CapacityForecast forecasts when the database reaches its configured maximum
+// size or fills the disk holding it at the growth rate of the recent days.
+type CapacityForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current size of the database in bytes.
+	DatabaseSizeBytes uint64 `protobuf:"varint,1,opt,name=database_size_bytes,json=databaseSizeBytes,proto3" json:"database_size_bytes,omitempty"`
+	// The growth rate of the database in bytes per day, negative if it
+	// shrinks.
+	GrowthBytesPerDay float64 `protobuf:"fixed64,2,opt,name=growth_bytes_per_day,json=growthBytesPerDay,proto3" json:"growth_bytes_per_day,omitempty"`
+	// The unix timestamp in seconds at which the database is forecast to
+	// run out of space, zero if it is not forecast to within a century.
+	ExhaustionTime int64 `protobuf:"varint,3,opt,name=exhaustion_time,json=exhaustionTime,proto3" json:"exhaustion_time,omitempty"`
+	// The limit the database is forecast to reach first, either
+	// max_database_size or disk. Empty if no limit is reached.
+	Limit string `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Whether the limit is forecast to be reached within the configured
+	// warning period, in which case the operator was notified.
+	Warning bool `protobuf:"varint,5,opt,name=warning,proto3" json:"warning,omitempty"`
+}
+
+func (x *CapacityForecast) Reset() {
+	*x = CapacityForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapacityForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityForecast) ProtoMessage() {}
+
+func (x *CapacityForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ecrpc_external_coordinator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregatedMissionControlResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityForecast.ProtoReflect.Descriptor instead.
+func (*CapacityForecast) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CapacityForecast) GetDatabaseSizeBytes() uint64 {
+	if x != nil {
+		return x.DatabaseSizeBytes
+	}
+	return 0
+}
+
+func (x *CapacityForecast) GetGrowthBytesPerDay() float64 {
+	if x != nil {
+		return x.GrowthBytesPerDay
+	}
+	return 0
+}
+
+func (x *CapacityForecast) GetExhaustionTime() int64 {
+	if x != nil {
+		return x.ExhaustionTime
+	}
+	return 0
+}
+
+func (x *CapacityForecast) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *CapacityForecast) GetWarning() bool {
+	if x != nil {
+		return x.Warning
+	}
+	return false
+}
+
+// StartupProgress describes the progress of the recovery steps run on
+// startup, e.g. bootstrapping from another coordinator or replaying the
+// write-ahead log.
+type StartupProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the step currently running.
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// The number of items the step completed so far.
+	Completed uint64 `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The total number of items of the step, zero if unknown.
+	Total uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *StartupProgress) Reset() {
+	*x = StartupProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartupProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartupProgress) ProtoMessage() {}
+
+func (x *StartupProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartupProgress.ProtoReflect.Descriptor instead.
+func (*StartupProgress) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartupProgress) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *StartupProgress) GetCompleted() uint64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *StartupProgress) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetStatsRequest is the request message for querying aggregate statistics of
+// the mission control data.
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{11}
+}
+
+// FreshnessBucket counts the pairs last updated within an age range.
+type FreshnessBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum age in seconds of the pairs counted, exclusive. Zero for
+	// the last bucket counting all pairs older than the previous one.
+	MaxAgeSeconds int64 `protobuf:"varint,1,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// The number of pairs counted.
+	Pairs uint64 `protobuf:"varint,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *FreshnessBucket) Reset() {
+	*x = FreshnessBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreshnessBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreshnessBucket) ProtoMessage() {}
+
+func (x *FreshnessBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreshnessBucket.ProtoReflect.Descriptor instead.
+func (*FreshnessBucket) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FreshnessBucket) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *FreshnessBucket) GetPairs() uint64 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+// RegionStats are the statistics of the pairs involving the nodes of a node
+// group, i.e. a region of the graph defined by the operator.
+type RegionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the node group.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The number of pairs involving any node of the group.
+	Pairs uint64 `protobuf:"varint,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	// The number of those pairs whose most recent result is a failure.
+	FailedPairs uint64 `protobuf:"varint,3,opt,name=failed_pairs,json=failedPairs,proto3" json:"failed_pairs,omitempty"`
+	// The fraction of the pairs whose most recent result is a failure.
+	FailureRate float64 `protobuf:"fixed64,4,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+}
+
+func (x *RegionStats) Reset() {
+	*x = RegionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionStats) ProtoMessage() {}
+
+func (x *RegionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionStats.ProtoReflect.Descriptor instead.
+func (*RegionStats) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RegionStats) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *RegionStats) GetPairs() uint64 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+func (x *RegionStats) GetFailedPairs() uint64 {
+	if x != nil {
+		return x.FailedPairs
+	}
+	return 0
+}
+
+func (x *RegionStats) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+// GetStatsResponse is the response message for querying aggregate statistics
+// of the mission control data.
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total number of pairs.
+	TotalPairs uint64 `protobuf:"varint,1,opt,name=total_pairs,json=totalPairs,proto3" json:"total_pairs,omitempty"`
+	// The number of distinct nodes involved in any pair.
+	Nodes uint64 `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	// The number of pairs whose most recent result is a failure.
+	FailedPairs uint64 `protobuf:"varint,3,opt,name=failed_pairs,json=failedPairs,proto3" json:"failed_pairs,omitempty"`
+	// The fraction of the pairs whose most recent result is a failure.
+	FailureRate float64 `protobuf:"fixed64,4,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	// The number of pairs by the age of their last update, youngest first.
+	Freshness []*FreshnessBucket `protobuf:"bytes,5,rep,name=freshness,proto3" json:"freshness,omitempty"`
+	// The statistics of each node group.
+	Regions []*RegionStats `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty"`
+	// An estimate of the number of distinct nodes involved in any pair ever
+	// stored by the coordinator, including pairs removed since, which tracks
+	// the growth of its network coverage over time.
+	NodesSeenEstimate uint64 `protobuf:"varint,7,opt,name=nodes_seen_estimate,json=nodesSeenEstimate,proto3" json:"nodes_seen_estimate,omitempty"`
+	// The number of pairs with conflicting reports within the conflict
+	// window.
+	ConflictingPairs uint64 `protobuf:"varint,8,opt,name=conflicting_pairs,json=conflictingPairs,proto3" json:"conflicting_pairs,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetStatsResponse) GetTotalPairs() uint64 {
+	if x != nil {
+		return x.TotalPairs
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetNodes() uint64 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetFailedPairs() uint64 {
+	if x != nil {
+		return x.FailedPairs
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetFreshness() []*FreshnessBucket {
+	if x != nil {
+		return x.Freshness
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetRegions() []*RegionStats {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetNodesSeenEstimate() uint64 {
+	if x != nil {
+		return x.NodesSeenEstimate
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetConflictingPairs() uint64 {
+	if x != nil {
+		return x.ConflictingPairs
+	}
+	return 0
+}
+
+// ListEpochsRequest is the request message for listing the epochs of the
+// mission control data.
+type ListEpochsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListEpochsRequest) Reset() {
+	*x = ListEpochsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEpochsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpochsRequest) ProtoMessage() {}
+
+func (x *ListEpochsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpochsRequest.ProtoReflect.Descriptor instead.
+func (*ListEpochsRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{15}
+}
+
+// Epoch describes an epoch of the mission control data. At the end of each
+// epoch the aggregated data is archived and aggregation starts afresh.
+type Epoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of the epoch, starting at zero.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The unix time the epoch started at.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The unix time the epoch ended at, zero for the current epoch.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The number of pairs archived at the end of the epoch, zero for the
+	// current epoch.
+	Pairs uint64 `protobuf:"varint,4,opt,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *Epoch) Reset() {
+	*x = Epoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Epoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Epoch) ProtoMessage() {}
+
+func (x *Epoch) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Epoch.ProtoReflect.Descriptor instead.
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Epoch) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Epoch) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Epoch) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Epoch) GetPairs() uint64 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+// ListEpochsResponse is the response message for listing the epochs of the
+// mission control data.
+type ListEpochsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The archived epochs, oldest first. Archives exceeding the retention of
+	// the coordinator are removed.
+	Archived []*Epoch `protobuf:"bytes,1,rep,name=archived,proto3" json:"archived,omitempty"`
+	// The current epoch.
+	Current *Epoch `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *ListEpochsResponse) Reset() {
+	*x = ListEpochsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEpochsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpochsResponse) ProtoMessage() {}
+
+func (x *ListEpochsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpochsResponse.ProtoReflect.Descriptor instead.
+func (*ListEpochsResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEpochsResponse) GetArchived() []*Epoch {
+	if x != nil {
+		return x.Archived
+	}
+	return nil
+}
+
+func (x *ListEpochsResponse) GetCurrent() *Epoch {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+// QueryEpochHistoryRequest is the request message for querying the mission
+// control data of an archived epoch.
+type QueryEpochHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of the archived epoch.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *QueryEpochHistoryRequest) Reset() {
+	*x = QueryEpochHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEpochHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEpochHistoryRequest) ProtoMessage() {}
+
+func (x *QueryEpochHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEpochHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryEpochHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryEpochHistoryRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// QueryPrivateMissionControlRequest is the request message for querying the
+// private mission control data of the owner of the access token.
+type QueryPrivateMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryPrivateMissionControlRequest) Reset() {
+	*x = QueryPrivateMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPrivateMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPrivateMissionControlRequest) ProtoMessage() {}
+
+func (x *QueryPrivateMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPrivateMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*QueryPrivateMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{19}
+}
+
+// QueryAggregatedMissionControlRequest is the request message for querying
+// aggregated mission control data.
+type QueryAggregatedMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional name of a node group. If set, only pairs where either the
+	// source or the destination node belongs to the group are returned.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// Optional node pair. If both nodes are set, only the history of the
+	// pair in both directions, from node_a to node_b and from node_b to
+	// node_a, is returned in a single response. This avoids one round trip
+	// per direction when looking up a channel.
+	NodeA []byte `protobuf:"bytes,2,opt,name=node_a,json=nodeA,proto3" json:"node_a,omitempty"`
+	// The second node of the optional node pair.
+	NodeB []byte `protobuf:"bytes,3,opt,name=node_b,json=nodeB,proto3" json:"node_b,omitempty"`
+	// Optional public key of the querying node. If set, the results are
+	// ranked by their graph distance from this node, so that the most route
+	// relevant pairs are returned first. The distance of a pair is the number
+	// of hops from this node to the source node of the pair in the graph
+	// formed by all known pairs. Pairs not connected to this node are
+	// returned last. This is ignored for node pair queries.
+	SourceNode []byte `protobuf:"bytes,4,opt,name=source_node,json=sourceNode,proto3" json:"source_node,omitempty"`
+	// Optional maximum graph distance from the source node. If set together
+	// with the source node, pairs further away are not returned.
+	MaxDistance uint32 `protobuf:"varint,5,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	// Optional sample size. If set, a uniform random sample of at most this
+	// many pairs is returned instead of all pairs, which allows estimating
+	// network statistics without downloading the entire dataset. This is
+	// ignored for node pair queries and cannot be combined with a source
+	// node.
+	SampleSize uint32 `protobuf:"varint,6,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	// Optional order of the results. Pairs are ordered by the public keys of
+	// their nodes by default. Pairs ordering equal keep this order. This is
+	// ignored for node pair queries and cannot be combined with a source
+	// node, whose results are ordered by graph distance.
+	SortOrder SortOrder `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3,enum=ecrpc.SortOrder" json:"sort_order,omitempty"`
+	// Optional unix timestamp. If set, only pairs whose last success or
+	// failure happened at or after this time are returned, which allows
+	// fetching recent updates without downloading the entire dataset. This
+	// is ignored for node pair queries and cannot be combined with a source
+	// node or a sample size.
+	UpdatedSince int64 `protobuf:"varint,8,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	// Optional maximum age in seconds of the pairs returned, i.e. of their
+	// last success or failure. Defaults to the query threshold of the
+	// coordinator. Pairs older than its history threshold are never
+	// returned.
+	MaxAgeSeconds int64 `protobuf:"varint,9,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// Optional format of the results. The pairs are returned as mission
+	// control data by default.
+	Format QueryFormat `protobuf:"varint,10,opt,name=format,proto3,enum=ecrpc.QueryFormat" json:"format,omitempty"`
+	// Optional past revision of the dataset to answer the query from, to
+	// know exactly which data a routing decision was based on. Zero queries
+	// the current dataset. Past revisions are kept within the revision log
+	// retention of the coordinator, see the oldest_revision of the dataset.
+	// It cannot be combined with node pair, ranked, sampled, sorted,
+	// updated_since or updated_until queries.
+	AsOfRevision uint64 `protobuf:"varint,11,opt,name=as_of_revision,json=asOfRevision,proto3" json:"as_of_revision,omitempty"`
+	// Optional minimum amount in millisatoshis of the payments the results
+	// are used for. If set, only pairs relevant to such payments are
+	// returned: pairs with a failure, and pairs which forwarded at least this
+	// amount successfully. This trims the noise of small successes for
+	// clients only routing large payments.
+	MinAmtMsat int64 `protobuf:"varint,12,opt,name=min_amt_msat,json=minAmtMsat,proto3" json:"min_amt_msat,omitempty"`
+	// Optional public key of a source node. If set, only pairs from this
+	// node are returned, e.g. the pairs of the peers of a routing node.
+	// Unlike a full query, only the pairs of the node are read.
+	NodeFrom []byte `protobuf:"bytes,13,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// Optional public key of a destination node. If set, only pairs to this
+	// node are returned. Together with node_from, only the single pair from
+	// node_from to node_to is returned.
+	NodeTo []byte `protobuf:"bytes,14,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// Optional unix timestamp. If set, only pairs whose last success or
+	// failure happened at or before this time are returned. Together with
+	// updated_since it limits the results to the pairs last updated within
+	// a time range. Like updated_since, it is ignored for node pair queries
+	// and cannot be combined with a source node or a sample size.
+	UpdatedUntil int64 `protobuf:"varint,15,opt,name=updated_until,json=updatedUntil,proto3" json:"updated_until,omitempty"`
+	// Optional number of pairs per response of the stream. Defaults to the
+	// query batch size of the coordinator and is capped by its maximum
+	// query batch size. Smaller batches bound the memory needed to decode
+	// a response, larger ones save per message overhead.
+	BatchSize uint32 `protobuf:"varint,16,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *QueryAggregatedMissionControlRequest) Reset() {
+	*x = QueryAggregatedMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAggregatedMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAggregatedMissionControlRequest) ProtoMessage() {}
+
+func (x *QueryAggregatedMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAggregatedMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*QueryAggregatedMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetNodeA() []byte {
+	if x != nil {
+		return x.NodeA
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetNodeB() []byte {
+	if x != nil {
+		return x.NodeB
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetSourceNode() []byte {
+	if x != nil {
+		return x.SourceNode
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetMaxDistance() uint32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetSampleSize() uint32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_NODE_PUBKEY
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetUpdatedSince() int64 {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetFormat() QueryFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QueryFormat_QUERY_FORMAT_PAIRS
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetAsOfRevision() uint64 {
+	if x != nil {
+		return x.AsOfRevision
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetMinAmtMsat() int64 {
+	if x != nil {
+		return x.MinAmtMsat
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetNodeFrom() []byte {
+	if x != nil {
+		return x.NodeFrom
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetNodeTo() []byte {
+	if x != nil {
+		return x.NodeTo
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetUpdatedUntil() int64 {
+	if x != nil {
+		return x.UpdatedUntil
+	}
+	return 0
+}
+
+func (x *QueryAggregatedMissionControlRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// PollMissionControlRequest is the request message for waiting for changes of
+// the mission control data.
+type PollMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The revision of the dataset the client last received, see the
+	// dataset of the responses. Zero requests the entire dataset.
+	AfterRevision uint64 `protobuf:"varint,1,opt,name=after_revision,json=afterRevision,proto3" json:"after_revision,omitempty"`
+	// The maximum number of seconds to wait for the dataset to change.
+	// Defaults to and is capped by the maximum poll timeout of the
+	// coordinator.
+	TimeoutSeconds uint32 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *PollMissionControlRequest) Reset() {
+	*x = PollMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollMissionControlRequest) ProtoMessage() {}
+
+func (x *PollMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*PollMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PollMissionControlRequest) GetAfterRevision() uint64 {
+	if x != nil {
+		return x.AfterRevision
+	}
+	return 0
+}
+
+func (x *PollMissionControlRequest) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// SubscribeMissionControlRequest is the request message for subscribing to the
+// changes of the mission control data.
+type SubscribeMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The revision of the dataset the client last received, see the
+	// dataset of the responses. Zero requests the entire dataset first.
+	AfterRevision uint64 `protobuf:"varint,1,opt,name=after_revision,json=afterRevision,proto3" json:"after_revision,omitempty"`
+}
+
+func (x *SubscribeMissionControlRequest) Reset() {
+	*x = SubscribeMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMissionControlRequest) ProtoMessage() {}
+
+func (x *SubscribeMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SubscribeMissionControlRequest) GetAfterRevision() uint64 {
+	if x != nil {
+		return x.AfterRevision
+	}
+	return 0
+}
+
+// PollMissionControlResponse is the response message holding the changes of
+// the mission control data. It is also sent for every update of a
+// subscription, whose after_revision is the revision of the previous update.
+type PollMissionControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current dataset. Its revision equals after_revision if the
+	// dataset did not change before the timeout elapsed, in which case no
+	// pairs are returned.
+	Dataset *DatasetInfo `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// The pairs stored or updated since after_revision.
+	Pairs []*PairHistory `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// The pairs removed since after_revision.
+	RemovedPairs []*NodePair `protobuf:"bytes,3,rep,name=removed_pairs,json=removedPairs,proto3" json:"removed_pairs,omitempty"`
+	// Whether the pairs are the entire dataset instead of the changes since
+	// after_revision, because the revision log of the coordinator no longer
+	// covers that revision. Clients replace their data with the pairs then.
+	Full bool `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (x *PollMissionControlResponse) Reset() {
+	*x = PollMissionControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollMissionControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollMissionControlResponse) ProtoMessage() {}
+
+func (x *PollMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*PollMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PollMissionControlResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *PollMissionControlResponse) GetPairs() []*PairHistory {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *PollMissionControlResponse) GetRemovedPairs() []*NodePair {
+	if x != nil {
+		return x.RemovedPairs
+	}
+	return nil
+}
+
+func (x *PollMissionControlResponse) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+// QueryMissionControlDeltaRequest is the request message for querying the
+// pairs modified since the last sync of the client.
+type QueryMissionControlDeltaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The watermark of the last sync, i.e. the unix timestamp in seconds
+	// returned by its response. Zero requests the entire dataset.
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *QueryMissionControlDeltaRequest) Reset() {
+	*x = QueryMissionControlDeltaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMissionControlDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMissionControlDeltaRequest) ProtoMessage() {}
+
+func (x *QueryMissionControlDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMissionControlDeltaRequest.ProtoReflect.Descriptor instead.
+func (*QueryMissionControlDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryMissionControlDeltaRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+// QueryMissionControlDeltaResponse is the response message holding the pairs
+// modified since the last sync of the client.
+type QueryMissionControlDeltaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current dataset.
+	Dataset *DatasetInfo `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// The pairs stored or updated since the watermark.
+	Pairs []*PairHistory `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// The pairs removed since the watermark.
+	RemovedPairs []*NodePair `protobuf:"bytes,3,rep,name=removed_pairs,json=removedPairs,proto3" json:"removed_pairs,omitempty"`
+	// Whether the pairs are the entire dataset instead of the changes since
+	// the watermark, because the coordinator no longer tracks the changes
+	// that far back. Clients replace their data with the pairs then.
+	Full bool `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`
+	// The watermark to pass as since with the next sync. It trails the time
+	// of the query slightly, so the next delta may repeat a few pairs.
+	Watermark int64 `protobuf:"varint,5,opt,name=watermark,proto3" json:"watermark,omitempty"`
+}
+
+func (x *QueryMissionControlDeltaResponse) Reset() {
+	*x = QueryMissionControlDeltaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMissionControlDeltaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMissionControlDeltaResponse) ProtoMessage() {}
+
+func (x *QueryMissionControlDeltaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMissionControlDeltaResponse.ProtoReflect.Descriptor instead.
+func (*QueryMissionControlDeltaResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryMissionControlDeltaResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *QueryMissionControlDeltaResponse) GetPairs() []*PairHistory {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *QueryMissionControlDeltaResponse) GetRemovedPairs() []*NodePair {
+	if x != nil {
+		return x.RemovedPairs
+	}
+	return nil
+}
+
+func (x *QueryMissionControlDeltaResponse) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *QueryMissionControlDeltaResponse) GetWatermark() int64 {
+	if x != nil {
+		return x.Watermark
+	}
+	return 0
+}
+
+// SyncMissionControlRequest is the request message for synchronizing a client
+// with the coordinator. A synchronization is uploaded as a stream of requests.
+type SyncMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pairs to register. A synchronization may upload no pairs at all.
+	Pairs []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// The network the pairs were observed on, see the network of
+	// RegisterMissionControlRequest. All requests of a stream must share
+	// the same network.
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// Whether the pairs are private, see the private flag of
+	// RegisterMissionControlRequest. All requests of a stream must share
+	// the same private flag.
+	Private bool `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	// The revision of the dataset the client last received, see the
+	// dataset of the changes. Zero requests the entire dataset. Only read
+	// from the first request of a stream.
+	AfterRevision uint64 `protobuf:"varint,4,opt,name=after_revision,json=afterRevision,proto3" json:"after_revision,omitempty"`
+}
+
+func (x *SyncMissionControlRequest) Reset() {
+	*x = SyncMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMissionControlRequest) ProtoMessage() {}
+
+func (x *SyncMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*SyncMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SyncMissionControlRequest) GetPairs() []*PairHistory {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *SyncMissionControlRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SyncMissionControlRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *SyncMissionControlRequest) GetAfterRevision() uint64 {
+	if x != nil {
+		return x.AfterRevision
+	}
+	return 0
+}
+
+// SyncMissionControlResponse is the response message of a synchronization.
+// Exactly one of its fields is set.
+type SyncMissionControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pairs changed since after_revision, sent once right after the
+	// first request of the stream was received.
+	Changes *PollMissionControlResponse `protobuf:"bytes,1,opt,name=changes,proto3" json:"changes,omitempty"`
+	// The result of registering the uploaded pairs, sent as the last
+	// response once the client finished the upload.
+	Registration *RegisterMissionControlResponse `protobuf:"bytes,2,opt,name=registration,proto3" json:"registration,omitempty"`
+}
+
+func (x *SyncMissionControlResponse) Reset() {
+	*x = SyncMissionControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMissionControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMissionControlResponse) ProtoMessage() {}
+
+func (x *SyncMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*SyncMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SyncMissionControlResponse) GetChanges() *PollMissionControlResponse {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncMissionControlResponse) GetRegistration() *RegisterMissionControlResponse {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+// QueryTopPairsRequest is the request message for querying the pairs with the
+// highest recent success amounts.
+type QueryTopPairsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of pairs to return. Defaults to 100 and is capped
+	// at 1000.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional public key of a node. If set, only pairs from or to this
+	// node are returned.
+	Node []byte `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// Optional maximum age in seconds of the last success of the pairs
+	// returned. Defaults to the query threshold of the coordinator.
+	MaxAgeSeconds int64 `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (x *QueryTopPairsRequest) Reset() {
+	*x = QueryTopPairsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTopPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTopPairsRequest) ProtoMessage() {}
+
+func (x *QueryTopPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTopPairsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTopPairsRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryTopPairsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryTopPairsRequest) GetNode() []byte {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *QueryTopPairsRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+// QueryTopPairsResponse is the response message holding the pairs with the
+// highest recent success amounts.
+type QueryTopPairsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current dataset.
+	Dataset *DatasetInfo `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// The pairs ordered by the amount of their last success, the highest
+	// amounts first. Pairs with the same amount are ordered by the time of
+	// their last success, the most recent first.
+	Pairs []*PairHistory `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *QueryTopPairsResponse) Reset() {
+	*x = QueryTopPairsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTopPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTopPairsResponse) ProtoMessage() {}
+
+func (x *QueryTopPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTopPairsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTopPairsResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryTopPairsResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *QueryTopPairsResponse) GetPairs() []*PairHistory {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// QueryPairRequest is the request message for querying the history of a
+// single pair.
+type QueryPairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex encoded public key of the source node of the pair.
+	NodeFrom string `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// The hex encoded public key of the destination node of the pair.
+	NodeTo string `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// Optional maximum age in seconds of the last success or failure of the
+	// pair. Defaults to the query threshold of the coordinator.
+	MaxAgeSeconds int64 `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (x *QueryPairRequest) Reset() {
+	*x = QueryPairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPairRequest) ProtoMessage() {}
+
+func (x *QueryPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPairRequest.ProtoReflect.Descriptor instead.
+func (*QueryPairRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryPairRequest) GetNodeFrom() string {
+	if x != nil {
+		return x.NodeFrom
+	}
+	return ""
+}
+
+func (x *QueryPairRequest) GetNodeTo() string {
+	if x != nil {
+		return x.NodeTo
+	}
+	return ""
+}
+
+func (x *QueryPairRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+// QueryPairResponse is the response message holding the history of a single
+// pair.
+type QueryPairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current dataset.
+	Dataset *DatasetInfo `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// The pair.
+	Pair *PairHistory `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (x *QueryPairResponse) Reset() {
+	*x = QueryPairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPairResponse) ProtoMessage() {}
+
+func (x *QueryPairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPairResponse.ProtoReflect.Descriptor instead.
+func (*QueryPairResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryPairResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *QueryPairResponse) GetPair() *PairHistory {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+// QueryNodeReputationRequest is the request message for querying the
+// reliability metrics of a node.
+type QueryNodeReputationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The public key of the node.
+	Node []byte `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Optional maximum age in seconds of the last success or failure of the
+	// pairs aggregated. Defaults to the query threshold of the coordinator.
+	MaxAgeSeconds int64 `protobuf:"varint,2,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (x *QueryNodeReputationRequest) Reset() {
+	*x = QueryNodeReputationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNodeReputationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNodeReputationRequest) ProtoMessage() {}
+
+func (x *QueryNodeReputationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryNodeReputationRequest.ProtoReflect.Descriptor instead.
+func (*QueryNodeReputationRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryNodeReputationRequest) GetNode() []byte {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *QueryNodeReputationRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+// QueryNodeReputationResponse is the response message holding the reliability
+// metrics of a node, aggregated over the pairs to the node.
+type QueryNodeReputationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of pairs to the node.
+	Pairs uint64 `protobuf:"varint,1,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	// The number of pairs to the node whose most recent result is a
+	// failure.
+	FailedPairs uint64 `protobuf:"varint,2,opt,name=failed_pairs,json=failedPairs,proto3" json:"failed_pairs,omitempty"`
+	// The fraction of the pairs to the node whose most recent result is a
+	// success, zero if there are no pairs.
+	SuccessRatio float64 `protobuf:"fixed64,3,opt,name=success_ratio,json=successRatio,proto3" json:"success_ratio,omitempty"`
+	// The average amount in millisatoshis of the last success of the pairs
+	// with a success, zero if there are none.
+	AvgSuccessAmtMsat int64 `protobuf:"varint,4,opt,name=avg_success_amt_msat,json=avgSuccessAmtMsat,proto3" json:"avg_success_amt_msat,omitempty"`
+	// The unix time of the most recent success of any pair, zero if there
+	// is none.
+	LastSuccessTime int64 `protobuf:"varint,5,opt,name=last_success_time,json=lastSuccessTime,proto3" json:"last_success_time,omitempty"`
+	// The unix time of the most recent failure of any pair, zero if there
+	// is none.
+	LastFailTime int64 `protobuf:"varint,6,opt,name=last_fail_time,json=lastFailTime,proto3" json:"last_fail_time,omitempty"`
+}
+
+func (x *QueryNodeReputationResponse) Reset() {
+	*x = QueryNodeReputationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNodeReputationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNodeReputationResponse) ProtoMessage() {}
+
+func (x *QueryNodeReputationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryNodeReputationResponse.ProtoReflect.Descriptor instead.
+func (*QueryNodeReputationResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryNodeReputationResponse) GetPairs() uint64 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+func (x *QueryNodeReputationResponse) GetFailedPairs() uint64 {
+	if x != nil {
+		return x.FailedPairs
+	}
+	return 0
+}
+
+func (x *QueryNodeReputationResponse) GetSuccessRatio() float64 {
+	if x != nil {
+		return x.SuccessRatio
+	}
+	return 0
+}
+
+func (x *QueryNodeReputationResponse) GetAvgSuccessAmtMsat() int64 {
+	if x != nil {
+		return x.AvgSuccessAmtMsat
+	}
+	return 0
+}
+
+func (x *QueryNodeReputationResponse) GetLastSuccessTime() int64 {
+	if x != nil {
+		return x.LastSuccessTime
+	}
+	return 0
+}
+
+func (x *QueryNodeReputationResponse) GetLastFailTime() int64 {
+	if x != nil {
+		return x.LastFailTime
+	}
+	return 0
+}
+
+// ExportMissionControlRequest is the request message for exporting the mission
+// control data for LND.
+type ExportMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The force flag of the export, which tells LND to override the
+	// history it has of the pairs instead of only importing newer results.
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *ExportMissionControlRequest) Reset() {
+	*x = ExportMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMissionControlRequest) ProtoMessage() {}
+
+func (x *ExportMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*ExportMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportMissionControlRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// ExportMissionControlResponse is the mission control data in the shape of the
+// XImportMissionControlRequest of the router service of LND, with the same
+// field numbers, names and types.
+type ExportMissionControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pairs to import.
+	Pairs []*LNDPairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// The force flag of the request.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *ExportMissionControlResponse) Reset() {
+	*x = ExportMissionControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMissionControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMissionControlResponse) ProtoMessage() {}
+
+func (x *ExportMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*ExportMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportMissionControlResponse) GetPairs() []*LNDPairHistory {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *ExportMissionControlResponse) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// LNDPairHistory is the history of a pair in the shape of the PairHistory of
+// the router service of LND.
+type LNDPairHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source node pubkey of the pair.
+	NodeFrom []byte `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// The destination node pubkey of the pair.
+	NodeTo []byte `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// History data for the pair.
+	History *LNDPairData `protobuf:"bytes,7,opt,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *LNDPairHistory) Reset() {
+	*x = LNDPairHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNDPairHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNDPairHistory) ProtoMessage() {}
+
+func (x *LNDPairHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNDPairHistory.ProtoReflect.Descriptor instead.
+func (*LNDPairHistory) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LNDPairHistory) GetNodeFrom() []byte {
+	if x != nil {
+		return x.NodeFrom
+	}
+	return nil
+}
+
+func (x *LNDPairHistory) GetNodeTo() []byte {
+	if x != nil {
+		return x.NodeTo
+	}
+	return nil
+}
+
+func (x *LNDPairHistory) GetHistory() *LNDPairData {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// LNDPairData is the history data of a pair in the shape of the PairData of the
+// router service of LND.
+type LNDPairData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time of last failure.
+	FailTime int64 `protobuf:"varint,1,opt,name=fail_time,json=failTime,proto3" json:"fail_time,omitempty"`
+	// Lowest amount that failed to forward rounded to whole sats.
+	FailAmtSat int64 `protobuf:"varint,2,opt,name=fail_amt_sat,json=failAmtSat,proto3" json:"fail_amt_sat,omitempty"`
+	// Lowest amount that failed to forward in millisats.
+	FailAmtMsat int64 `protobuf:"varint,4,opt,name=fail_amt_msat,json=failAmtMsat,proto3" json:"fail_amt_msat,omitempty"`
+	// Time of last success.
+	SuccessTime int64 `protobuf:"varint,5,opt,name=success_time,json=successTime,proto3" json:"success_time,omitempty"`
+	// Highest amount that we could successfully forward rounded to whole
+	// sats.
+	SuccessAmtSat int64 `protobuf:"varint,6,opt,name=success_amt_sat,json=successAmtSat,proto3" json:"success_amt_sat,omitempty"`
+	// Highest amount that we could successfully forward in millisats.
+	SuccessAmtMsat int64 `protobuf:"varint,7,opt,name=success_amt_msat,json=successAmtMsat,proto3" json:"success_amt_msat,omitempty"`
+}
+
+func (x *LNDPairData) Reset() {
+	*x = LNDPairData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNDPairData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNDPairData) ProtoMessage() {}
+
+func (x *LNDPairData) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNDPairData.ProtoReflect.Descriptor instead.
+func (*LNDPairData) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LNDPairData) GetFailTime() int64 {
+	if x != nil {
+		return x.FailTime
+	}
+	return 0
+}
+
+func (x *LNDPairData) GetFailAmtSat() int64 {
+	if x != nil {
+		return x.FailAmtSat
+	}
+	return 0
+}
+
+func (x *LNDPairData) GetFailAmtMsat() int64 {
+	if x != nil {
+		return x.FailAmtMsat
+	}
+	return 0
+}
+
+func (x *LNDPairData) GetSuccessTime() int64 {
+	if x != nil {
+		return x.SuccessTime
+	}
+	return 0
+}
+
+func (x *LNDPairData) GetSuccessAmtSat() int64 {
+	if x != nil {
+		return x.SuccessAmtSat
+	}
+	return 0
+}
+
+func (x *LNDPairData) GetSuccessAmtMsat() int64 {
+	if x != nil {
+		return x.SuccessAmtMsat
+	}
+	return 0
+}
+
+// ImportMissionControlRequest is the request message for registering the
+// mission control data of an LND node as printed by `lncli querymc`.
+type ImportMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The JSON output of `lncli querymc` as is, with the node pubkeys hex
+	// encoded.
+	QuerymcOutput string `protobuf:"bytes,1,opt,name=querymc_output,json=querymcOutput,proto3" json:"querymc_output,omitempty"`
+	// The network the data was observed on, like the network of
+	// RegisterMissionControlRequest.
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// Whether the pairs are private, like the pairs of
+	// RegisterMissionControlRequest.
+	Private bool `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *ImportMissionControlRequest) Reset() {
+	*x = ImportMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMissionControlRequest) ProtoMessage() {}
+
+func (x *ImportMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*ImportMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportMissionControlRequest) GetQuerymcOutput() string {
+	if x != nil {
+		return x.QuerymcOutput
+	}
+	return ""
+}
+
+func (x *ImportMissionControlRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ImportMissionControlRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+// NodePair identifies a directed pair of nodes.
+type NodePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source node pubkey of the pair.
+	NodeFrom []byte `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// The destination node pubkey of the pair.
+	NodeTo []byte `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+}
+
+func (x *NodePair) Reset() {
+	*x = NodePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePair) ProtoMessage() {}
+
+func (x *NodePair) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePair.ProtoReflect.Descriptor instead.
+func (*NodePair) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NodePair) GetNodeFrom() []byte {
+	if x != nil {
+		return x.NodeFrom
+	}
+	return nil
+}
+
+func (x *NodePair) GetNodeTo() []byte {
+	if x != nil {
+		return x.NodeTo
+	}
+	return nil
+}
+
+// QueryAggregatedMissionControlResponse is the response message for querying
+// aggregated mission control data.
+//
+// NOTE: This is the same message that is found in LND.
+type QueryAggregatedMissionControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// Information about the queried dataset. It is only set on the single
+	// response sent for a query without any results, so that clients can
+	// tell an empty result from a failed stream.
+	Dataset *DatasetInfo `protobuf:"bytes,2,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// The liquidity bounds of the channels of the pairs if the query
+	// requested the QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS format, in which case
+	// no pairs are returned.
+	LiquidityBounds []*LiquidityBounds `protobuf:"bytes,3,rep,name=liquidity_bounds,json=liquidityBounds,proto3" json:"liquidity_bounds,omitempty"`
+}
+
+func (x *QueryAggregatedMissionControlResponse) Reset() {
+	*x = QueryAggregatedMissionControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAggregatedMissionControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAggregatedMissionControlResponse) ProtoMessage() {}
+
+func (x *QueryAggregatedMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAggregatedMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*QueryAggregatedMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueryAggregatedMissionControlResponse) GetPairs() []*PairHistory {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *QueryAggregatedMissionControlResponse) GetLiquidityBounds() []*LiquidityBounds {
+	if x != nil {
+		return x.LiquidityBounds
+	}
+	return nil
+}
+
+// LiquidityBounds are the bounds of the liquidity available in a direction of
+// a channel, as tracked by the ProbabilisticScorer of LDK. Unlike LDK, which
+// tracks them as offsets from zero and the capacity of the channel, the
+// bounds are absolute amounts, since the coordinator does not know the
+// capacities of the channels.
+type LiquidityBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The short channel id of the channel, zero if the channel graph of the
+	// coordinator does not know a channel between the nodes. Parallel
+	// channels share the bounds of their pair.
+	ShortChannelId uint64 `protobuf:"varint,1,opt,name=short_channel_id,json=shortChannelId,proto3" json:"short_channel_id,omitempty"`
+	// The pubkey of the node the liquidity is available to.
+	SourceNode []byte `protobuf:"bytes,2,opt,name=source_node,json=sourceNode,proto3" json:"source_node,omitempty"`
+	// The pubkey of the node the liquidity can be sent to.
+	TargetNode []byte `protobuf:"bytes,3,opt,name=target_node,json=targetNode,proto3" json:"target_node,omitempty"`
+	// The lower bound of the liquidity in millisats, the highest amount
+	// forwarded successfully.
+	MinLiquidityMsat uint64 `protobuf:"varint,4,opt,name=min_liquidity_msat,json=minLiquidityMsat,proto3" json:"min_liquidity_msat,omitempty"`
+	// The upper bound of the liquidity in millisats, the lowest amount that
+	// failed to forward. Zero if no failure bounds the liquidity, in which
+	// case it is bounded by the capacity of the channel.
+	MaxLiquidityMsat uint64 `protobuf:"varint,5,opt,name=max_liquidity_msat,json=maxLiquidityMsat,proto3" json:"max_liquidity_msat,omitempty"`
+	// The unix time the bounds were last updated at, which LDK decays the
+	// bounds from.
+	LastUpdated int64 `protobuf:"varint,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *LiquidityBounds) Reset() {
+	*x = LiquidityBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityBounds) ProtoMessage() {}
+
+func (x *LiquidityBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityBounds.ProtoReflect.Descriptor instead.
+func (*LiquidityBounds) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LiquidityBounds) GetShortChannelId() uint64 {
+	if x != nil {
+		return x.ShortChannelId
+	}
+	return 0
+}
+
+func (x *LiquidityBounds) GetSourceNode() []byte {
+	if x != nil {
+		return x.SourceNode
+	}
+	return nil
+}
+
+func (x *LiquidityBounds) GetTargetNode() []byte {
+	if x != nil {
+		return x.TargetNode
+	}
+	return nil
+}
+
+func (x *LiquidityBounds) GetMinLiquidityMsat() uint64 {
+	if x != nil {
+		return x.MinLiquidityMsat
+	}
+	return 0
+}
+
+func (x *LiquidityBounds) GetMaxLiquidityMsat() uint64 {
+	if x != nil {
+		return x.MaxLiquidityMsat
+	}
+	return 0
+}
+
+func (x *LiquidityBounds) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+// DatasetInfo describes the dataset a query was answered from.
+type DatasetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The revision of the dataset, increased whenever pairs are stored or
+	// removed. Zero for archived epochs, which never change.
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The total number of pairs in the dataset regardless of the filters of
+	// the query.
+	TotalPairs uint64 `protobuf:"varint,2,opt,name=total_pairs,json=totalPairs,proto3" json:"total_pairs,omitempty"`
+	// The epoch of the dataset.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The oldest revision the dataset can be queried at through
+	// as_of_revision. It equals the current revision if the coordinator
+	// keeps no revision log.
+	OldestRevision uint64 `protobuf:"varint,4,opt,name=oldest_revision,json=oldestRevision,proto3" json:"oldest_revision,omitempty"`
+	// The pathfinding parameters the operator recommends to use with the
+	// dataset. It is unset if the operator recommends none, and for
+	// archived epochs and past revisions.
+	PathfindingParameters *PathfindingParameters `protobuf:"bytes,5,opt,name=pathfinding_parameters,json=pathfindingParameters,proto3" json:"pathfinding_parameters,omitempty"`
+}
+
+func (x *DatasetInfo) Reset() {
+	*x = DatasetInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatasetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasetInfo) ProtoMessage() {}
+
+func (x *DatasetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasetInfo.ProtoReflect.Descriptor instead.
+func (*DatasetInfo) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DatasetInfo) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DatasetInfo) GetTotalPairs() uint64 {
+	if x != nil {
+		return x.TotalPairs
+	}
+	return 0
+}
+
+func (x *DatasetInfo) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *DatasetInfo) GetOldestRevision() uint64 {
+	if x != nil {
+		return x.OldestRevision
+	}
+	return 0
+}
+
+func (x *DatasetInfo) GetPathfindingParameters() *PathfindingParameters {
+	if x != nil {
+		return x.PathfindingParameters
+	}
+	return nil
+}
+
+// PathfindingParameters are the apriori parameters of the mission control of
+// LND recommended by the operator of the coordinator, so that a fleet can tune
+// the mission control of all its nodes centrally. They match the
+// AprioriParameters of the SetMissionControlConfig RPC of the router service
+// of LND.
+type PathfindingParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time in seconds after which the penalty of a failed pair is
+	// halved.
+	HalfLifeSeconds uint64 `protobuf:"varint,1,opt,name=half_life_seconds,json=halfLifeSeconds,proto3" json:"half_life_seconds,omitempty"`
+	// The probability of successfully routing through a hop without any
+	// payment history.
+	HopProbability float64 `protobuf:"fixed64,2,opt,name=hop_probability,json=hopProbability,proto3" json:"hop_probability,omitempty"`
+	// The weight of the apriori hop probability against the history of a
+	// node, between 0 and 1.
+	Weight float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// The unix timestamp in seconds at which the operator last set the
+	// parameters.
+	UpdatedAt int64 `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PathfindingParameters) Reset() {
+	*x = PathfindingParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathfindingParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathfindingParameters) ProtoMessage() {}
+
+func (x *PathfindingParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathfindingParameters.ProtoReflect.Descriptor instead.
+func (*PathfindingParameters) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PathfindingParameters) GetHalfLifeSeconds() uint64 {
+	if x != nil {
+		return x.HalfLifeSeconds
+	}
+	return 0
+}
+
+func (x *PathfindingParameters) GetHopProbability() float64 {
+	if x != nil {
+		return x.HopProbability
+	}
+	return 0
+}
+
+func (x *PathfindingParameters) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PathfindingParameters) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// PairHistory contains the mission control state for a particular node pair.
+type PairHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source node pubkey of the pair.
+	NodeFrom []byte `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// The destination node pubkey of the pair.
+	NodeTo []byte `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// History data for the pair.
+	History *PairData `protobuf:"bytes,3,opt,name=history,proto3" json:"history,omitempty"`
+	// Optional short channel id of a channel between the nodes of the pair,
+	// proving that the pair exists. It is verified against the channel graph
+	// of the coordinator if channel proofs are required. This is only read
+	// when registering mission control data and is never returned in query
+	// responses.
+	ShortChannelId uint64 `protobuf:"varint,4,opt,name=short_channel_id,json=shortChannelId,proto3" json:"short_channel_id,omitempty"`
+}
+
+func (x *PairHistory) Reset() {
+	*x = PairHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairHistory) ProtoMessage() {}
+
+func (x *PairHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairHistory.ProtoReflect.Descriptor instead.
+func (*PairHistory) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PairHistory) GetNodeFrom() []byte {
+	if x != nil {
+		return x.NodeFrom
+	}
+	return nil
+}
+
+func (x *PairHistory) GetNodeTo() []byte {
+	if x != nil {
+		return x.NodeTo
+	}
+	return nil
+}
+
+func (x *PairHistory) GetHistory() *PairData {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *PairHistory) GetShortChannelId() uint64 {
+	if x != nil {
+		return x.ShortChannelId
+	}
+	return 0
+}
+
+// PairData contains the detailed history data for a node pair.
+type PairData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time of last failure.
+	FailTime int64 `protobuf:"varint,1,opt,name=fail_time,json=failTime,proto3" json:"fail_time,omitempty"`
+	// Lowest amount that failed to forward rounded to whole sats. This may be
+	// set to zero if the failure is independent of amount.
+	FailAmtSat int64 `protobuf:"varint,2,opt,name=fail_amt_sat,json=failAmtSat,proto3" json:"fail_amt_sat,omitempty"`
+	// Lowest amount that failed to forward in millisats. This may be set to
+	// zero if the failure is independent of amount.
+	FailAmtMsat int64 `protobuf:"varint,3,opt,name=fail_amt_msat,json=failAmtMsat,proto3" json:"fail_amt_msat,omitempty"`
+	// Time of last success.
+	SuccessTime int64 `protobuf:"varint,4,opt,name=success_time,json=successTime,proto3" json:"success_time,omitempty"`
+	// Highest amount that we could successfully forward rounded to whole sats.
+	SuccessAmtSat int64 `protobuf:"varint,5,opt,name=success_amt_sat,json=successAmtSat,proto3" json:"success_amt_sat,omitempty"`
+	// Highest amount that we could successfully forward in millisats.
+	SuccessAmtMsat int64 `protobuf:"varint,6,opt,name=success_amt_msat,json=successAmtMsat,proto3" json:"success_amt_msat,omitempty"`
+	// Observed HTLC resolution latency in milliseconds as reported by the
+	// submitter. This is only read when registering mission control data and
+	// is never returned in query responses.
+	ResolutionLatencyMs uint32 `protobuf:"varint,7,opt,name=resolution_latency_ms,json=resolutionLatencyMs,proto3" json:"resolution_latency_ms,omitempty"`
+	// Median HTLC resolution latency in milliseconds aggregated over the most
+	// recent latency observations of the pair. Set by the coordinator.
+	LatencyP50Ms uint32 `protobuf:"varint,8,opt,name=latency_p50_ms,json=latencyP50Ms,proto3" json:"latency_p50_ms,omitempty"`
+	// 95th percentile HTLC resolution latency in milliseconds aggregated over
+	// the most recent latency observations of the pair. Set by the
+	// coordinator.
+	LatencyP95Ms uint32 `protobuf:"varint,9,opt,name=latency_p95_ms,json=latencyP95Ms,proto3" json:"latency_p95_ms,omitempty"`
+	// Number of consecutive failures recorded for the pair since its last
+	// success. Set by the coordinator.
+	FailureStreak uint32 `protobuf:"varint,10,opt,name=failure_streak,json=failureStreak,proto3" json:"failure_streak,omitempty"`
+	// Number of seconds between the last success and the last failure if the
+	// pair is currently failing, zero otherwise. Set by the coordinator.
+	SuccessGapSeconds int64 `protobuf:"varint,11,opt,name=success_gap_seconds,json=successGapSeconds,proto3" json:"success_gap_seconds,omitempty"`
+	// Number of reports within the conflict window contradicting the result
+	// the pair was resolved to, zero if the pair has no conflicting reports.
+	// Set by the coordinator.
+	ConflictingReports uint32 `protobuf:"varint,12,opt,name=conflicting_reports,json=conflictingReports,proto3" json:"conflicting_reports,omitempty"`
+}
+
+func (x *PairData) Reset() {
+	*x = PairData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairData) ProtoMessage() {}
+
+func (x *PairData) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairData.ProtoReflect.Descriptor instead.
+func (*PairData) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PairData) GetFailTime() int64 {
+	if x != nil {
+		return x.FailTime
+	}
+	return 0
+}
+
+func (x *PairData) GetFailAmtSat() int64 {
+	if x != nil {
+		return x.FailAmtSat
+	}
+	return 0
+}
+
+func (x *PairData) GetFailAmtMsat() int64 {
+	if x != nil {
+		return x.FailAmtMsat
+	}
+	return 0
+}
+
+func (x *PairData) GetSuccessTime() int64 {
+	if x != nil {
+		return x.SuccessTime
+	}
+	return 0
+}
+
+func (x *PairData) GetSuccessAmtSat() int64 {
+	if x != nil {
+		return x.SuccessAmtSat
+	}
+	return 0
+}
+
+func (x *PairData) GetSuccessAmtMsat() int64 {
+	if x != nil {
+		return x.SuccessAmtMsat
+	}
+	return 0
+}
+
+func (x *PairData) GetResolutionLatencyMs() uint32 {
+	if x != nil {
+		return x.ResolutionLatencyMs
+	}
+	return 0
+}
+
+func (x *PairData) GetLatencyP50Ms() uint32 {
+	if x != nil {
+		return x.LatencyP50Ms
+	}
+	return 0
+}
+
+func (x *PairData) GetLatencyP95Ms() uint32 {
+	if x != nil {
+		return x.LatencyP95Ms
+	}
+	return 0
+}
+
+func (x *PairData) GetFailureStreak() uint32 {
+	if x != nil {
+		return x.FailureStreak
+	}
+	return 0
+}
+
+func (x *PairData) GetSuccessGapSeconds() int64 {
+	if x != nil {
+		return x.SuccessGapSeconds
+	}
+	return 0
+}
+
+func (x *PairData) GetConflictingReports() uint32 {
+	if x != nil {
+		return x.ConflictingReports
+	}
+	return 0
+}
+
+// ErrorDetail is attached as a detail to the status of failed requests with a
+// specific reason. The ecerrors Go package turns it into typed errors.
+type ErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason the request failed.
+	Reason ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=ecrpc.ErrorReason" json:"reason,omitempty"`
+	// The index of the invalid pair within the registration. Only set for
+	// ERROR_REASON_INVALID_PAIR.
+	PairIndex uint32 `protobuf:"varint,2,opt,name=pair_index,json=pairIndex,proto3" json:"pair_index,omitempty"`
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ecrpc_external_coordinator_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_ecrpc_external_coordinator_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_ecrpc_external_coordinator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ErrorDetail) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+func (x *ErrorDetail) GetPairIndex() uint32 {
+	if x != nil {
+		return x.PairIndex
+	}
+	return 0
+}
+
+var File_ecrpc_external_coordinator_proto protoreflect.FileDescriptor
+
+var file_ecrpc_external_coordinator_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x05, 0x65, 0x63, 0x72, 0x70, 0x63, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7d, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x4c, 0x4e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x30, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x4c, 0x4e, 0x50, 0x61, 0x79,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0d, 0x43, 0x4c, 0x4e, 0x50, 0x61, 0x79,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x4c, 0x4e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x6f, 0x70, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x58, 0x0a, 0x0b, 0x43, 0x4c,
+	0x4e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x75,
+	0x6e, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x5f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x75, 0x6e,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x70, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x0f, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x79,
+	0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75, 0x73, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x75, 0x73, 0x79, 0x22, 0x10, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x04,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a,
+	0x0a, 0x19, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x17, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x75, 0x70, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x10, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xcc,
+	0x01, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x44, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65,
+	0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x5b, 0x0a,
+	0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a,
+	0x0f, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x7f,
+	0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22,
+	0xd0, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x34, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x09, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65, 0x65, 0x6e, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x69,
+	0x72, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x66, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x30,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x22, 0x23, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbb, 0x04, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x12, 0x15, 0x0a, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x42, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x4f, 0x66, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
+	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d,
+	0x69, 0x6e, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74,
+	0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x12,
+	0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x6b, 0x0a, 0x19, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x47, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x1a, 0x50, 0x6f,
+	0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x34, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x37, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x34, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x53, 0x79, 0x6e,
+	0x63, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x1a,
+	0x53, 0x79, 0x6e, 0x63, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x70, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x6f, 0x0a, 0x15,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x70, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x70, 0x0a,
+	0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x69, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x58, 0x0a, 0x1a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x61, 0x76, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x6d, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x61, 0x0a, 0x1c, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x4e, 0x44, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x8c, 0x01,
+	0x0a, 0x0e, 0x4c, 0x4e, 0x44, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x4e, 0x44, 0x50, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0xeb, 0x01, 0x0a,
+	0x0b, 0x4c, 0x4e, 0x44, 0x50, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6d,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x6d, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x78, 0x0a, 0x1b, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x6d, 0x63, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x6d, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x22, 0x40, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x0f, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xde, 0x01, 0x0a, 0x0b, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a,
+	0x0f, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x16, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x15, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x15,
+	0x50, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6c, 0x69,
+	0x66, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x68, 0x61, 0x6c, 0x66, 0x4c, 0x69, 0x66, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x68, 0x6f, 0x70, 0x50,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xea, 0x03, 0x0a,
+	0x08, 0x50, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69,
+	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x61,
+	0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x61,
+	0x69, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c,
+	0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x70, 0x35, 0x30, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x35, 0x30, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x35, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x35, 0x4d,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x67, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x61,
+	0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x2a, 0x85, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x72, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x49, 0x52, 0x5f,
+	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x49, 0x52,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x0b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x51, 0x55,
+	0x45, 0x52, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x53,
+	0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4c, 0x44, 0x4b, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59,
+	0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x2a, 0x60, 0x0a, 0x09, 0x53, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x4b, 0x45, 0x59,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x46, 0x52, 0x45, 0x53, 0x48, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x88, 0x01, 0x0a, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f,
+	0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x50, 0x41, 0x49, 0x52, 0x10, 0x03, 0x32, 0xa6, 0x12, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x8e,
+	0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x24, 0x2e, 0x65, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01,
+	0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0xaa, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x2b, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x55, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x18, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12,
+	0x90, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d,
+	0x2f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x30, 0x01, 0x12, 0x4e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16,
+	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x28, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x30, 0x01,
+	0x12, 0x83, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x4c, 0x4e,
+	0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x63, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x4c, 0x4e, 0x50, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
+	0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x6e, 0x2f, 0x70, 0x61, 0x79, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x7b, 0x0a, 0x12, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x20, 0x2e, 0x65,
+	0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6f, 0x6c, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x12, 0x94, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x26, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x25, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x30, 0x01, 0x12, 0x9d, 0x01, 0x0a, 0x1c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x2e, 0x65, 0x63, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a,
+	0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x28, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x79,
+	0x6e, 0x63, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x12, 0x20, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a,
+	0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x28, 0x01, 0x30, 0x01, 0x12, 0x61,
+	0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x70, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0x1b, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x70,
+	0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65,
+	0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x70, 0x50, 0x61, 0x69,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x12, 0x70, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x17,
+	0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x7b, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x7d, 0x2f, 0x7b, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x74, 0x6f, 0x7d, 0x12, 0x79, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x65, 0x63, 0x72,
+	0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x83,
+	0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x22, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x22, 0x2e,
+	0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42,
+	0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x69,
+	0x67, 0x67, 0x69, 0x65, 0x31, 0x39, 0x38, 0x34, 0x2f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x64, 0x2d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2d, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x4c, 0x4e, 0x44, 0x2f, 0x65, 0x63, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ecrpc_external_coordinator_proto_rawDescOnce sync.Once
+	file_ecrpc_external_coordinator_proto_rawDescData = file_ecrpc_external_coordinator_proto_rawDesc
+)
+
+func file_ecrpc_external_coordinator_proto_rawDescGZIP() []byte {
+	file_ecrpc_external_coordinator_proto_rawDescOnce.Do(func() {
+		file_ecrpc_external_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(file_ecrpc_external_coordinator_proto_rawDescData)
+	})
+	return file_ecrpc_external_coordinator_proto_rawDescData
+}
+
+var file_ecrpc_external_coordinator_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ecrpc_external_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_ecrpc_external_coordinator_proto_goTypes = []interface{}{
+	(PairOutcome)(0),                              // 0: ecrpc.PairOutcome
+	(QueryFormat)(0),                              // 1: ecrpc.QueryFormat
+	(SortOrder)(0),                                // 2: ecrpc.SortOrder
+	(ErrorReason)(0),                              // 3: ecrpc.ErrorReason
+	(*RegisterMissionControlRequest)(nil),         // 4: ecrpc.RegisterMissionControlRequest
+	(*RegisterCLNPayResultsRequest)(nil),          // 5: ecrpc.RegisterCLNPayResultsRequest
+	(*CLNPayAttempt)(nil),                         // 6: ecrpc.CLNPayAttempt
+	(*CLNRouteHop)(nil),                           // 7: ecrpc.CLNRouteHop
+	(*RegisterMissionControlResponse)(nil),        // 8: ecrpc.RegisterMissionControlResponse
+	(*PairResult)(nil),                            // 9: ecrpc.PairResult
+	(*SubmissionHints)(nil),                       // 10: ecrpc.SubmissionHints
+	(*GetInfoRequest)(nil),                        // 11: ecrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                       // 12: ecrpc.GetInfoResponse
+	(*CapacityForecast)(nil),                      // 13: ecrpc.CapacityForecast
+	(*StartupProgress)(nil),                       // 14: ecrpc.StartupProgress
+	(*GetStatsRequest)(nil),                       // 15: ecrpc.GetStatsRequest
+	(*FreshnessBucket)(nil),                       // 16: ecrpc.FreshnessBucket
+	(*RegionStats)(nil),                           // 17: ecrpc.RegionStats
+	(*GetStatsResponse)(nil),                      // 18: ecrpc.GetStatsResponse
+	(*ListEpochsRequest)(nil),                     // 19: ecrpc.ListEpochsRequest
+	(*Epoch)(nil),                                 // 20: ecrpc.Epoch
+	(*ListEpochsResponse)(nil),                    // 21: ecrpc.ListEpochsResponse
+	(*QueryEpochHistoryRequest)(nil),              // 22: ecrpc.QueryEpochHistoryRequest
+	(*QueryPrivateMissionControlRequest)(nil),     // 23: ecrpc.QueryPrivateMissionControlRequest
+	(*QueryAggregatedMissionControlRequest)(nil),  // 24: ecrpc.QueryAggregatedMissionControlRequest
+	(*PollMissionControlRequest)(nil),             // 25: ecrpc.PollMissionControlRequest
+	(*SubscribeMissionControlRequest)(nil),        // 26: ecrpc.SubscribeMissionControlRequest
+	(*PollMissionControlResponse)(nil),            // 27: ecrpc.PollMissionControlResponse
+	(*QueryMissionControlDeltaRequest)(nil),       // 28: ecrpc.QueryMissionControlDeltaRequest
+	(*QueryMissionControlDeltaResponse)(nil),      // 29: ecrpc.QueryMissionControlDeltaResponse
+	(*SyncMissionControlRequest)(nil),             // 30: ecrpc.SyncMissionControlRequest
+	(*SyncMissionControlResponse)(nil),            // 31: ecrpc.SyncMissionControlResponse
+	(*QueryTopPairsRequest)(nil),                  // 32: ecrpc.QueryTopPairsRequest
+	(*QueryTopPairsResponse)(nil),                 // 33: ecrpc.QueryTopPairsResponse
+	(*QueryPairRequest)(nil),                      // 34: ecrpc.QueryPairRequest
+	(*QueryPairResponse)(nil),                     // 35: ecrpc.QueryPairResponse
+	(*QueryNodeReputationRequest)(nil),            // 36: ecrpc.QueryNodeReputationRequest
+	(*QueryNodeReputationResponse)(nil),           // 37: ecrpc.QueryNodeReputationResponse
+	(*ExportMissionControlRequest)(nil),           // 38: ecrpc.ExportMissionControlRequest
+	(*ExportMissionControlResponse)(nil),          // 39: ecrpc.ExportMissionControlResponse
+	(*LNDPairHistory)(nil),                        // 40: ecrpc.LNDPairHistory
+	(*LNDPairData)(nil),                           // 41: ecrpc.LNDPairData
+	(*ImportMissionControlRequest)(nil),           // 42: ecrpc.ImportMissionControlRequest
+	(*NodePair)(nil),                              // 43: ecrpc.NodePair
+	(*QueryAggregatedMissionControlResponse)(nil), // 44: ecrpc.QueryAggregatedMissionControlResponse
+	(*LiquidityBounds)(nil),                       // 45: ecrpc.LiquidityBounds
+	(*DatasetInfo)(nil),                           // 46: ecrpc.DatasetInfo
+	(*PathfindingParameters)(nil),                 // 47: ecrpc.PathfindingParameters
+	(*PairHistory)(nil),                           // 48: ecrpc.PairHistory
+	(*PairData)(nil),                              // 49: ecrpc.PairData
+	(*ErrorDetail)(nil),                           // 50: ecrpc.ErrorDetail
+}
+var file_ecrpc_external_coordinator_proto_depIdxs = []int32{
+	48, // 0: ecrpc.RegisterMissionControlRequest.pairs:type_name -> ecrpc.PairHistory
+	6,  // 1: ecrpc.RegisterCLNPayResultsRequest.attempts:type_name -> ecrpc.CLNPayAttempt
+	7,  // 2: ecrpc.CLNPayAttempt.route:type_name -> ecrpc.CLNRouteHop
+	10, // 3: ecrpc.RegisterMissionControlResponse.hints:type_name -> ecrpc.SubmissionHints
+	9,  // 4: ecrpc.RegisterMissionControlResponse.pair_results:type_name -> ecrpc.PairResult
+	0,  // 5: ecrpc.PairResult.outcome:type_name -> ecrpc.PairOutcome
+	10, // 6: ecrpc.GetInfoResponse.submission_hints:type_name -> ecrpc.SubmissionHints
+	14, // 7: ecrpc.GetInfoResponse.startup:type_name -> ecrpc.StartupProgress
+	13, // 8: ecrpc.GetInfoResponse.capacity_forecast:type_name -> ecrpc.CapacityForecast
+	46, // 9: ecrpc.GetInfoResponse.dataset:type_name -> ecrpc.DatasetInfo
+	16, // 10: ecrpc.GetStatsResponse.freshness:type_name -> ecrpc.FreshnessBucket
+	17, // 11: ecrpc.GetStatsResponse.regions:type_name -> ecrpc.RegionStats
+	20, // 12: ecrpc.ListEpochsResponse.archived:type_name -> ecrpc.Epoch
+	20, // 13: ecrpc.ListEpochsResponse.current:type_name -> ecrpc.Epoch
+	2,  // 14: ecrpc.QueryAggregatedMissionControlRequest.sort_order:type_name -> ecrpc.SortOrder
+	1,  // 15: ecrpc.QueryAggregatedMissionControlRequest.format:type_name -> ecrpc.QueryFormat
+	46, // 16: ecrpc.PollMissionControlResponse.dataset:type_name -> ecrpc.DatasetInfo
+	48, // 17: ecrpc.PollMissionControlResponse.pairs:type_name -> ecrpc.PairHistory
+	43, // 18: ecrpc.PollMissionControlResponse.removed_pairs:type_name -> ecrpc.NodePair
+	46, // 19: ecrpc.QueryMissionControlDeltaResponse.dataset:type_name -> ecrpc.DatasetInfo
+	48, // 20: ecrpc.QueryMissionControlDeltaResponse.pairs:type_name -> ecrpc.PairHistory
+	43, // 21: ecrpc.QueryMissionControlDeltaResponse.removed_pairs:type_name -> ecrpc.NodePair
+	48, // 22: ecrpc.SyncMissionControlRequest.pairs:type_name -> ecrpc.PairHistory
+	27, // 23: ecrpc.SyncMissionControlResponse.changes:type_name -> ecrpc.PollMissionControlResponse
+	8,  // 24: ecrpc.SyncMissionControlResponse.registration:type_name -> ecrpc.RegisterMissionControlResponse
+	46, // 25: ecrpc.QueryTopPairsResponse.dataset:type_name -> ecrpc.DatasetInfo
+	48, // 26: ecrpc.QueryTopPairsResponse.pairs:type_name -> ecrpc.PairHistory
+	46, // 27: ecrpc.QueryPairResponse.dataset:type_name -> ecrpc.DatasetInfo
+	48, // 28: ecrpc.QueryPairResponse.pair:type_name -> ecrpc.PairHistory
+	40, // 29: ecrpc.ExportMissionControlResponse.pairs:type_name -> ecrpc.LNDPairHistory
+	41, // 30: ecrpc.LNDPairHistory.history:type_name -> ecrpc.LNDPairData
+	48, // 31: ecrpc.QueryAggregatedMissionControlResponse.pairs:type_name -> ecrpc.PairHistory
+	46, // 32: ecrpc.QueryAggregatedMissionControlResponse.dataset:type_name -> ecrpc.DatasetInfo
+	45, // 33: ecrpc.QueryAggregatedMissionControlResponse.liquidity_bounds:type_name -> ecrpc.LiquidityBounds
+	47, // 34: ecrpc.DatasetInfo.pathfinding_parameters:type_name -> ecrpc.PathfindingParameters
+	49, // 35: ecrpc.PairHistory.history:type_name -> ecrpc.PairData
+	3,  // 36: ecrpc.ErrorDetail.reason:type_name -> ecrpc.ErrorReason
+	4,  // 37: ecrpc.ExternalCoordinator.RegisterMissionControl:input_type -> ecrpc.RegisterMissionControlRequest
+	24, // 38: ecrpc.ExternalCoordinator.QueryAggregatedMissionControl:input_type -> ecrpc.QueryAggregatedMissionControlRequest
+	11, // 39: ecrpc.ExternalCoordinator.GetInfo:input_type -> ecrpc.GetInfoRequest
+	19, // 40: ecrpc.ExternalCoordinator.ListEpochs:input_type -> ecrpc.ListEpochsRequest
+	22, // 41: ecrpc.ExternalCoordinator.QueryEpochHistory:input_type -> ecrpc.QueryEpochHistoryRequest
+	15, // 42: ecrpc.ExternalCoordinator.GetStats:input_type -> ecrpc.GetStatsRequest
+	23, // 43: ecrpc.ExternalCoordinator.QueryPrivateMissionControl:input_type -> ecrpc.QueryPrivateMissionControlRequest
+	5,  // 44: ecrpc.ExternalCoordinator.RegisterCLNPayResults:input_type -> ecrpc.RegisterCLNPayResultsRequest
+	25, // 45: ecrpc.ExternalCoordinator.PollMissionControl:input_type -> ecrpc.PollMissionControlRequest
+	28, // 46: ecrpc.ExternalCoordinator.QueryMissionControlDelta:input_type -> ecrpc.QueryMissionControlDeltaRequest
+	26, // 47: ecrpc.ExternalCoordinator.SubscribeMissionControl:input_type -> ecrpc.SubscribeMissionControlRequest
+	4,  // 48: ecrpc.ExternalCoordinator.RegisterMissionControlStream:input_type -> ecrpc.RegisterMissionControlRequest
+	30, // 49: ecrpc.ExternalCoordinator.SyncMissionControl:input_type -> ecrpc.SyncMissionControlRequest
+	32, // 50: ecrpc.ExternalCoordinator.QueryTopPairs:input_type -> ecrpc.QueryTopPairsRequest
+	34, // 51: ecrpc.ExternalCoordinator.QueryPair:input_type -> ecrpc.QueryPairRequest
+	36, // 52: ecrpc.ExternalCoordinator.QueryNodeReputation:input_type -> ecrpc.QueryNodeReputationRequest
+	38, // 53: ecrpc.ExternalCoordinator.ExportMissionControl:input_type -> ecrpc.ExportMissionControlRequest
+	42, // 54: ecrpc.ExternalCoordinator.ImportMissionControl:input_type -> ecrpc.ImportMissionControlRequest
+	8,  // 55: ecrpc.ExternalCoordinator.RegisterMissionControl:output_type -> ecrpc.RegisterMissionControlResponse
+	44, // 56: ecrpc.ExternalCoordinator.QueryAggregatedMissionControl:output_type -> ecrpc.QueryAggregatedMissionControlResponse
+	12, // 57: ecrpc.ExternalCoordinator.GetInfo:output_type -> ecrpc.GetInfoResponse
+	21, // 58: ecrpc.ExternalCoordinator.ListEpochs:output_type -> ecrpc.ListEpochsResponse
+	44, // 59: ecrpc.ExternalCoordinator.QueryEpochHistory:output_type -> ecrpc.QueryAggregatedMissionControlResponse
+	18, // 60: ecrpc.ExternalCoordinator.GetStats:output_type -> ecrpc.GetStatsResponse
+	44, // 61: ecrpc.ExternalCoordinator.QueryPrivateMissionControl:output_type -> ecrpc.QueryAggregatedMissionControlResponse
+	8,  // 62: ecrpc.ExternalCoordinator.RegisterCLNPayResults:output_type -> ecrpc.RegisterMissionControlResponse
+	27, // 63: ecrpc.ExternalCoordinator.PollMissionControl:output_type -> ecrpc.PollMissionControlResponse
+	29, // 64: ecrpc.ExternalCoordinator.QueryMissionControlDelta:output_type -> ecrpc.QueryMissionControlDeltaResponse
+	27, // 65: ecrpc.ExternalCoordinator.SubscribeMissionControl:output_type -> ecrpc.PollMissionControlResponse
+	8,  // 66: ecrpc.ExternalCoordinator.RegisterMissionControlStream:output_type -> ecrpc.RegisterMissionControlResponse
+	31, // 67: ecrpc.ExternalCoordinator.SyncMissionControl:output_type -> ecrpc.SyncMissionControlResponse
+	33, // 68: ecrpc.ExternalCoordinator.QueryTopPairs:output_type -> ecrpc.QueryTopPairsResponse
+	35, // 69: ecrpc.ExternalCoordinator.QueryPair:output_type -> ecrpc.QueryPairResponse
+	37, // 70: ecrpc.ExternalCoordinator.QueryNodeReputation:output_type -> ecrpc.QueryNodeReputationResponse
+	39, // 71: ecrpc.ExternalCoordinator.ExportMissionControl:output_type -> ecrpc.ExportMissionControlResponse
+	8,  // 72: ecrpc.ExternalCoordinator.ImportMissionControl:output_type -> ecrpc.RegisterMissionControlResponse
+	55, // [55:73] is the sub-list for method output_type
+	37, // [37:55] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_ecrpc_external_coordinator_proto_init() }
+func file_ecrpc_external_coordinator_proto_init() {
+	if File_ecrpc_external_coordinator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ecrpc_external_coordinator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCLNPayResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLNPayAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLNRouteHop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionHints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapacityForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartupProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreshnessBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEpochsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Epoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEpochsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEpochHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPrivateMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
 			}
 		}
-		file_ecrpc_external_coordinator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_ecrpc_external_coordinator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAggregatedMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMissionControlDeltaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMissionControlDeltaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTopPairsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTopPairsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNodeReputationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNodeReputationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNDPairHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNDPairData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodePair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAggregatedMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityBounds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatasetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathfindingParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ecrpc_external_coordinator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PairHistory); i {
 			case 0:
 				return &v.state
@@ -551,7 +5264,7 @@ func file_ecrpc_external_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_ecrpc_external_coordinator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_ecrpc_external_coordinator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PairData); i {
 			case 0:
 				return &v.state
@@ -563,19 +5276,32 @@ func file_ecrpc_external_coordinator_proto_init() {
 				return nil
 			}
 		}
+		file_ecrpc_external_coordinator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ecrpc_external_coordinator_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      4,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ecrpc_external_coordinator_proto_goTypes,
 		DependencyIndexes: file_ecrpc_external_coordinator_proto_depIdxs,
+		EnumInfos:         file_ecrpc_external_coordinator_proto_enumTypes,
 		MessageInfos:      file_ecrpc_external_coordinator_proto_msgTypes,
 	}.Build()
 	File_ecrpc_external_coordinator_proto = out.File
@@ -21,6 +21,22 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	ExternalCoordinator_RegisterMissionControl_FullMethodName        = "/ecrpc.ExternalCoordinator/RegisterMissionControl"
 	ExternalCoordinator_QueryAggregatedMissionControl_FullMethodName = "/ecrpc.ExternalCoordinator/QueryAggregatedMissionControl"
+	ExternalCoordinator_GetInfo_FullMethodName                       = "/ecrpc.ExternalCoordinator/GetInfo"
+	ExternalCoordinator_ListEpochs_FullMethodName                    = "/ecrpc.ExternalCoordinator/ListEpochs"
+	ExternalCoordinator_QueryEpochHistory_FullMethodName             = "/ecrpc.ExternalCoordinator/QueryEpochHistory"
+	ExternalCoordinator_GetStats_FullMethodName                      = "/ecrpc.ExternalCoordinator/GetStats"
+	ExternalCoordinator_QueryPrivateMissionControl_FullMethodName    = "/ecrpc.ExternalCoordinator/QueryPrivateMissionControl"
+	ExternalCoordinator_RegisterCLNPayResults_FullMethodName         = "/ecrpc.ExternalCoordinator/RegisterCLNPayResults"
+	ExternalCoordinator_PollMissionControl_FullMethodName            = "/ecrpc.ExternalCoordinator/PollMissionControl"
+	ExternalCoordinator_QueryMissionControlDelta_FullMethodName      = "/ecrpc.ExternalCoordinator/QueryMissionControlDelta"
+	ExternalCoordinator_SubscribeMissionControl_FullMethodName       = "/ecrpc.ExternalCoordinator/SubscribeMissionControl"
+	ExternalCoordinator_RegisterMissionControlStream_FullMethodName  = "/ecrpc.ExternalCoordinator/RegisterMissionControlStream"
+	ExternalCoordinator_SyncMissionControl_FullMethodName            = "/ecrpc.ExternalCoordinator/SyncMissionControl"
+	ExternalCoordinator_QueryTopPairs_FullMethodName                 = "/ecrpc.ExternalCoordinator/QueryTopPairs"
+	ExternalCoordinator_QueryPair_FullMethodName                     = "/ecrpc.ExternalCoordinator/QueryPair"
+	ExternalCoordinator_QueryNodeReputation_FullMethodName           = "/ecrpc.ExternalCoordinator/QueryNodeReputation"
+	ExternalCoordinator_ExportMissionControl_FullMethodName          = "/ecrpc.ExternalCoordinator/ExportMissionControl"
+	ExternalCoordinator_ImportMissionControl_FullMethodName          = "/ecrpc.ExternalCoordinator/ImportMissionControl"
 )
 
 // ExternalCoordinatorClient is the client API for ExternalCoordinator service.
@@ -31,6 +47,89 @@ type ExternalCoordinatorClient interface {
 	RegisterMissionControl(ctx context.Context, in *RegisterMissionControlRequest, opts ...grpc.CallOption) (*RegisterMissionControlResponse, error)
 	// QueryAggregatedMissionControl queries aggregated mission control data.
 	QueryAggregatedMissionControl(ctx context.Context, in *QueryAggregatedMissionControlRequest, opts ...grpc.CallOption) (ExternalCoordinator_QueryAggregatedMissionControlClient, error)
+	// GetInfo returns information about the coordinator including its
+	// version, the dataset it serves and hints on how clients should pace
+	// their submissions.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// ListEpochs lists the archived epochs of the mission control data
+	// together with the current one.
+	ListEpochs(ctx context.Context, in *ListEpochsRequest, opts ...grpc.CallOption) (*ListEpochsResponse, error)
+	// QueryEpochHistory queries the mission control data archived at the end
+	// of an epoch.
+	QueryEpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (ExternalCoordinator_QueryEpochHistoryClient, error)
+	// GetStats returns aggregate statistics of the mission control data
+	// without revealing the data of any pair. It is served even if the
+	// coordinator withholds the pairs themselves.
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// QueryPrivateMissionControl queries the private mission control data
+	// registered with access tokens of the same owner as the access token of
+	// the request. Private data is never part of the public aggregate.
+	QueryPrivateMissionControl(ctx context.Context, in *QueryPrivateMissionControlRequest, opts ...grpc.CallOption) (ExternalCoordinator_QueryPrivateMissionControlClient, error)
+	// RegisterCLNPayResults registers the results of payment attempts made
+	// by a Core Lightning node, as derived from its listsendpays and
+	// listpays commands. The attempts are mapped to the mission control
+	// data of the pairs along their routes and registered like by
+	// RegisterMissionControl.
+	RegisterCLNPayResults(ctx context.Context, in *RegisterCLNPayResultsRequest, opts ...grpc.CallOption) (*RegisterMissionControlResponse, error)
+	// PollMissionControl waits until the revision of the dataset exceeds the
+	// revision known to the client or the timeout elapses, and returns the
+	// pairs changed since. It serves clients keeping their data in sync
+	// without holding a stream open.
+	PollMissionControl(ctx context.Context, in *PollMissionControlRequest, opts ...grpc.CallOption) (*PollMissionControlResponse, error)
+	// QueryMissionControlDelta returns the pairs modified since the
+	// watermark of the client's last sync, which turns every periodic sync
+	// into a small diff instead of a full download. It does not depend on
+	// the revision log.
+	QueryMissionControlDelta(ctx context.Context, in *QueryMissionControlDeltaRequest, opts ...grpc.CallOption) (*QueryMissionControlDeltaResponse, error)
+	// SubscribeMissionControl streams the pairs changed since the revision
+	// known to the client, followed by the pairs changed by every later
+	// revision as soon as the dataset changes. It serves clients applying
+	// updates incrementally instead of polling.
+	SubscribeMissionControl(ctx context.Context, in *SubscribeMissionControlRequest, opts ...grpc.CallOption) (ExternalCoordinator_SubscribeMissionControlClient, error)
+	// RegisterMissionControlStream registers mission control data uploaded
+	// as a stream of requests, e.g. by nodes with more pairs than fit into
+	// a single request. The pairs are registered in batches while they are
+	// received. All requests of a stream must share the same network and
+	// private flag. Over REST, the requests are posted as newline delimited
+	// JSON.
+	RegisterMissionControlStream(ctx context.Context, opts ...grpc.CallOption) (ExternalCoordinator_RegisterMissionControlStreamClient, error)
+	// SyncMissionControl synchronizes a client with the coordinator over a
+	// single stream. The client uploads its pairs as a stream of requests,
+	// which are registered like by RegisterMissionControlStream, while it
+	// receives the pairs changed since the revision it last received, like
+	// from PollMissionControl. The registration result follows once the
+	// client finished the upload. It saves periodic synchronizations the
+	// second round trip and connection.
+	SyncMissionControl(ctx context.Context, opts ...grpc.CallOption) (ExternalCoordinator_SyncMissionControlClient, error)
+	// QueryTopPairs returns the pairs with the highest recent success
+	// amounts, optionally limited to the pairs of a node. It serves route
+	// planners looking for the most reliable corridors without downloading
+	// the entire dataset.
+	QueryTopPairs(ctx context.Context, in *QueryTopPairsRequest, opts ...grpc.CallOption) (*QueryTopPairsResponse, error)
+	// QueryPair returns the history of a single pair, identified by the hex
+	// encoded public keys of its nodes. Unlike the node pair query of
+	// QueryAggregatedMissionControl, the public keys fit into the REST path
+	// as is, so that browser and dashboard clients need not encode them in
+	// base64. The pair is filtered, transformed and accounted like the pairs
+	// of a query.
+	QueryPair(ctx context.Context, in *QueryPairRequest, opts ...grpc.CallOption) (*QueryPairResponse, error)
+	// QueryNodeReputation aggregates the pairs to a node into reliability
+	// metrics of the node, such as the fraction of the pairs whose most
+	// recent result is a success. Like GetStats, it does not reveal the
+	// data of any single pair and is served even if the coordinator
+	// withholds the pairs themselves.
+	QueryNodeReputation(ctx context.Context, in *QueryNodeReputationRequest, opts ...grpc.CallOption) (*QueryNodeReputationResponse, error)
+	// ExportMissionControl exports the mission control data in the shape of
+	// the XImportMissionControlRequest of the router service of LND, so that
+	// it can be imported into a node as is. Over REST, the response is always
+	// rendered like the REST API of LND renders its messages, regardless of
+	// the REST options of the coordinator.
+	ExportMissionControl(ctx context.Context, in *ExportMissionControlRequest, opts ...grpc.CallOption) (*ExportMissionControlResponse, error)
+	// ImportMissionControl registers the mission control data of an LND
+	// node as printed by `lncli querymc`. The output is converted into pairs
+	// by the coordinator and registered like by RegisterMissionControl, so
+	// that contributors need no conversion tool of their own.
+	ImportMissionControl(ctx context.Context, in *ImportMissionControlRequest, opts ...grpc.CallOption) (*RegisterMissionControlResponse, error)
 }
 
 type externalCoordinatorClient struct {
@@ -82,6 +181,266 @@ func (x *externalCoordinatorQueryAggregatedMissionControlClient) Recv() (*QueryA
 	return m, nil
 }
 
+func (c *externalCoordinatorClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_GetInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) ListEpochs(ctx context.Context, in *ListEpochsRequest, opts ...grpc.CallOption) (*ListEpochsResponse, error) {
+	out := new(ListEpochsResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_ListEpochs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) QueryEpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (ExternalCoordinator_QueryEpochHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalCoordinator_ServiceDesc.Streams[1], ExternalCoordinator_QueryEpochHistory_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalCoordinatorQueryEpochHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExternalCoordinator_QueryEpochHistoryClient interface {
+	Recv() (*QueryAggregatedMissionControlResponse, error)
+	grpc.ClientStream
+}
+
+type externalCoordinatorQueryEpochHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalCoordinatorQueryEpochHistoryClient) Recv() (*QueryAggregatedMissionControlResponse, error) {
+	m := new(QueryAggregatedMissionControlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *externalCoordinatorClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_GetStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) QueryPrivateMissionControl(ctx context.Context, in *QueryPrivateMissionControlRequest, opts ...grpc.CallOption) (ExternalCoordinator_QueryPrivateMissionControlClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalCoordinator_ServiceDesc.Streams[2], ExternalCoordinator_QueryPrivateMissionControl_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalCoordinatorQueryPrivateMissionControlClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExternalCoordinator_QueryPrivateMissionControlClient interface {
+	Recv() (*QueryAggregatedMissionControlResponse, error)
+	grpc.ClientStream
+}
+
+type externalCoordinatorQueryPrivateMissionControlClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalCoordinatorQueryPrivateMissionControlClient) Recv() (*QueryAggregatedMissionControlResponse, error) {
+	m := new(QueryAggregatedMissionControlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *externalCoordinatorClient) RegisterCLNPayResults(ctx context.Context, in *RegisterCLNPayResultsRequest, opts ...grpc.CallOption) (*RegisterMissionControlResponse, error) {
+	out := new(RegisterMissionControlResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_RegisterCLNPayResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) PollMissionControl(ctx context.Context, in *PollMissionControlRequest, opts ...grpc.CallOption) (*PollMissionControlResponse, error) {
+	out := new(PollMissionControlResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_PollMissionControl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) QueryMissionControlDelta(ctx context.Context, in *QueryMissionControlDeltaRequest, opts ...grpc.CallOption) (*QueryMissionControlDeltaResponse, error) {
+	out := new(QueryMissionControlDeltaResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_QueryMissionControlDelta_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) SubscribeMissionControl(ctx context.Context, in *SubscribeMissionControlRequest, opts ...grpc.CallOption) (ExternalCoordinator_SubscribeMissionControlClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalCoordinator_ServiceDesc.Streams[3], ExternalCoordinator_SubscribeMissionControl_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalCoordinatorSubscribeMissionControlClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExternalCoordinator_SubscribeMissionControlClient interface {
+	Recv() (*PollMissionControlResponse, error)
+	grpc.ClientStream
+}
+
+type externalCoordinatorSubscribeMissionControlClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalCoordinatorSubscribeMissionControlClient) Recv() (*PollMissionControlResponse, error) {
+	m := new(PollMissionControlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *externalCoordinatorClient) RegisterMissionControlStream(ctx context.Context, opts ...grpc.CallOption) (ExternalCoordinator_RegisterMissionControlStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalCoordinator_ServiceDesc.Streams[4], ExternalCoordinator_RegisterMissionControlStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalCoordinatorRegisterMissionControlStreamClient{stream}
+	return x, nil
+}
+
+type ExternalCoordinator_RegisterMissionControlStreamClient interface {
+	Send(*RegisterMissionControlRequest) error
+	CloseAndRecv() (*RegisterMissionControlResponse, error)
+	grpc.ClientStream
+}
+
+type externalCoordinatorRegisterMissionControlStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalCoordinatorRegisterMissionControlStreamClient) Send(m *RegisterMissionControlRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *externalCoordinatorRegisterMissionControlStreamClient) CloseAndRecv() (*RegisterMissionControlResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RegisterMissionControlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *externalCoordinatorClient) SyncMissionControl(ctx context.Context, opts ...grpc.CallOption) (ExternalCoordinator_SyncMissionControlClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalCoordinator_ServiceDesc.Streams[5], ExternalCoordinator_SyncMissionControl_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalCoordinatorSyncMissionControlClient{stream}
+	return x, nil
+}
+
+type ExternalCoordinator_SyncMissionControlClient interface {
+	Send(*SyncMissionControlRequest) error
+	Recv() (*SyncMissionControlResponse, error)
+	grpc.ClientStream
+}
+
+type externalCoordinatorSyncMissionControlClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalCoordinatorSyncMissionControlClient) Send(m *SyncMissionControlRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *externalCoordinatorSyncMissionControlClient) Recv() (*SyncMissionControlResponse, error) {
+	m := new(SyncMissionControlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *externalCoordinatorClient) QueryTopPairs(ctx context.Context, in *QueryTopPairsRequest, opts ...grpc.CallOption) (*QueryTopPairsResponse, error) {
+	out := new(QueryTopPairsResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_QueryTopPairs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) QueryPair(ctx context.Context, in *QueryPairRequest, opts ...grpc.CallOption) (*QueryPairResponse, error) {
+	out := new(QueryPairResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_QueryPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) QueryNodeReputation(ctx context.Context, in *QueryNodeReputationRequest, opts ...grpc.CallOption) (*QueryNodeReputationResponse, error) {
+	out := new(QueryNodeReputationResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_QueryNodeReputation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) ExportMissionControl(ctx context.Context, in *ExportMissionControlRequest, opts ...grpc.CallOption) (*ExportMissionControlResponse, error) {
+	out := new(ExportMissionControlResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_ExportMissionControl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalCoordinatorClient) ImportMissionControl(ctx context.Context, in *ImportMissionControlRequest, opts ...grpc.CallOption) (*RegisterMissionControlResponse, error) {
+	out := new(RegisterMissionControlResponse)
+	err := c.cc.Invoke(ctx, ExternalCoordinator_ImportMissionControl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExternalCoordinatorServer is the server API for ExternalCoordinator service.
 // All implementations must embed UnimplementedExternalCoordinatorServer
 // for forward compatibility
@@ -90,6 +449,89 @@ type ExternalCoordinatorServer interface {
 	RegisterMissionControl(context.Context, *RegisterMissionControlRequest) (*RegisterMissionControlResponse, error)
 	// QueryAggregatedMissionControl queries aggregated mission control data.
 	QueryAggregatedMissionControl(*QueryAggregatedMissionControlRequest, ExternalCoordinator_QueryAggregatedMissionControlServer) error
+	// GetInfo returns information about the coordinator including its
+	// version, the dataset it serves and hints on how clients should pace
+	// their submissions.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// ListEpochs lists the archived epochs of the mission control data
+	// together with the current one.
+	ListEpochs(context.Context, *ListEpochsRequest) (*ListEpochsResponse, error)
+	// QueryEpochHistory queries the mission control data archived at the end
+	// of an epoch.
+	QueryEpochHistory(*QueryEpochHistoryRequest, ExternalCoordinator_QueryEpochHistoryServer) error
+	// GetStats returns aggregate statistics of the mission control data
+	// without revealing the data of any pair. It is served even if the
+	// coordinator withholds the pairs themselves.
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// QueryPrivateMissionControl queries the private mission control data
+	// registered with access tokens of the same owner as the access token of
+	// the request. Private data is never part of the public aggregate.
+	QueryPrivateMissionControl(*QueryPrivateMissionControlRequest, ExternalCoordinator_QueryPrivateMissionControlServer) error
+	// RegisterCLNPayResults registers the results of payment attempts made
+	// by a Core Lightning node, as derived from its listsendpays and
+	// listpays commands. The attempts are mapped to the mission control
+	// data of the pairs along their routes and registered like by
+	// RegisterMissionControl.
+	RegisterCLNPayResults(context.Context, *RegisterCLNPayResultsRequest) (*RegisterMissionControlResponse, error)
+	// PollMissionControl waits until the revision of the dataset exceeds the
+	// revision known to the client or the timeout elapses, and returns the
+	// pairs changed since. It serves clients keeping their data in sync
+	// without holding a stream open.
+	PollMissionControl(context.Context, *PollMissionControlRequest) (*PollMissionControlResponse, error)
+	// QueryMissionControlDelta returns the pairs modified since the
+	// watermark of the client's last sync, which turns every periodic sync
+	// into a small diff instead of a full download. It does not depend on
+	// the revision log.
+	QueryMissionControlDelta(context.Context, *QueryMissionControlDeltaRequest) (*QueryMissionControlDeltaResponse, error)
+	// SubscribeMissionControl streams the pairs changed since the revision
+	// known to the client, followed by the pairs changed by every later
+	// revision as soon as the dataset changes. It serves clients applying
+	// updates incrementally instead of polling.
+	SubscribeMissionControl(*SubscribeMissionControlRequest, ExternalCoordinator_SubscribeMissionControlServer) error
+	// RegisterMissionControlStream registers mission control data uploaded
+	// as a stream of requests, e.g. by nodes with more pairs than fit into
+	// a single request. The pairs are registered in batches while they are
+	// received. All requests of a stream must share the same network and
+	// private flag. Over REST, the requests are posted as newline delimited
+	// JSON.
+	RegisterMissionControlStream(ExternalCoordinator_RegisterMissionControlStreamServer) error
+	// SyncMissionControl synchronizes a client with the coordinator over a
+	// single stream. The client uploads its pairs as a stream of requests,
+	// which are registered like by RegisterMissionControlStream, while it
+	// receives the pairs changed since the revision it last received, like
+	// from PollMissionControl. The registration result follows once the
+	// client finished the upload. It saves periodic synchronizations the
+	// second round trip and connection.
+	SyncMissionControl(ExternalCoordinator_SyncMissionControlServer) error
+	// QueryTopPairs returns the pairs with the highest recent success
+	// amounts, optionally limited to the pairs of a node. It serves route
+	// planners looking for the most reliable corridors without downloading
+	// the entire dataset.
+	QueryTopPairs(context.Context, *QueryTopPairsRequest) (*QueryTopPairsResponse, error)
+	// QueryPair returns the history of a single pair, identified by the hex
+	// encoded public keys of its nodes. Unlike the node pair query of
+	// QueryAggregatedMissionControl, the public keys fit into the REST path
+	// as is, so that browser and dashboard clients need not encode them in
+	// base64. The pair is filtered, transformed and accounted like the pairs
+	// of a query.
+	QueryPair(context.Context, *QueryPairRequest) (*QueryPairResponse, error)
+	// QueryNodeReputation aggregates the pairs to a node into reliability
+	// metrics of the node, such as the fraction of the pairs whose most
+	// recent result is a success. Like GetStats, it does not reveal the
+	// data of any single pair and is served even if the coordinator
+	// withholds the pairs themselves.
+	QueryNodeReputation(context.Context, *QueryNodeReputationRequest) (*QueryNodeReputationResponse, error)
+	// ExportMissionControl exports the mission control data in the shape of
+	// the XImportMissionControlRequest of the router service of LND, so that
+	// it can be imported into a node as is. Over REST, the response is always
+	// rendered like the REST API of LND renders its messages, regardless of
+	// the REST options of the coordinator.
+	ExportMissionControl(context.Context, *ExportMissionControlRequest) (*ExportMissionControlResponse, error)
+	// ImportMissionControl registers the mission control data of an LND
+	// node as printed by `lncli querymc`. The output is converted into pairs
+	// by the coordinator and registered like by RegisterMissionControl, so
+	// that contributors need no conversion tool of their own.
+	ImportMissionControl(context.Context, *ImportMissionControlRequest) (*RegisterMissionControlResponse, error)
 	mustEmbedUnimplementedExternalCoordinatorServer()
 }
 
@@ -103,6 +545,54 @@ func (UnimplementedExternalCoordinatorServer) RegisterMissionControl(context.Con
 func (UnimplementedExternalCoordinatorServer) QueryAggregatedMissionControl(*QueryAggregatedMissionControlRequest, ExternalCoordinator_QueryAggregatedMissionControlServer) error {
 	return status.Errorf(codes.Unimplemented, "method QueryAggregatedMissionControl not implemented")
 }
+func (UnimplementedExternalCoordinatorServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) ListEpochs(context.Context, *ListEpochsRequest) (*ListEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpochs not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) QueryEpochHistory(*QueryEpochHistoryRequest, ExternalCoordinator_QueryEpochHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryEpochHistory not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) QueryPrivateMissionControl(*QueryPrivateMissionControlRequest, ExternalCoordinator_QueryPrivateMissionControlServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryPrivateMissionControl not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) RegisterCLNPayResults(context.Context, *RegisterCLNPayResultsRequest) (*RegisterMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCLNPayResults not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) PollMissionControl(context.Context, *PollMissionControlRequest) (*PollMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollMissionControl not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) QueryMissionControlDelta(context.Context, *QueryMissionControlDeltaRequest) (*QueryMissionControlDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMissionControlDelta not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) SubscribeMissionControl(*SubscribeMissionControlRequest, ExternalCoordinator_SubscribeMissionControlServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMissionControl not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) RegisterMissionControlStream(ExternalCoordinator_RegisterMissionControlStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterMissionControlStream not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) SyncMissionControl(ExternalCoordinator_SyncMissionControlServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyncMissionControl not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) QueryTopPairs(context.Context, *QueryTopPairsRequest) (*QueryTopPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTopPairs not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) QueryPair(context.Context, *QueryPairRequest) (*QueryPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPair not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) QueryNodeReputation(context.Context, *QueryNodeReputationRequest) (*QueryNodeReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNodeReputation not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) ExportMissionControl(context.Context, *ExportMissionControlRequest) (*ExportMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMissionControl not implemented")
+}
+func (UnimplementedExternalCoordinatorServer) ImportMissionControl(context.Context, *ImportMissionControlRequest) (*RegisterMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMissionControl not implemented")
+}
 func (UnimplementedExternalCoordinatorServer) mustEmbedUnimplementedExternalCoordinatorServer() {}
 
 // UnsafeExternalCoordinatorServer may be embedded to opt out of forward compatibility for this service.
@@ -155,6 +645,319 @@ func (x *externalCoordinatorQueryAggregatedMissionControlServer) Send(m *QueryAg
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ExternalCoordinator_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_GetInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_ListEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).ListEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_ListEpochs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).ListEpochs(ctx, req.(*ListEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_QueryEpochHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryEpochHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExternalCoordinatorServer).QueryEpochHistory(m, &externalCoordinatorQueryEpochHistoryServer{stream})
+}
+
+type ExternalCoordinator_QueryEpochHistoryServer interface {
+	Send(*QueryAggregatedMissionControlResponse) error
+	grpc.ServerStream
+}
+
+type externalCoordinatorQueryEpochHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalCoordinatorQueryEpochHistoryServer) Send(m *QueryAggregatedMissionControlResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExternalCoordinator_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_QueryPrivateMissionControl_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryPrivateMissionControlRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExternalCoordinatorServer).QueryPrivateMissionControl(m, &externalCoordinatorQueryPrivateMissionControlServer{stream})
+}
+
+type ExternalCoordinator_QueryPrivateMissionControlServer interface {
+	Send(*QueryAggregatedMissionControlResponse) error
+	grpc.ServerStream
+}
+
+type externalCoordinatorQueryPrivateMissionControlServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalCoordinatorQueryPrivateMissionControlServer) Send(m *QueryAggregatedMissionControlResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExternalCoordinator_RegisterCLNPayResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCLNPayResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).RegisterCLNPayResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_RegisterCLNPayResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).RegisterCLNPayResults(ctx, req.(*RegisterCLNPayResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_PollMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).PollMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_PollMissionControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).PollMissionControl(ctx, req.(*PollMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_QueryMissionControlDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissionControlDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).QueryMissionControlDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_QueryMissionControlDelta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).QueryMissionControlDelta(ctx, req.(*QueryMissionControlDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_SubscribeMissionControl_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMissionControlRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExternalCoordinatorServer).SubscribeMissionControl(m, &externalCoordinatorSubscribeMissionControlServer{stream})
+}
+
+type ExternalCoordinator_SubscribeMissionControlServer interface {
+	Send(*PollMissionControlResponse) error
+	grpc.ServerStream
+}
+
+type externalCoordinatorSubscribeMissionControlServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalCoordinatorSubscribeMissionControlServer) Send(m *PollMissionControlResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExternalCoordinator_RegisterMissionControlStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExternalCoordinatorServer).RegisterMissionControlStream(&externalCoordinatorRegisterMissionControlStreamServer{stream})
+}
+
+type ExternalCoordinator_RegisterMissionControlStreamServer interface {
+	SendAndClose(*RegisterMissionControlResponse) error
+	Recv() (*RegisterMissionControlRequest, error)
+	grpc.ServerStream
+}
+
+type externalCoordinatorRegisterMissionControlStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalCoordinatorRegisterMissionControlStreamServer) SendAndClose(m *RegisterMissionControlResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *externalCoordinatorRegisterMissionControlStreamServer) Recv() (*RegisterMissionControlRequest, error) {
+	m := new(RegisterMissionControlRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ExternalCoordinator_SyncMissionControl_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExternalCoordinatorServer).SyncMissionControl(&externalCoordinatorSyncMissionControlServer{stream})
+}
+
+type ExternalCoordinator_SyncMissionControlServer interface {
+	Send(*SyncMissionControlResponse) error
+	Recv() (*SyncMissionControlRequest, error)
+	grpc.ServerStream
+}
+
+type externalCoordinatorSyncMissionControlServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalCoordinatorSyncMissionControlServer) Send(m *SyncMissionControlResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *externalCoordinatorSyncMissionControlServer) Recv() (*SyncMissionControlRequest, error) {
+	m := new(SyncMissionControlRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ExternalCoordinator_QueryTopPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).QueryTopPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_QueryTopPairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).QueryTopPairs(ctx, req.(*QueryTopPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_QueryPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).QueryPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_QueryPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).QueryPair(ctx, req.(*QueryPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_QueryNodeReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodeReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).QueryNodeReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_QueryNodeReputation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).QueryNodeReputation(ctx, req.(*QueryNodeReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_ExportMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).ExportMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_ExportMissionControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).ExportMissionControl(ctx, req.(*ExportMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalCoordinator_ImportMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalCoordinatorServer).ImportMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExternalCoordinator_ImportMissionControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalCoordinatorServer).ImportMissionControl(ctx, req.(*ImportMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExternalCoordinator_ServiceDesc is the grpc.ServiceDesc for ExternalCoordinator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -166,6 +969,50 @@ var ExternalCoordinator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterMissionControl",
 			Handler:    _ExternalCoordinator_RegisterMissionControl_Handler,
 		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _ExternalCoordinator_GetInfo_Handler,
+		},
+		{
+			MethodName: "ListEpochs",
+			Handler:    _ExternalCoordinator_ListEpochs_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _ExternalCoordinator_GetStats_Handler,
+		},
+		{
+			MethodName: "RegisterCLNPayResults",
+			Handler:    _ExternalCoordinator_RegisterCLNPayResults_Handler,
+		},
+		{
+			MethodName: "PollMissionControl",
+			Handler:    _ExternalCoordinator_PollMissionControl_Handler,
+		},
+		{
+			MethodName: "QueryMissionControlDelta",
+			Handler:    _ExternalCoordinator_QueryMissionControlDelta_Handler,
+		},
+		{
+			MethodName: "QueryTopPairs",
+			Handler:    _ExternalCoordinator_QueryTopPairs_Handler,
+		},
+		{
+			MethodName: "QueryPair",
+			Handler:    _ExternalCoordinator_QueryPair_Handler,
+		},
+		{
+			MethodName: "QueryNodeReputation",
+			Handler:    _ExternalCoordinator_QueryNodeReputation_Handler,
+		},
+		{
+			MethodName: "ExportMissionControl",
+			Handler:    _ExternalCoordinator_ExportMissionControl_Handler,
+		},
+		{
+			MethodName: "ImportMissionControl",
+			Handler:    _ExternalCoordinator_ImportMissionControl_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -173,6 +1020,32 @@ var ExternalCoordinator_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ExternalCoordinator_QueryAggregatedMissionControl_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "QueryEpochHistory",
+			Handler:       _ExternalCoordinator_QueryEpochHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "QueryPrivateMissionControl",
+			Handler:       _ExternalCoordinator_QueryPrivateMissionControl_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMissionControl",
+			Handler:       _ExternalCoordinator_SubscribeMissionControl_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterMissionControlStream",
+			Handler:       _ExternalCoordinator_RegisterMissionControlStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SyncMissionControl",
+			Handler:       _ExternalCoordinator_SyncMissionControl_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "ecrpc/external_coordinator.proto",
 }
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// epochCheckInterval is the maximum interval on which the epoch routine checks
+// whether the current epoch ended.
+const epochCheckInterval = time.Minute
+
+var (
+	// epochKey is the key of the number of the current epoch within the
+	// metadata bucket.
+	epochKey = []byte("epoch")
+
+	// epochStartKey is the key of the start time of the current epoch
+	// within the metadata bucket.
+	epochStartKey = []byte("epoch_start")
+
+	// archiveStartKey, archiveEndKey and archiveCountKey are the keys of
+	// the start time, the end time and the number of pairs of an archived
+	// epoch within its bucket.
+	archiveStartKey = []byte("start")
+	archiveEndKey   = []byte("end")
+	archiveCountKey = []byte("count")
+
+	// archivePairsKey is the name of the nested bucket holding the pairs of
+	// an archived epoch.
+	archivePairsKey = []byte("pairs")
+)
+
+// encodeUint64 encodes the value as big-endian bytes, so that encoded values
+// sort in numerical order.
+func encodeUint64(v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+
+	return b[:]
+}
+
+// decodeUint64 decodes a big-endian value. Missing values decode to zero.
+func decodeUint64(b []byte) uint64 {
+	if len(b) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(b)
+}
+
+// initEpoch starts the first epoch at the given time unless an epoch was
+// already started.
+func initEpoch(tx *bbolt.Tx, now time.Time) error {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	if meta.Get(epochStartKey) != nil {
+		return nil
+	}
+
+	if err := meta.Put(epochKey, encodeUint64(0)); err != nil {
+		return err
+	}
+
+	return meta.Put(epochStartKey, encodeUint64(uint64(now.Unix())))
+}
+
+// currentEpoch returns the current epoch.
+func currentEpoch(tx *bbolt.Tx) *ecrpc.Epoch {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+
+	return &ecrpc.Epoch{
+		Epoch:     decodeUint64(meta.Get(epochKey)),
+		StartTime: int64(decodeUint64(meta.Get(epochStartKey))),
+	}
+}
+
+// archivedEpoch returns the archived epoch stored in the given bucket.
+func archivedEpoch(epoch uint64, b *bbolt.Bucket) *ecrpc.Epoch {
+	return &ecrpc.Epoch{
+		Epoch:     epoch,
+		StartTime: int64(decodeUint64(b.Get(archiveStartKey))),
+		EndTime:   int64(decodeUint64(b.Get(archiveEndKey))),
+		Pairs:     decodeUint64(b.Get(archiveCountKey)),
+	}
+}
+
+// rolloverEpoch archives the pairs of the current epoch and starts a new epoch
+// at the given time with empty mission control data. The latency samples and
+// the experimental aggregation are reset as well, so that the new epoch is
+// aggregated afresh. Archives exceeding the given maximum are removed, oldest
+// first, unless the maximum is zero. It returns the archived epoch.
+func rolloverEpoch(tx *bbolt.Tx, now time.Time,
+	maxArchived int) (*ecrpc.Epoch, error) {
+	current := currentEpoch(tx)
+	archive := tx.Bucket([]byte(ArchiveBucketName))
+	b, err := archive.CreateBucket(encodeUint64(current.Epoch))
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := b.CreateBucket(archivePairsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	count := uint64(0)
+	err = tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, v []byte) error {
+			count++
+			return pairs.Put(bytes.Clone(k), bytes.Clone(v))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := &ecrpc.Epoch{
+		Epoch:     current.Epoch,
+		StartTime: current.StartTime,
+		EndTime:   now.Unix(),
+		Pairs:     count,
+	}
+	values := []struct {
+		key   []byte
+		value uint64
+	}{
+		{archiveStartKey, uint64(archived.StartTime)},
+		{archiveEndKey, uint64(archived.EndTime)},
+		{archiveCountKey, archived.Pairs},
+	}
+	for _, v := range values {
+		if err := b.Put(v.key, encodeUint64(v.value)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Start the new epoch with empty data.
+	buckets := []string{
+		DatabaseBucketName, LatencySamplesBucketName,
+		UpdateIndexBucketName, NodeIndexBucketName,
+		MerkleEntriesBucketName, MerkleLeavesBucketName,
+		AggregationExperimentBucketName, PairObservationsBucketName,
+		PairSubmittersBucketName, JournalBucketName,
+	}
+	for _, bucket := range buckets {
+		if err := tx.DeleteBucket([]byte(bucket)); err != nil {
+			return nil, err
+		}
+		if _, err := tx.CreateBucket([]byte(bucket)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := bumpDatasetRevision(tx); err != nil {
+		return nil, err
+	}
+	if err := resetRevisionLog(tx); err != nil {
+		return nil, err
+	}
+	if err := resetModifiedIndex(tx); err != nil {
+		return nil, err
+	}
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	err = meta.Put(epochKey, encodeUint64(current.Epoch+1))
+	if err != nil {
+		return nil, err
+	}
+	err = meta.Put(epochStartKey, encodeUint64(uint64(now.Unix())))
+	if err != nil {
+		return nil, err
+	}
+
+	if maxArchived <= 0 {
+		return archived, nil
+	}
+
+	// Remove the oldest archives exceeding the maximum. The archives are
+	// keyed by their number, so the oldest ones come first.
+	var epochs [][]byte
+	c := archive.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		epochs = append(epochs, bytes.Clone(k))
+	}
+	for len(epochs) > maxArchived {
+		if err := archive.DeleteBucket(epochs[0]); err != nil {
+			return nil, err
+		}
+		epochs = epochs[1:]
+	}
+
+	return archived, nil
+}
+
+// maybeRolloverEpoch rolls over to a new epoch if the current one lasted for
+// the configured epoch duration. Warm standby coordinators never roll over,
+// since their data is replaced by the snapshots of the primary coordinator.
+func (s *externalCoordinatorServer) maybeRolloverEpoch(now time.Time) error {
+	var archived *ecrpc.Epoch
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		if isStandby(tx) {
+			return nil
+		}
+
+		start := time.Unix(currentEpoch(tx).StartTime, 0)
+		if now.Before(start.Add(s.config.Server.EpochDuration)) {
+			return nil
+		}
+
+		var err error
+		archived, err = rolloverEpoch(
+			tx, now, s.config.Server.ArchivedEpochs,
+		)
+		return err
+	})
+	if err != nil || archived == nil {
+		return err
+	}
+
+	// The standby coordinator is resynced completely since all pairs were
+	// removed.
+	s.snapshots.markFull()
+
+	logrus.Infof("Epoch %d ended and %d pairs were archived",
+		archived.Epoch, archived.Pairs)
+
+	return nil
+}
+
+// RunEpochRoutine runs a routine rolling over to a new epoch whenever the
+// current one lasted for the configured epoch duration. It does nothing if
+// epochs are disabled.
+func (s *externalCoordinatorServer) RunEpochRoutine(ctx context.Context) {
+	duration := s.config.Server.EpochDuration
+	if duration <= 0 {
+		return
+	}
+
+	logrus.Infof("Epoch routine started to archive mission control data "+
+		"every %s", formatDuration(duration))
+
+	ticker := time.NewTicker(min(duration, epochCheckInterval))
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case now := <-ticker.C:
+				if err := s.maybeRolloverEpoch(now); err != nil {
+					logrus.Errorf("Failed to roll over "+
+						"epoch: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// ListEpochs lists the archived epochs of the mission control data together
+// with the current one.
+func (s *externalCoordinatorServer) ListEpochs(ctx context.Context,
+	req *ecrpc.ListEpochsRequest) (*ecrpc.ListEpochsResponse, error) {
+	resp := &ecrpc.ListEpochsResponse{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		resp.Current = currentEpoch(tx)
+
+		archive := tx.Bucket([]byte(ArchiveBucketName))
+		return archive.ForEach(func(k, v []byte) error {
+			resp.Archived = append(resp.Archived, archivedEpoch(
+				decodeUint64(k), archive.Bucket(k),
+			))
+			return nil
+		})
+	})
+	if err != nil {
+		msg := "failed to list epochs: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return resp, nil
+}
+
+// QueryEpochHistory streams the pairs archived at the end of the requested
+// epoch in chunks of the configured batch size. Such queries count towards the
+// daily egress cap of the client like regular queries.
+func (s *externalCoordinatorServer) QueryEpochHistory(
+	req *ecrpc.QueryEpochHistoryRequest,
+	stream ecrpc.ExternalCoordinator_QueryEpochHistoryServer) error {
+	logrus.Infof("Received QueryEpochHistory request for epoch %d",
+		req.GetEpoch())
+
+	if err := s.checkServesPairs(); err != nil {
+		return err
+	}
+
+	client := clientIdentity(stream.Context())
+	if err := s.checkEgressCap(stream.Context(), client); err != nil {
+		logrus.Infof("Query rejected: %v", err)
+		return err
+	}
+
+	metered := &meteredQueryStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: stream,
+	}
+	filter := accessScopeFromContext(stream.Context()).filter(nil)
+	served := s.transforms.transformServed(
+		s.watermarkServed(stream.Context(), metered),
+	)
+	sent, err := s.streamArchivedPairs(served, req.GetEpoch(), filter)
+	s.recordEgress(client, metered.bytes.Load())
+	switch status.Code(err) {
+	case codes.NotFound:
+		return err
+
+	case codes.DeadlineExceeded, codes.Canceled:
+		logrus.Warnf("Query aborted: %v", err)
+		return err
+	}
+	if err != nil {
+		msg := "query failed: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	s.recordQueryAudit(stream.Context(), "", sent)
+
+	return nil
+}
+
+// streamArchivedPairs streams the pairs archived at the end of the given epoch
+// accepted by the filter in chunks of the configured batch size. The query is aborted once the
+// configured execution timeout elapses or the client goes away. It returns
+// the number of pairs sent.
+func (s *externalCoordinatorServer) streamArchivedPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	epoch uint64, filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	var keys, values [][]byte
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		archive := tx.Bucket([]byte(ArchiveBucketName))
+		b := archive.Bucket(encodeUint64(epoch))
+		if b == nil {
+			return status.Errorf(codes.NotFound, "epoch %d is not "+
+				"archived", epoch)
+		}
+
+		c := b.Bucket(archivePairsKey).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			keys = append(keys, bytes.Clone(k))
+			values = append(values, bytes.Clone(v))
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		return 0, s.abortedQueryError(err)
+	}
+
+	// Archives never change, so they are described by their epoch only.
+	if len(keys) == 0 {
+		return 0, sendEmptyResponse(stream, &ecrpc.DatasetInfo{
+			Epoch: epoch,
+		})
+	}
+
+	return s.sendPairBatches(stream, keys, values)
+}
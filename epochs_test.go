@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestEpochRollover tests that the data is archived at the end of each epoch,
+// that archived epochs can be queried and that the oldest archives are
+// removed once the retention is exceeded.
+func TestEpochRollover(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.EpochDuration = 24 * time.Hour
+	config.Server.ArchivedEpochs = 2
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	// countPairs returns the number of pairs sent to the stream.
+	countPairs := func(
+		stream *mockQueryAggregatedMissionControlServer) int {
+		pairs := 0
+		for _, resp := range stream.Responses {
+			pairs += len(resp.Pairs)
+		}
+
+		return pairs
+	}
+	queryLive := func() (int, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{}, stream,
+		)
+
+		return countPairs(stream), err
+	}
+	queryArchive := func(epoch uint64) (int, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryEpochHistory(
+			&ecrpc.QueryEpochHistoryRequest{Epoch: epoch}, stream,
+		)
+
+		return countPairs(stream), err
+	}
+
+	// Nothing is archived before the epoch ends.
+	start := time.Now()
+	require.NoError(t, server.maybeRolloverEpoch(start))
+	epochs, err := server.ListEpochs(
+		context.Background(), &ecrpc.ListEpochsRequest{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, epochs.Archived)
+	require.Zero(t, epochs.Current.Epoch)
+
+	// Once the epoch ended, its data is archived and aggregation starts
+	// afresh.
+	end := start.Add(config.Server.EpochDuration)
+	require.NoError(t, server.maybeRolloverEpoch(end))
+
+	pairs, err := queryLive()
+	require.NoError(t, err)
+	require.Zero(t, pairs)
+
+	pairs, err = queryArchive(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, pairs)
+
+	epochs, err = server.ListEpochs(
+		context.Background(), &ecrpc.ListEpochsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, epochs.Archived, 1)
+	require.Equal(t, uint64(1), epochs.Archived[0].Pairs)
+	require.Equal(t, end.Unix(), epochs.Archived[0].EndTime)
+	require.Equal(t, uint64(1), epochs.Current.Epoch)
+	require.Equal(t, end.Unix(), epochs.Current.StartTime)
+
+	// Unknown epochs are not found.
+	_, err = queryArchive(1)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The oldest archives are removed once the retention is exceeded.
+	for i := 1; i <= 2; i++ {
+		now := end.Add(time.Duration(i) * config.Server.EpochDuration)
+		require.NoError(t, server.maybeRolloverEpoch(now))
+	}
+	epochs, err = server.ListEpochs(
+		context.Background(), &ecrpc.ListEpochsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, epochs.Archived, 2)
+	require.Equal(t, uint64(1), epochs.Archived[0].Epoch)
+	require.Equal(t, uint64(2), epochs.Archived[1].Epoch)
+	require.Equal(t, uint64(3), epochs.Current.Epoch)
+}
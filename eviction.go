@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// evictedPairs counts the pairs evicted because their source node exceeded
+// the maximum number of stored pairs.
+var evictedPairs = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Name:      "evicted_pairs_total",
+	Help: "Pairs evicted because their source node exceeded the " +
+		"maximum number of stored pairs.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(evictedPairs)
+}
+
+// evictExcessPairs enforces the maximum number of pairs stored per source node
+// for the source nodes of the given pairs. Pairs exceeding it are evicted
+// stalest first, so that a node cannot inflate the dataset with pairs towards
+// generated keys. A maximum of zero disables the limit. It returns the keys
+// of the evicted pairs.
+func evictExcessPairs(tx *bbolt.Tx, pairs []*ecrpc.PairHistory,
+	maxPairs int) ([][]byte, error) {
+	if maxPairs <= 0 {
+		return nil, nil
+	}
+
+	// Each source node is only checked once per registration.
+	nodes := make(map[nodeKey]struct{})
+	for _, pair := range pairs {
+		nodes[nodeKey(pair.NodeFrom)] = struct{}{}
+	}
+
+	var evicted [][]byte
+	for node := range nodes {
+		keys, err := excessPairKeys(tx, []byte(node), maxPairs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			if err := removePairData(tx, key); err != nil {
+				return nil, err
+			}
+			logrus.Debugf("Evicted pair %s since its source node "+
+				"exceeds %d pairs", hex.EncodeToString(key),
+				maxPairs)
+		}
+		evicted = append(evicted, keys...)
+	}
+
+	return evicted, nil
+}
+
+// excessPairKeys returns the keys of the stalest pairs of the source node
+// exceeding the maximum number of pairs. The pairs of a node share the prefix
+// of their keys, so only its own pairs are scanned.
+func excessPairKeys(tx *bbolt.Tx, node []byte, maxPairs int) ([][]byte,
+	error) {
+	c := tx.Bucket([]byte(DatabaseBucketName)).Cursor()
+
+	// Counting the pairs is cheap, so their data is only decoded once the
+	// limit is exceeded.
+	count := 0
+	for k, _ := c.Seek(node); bytes.HasPrefix(k, node); k, _ = c.Next() {
+		count++
+	}
+	if count <= maxPairs {
+		return nil, nil
+	}
+
+	var pairs []indexedPair
+	for k, v := c.Seek(node); bytes.HasPrefix(k, node); k, v = c.Next() {
+		updated, err := pairUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+
+		// The key is only valid for the lifetime of the transaction,
+		// so it is copied.
+		pairs = append(pairs, indexedPair{
+			key:     bytes.Clone(k),
+			updated: updated,
+		})
+	}
+
+	// Pairs updated at the same time are evicted in key order, so that
+	// retried transactions evict the same pairs.
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].updated < pairs[j].updated
+	})
+
+	keys := make([][]byte, 0, count-maxPairs)
+	for _, pair := range pairs[:count-maxPairs] {
+		keys = append(keys, pair.key)
+	}
+
+	return keys, nil
+}
+
+// removePairData removes a pair together with its latency samples, its
+// observations, its submitters and its experimental aggregation.
+func removePairData(tx *bbolt.Tx, key []byte) error {
+	if err := deletePair(tx, key); err != nil {
+		return err
+	}
+
+	err := tx.Bucket([]byte(PairSubmittersBucketName)).Delete(key)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Bucket([]byte(LatencySamplesBucketName)).Delete(key)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Bucket([]byte(PairObservationsBucketName)).Delete(key)
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket([]byte(AggregationExperimentBucketName)).Delete(key)
+}
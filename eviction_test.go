@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestEvictExcessPairs tests that the stalest pairs of a source node are
+// evicted once it exceeds the maximum number of pairs, while the pairs of
+// other nodes are left untouched.
+func TestEvictExcessPairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.MaxPairsPerNode = 2
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	now := time.Now().Unix()
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	pair := func(nodeFrom, nodeTo []byte,
+		successTime int64) *ecrpc.PairHistory {
+
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    successTime,
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	register := func(pairs ...*ecrpc.PairHistory) {
+		_, err := server.RegisterMissionControl(
+			context.Background(),
+			&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+		)
+		require.NoError(t, err)
+	}
+
+	// Nodes within the limit keep all their pairs.
+	register(
+		pair(nodeA, nodeB, now-30), pair(nodeA, nodeC, now-20),
+		pair(nodeB, nodeA, now-60),
+	)
+	require.ElementsMatch(t, []string{
+		string(pairKey(nodeA, nodeB)), string(pairKey(nodeA, nodeC)),
+		string(pairKey(nodeB, nodeA)),
+	}, storedPairKeys(t, db))
+
+	// Exceeding the limit evicts the stalest pair of the node only.
+	register(pair(nodeA, nodeD, now-10))
+	expected := []string{
+		string(pairKey(nodeA, nodeC)), string(pairKey(nodeA, nodeD)),
+		string(pairKey(nodeB, nodeA)),
+	}
+	require.ElementsMatch(t, expected, storedPairKeys(t, db))
+	require.ElementsMatch(t, expected, indexedPairKeys(t, db))
+
+	// A new pair staler than the existing ones is evicted itself.
+	register(pair(nodeA, nodeB, now-50))
+	require.ElementsMatch(t, expected, storedPairKeys(t, db))
+
+	// Disabling the limit keeps all pairs.
+	config.Server.MaxPairsPerNode = 0
+	register(pair(nodeA, nodeB, now-50))
+	require.Len(t, storedPairKeys(t, db), 4)
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// experimentPolicyKey is the key under which the name of the experimental
+// aggregation policy is stored in the experiment bucket. It is shorter than
+// the pair keys, so it never collides with them.
+var experimentPolicyKey = []byte("policy")
+
+// aggregationPolicy merges newly registered pair data into the aggregated
+// pair data of the same pair.
+type aggregationPolicy func(existingData, newData *ecrpc.PairData)
+
+// aggregationPolicies holds the aggregation policies which can be run as an
+// experiment by their name.
+var aggregationPolicies = map[string]aggregationPolicy{
+	"default": mergePairData,
+	"latest":  mergeLatestPairData,
+}
+
+// mergeLatestPairData merges the pair data from two pairs by adopting the most
+// recent success and failure as reported. Unlike mergePairData it neither
+// retains the maximum success amount nor delays failures within the minimum
+// failure relaxation interval, so the aggregated ranges follow the most recent
+// observations more closely.
+func mergeLatestPairData(existingData, newData *ecrpc.PairData) {
+	if newData.SuccessTime > existingData.SuccessTime {
+		existingData.SuccessTime = newData.SuccessTime
+		existingData.SuccessAmtMsat = newData.SuccessAmtMsat
+	}
+
+	if newData.FailTime > existingData.FailTime {
+		existingData.FailTime = newData.FailTime
+		existingData.FailAmtMsat = newData.FailAmtMsat
+	}
+
+	// Resolve overlapping ranges in favour of the most recent result.
+	if existingData.FailTime != 0 &&
+		existingData.SuccessAmtMsat >= existingData.FailAmtMsat {
+		if existingData.FailTime > existingData.SuccessTime {
+			// An amount-independent failure also resets the
+			// success amount.
+			existingData.SuccessAmtMsat = 0
+			if existingData.FailAmtMsat > 0 {
+				existingData.SuccessAmtMsat =
+					existingData.FailAmtMsat - 1
+			}
+		} else {
+			existingData.FailAmtMsat =
+				existingData.SuccessAmtMsat + 1
+		}
+	}
+
+	existingData.SuccessAmtSat = existingData.SuccessAmtMsat / mSatScale
+	existingData.FailAmtSat = existingData.FailAmtMsat / mSatScale
+}
+
+// StartAggregationExperiment starts aggregating all registered pairs with the
+// configured experimental aggregation policy in addition to the primary one.
+// The experiment results are stored in their own bucket and never served to
+// clients. The results of a previous experiment with a different policy are
+// discarded.
+func (s *externalCoordinatorServer) StartAggregationExperiment() error {
+	name := s.config.Server.ExperimentalAggregationPolicy
+	policy, ok := aggregationPolicies[name]
+	if !ok {
+		return fmt.Errorf("unknown aggregation policy %q", name)
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(AggregationExperimentBucketName))
+		if string(b.Get(experimentPolicyKey)) == name {
+			return nil
+		}
+
+		logrus.Infof("Discarding previous aggregation experiment "+
+			"results for policy %s", name)
+
+		bucketName := []byte(AggregationExperimentBucketName)
+		if err := tx.DeleteBucket(bucketName); err != nil {
+			return err
+		}
+		b, err := tx.CreateBucket(bucketName)
+		if err != nil {
+			return err
+		}
+
+		return b.Put(experimentPolicyKey, []byte(name))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to prepare aggregation experiment: %v",
+			err)
+	}
+	s.experiment = policy
+
+	logrus.Infof("Running aggregation experiment with policy %s", name)
+
+	return nil
+}
+
+// clonePairs returns a deep copy of the given pairs.
+func clonePairs(pairs []*ecrpc.PairHistory) []*ecrpc.PairHistory {
+	clones := make([]*ecrpc.PairHistory, 0, len(pairs))
+	for _, pair := range pairs {
+		clones = append(clones, proto.Clone(pair).(*ecrpc.PairHistory))
+	}
+
+	return clones
+}
+
+// applyAggregationExperiment aggregates the given pairs with the experimental
+// policy into the experiment bucket.
+func applyAggregationExperiment(b *bbolt.Bucket, pairs []*ecrpc.PairHistory,
+	policy aggregationPolicy) error {
+	for _, pair := range pairs {
+		key := append(pair.NodeFrom, pair.NodeTo...)
+
+		data := pair.History
+		if v := b.Get(key); v != nil {
+			existingData, err := decodePairData(v)
+			if err != nil {
+				return err
+			}
+
+			prevFailTime := existingData.FailTime
+			prevSuccessTime := existingData.SuccessTime
+			policy(existingData, pair.History)
+			updateFailureStreak(
+				existingData, prevFailTime, prevSuccessTime,
+			)
+			data = existingData
+		} else {
+			updateFailureStreak(data, 0, 0)
+		}
+		data.ResolutionLatencyMs = 0
+
+		value, err := encodePairData(data)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// absDiff returns the absolute difference of two amounts.
+func absDiff(a, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
+
+// CompareAggregationExperiment compares the pairs aggregated by the
+// experimental aggregation policy with the ones aggregated by the primary
+// policy since the experiment was started.
+func (s *adminServer) CompareAggregationExperiment(ctx context.Context,
+	req *ecadminrpc.CompareAggregationExperimentRequest) (*ecadminrpc.CompareAggregationExperimentResponse, error) {
+	if s.config.Server.ExperimentalAggregationPolicy == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "no "+
+			"aggregation experiment is configured")
+	}
+
+	resp := &ecadminrpc.CompareAggregationExperimentResponse{}
+	var successDelta, failDelta int64
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		primary := tx.Bucket([]byte(DatabaseBucketName))
+		b := tx.Bucket([]byte(AggregationExperimentBucketName))
+		resp.Policy = string(b.Get(experimentPolicyKey))
+
+		return b.ForEach(func(k, v []byte) error {
+			if pairKeys.validateKey(k) != nil {
+				return nil
+			}
+
+			primaryValue := primary.Get(k)
+			if primaryValue == nil {
+				resp.PairsMissing++
+				return nil
+			}
+
+			primaryData, err := decodePairData(primaryValue)
+			if err != nil {
+				return err
+			}
+			experimentData, err := decodePairData(v)
+			if err != nil {
+				return err
+			}
+
+			resp.PairsCompared++
+			success := absDiff(
+				primaryData.SuccessAmtMsat,
+				experimentData.SuccessAmtMsat,
+			)
+			fail := absDiff(
+				primaryData.FailAmtMsat,
+				experimentData.FailAmtMsat,
+			)
+			successDelta += success
+			failDelta += fail
+			if success == 0 && fail == 0 {
+				return nil
+			}
+
+			resp.PairsDiffering++
+			if len(resp.Differences) >= int(req.GetMaxDifferences()) {
+				return nil
+			}
+
+			// The keys are only valid for the lifetime of the
+			// transaction, so they are copied.
+			nodeFrom, nodeTo := splitPairKey(bytes.Clone(k))
+			diff := &ecadminrpc.AggregationDifference{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+			}
+			diff.PrimarySuccessAmtMsat = primaryData.SuccessAmtMsat
+			diff.ExperimentSuccessAmtMsat = experimentData.SuccessAmtMsat
+			diff.PrimaryFailAmtMsat = primaryData.FailAmtMsat
+			diff.ExperimentFailAmtMsat = experimentData.FailAmtMsat
+			resp.Differences = append(resp.Differences, diff)
+
+			return nil
+		})
+	})
+	if err != nil {
+		msg := "failed to compare aggregation experiment: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	if resp.PairsCompared > 0 {
+		n := int64(resp.PairsCompared)
+		resp.MeanSuccessAmtDeltaMsat = successDelta / n
+		resp.MeanFailAmtDeltaMsat = failDelta / n
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestMergeLatestPairData tests that the latest aggregation policy adopts the
+// most recent results.
+func TestMergeLatestPairData(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name     string
+		existing *ecrpc.PairData
+		new      *ecrpc.PairData
+		expected *ecrpc.PairData
+	}{
+		{
+			name: "LowerSuccessAdopted",
+			existing: &ecrpc.PairData{
+				SuccessTime: now - 10, SuccessAmtMsat: 8000,
+			},
+			new: &ecrpc.PairData{
+				SuccessTime: now, SuccessAmtMsat: 3000,
+			},
+			expected: &ecrpc.PairData{
+				SuccessTime: now, SuccessAmtMsat: 3000,
+				SuccessAmtSat: 3,
+			},
+		},
+		{
+			name: "FailureWithinRelaxIntervalAdopted",
+			existing: &ecrpc.PairData{
+				FailTime: now - 10, FailAmtMsat: 5000,
+			},
+			new: &ecrpc.PairData{
+				FailTime: now, FailAmtMsat: 8000,
+			},
+			expected: &ecrpc.PairData{
+				FailTime: now, FailAmtMsat: 8000, FailAmtSat: 8,
+			},
+		},
+		{
+			name: "FailureMovesSuccessDown",
+			existing: &ecrpc.PairData{
+				SuccessTime: now - 10, SuccessAmtMsat: 8000,
+			},
+			new: &ecrpc.PairData{
+				FailTime: now, FailAmtMsat: 5000,
+			},
+			expected: &ecrpc.PairData{
+				SuccessTime: now - 10, SuccessAmtMsat: 4999,
+				SuccessAmtSat: 4, FailTime: now,
+				FailAmtMsat: 5000, FailAmtSat: 5,
+			},
+		},
+		{
+			name: "SuccessMovesFailureUp",
+			existing: &ecrpc.PairData{
+				FailTime: now - 10, FailAmtMsat: 5000,
+			},
+			new: &ecrpc.PairData{
+				SuccessTime: now, SuccessAmtMsat: 8000,
+			},
+			expected: &ecrpc.PairData{
+				SuccessTime: now, SuccessAmtMsat: 8000,
+				SuccessAmtSat: 8, FailTime: now - 10,
+				FailAmtMsat: 8001, FailAmtSat: 8,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mergeLatestPairData(tc.existing, tc.new)
+			require.Equal(t, tc.expected, tc.existing)
+		})
+	}
+}
+
+// TestAggregationExperiment tests that registered pairs are aggregated by the
+// experimental policy into its own bucket and compared with the primary
+// aggregation.
+func TestAggregationExperiment(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	// The comparison is rejected while no experiment is configured.
+	_, err = admin.CompareAggregationExperiment(
+		ctx, &ecadminrpc.CompareAggregationExperimentRequest{},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	config.Server.ExperimentalAggregationPolicy = "unknown"
+	require.Error(t, server.StartAggregationExperiment())
+
+	config.Server.ExperimentalAggregationPolicy = "latest"
+	require.NoError(t, server.StartAggregationExperiment())
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	now := time.Now().Unix()
+	register := func(failTime, failAmtMsat int64) {
+		_, err := server.RegisterMissionControl(
+			ctx, &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						FailTime:    failTime,
+						FailAmtSat:  failAmtMsat / 1000,
+						FailAmtMsat: failAmtMsat,
+					},
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// Both policies agree on the first failure.
+	register(now-10, 5000)
+	resp, err := admin.CompareAggregationExperiment(
+		ctx, &ecadminrpc.CompareAggregationExperimentRequest{
+			MaxDifferences: 10,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "latest", resp.Policy)
+	require.EqualValues(t, 1, resp.PairsCompared)
+	require.Zero(t, resp.PairsDiffering)
+	require.Empty(t, resp.Differences)
+
+	// A higher failure within the relaxation interval is only adopted by
+	// the experimental policy.
+	register(now, 8000)
+	resp, err = admin.CompareAggregationExperiment(
+		ctx, &ecadminrpc.CompareAggregationExperimentRequest{
+			MaxDifferences: 10,
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.PairsCompared)
+	require.EqualValues(t, 1, resp.PairsDiffering)
+	require.EqualValues(t, 3000, resp.MeanFailAmtDeltaMsat)
+	require.Len(t, resp.Differences, 1)
+	require.Equal(t, nodeFrom, resp.Differences[0].NodeFrom)
+	require.Equal(t, nodeTo, resp.Differences[0].NodeTo)
+	require.EqualValues(t, 5000, resp.Differences[0].PrimaryFailAmtMsat)
+	require.EqualValues(t, 8000, resp.Differences[0].ExperimentFailAmtMsat)
+
+	// Restarting the experiment with another policy discards its results.
+	config.Server.ExperimentalAggregationPolicy = "default"
+	require.NoError(t, server.StartAggregationExperiment())
+	resp, err = admin.CompareAggregationExperiment(
+		ctx, &ecadminrpc.CompareAggregationExperimentRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "default", resp.Policy)
+	require.Zero(t, resp.PairsCompared)
+}
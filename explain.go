@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxExplainedSteps is the maximum number of merges returned when explaining
+// a pair.
+const maxExplainedSteps = 1000
+
+// pairResults returns the last success and failure of the pair data.
+func pairResults(history *ecrpc.PairData) *ecadminrpc.PairResults {
+	return &ecadminrpc.PairResults{
+		FailTime:       history.FailTime,
+		FailAmtMsat:    history.FailAmtMsat,
+		SuccessTime:    history.SuccessTime,
+		SuccessAmtMsat: history.SuccessAmtMsat,
+	}
+}
+
+// explainPair replays the journaled registrations of the pair in the order
+// they were stored through the aggregation and records the rules applied by
+// each merge. Occurrences of the pair within a registration are merged first,
+// like the aggregation does. It returns the merges, oldest first, and the
+// replayed data of the pair, which is nil if the pair was never journaled.
+func explainPair(ctx context.Context, tx *bbolt.Tx, key []byte) (
+	[]*ecadminrpc.MergeStep, *ecrpc.PairData, error) {
+
+	var steps []*ecadminrpc.MergeStep
+	var replayed *ecrpc.PairData
+	c := tx.Bucket([]byte(JournalBucketName)).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		req := &ecrpc.RegisterMissionControlRequest{}
+		if err := proto.Unmarshal(v, req); err != nil {
+			return nil, nil, status.Errorf(codes.DataLoss,
+				"failed to decode journaled registration: %v",
+				err)
+		}
+
+		var occurrences []*ecrpc.PairHistory
+		for _, pair := range req.Pairs {
+			if string(pairKey(pair.NodeFrom, pair.NodeTo)) ==
+				string(key) {
+
+				occurrences = append(occurrences, pair)
+			}
+		}
+		if len(occurrences) == 0 {
+			continue
+		}
+		merged, _ := mergeDuplicatePairs(occurrences)
+		registered := merged[0].History
+
+		step := &ecadminrpc.MergeStep{
+			JournaledAt: int64(binary.BigEndian.Uint64(k[:8])),
+			Submitter:   string(k[journalKeySize:]),
+			Registered:  pairResults(registered),
+		}
+		explain := func(rule ecadminrpc.MergeRule) {
+			step.Rules = append(step.Rules, rule)
+		}
+		if replayed == nil {
+			replayed = proto.Clone(registered).(*ecrpc.PairData)
+			explain(ecadminrpc.
+				MergeRule_MERGE_RULE_FIRST_REGISTRATION)
+		} else {
+			explainMergePairData(replayed, registered, explain)
+		}
+		step.Merged = pairResults(replayed)
+		steps = append(steps, step)
+	}
+
+	return steps, replayed, nil
+}
+
+// ExplainPair explains how the stored data of a pair was derived by replaying
+// its journaled registrations through the aggregation.
+func (a *adminServer) ExplainPair(ctx context.Context,
+	req *ecadminrpc.ExplainPairRequest) (*ecadminrpc.ExplainPairResponse,
+	error) {
+
+	if !a.config.Server.JournalRegistrations {
+		return nil, status.Errorf(codes.FailedPrecondition, "the "+
+			"registration journal is not enabled")
+	}
+	err := validateNodeFilter(req.GetNodeFrom(), req.GetNodeTo())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetNodeFrom()) == 0 || len(req.GetNodeTo()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NodeFrom "+
+			"and NodeTo must be set")
+	}
+
+	key := pairKey(req.NodeFrom, req.NodeTo)
+	resp := &ecadminrpc.ExplainPairResponse{}
+	var replayed *ecrpc.PairData
+	err = a.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		resp.Steps, replayed, err = explainPair(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+
+		v := tx.Bucket([]byte(DatabaseBucketName)).Get(key)
+		if v == nil {
+			return nil
+		}
+		stored, err := decodePairData(v)
+		if err != nil {
+			return status.Errorf(codes.DataLoss, "failed to "+
+				"decode history data: %v", err)
+		}
+		resp.Stored = pairResults(stored)
+
+		return nil
+	})
+	if err != nil {
+		msg := "failed to explain pair: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	if resp.Stored == nil && replayed == nil {
+		return nil, status.Error(codes.NotFound, "the pair is neither "+
+			"stored nor journaled")
+	}
+	if resp.Stored != nil && replayed != nil {
+		resp.Reproduced = proto.Equal(
+			resp.Stored, pairResults(replayed),
+		)
+	}
+	if len(resp.Steps) > maxExplainedSteps {
+		resp.Steps = resp.Steps[len(resp.Steps)-maxExplainedSteps:]
+		resp.Truncated = true
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestExplainPair tests that the merges of the journaled registrations of a
+// pair are explained by the rules they applied.
+func TestExplainPair(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.JournalRegistrations = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	admin.coordinator = server
+
+	nodeA, nodeB := generateTestKeys(t)
+	now := time.Now().Unix()
+	register := func(addr string, history *ecrpc.PairData) {
+		_, err := server.RegisterMissionControl(
+			submitterContext(addr),
+			&ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeB,
+					History:  history,
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+	register("203.0.113.1", &ecrpc.PairData{
+		SuccessTime:    now - 300,
+		SuccessAmtSat:  5000,
+		SuccessAmtMsat: 5_000_000,
+	})
+	register("203.0.113.2", &ecrpc.PairData{
+		SuccessTime:    now - 200,
+		SuccessAmtSat:  3000,
+		SuccessAmtMsat: 3_000_000,
+	})
+	register("203.0.113.1", &ecrpc.PairData{
+		FailTime:    now - 100,
+		FailAmtSat:  4000,
+		FailAmtMsat: 4_000_000,
+	})
+	register("203.0.113.2", &ecrpc.PairData{
+		FailTime:    now - 50,
+		FailAmtSat:  6000,
+		FailAmtMsat: 6_000_000,
+	})
+
+	resp, err := admin.ExplainPair(
+		context.Background(), &ecadminrpc.ExplainPairRequest{
+			NodeFrom: nodeA,
+			NodeTo:   nodeB,
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, resp.Reproduced)
+	require.False(t, resp.Truncated)
+	require.Equal(t, now-100, resp.Stored.FailTime)
+	require.EqualValues(t, 4_000_000, resp.Stored.FailAmtMsat)
+	require.EqualValues(t, 3_999_999, resp.Stored.SuccessAmtMsat)
+
+	submitters := make([]string, 0, len(resp.Steps))
+	rules := make([][]ecadminrpc.MergeRule, 0, len(resp.Steps))
+	for _, step := range resp.Steps {
+		submitters = append(submitters, step.Submitter)
+		rules = append(rules, step.Rules)
+	}
+	require.Equal(t, []string{
+		"203.0.113.1", "203.0.113.2", "203.0.113.1", "203.0.113.2",
+	}, submitters)
+	require.Equal(t, [][]ecadminrpc.MergeRule{{
+		ecadminrpc.MergeRule_MERGE_RULE_FIRST_REGISTRATION,
+	}, {
+		ecadminrpc.MergeRule_MERGE_RULE_SUCCESS_AMOUNT_RETAINED,
+	}, {
+		ecadminrpc.MergeRule_MERGE_RULE_NEWER_FAILURE,
+		ecadminrpc.MergeRule_MERGE_RULE_SUCCESS_RANGE_LOWERED,
+	}, {
+		ecadminrpc.MergeRule_MERGE_RULE_FAILURE_RELAXATION,
+	}}, rules)
+	require.EqualValues(t, 5_000_000, resp.Steps[1].Merged.SuccessAmtMsat)
+
+	// Pairs neither stored nor journaled cannot be explained.
+	_, err = admin.ExplainPair(
+		context.Background(), &ecadminrpc.ExplainPairRequest{
+			NodeFrom: nodeB,
+			NodeTo:   nodeA,
+		},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = admin.ExplainPair(
+		context.Background(), &ecadminrpc.ExplainPairRequest{
+			NodeFrom: nodeA,
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	config.Server.JournalRegistrations = false
+	_, err = admin.ExplainPair(
+		context.Background(), &ecadminrpc.ExplainPairRequest{
+			NodeFrom: nodeA,
+			NodeTo:   nodeB,
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// lndMarshalOptions are the options the REST API of LND renders its messages
+// with.
+var lndMarshalOptions = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// restExportMarshaler renders exports like the REST API of LND renders its
+// messages, regardless of the REST options of the coordinator, so that they
+// can be posted to LND as is. Any other value is rendered by the wrapped
+// marshaler.
+type restExportMarshaler struct {
+	runtime.Marshaler
+}
+
+// Marshal renders exports in the JSON of LND and any other value with the
+// wrapped marshaler.
+func (m *restExportMarshaler) Marshal(v any) ([]byte, error) {
+	if export, ok := v.(*ecrpc.ExportMissionControlResponse); ok {
+		return lndMarshalOptions.Marshal(export)
+	}
+
+	return m.Marshaler.Marshal(v)
+}
+
+// lndPairHistory converts the history of a pair to the PairHistory of the
+// router service of LND, leaving out the fields LND does not know.
+func lndPairHistory(pair *ecrpc.PairHistory) *ecrpc.LNDPairHistory {
+	history := pair.GetHistory()
+
+	return &ecrpc.LNDPairHistory{
+		NodeFrom: pair.NodeFrom,
+		NodeTo:   pair.NodeTo,
+		History: &ecrpc.LNDPairData{
+			FailTime:       history.GetFailTime(),
+			FailAmtSat:     history.GetFailAmtSat(),
+			FailAmtMsat:    history.GetFailAmtMsat(),
+			SuccessTime:    history.GetSuccessTime(),
+			SuccessAmtSat:  history.GetSuccessAmtSat(),
+			SuccessAmtMsat: history.GetSuccessAmtMsat(),
+		},
+	}
+}
+
+// ExportMissionControl exports all pairs in the shape of the
+// XImportMissionControlRequest of the router service of LND. The pairs are
+// filtered, transformed and accounted like the ones of a query.
+func (s *externalCoordinatorServer) ExportMissionControl(ctx context.Context,
+	req *ecrpc.ExportMissionControlRequest) (
+	*ecrpc.ExportMissionControlResponse, error) {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return nil, err
+	}
+
+	// Reject clients which already reached their daily egress cap.
+	client := clientIdentity(ctx)
+	if err := s.checkEgressCap(ctx, client); err != nil {
+		logrus.Infof("Export rejected: %v", err)
+		return nil, err
+	}
+
+	changes, err := s.collectPairChanges(ctx, allPairs)
+	if err != nil {
+		msg := "export failed: %v"
+		st := status.Convert(err)
+		logrus.Errorf(msg, st.Message())
+		return nil, status.Errorf(st.Code(), msg, st.Message())
+	}
+
+	resp := &ecrpc.ExportMissionControlResponse{
+		Pairs: make([]*ecrpc.LNDPairHistory, 0, len(changes.Pairs)),
+		Force: req.GetForce(),
+	}
+	for _, pair := range changes.Pairs {
+		resp.Pairs = append(resp.Pairs, lndPairHistory(pair))
+	}
+	s.recordEgress(client, int64(proto.Size(resp)))
+	logrus.Infof("Exported %d pairs", len(resp.Pairs))
+
+	return resp, nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestExportMissionControl tests that the pairs are exported in the shape LND
+// imports them in.
+func TestExportMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	ctx := context.Background()
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	now := time.Now().Unix()
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					FailTime:            now,
+					FailAmtSat:          5,
+					FailAmtMsat:         5000,
+					SuccessTime:         now - 10,
+					SuccessAmtSat:       2,
+					SuccessAmtMsat:      2000,
+					ResolutionLatencyMs: 100,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	resp, err := server.ExportMissionControl(
+		ctx, &ecrpc.ExportMissionControlRequest{Force: true},
+	)
+	require.NoError(t, err)
+	require.True(t, resp.Force)
+	require.Len(t, resp.Pairs, 1)
+	require.Equal(t, nodeFrom, resp.Pairs[0].NodeFrom)
+	require.Equal(t, nodeTo, resp.Pairs[0].NodeTo)
+	require.True(t, proto.Equal(&ecrpc.LNDPairData{
+		FailTime:       now,
+		FailAmtSat:     5,
+		FailAmtMsat:    5000,
+		SuccessTime:    now - 10,
+		SuccessAmtSat:  2,
+		SuccessAmtMsat: 2000,
+	}, resp.Pairs[0].History))
+
+	// The messages share the field numbers of LND, so that the binary
+	// encoding is imported as well.
+	numbers := map[protoreflect.FullName]protoreflect.FieldNumber{
+		"ecrpc.LNDPairHistory.node_from":     1,
+		"ecrpc.LNDPairHistory.node_to":       2,
+		"ecrpc.LNDPairHistory.history":       7,
+		"ecrpc.LNDPairData.fail_time":        1,
+		"ecrpc.LNDPairData.fail_amt_sat":     2,
+		"ecrpc.LNDPairData.fail_amt_msat":    4,
+		"ecrpc.LNDPairData.success_time":     5,
+		"ecrpc.LNDPairData.success_amt_sat":  6,
+		"ecrpc.LNDPairData.success_amt_msat": 7,
+	}
+	for _, msg := range []protoreflect.MessageDescriptor{
+		(&ecrpc.LNDPairHistory{}).ProtoReflect().Descriptor(),
+		(&ecrpc.LNDPairData{}).ProtoReflect().Descriptor(),
+	} {
+		fields := msg.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			number := numbers[field.FullName()]
+			require.Equal(t, number, field.Number())
+		}
+	}
+
+	// Exports are withheld by coordinators publishing statistics only.
+	config.Server.StatsOnly = true
+	_, err = server.ExportMissionControl(
+		ctx, &ecrpc.ExportMissionControlRequest{},
+	)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestRESTExportMarshaler tests that exports are rendered like LND renders its
+// messages regardless of the REST options, while other values are not.
+func TestRESTExportMarshaler(t *testing.T) {
+	base, err := newRESTMarshaler(&ServerConfig{
+		RESTAmountUnits:       restAmountsMsat,
+		RESTRFC3339Timestamps: true,
+	})
+	require.NoError(t, err)
+	marshaler := &restExportMarshaler{Marshaler: base}
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	data, err := marshaler.Marshal(&ecrpc.ExportMissionControlResponse{
+		Pairs: []*ecrpc.LNDPairHistory{{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.LNDPairData{
+				SuccessTime:    100,
+				SuccessAmtSat:  2,
+				SuccessAmtMsat: 2000,
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	var export struct {
+		Pairs []struct {
+			NodeFrom []byte         `json:"node_from"`
+			NodeTo   []byte         `json:"node_to"`
+			History  map[string]any `json:"history"`
+		} `json:"pairs"`
+		Force *bool `json:"force"`
+	}
+	require.NoError(t, json.Unmarshal(data, &export))
+	require.NotNil(t, export.Force)
+	require.Len(t, export.Pairs, 1)
+	require.Equal(t, nodeFrom, export.Pairs[0].NodeFrom)
+	require.Equal(t, nodeTo, export.Pairs[0].NodeTo)
+	require.Equal(t, map[string]any{
+		"fail_time":        "0",
+		"fail_amt_sat":     "0",
+		"fail_amt_msat":    "0",
+		"success_time":     "100",
+		"success_amt_sat":  "2",
+		"success_amt_msat": "2000",
+	}, export.Pairs[0].History)
+
+	// Other values are still rendered in the configured units.
+	data, err = marshaler.Marshal(&ecrpc.PairData{
+		SuccessAmtSat:  2,
+		SuccessAmtMsat: 2000,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"successAmtMsat":"2000"}`, string(data))
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	btcec "github.com/btcsuite/btcd/btcec/v2"
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nodeGroupMembers is the set of compressed pubkeys belonging to a node group.
+type nodeGroupMembers map[[PubKeyCompressedSize]byte]struct{}
+
+// contains returns true if the given compressed pubkey is a member of the
+// group.
+func (m nodeGroupMembers) contains(node []byte) bool {
+	_, ok := m[[PubKeyCompressedSize]byte(node)]
+	return ok
+}
+
+// SetNodeGroup creates a named node group or replaces the members of an
+// existing one.
+func (s *adminServer) SetNodeGroup(ctx context.Context,
+	req *ecadminrpc.SetNodeGroupRequest) (*ecadminrpc.SetNodeGroupResponse, error) {
+	// Validate the request data first.
+	if req == nil || req.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group "+
+			"cannot be nil")
+	}
+	if err := validateNodeGroup(req.Group); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		groups := tx.Bucket([]byte(NodeGroupsBucketName))
+
+		// Remove the existing group if any so that its members are
+		// replaced rather than extended.
+		name := []byte(req.Group.Name)
+		err := groups.DeleteBucket(name)
+		if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return err
+		}
+
+		group, err := groups.CreateBucket(name)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range req.Group.Nodes {
+			if err := group.Put(node, []byte{}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		msg := "failed to store node group: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Node group %q stored with %d nodes", req.Group.Name,
+		len(req.Group.Nodes))
+
+	return &ecadminrpc.SetNodeGroupResponse{}, nil
+}
+
+// DeleteNodeGroup deletes a named node group.
+func (s *adminServer) DeleteNodeGroup(ctx context.Context,
+	req *ecadminrpc.DeleteNodeGroupRequest) (*ecadminrpc.DeleteNodeGroupResponse, error) {
+	if err := validateNodeGroupName(req.GetName()); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		groups := tx.Bucket([]byte(NodeGroupsBucketName))
+		return groups.DeleteBucket([]byte(req.Name))
+	})
+	switch {
+	case errors.Is(err, bbolt.ErrBucketNotFound):
+		return nil, status.Errorf(codes.NotFound, "node group %q not "+
+			"found", req.Name)
+
+	case err != nil:
+		msg := "failed to delete node group: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Node group %q deleted", req.Name)
+
+	return &ecadminrpc.DeleteNodeGroupResponse{}, nil
+}
+
+// ListNodeGroups lists all named node groups and their members.
+func (s *adminServer) ListNodeGroups(ctx context.Context,
+	req *ecadminrpc.ListNodeGroupsRequest) (*ecadminrpc.ListNodeGroupsResponse, error) {
+	var groups []*ecadminrpc.NodeGroup
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(NodeGroupsBucketName))
+
+		// Every group is stored as a nested bucket, so only keys with
+		// a nil value are of interest here.
+		return b.ForEach(func(k, v []byte) error {
+			if v != nil {
+				return nil
+			}
+
+			group := &ecadminrpc.NodeGroup{Name: string(k)}
+			err := b.Bucket(k).ForEach(func(node, _ []byte) error {
+				group.Nodes = append(
+					group.Nodes, append([]byte{}, node...),
+				)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			groups = append(groups, group)
+
+			return nil
+		})
+	})
+	if err != nil {
+		msg := "failed to list node groups: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return &ecadminrpc.ListNodeGroupsResponse{Groups: groups}, nil
+}
+
+// loadNodeGroup loads the members of the named node group within the given
+// transaction. It returns a NotFound status error if the group does not exist.
+func loadNodeGroup(tx *bbolt.Tx, name string) (nodeGroupMembers, error) {
+	groups := tx.Bucket([]byte(NodeGroupsBucketName))
+	group := groups.Bucket([]byte(name))
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "node group %q not "+
+			"found", name)
+	}
+
+	members := make(nodeGroupMembers)
+	err := group.ForEach(func(node, _ []byte) error {
+		members[[PubKeyCompressedSize]byte(node)] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// validateNodeGroup checks the integrity and correctness of a node group.
+func validateNodeGroup(group *ecadminrpc.NodeGroup) error {
+	if err := validateNodeGroupName(group.Name); err != nil {
+		return err
+	}
+
+	if len(group.Nodes) == 0 {
+		return status.Errorf(codes.InvalidArgument, "group must "+
+			"include at least one node")
+	}
+
+	for _, node := range group.Nodes {
+		// Validate that the node is exactly 33 bytes i.e compressed
+		// sec pub key.
+		if len(node) != PubKeyCompressedSize {
+			return status.Errorf(codes.InvalidArgument, "node "+
+				"must be exactly %d bytes", PubKeyCompressedSize)
+		}
+
+		// Validate the node public key.
+		if _, err := btcec.ParsePubKey(node); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid "+
+				"node public key %s: %v", hex.EncodeToString(node),
+				err)
+		}
+	}
+
+	return nil
+}
+
+// validateNodeGroupName checks that a node group name is non-empty and does not
+// exceed the maximum allowed length.
+func validateNodeGroupName(name string) error {
+	if name == "" {
+		return status.Errorf(codes.InvalidArgument, "group name "+
+			"cannot be empty")
+	}
+
+	if len(name) > MaxNodeGroupNameLength {
+		return status.Errorf(codes.InvalidArgument, "group name must "+
+			"not exceed %d bytes", MaxNodeGroupNameLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestNodeGroups tests the node group RPCs of the ExternalCoordinatorAdmin
+// server and group scoped queries of the ExternalCoordinatorServer.
+func TestNodeGroups(t *testing.T) {
+	tempDir := t.TempDir()
+
+	config := &Config{
+		Server: ServerConfig{
+			HistoryThresholdDuration:     10 * time.Minute,
+			QueryMissionControlBatchSize: 100,
+		},
+		Database: DatabaseConfig{
+			DatabaseDirPath: tempDir,
+			DatabaseFile:    "test.db",
+			FileLockTimeout: 10 * time.Second,
+			MaxBatchDelay:   time.Nanosecond,
+			MaxBatchSize:    1000,
+		},
+	}
+
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	// Register two unrelated pairs.
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	now := time.Now().Unix()
+	history := func() *ecrpc.PairData {
+		return &ecrpc.PairData{
+			SuccessTime:    now,
+			SuccessAmtSat:  1,
+			SuccessAmtMsat: 1000,
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				{NodeFrom: nodeA, NodeTo: nodeB, History: history()},
+				{NodeFrom: nodeC, NodeTo: nodeD, History: history()},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	t.Run("SetNodeGroup", func(t *testing.T) {
+		// Case 1: Nil group.
+		_, err := admin.SetNodeGroup(
+			ctx, &ecadminrpc.SetNodeGroupRequest{},
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Case 2: Empty name.
+		_, err = admin.SetNodeGroup(ctx, &ecadminrpc.SetNodeGroupRequest{
+			Group: &ecadminrpc.NodeGroup{Nodes: [][]byte{nodeA}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Case 3: Invalid node pubkey.
+		_, err = admin.SetNodeGroup(ctx, &ecadminrpc.SetNodeGroupRequest{
+			Group: &ecadminrpc.NodeGroup{
+				Name:  "fleet",
+				Nodes: [][]byte{{0x01, 0x02}},
+			},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Case 4: Valid group, replaced by a second call.
+		_, err = admin.SetNodeGroup(ctx, &ecadminrpc.SetNodeGroupRequest{
+			Group: &ecadminrpc.NodeGroup{
+				Name:  "fleet",
+				Nodes: [][]byte{nodeA, nodeC},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = admin.SetNodeGroup(ctx, &ecadminrpc.SetNodeGroupRequest{
+			Group: &ecadminrpc.NodeGroup{
+				Name:  "fleet",
+				Nodes: [][]byte{nodeB},
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err := admin.ListNodeGroups(
+			ctx, &ecadminrpc.ListNodeGroupsRequest{},
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.Groups, 1)
+		require.Equal(t, "fleet", resp.Groups[0].Name)
+		require.Equal(t, [][]byte{nodeB}, resp.Groups[0].Nodes)
+	})
+
+	t.Run("GroupScopedQuery", func(t *testing.T) {
+		// Only the pair involving nodeB is expected to be returned.
+		mockStream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				Group: "fleet",
+			}, mockStream,
+		)
+		require.NoError(t, err)
+		require.Len(t, mockStream.Responses, 1)
+		require.Len(t, mockStream.Responses[0].Pairs, 1)
+		require.Equal(t, nodeA, mockStream.Responses[0].Pairs[0].NodeFrom)
+		require.Equal(t, nodeB, mockStream.Responses[0].Pairs[0].NodeTo)
+
+		// Querying an unknown group must fail with NotFound.
+		err = server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				Group: "unknown",
+			}, &mockQueryAggregatedMissionControlServer{},
+		)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("DeleteNodeGroup", func(t *testing.T) {
+		_, err := admin.DeleteNodeGroup(
+			ctx, &ecadminrpc.DeleteNodeGroupRequest{Name: "fleet"},
+		)
+		require.NoError(t, err)
+
+		// Deleting the group a second time must fail with NotFound.
+		_, err = admin.DeleteNodeGroup(
+			ctx, &ecadminrpc.DeleteNodeGroupRequest{Name: "fleet"},
+		)
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		resp, err := admin.ListNodeGroups(
+			ctx, &ecadminrpc.ListNodeGroupsRequest{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, resp.Groups)
+	})
+}
@@ -4,17 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sync/atomic"
 	"time"
 
-	btcec "github.com/btcsuite/btcd/btcec/v2"
 	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecerrors "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecerrors"
 	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
 	bbolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -38,33 +41,228 @@ type externalCoordinatorServer struct {
 	ecrpc.UnimplementedExternalCoordinatorServer
 	config *Config
 	db     *bbolt.DB
+
+	// registrations tracks the registrations currently being processed to
+	// derive submission hints for clients.
+	registrations loadTracker
+
+	// writeQueue applies registered pairs asynchronously if async writes
+	// are enabled, nil otherwise.
+	writeQueue *writeQueue
+
+	// shadow mirrors registrations to a secondary coordinator if
+	// configured, nil otherwise.
+	shadow *shadowMirror
+
+	// snapshots ships snapshots to a warm standby coordinator if
+	// configured, nil otherwise.
+	snapshots *snapshotShipper
+
+	// relay relays the registered pairs to an upstream coordinator if
+	// configured, nil otherwise.
+	relay *submissionRelay
+
+	// experiment is the experimental aggregation policy run side by side
+	// with the primary one if configured, nil otherwise.
+	experiment aggregationPolicy
+
+	// conflicts resolves conflicting reports of pairs if configured, nil
+	// otherwise.
+	conflicts *conflictResolver
+
+	// transforms runs the hooks of the operator transform script if
+	// configured, nil otherwise.
+	transforms *pairTransformer
+
+	// staleSubmitters removes the pairs contributed solely by stale
+	// submitters if configured, nil otherwise.
+	staleSubmitters *staleSubmitterPruner
+
+	// shiftLimiter caps how far a single submitter shifts the amounts of
+	// a pair within a window if configured, nil otherwise.
+	shiftLimiter *shiftLimiter
+
+	// authz asks the external authorization policy whether requests are
+	// allowed if configured, nil otherwise.
+	authz *authzHook
+
+	// watermarks embeds the watermark of their recipient in the pairs
+	// served if configured, nil otherwise.
+	watermarks *watermarker
+
+	// subscriptions is cancelled by endSubscriptions to end all
+	// subscriptions when the coordinator shuts down.
+	subscriptions    context.Context
+	endSubscriptions context.CancelFunc
+
+	// notifications delivers operator notifications once started, nil
+	// otherwise.
+	notifications *notificationDispatcher
+
+	// capacity is the latest forecast of when the database runs out of
+	// space, nil until enough growth was sampled.
+	capacity atomic.Pointer[ecrpc.CapacityForecast]
+
+	// cleanupFailures counts the consecutive failures of the cleanup
+	// routine.
+	cleanupFailures atomic.Int64
+
+	// lastCleanup is the unix time the cleanup routine last ran at, zero
+	// if it has not run yet.
+	lastCleanup atomic.Int64
+
+	// startTime is the time the coordinator was started at.
+	startTime time.Time
+
+	// egress accounts the bytes of query responses served to each client.
+	egress *egressTracker
+
+	// talkers accounts the volumes of the requests of each client over the
+	// last 24 hours.
+	talkers *talkerTracker
+
+	// streams tracks the streaming RPCs currently being served.
+	streams *streamTracker
+
+	// clock tells the time the staleness of history data is judged by.
+	clock clock
+
+	// startup tracks the recovery steps run on startup.
+	startup startupProgress
 }
 
 // NewExternalCoordinatorServer creates a new instance of
 // ExternalCoordinatorServer.
 func NewExternalCoordinatorServer(config *Config,
 	db *bbolt.DB) *externalCoordinatorServer {
-	return &externalCoordinatorServer{db: db, config: config}
+	subscriptions, endSubscriptions := context.WithCancel(
+		context.Background(),
+	)
+
+	return &externalCoordinatorServer{
+		db:               db,
+		config:           config,
+		egress:           newEgressTracker(),
+		talkers:          newTalkerTracker(),
+		streams:          newStreamTracker(),
+		clock:            systemClock{},
+		startTime:        time.Now(),
+		subscriptions:    subscriptions,
+		endSubscriptions: endSubscriptions,
+	}
 }
 
 // RegisterMissionControl registers mission control data. It processes a
 // RegisterMissionControlRequest to aggregate user-provided pair data with
 // existing data in the database, removing stale history pairs and storing the
 // aggregated data. This method ensures data consistency and enhances
-// performance by utilizing batch operations over individual updates.
+// performance by utilizing batch operations over individual updates. If async
+// writes are enabled, the pairs are only queued and acknowledged once they are
+// persisted to the write-ahead log.
 func (s *externalCoordinatorServer) RegisterMissionControl(ctx context.Context,
 	req *ecrpc.RegisterMissionControlRequest) (*ecrpc.RegisterMissionControlResponse, error) {
+	// Track the registration for as long as it is being processed.
+	done := s.registrations.start()
+	defer done()
+
+	result, err := s.registerPairs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.registrationResponse(result), nil
+}
+
+// registrationResult holds what a registration did with its pairs.
+type registrationResult struct {
+	// action describes what was done with the registered pairs.
+	action string
+
+	// registered is the number of pairs registered.
+	registered int
+
+	// staleRemoved is the number of stale pairs removed.
+	staleRemoved int
+
+	// duplicatesMerged is the number of duplicate pairs merged into their
+	// first occurrence.
+	duplicatesMerged int
+
+	// unprovenRejected is the number of pairs rejected for not proving
+	// their channel.
+	unprovenRejected int
+
+	// shiftCapped is the number of pairs capped for being shifted too far
+	// by their submitter.
+	shiftCapped int
+
+	// pairResults holds the outcome of each pair of the request.
+	pairResults []*ecrpc.PairResult
+}
+
+// add adds the counts of another registration of the same kind. The outcomes
+// of the pairs are not added, so that registrations of any size are never
+// held in memory at once.
+func (r *registrationResult) add(other *registrationResult) {
+	r.action = other.action
+	r.registered += other.registered
+	r.staleRemoved += other.staleRemoved
+	r.duplicatesMerged += other.duplicatesMerged
+	r.unprovenRejected += other.unprovenRejected
+	r.shiftCapped += other.shiftCapped
+}
+
+// registerPairs validates the request and registers its pairs, removing stale
+// history pairs and storing the aggregated data. It returns what it did with
+// the pairs.
+func (s *externalCoordinatorServer) registerPairs(ctx context.Context,
+	req *ecrpc.RegisterMissionControlRequest) (*registrationResult, error) {
+
+	// Warm standby coordinators only store the snapshots of their primary
+	// coordinator until they are promoted.
+	if err := s.checkAcceptsRegistrations(); err != nil {
+		return nil, err
+	}
+
+	// Mirror the registration as received to the shadow coordinator if
+	// configured. Private pairs never leave the coordinator.
+	if s.shadow != nil && req != nil && !req.Private {
+		s.shadow.mirror(req)
+	}
+
 	// Validate the request data first.
 	if err := s.validateRegisterMissionControlRequest(req); err != nil {
 		return nil, err
 	}
 
+	// Private pairs are owned by the owner of the access token.
+	var owner string
+	if req.Private {
+		var err error
+		owner, err = privatePairsOwner(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Log that there is an incoming request with the number of pairs.
 	logrus.Infof("Received RegisterMissionControl request with %d pairs",
 		len(req.Pairs))
 
+	// Track the outcome of each pair through the steps below, each of
+	// which may drop pairs from the request.
+	outcomes := newPairOutcomes(req.Pairs)
+	before := append([]*ecrpc.PairHistory(nil), req.Pairs...)
+	stale := ecrpc.PairOutcome_PAIR_OUTCOME_REJECTED_STALE
+	invalid := ecrpc.PairOutcome_PAIR_OUTCOME_REJECTED_INVALID
+
 	// Sanitize the request data by filtering out pairs with stale history.
 	stalePairsRemoved := s.sanitizeRegisterMissionControlRequest(req)
+	threshold := formatDuration(s.config.Server.HistoryThresholdDuration)
+	outcomes.drop(
+		before, req.Pairs, stale,
+		fmt.Sprintf("history exceeds the threshold of %s", threshold),
+	)
 
 	// Log how many stale history pairs are removed from the request if any.
 	if stalePairsRemoved != 0 {
@@ -72,105 +270,387 @@ func (s *externalCoordinatorServer) RegisterMissionControl(ctx context.Context,
 			stalePairsRemoved)
 	}
 
-	// Initialize a map to aggregate mission control data.
-	aggregatedData := make(
-		map[[PubKeyCompressedSizeDouble]byte]*ecrpc.PairData,
+	// Reject the pairs not proving their channel if proofs are required.
+	before = append(before[:0], req.Pairs...)
+	unprovenPairsRejected, err := s.removeUnprovenPairs(req)
+	if err != nil {
+		return nil, err
+	}
+	if unprovenPairsRejected != 0 {
+		logrus.Infof("Rejected %d pairs without channel proof",
+			unprovenPairsRejected)
+	}
+	outcomes.drop(
+		before, req.Pairs, invalid,
+		"channel proof required",
+	)
+
+	// Let the ingest hook of the operator transform or drop the pairs.
+	before = append(before[:0], req.Pairs...)
+	req.Pairs, err = s.transforms.transformIngested(req.Pairs)
+	if err != nil {
+		return nil, err
+	}
+	if dropped := len(before) - len(req.Pairs); dropped != 0 {
+		logrus.Infof("Ingest hook dropped %d pairs", dropped)
+	}
+	outcomes.replace(
+		before, req.Pairs, invalid,
+		"dropped by the ingest hook",
 	)
 
+	// Pairs occurring multiple times in the request are merged before they
+	// are aggregated, so report how many duplicates there are.
+	duplicatesMerged := countDuplicatePairs(req.Pairs)
+	if duplicatesMerged != 0 {
+		logrus.Infof("Merging %d duplicate pairs", duplicatesMerged)
+	}
+	outcomes.merge(req.Pairs)
+
+	// Cap how far the submitter shifts the stored amounts of each public
+	// pair to slow down gradual poisoning if configured.
+	submitter := submitterIdentity(ctx)
+	shiftCapped := 0
+	if s.shiftLimiter != nil && !req.Private {
+		before = append(before[:0], req.Pairs...)
+		shiftCapped, err = s.capSubmitterShifts(submitter, req.Pairs)
+		if err != nil {
+			msg := "failed to cap submitter shifts: %v"
+			logrus.Errorf(msg, err)
+			return nil, status.Errorf(
+				storageErrorCode(err), msg, err,
+			)
+		}
+		if shiftCapped != 0 {
+			logrus.Warnf("Capped the amounts of %d pairs shifted "+
+				"too far by submitter %s", shiftCapped,
+				submitter)
+		}
+		outcomes.alter(
+			before, req.Pairs, "amounts capped, shifted too far "+
+				"by the submitter",
+		)
+	}
+
+	// Either queue the pairs to be applied asynchronously or store them
+	// right away. Private pairs are always stored right away, since the
+	// write-ahead log only holds public pairs.
+	action := "registered"
+	if req.Private {
+		if err := s.storePrivatePairs(owner, req.Pairs); err != nil {
+			return nil, err
+		}
+		action = "privately registered"
+	} else if s.writeQueue != nil {
+		err := s.writeQueue.enqueue(submitter, req.Pairs)
+		if err != nil {
+			return nil, err
+		}
+		action = "queued"
+	} else {
+		err := s.storeMissionControlPairs(submitter, req.Pairs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Track the submitter of the public pairs to prune them once it is
+	// stale. The pairs are stored already, so failures are only logged.
+	if s.staleSubmitters != nil && !req.Private && len(req.Pairs) > 0 {
+		err := s.recordSubmitter(submitter, req.Pairs)
+		if err != nil {
+			logrus.Errorf("failed to record submitter: %v", err)
+		}
+	}
+
+	return &registrationResult{
+		action:           action,
+		registered:       len(req.Pairs),
+		staleRemoved:     stalePairsRemoved,
+		duplicatesMerged: duplicatesMerged,
+		unprovenRejected: unprovenPairsRejected,
+		shiftCapped:      shiftCapped,
+		pairResults:      outcomes.results,
+	}, nil
+}
+
+// registrationResponse builds the response of a registration from what it did
+// with its pairs.
+func (s *externalCoordinatorServer) registrationResponse(
+	result *registrationResult) *ecrpc.RegisterMissionControlResponse {
+
+	// Construct the registration success message indicating the number of
+	// pairs registered.
+	successMessage := fmt.Sprintf("Successfully %s %d pairs",
+		result.action, result.registered)
+
+	// If there are stale pairs already removed update the registration
+	// success message to include the number of pairs removed.
+	if result.staleRemoved > 0 {
+		successMessage = fmt.Sprintf("%s and removed %d stale pairs",
+			successMessage, result.staleRemoved)
+	}
+
+	// If duplicate pairs were merged, update the registration success
+	// message to include their number.
+	if result.duplicatesMerged > 0 {
+		successMessage = fmt.Sprintf("%s and merged %d duplicate pairs",
+			successMessage, result.duplicatesMerged)
+	}
+
+	// If pairs without channel proof were rejected, update the
+	// registration success message to include their number.
+	if result.unprovenRejected > 0 {
+		successMessage = fmt.Sprintf("%s and rejected %d pairs without "+
+			"channel proof", successMessage,
+			result.unprovenRejected)
+	}
+
+	// If pairs shifted too far by the submitter were capped, update the
+	// registration success message to include their number.
+	if result.shiftCapped > 0 {
+		successMessage = fmt.Sprintf("%s and capped %d pairs shifted "+
+			"too far", successMessage, result.shiftCapped)
+	}
+
+	// Construct RegisterMissionControlResponse with the success message,
+	// the number of duplicates merged, the submission hints and the
+	// outcome of each pair.
+	return &ecrpc.RegisterMissionControlResponse{
+		SuccessMessage:        successMessage,
+		Hints:                 s.submissionHints(),
+		DuplicatesMerged:      uint32(result.duplicatesMerged),
+		UnprovenPairsRejected: uint32(result.unprovenRejected),
+		PairResults:           result.pairResults,
+	}
+}
+
+// storeMissionControlPairs aggregates the given pairs of the submitter with the
+// existing data in the database and stores the aggregated data. The aggregation
+// is aborted once the configured execution timeout elapses.
+//
+// The pairs are aggregated within a batch transaction, which bbolt may run
+// more than once if another function of the same batch fails. The aggregation
+// therefore never modifies the given pairs, so that a retried run yields the
+// same result as a single one.
+func (s *externalCoordinatorServer) storeMissionControlPairs(submitter string,
+	pairs []*ecrpc.PairHistory) error {
+	start := time.Now()
+	ctx, cancel := s.operationContext(context.Background())
+	defer cancel()
+
 	// Use Batch over Update to reduce tx commits overhead and database
 	// locking, enhancing performance and responsiveness under high write
 	// loads.
+	scanned := 0
+	var evicted [][]byte
 	err := s.db.Batch(func(tx *bbolt.Tx) error {
-		b := tx.Bucket([]byte(DatabaseBucketName))
-
-		// Retrieve all data from the database in order to aggregate
-		// them later with user registered data.
-		err := b.ForEach(func(k, v []byte) error {
-			// Unmarshal the pair history data.
-			history := &ecrpc.PairData{}
-			if err := json.Unmarshal(v, history); err != nil {
-				msg := "failed to unmarshal history data: %v"
-				logrus.Errorf(msg, err)
-				return status.Errorf(codes.Internal, msg, err)
-			}
-
-			aggregatedData[[PubKeyCompressedSizeDouble]byte(k)] = history
-
-			return nil
-		})
+		var err error
+		scanned, err = aggregatePairs(ctx, tx, pairs, s.conflicts)
 		if err != nil {
-			msg := "error while retrieving all data in the " +
-				"bucket to aggregate them with user " +
-				"registered data: %v"
-			logrus.Errorf(msg, err)
-			return status.Errorf(codes.Internal, msg, err)
+			return err
 		}
 
-		// Aggregate all data in the database with user registered data.
-		for _, pair := range req.Pairs {
-			// Aggregate the data based on the key.
-			key := [PubKeyCompressedSizeDouble]byte(
-				append(pair.NodeFrom, pair.NodeTo...),
+		// Journal the pairs as received to be able to recompute them
+		// once the aggregation rules change.
+		if s.config.Server.JournalRegistrations {
+			err := appendJournal(
+				tx, s.clock.Now(),
+				s.journaledSubmitter(submitter), pairs,
 			)
-
-			if existingData, ok := aggregatedData[key]; ok {
-				// If data for the key exists, merge it with
-				// the current data.
-				mergePairData(existingData, pair.History)
-			} else {
-				// If no data exists for the key, set it.
-				aggregatedData[key] = pair.History
+			if err != nil {
+				return err
 			}
 		}
 
-		// Store the aggregated data.
-		for key, value := range aggregatedData {
-			// Marshal the pair history data.
-			data, err := json.Marshal(value)
-			if err != nil {
-				msg := "failed to unmarshal history data: %v"
-				logrus.Errorf(msg, err)
-				return status.Errorf(codes.Internal, msg, err)
-			}
+		// Evict the stalest pairs of the source nodes exceeding the
+		// maximum number of pairs to bound dataset poisoning.
+		evicted, err = evictExcessPairs(
+			tx, pairs, s.config.Server.MaxPairsPerNode,
+		)
+		if err != nil {
+			return err
+		}
 
-			// Store the aggregated data point in the database.
-			if err := b.Put([]byte(key[:]), data); err != nil {
-				msg := "failed to store data in the bucket: %v"
-				logrus.Errorf(msg, err)
-				return status.Errorf(codes.Internal, msg, err)
+		// Aggregate the pairs with the experimental policy as well. The
+		// experiment must never fail the registration itself, so
+		// failures are only logged. It aggregates copies of the pairs
+		// since it modifies them in place.
+		if s.experiment != nil {
+			err := applyAggregationExperiment(
+				tx.Bucket([]byte(AggregationExperimentBucketName)),
+				clonePairs(pairs), s.experiment,
+			)
+			if err != nil {
+				logrus.Errorf("failed to apply aggregation "+
+					"experiment: %v", err)
 			}
 		}
 
 		// Log how many pairs are processed and stored.
 		logrus.Infof("%d pairs were processed and stored successfully",
-			len(req.Pairs))
+			len(pairs))
 
 		return nil
 	})
+	s.observeOperation(operationRegister, start, scanned)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		msg := "registration exceeded the execution timeout of %v"
+		logrus.Errorf(msg, s.config.Server.OperationTimeout)
+		return status.Errorf(codes.DeadlineExceeded, msg,
+			s.config.Server.OperationTimeout)
+	}
 	if err != nil {
 		msg := "batch operation failed: %v"
 		logrus.Errorf(msg, err)
-		return nil, status.Errorf(codes.Internal, msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
 	}
 
-	// Construct the registration success message indicating the number of
-	// pairs registered.
-	successMessage := fmt.Sprintf("Successfully registered %d pairs",
-		len(req.Pairs))
+	if len(evicted) > 0 {
+		logrus.Infof("%d pairs were evicted since their source nodes "+
+			"exceed %d pairs", len(evicted),
+			s.config.Server.MaxPairsPerNode)
+		evictedPairs.Add(float64(len(evicted)))
+	}
 
-	// If there are stale pairs already removed update the registration
-	// success message to include the number of pairs removed.
-	if stalePairsRemoved > 0 {
-		successMessage = fmt.Sprintf("%s and removed %d stale pairs",
-			successMessage, stalePairsRemoved)
+	// Ship the changed and evicted pairs with the next snapshot to the
+	// standby coordinator if configured. The changed pairs are relayed to
+	// the upstream coordinator as well.
+	for _, pair := range pairs {
+		key := pairKey(pair.NodeFrom, pair.NodeTo)
+		s.snapshots.markDirty(key)
+		s.relay.markDirty(key)
+	}
+	s.snapshots.markDirty(evicted...)
+
+	return nil
+}
+
+// aggregatePairs aggregates the given pairs into the mission control bucket
+// with one read-modify-write operation per pair. Duplicate pairs are merged
+// deterministically first. Conflicting reports are resolved by the given
+// resolver, or the last report wins if it is nil. The given pairs are never
+// modified, so the function can safely be run again after its transaction was
+// rolled back. It returns the number of keys read.
+func aggregatePairs(ctx context.Context, tx *bbolt.Tx,
+	pairs []*ecrpc.PairHistory, conflicts *conflictResolver) (int, error) {
+	b := tx.Bucket([]byte(DatabaseBucketName))
+	latencyBucket := tx.Bucket([]byte(LatencySamplesBucketName))
+	observationsBucket := tx.Bucket([]byte(PairObservationsBucketName))
+
+	merged, latencies := mergeDuplicatePairs(pairs)
+	for i, pair := range merged {
+		// Abort runaway registrations once the timeout elapsed.
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+
+		key := pairKey(pair.NodeFrom, pair.NodeTo)
+		history := proto.Clone(pair.History).(*ecrpc.PairData)
+
+		// Read the stored data of the pair, if any.
+		var existingData *ecrpc.PairData
+		if v := b.Get(key); v != nil {
+			var err error
+			existingData, err = decodePairData(v)
+			if err != nil {
+				msg := "failed to decode history data: %v"
+				logrus.Errorf(msg, err)
+				return i + 1, status.Errorf(
+					codes.DataLoss, msg, err,
+				)
+			}
+		}
+
+		// Remember the results stored before the merge, which the
+		// new ones may conflict with.
+		var previous []pairObservation
+		if existingData != nil {
+			previous = reportedObservations(existingData)
+		}
+
+		if existingData != nil {
+			// If data for the key exists, merge it with the
+			// current data and update its failure streak based on
+			// the previous result times.
+			prevFailTime := existingData.FailTime
+			prevSuccessTime := existingData.SuccessTime
+			mergePairData(existingData, history)
+			updateFailureStreak(
+				existingData, prevFailTime, prevSuccessTime,
+			)
+			history = existingData
+		} else {
+			// If no data exists for the key, set it. Submitted
+			// latency percentiles are ignored.
+			history.LatencyP50Ms = 0
+			history.LatencyP95Ms = 0
+			updateFailureStreak(history, 0, 0)
+		}
+
+		// Resolve conflicting reports of the pair if enabled. Without
+		// a resolver the pair is never marked as conflicting.
+		history.ConflictingReports = 0
+		if conflicts != nil {
+			err := conflicts.resolve(
+				observationsBucket, key, previous,
+				pair.History, history,
+			)
+			if err != nil {
+				msg := "failed to resolve conflicting " +
+					"reports: %v"
+				logrus.Errorf(msg, err)
+				return i + 1, status.Errorf(
+					storageErrorCode(err), msg, err,
+				)
+			}
+		}
+
+		// The observed latency is only an input to the aggregated
+		// percentiles and is never stored as is.
+		history.ResolutionLatencyMs = 0
+
+		// Record the observed latencies and refresh the aggregated
+		// latency percentiles of the pair.
+		var samples []uint32
+		observed := latencies[string(key)]
+		for _, latencyMs := range observed {
+			var err error
+			samples, err = recordLatencySample(
+				latencyBucket, key, latencyMs,
+			)
+			if err != nil {
+				msg := "failed to record latency sample: %v"
+				logrus.Errorf(msg, err)
+				return i + 1, status.Errorf(
+					storageErrorCode(err), msg, err,
+				)
+			}
+		}
+		if len(samples) > 0 {
+			history.LatencyP50Ms = latencyPercentile(samples, 50)
+			history.LatencyP95Ms = latencyPercentile(samples, 95)
+		}
+
+		// Store the aggregated data point in the database.
+		data, err := encodePairData(history)
+		if err != nil {
+			msg := "failed to encode history data: %v"
+			logrus.Errorf(msg, err)
+			return i + 1, status.Errorf(codes.Internal, msg, err)
+		}
+		if err := putPair(tx, key, data); err != nil {
+			msg := "failed to store data in the bucket: %v"
+			logrus.Errorf(msg, err)
+			return i + 1, status.Errorf(codes.Internal, msg, err)
+		}
 	}
 
-	// Construct RegisterMissionControlResponse with the success message.
-	response := &ecrpc.RegisterMissionControlResponse{
-		SuccessMessage: successMessage,
+	if len(merged) == 0 {
+		return 0, nil
 	}
 
-	return response, nil
+	return len(merged), bumpDatasetRevision(tx)
 }
 
 // QueryAggregatedMissionControl queries aggregated mission control data.
@@ -180,84 +660,241 @@ func (s *externalCoordinatorServer) QueryAggregatedMissionControl(
 	// Log the receipt of the query request.
 	logrus.Info("Received QueryAggregatedMissionControl request")
 
-	var pairs []*ecrpc.PairHistory
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		b := tx.Bucket([]byte(DatabaseBucketName))
-
-		// Pre-allocate memory for the pairs slice based on the
-		// estimated number of key-value pairs in the bucket. This
-		// ensures sufficient capacity to hold all key-value pairs
-		// without resizing during iteration.
-		//
-		// NOTE: The number of estimated keys retrieved may be less or
-		// greater than the actual number of keys in the db.
-		pairs = make([]*ecrpc.PairHistory, 0, b.Stats().KeyN)
-		err := b.ForEach(func(k, v []byte) error {
-			history := &ecrpc.PairData{}
-			if err := json.Unmarshal(v, history); err != nil {
-				msg := "failed to unmarshal history data: %v"
-				logrus.Errorf(msg, err)
-				return status.Errorf(codes.Internal, msg, err)
-			}
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return err
+	}
 
-			nodeFrom := k[:PubKeyCompressedSize]
-			nodeTo := k[PubKeyCompressedSize:]
-			pair := &ecrpc.PairHistory{
-				NodeFrom: nodeFrom,
-				NodeTo:   nodeTo,
-				History:  history,
-			}
-			pairs = append(pairs, pair)
-
-			// If the batch size is reached, send the batch.
-			batch := s.config.Server.QueryMissionControlBatchSize
-			if len(pairs) == batch {
-				response := &ecrpc.QueryAggregatedMissionControlResponse{
-					Pairs: pairs,
-				}
-				if err := stream.Send(response); err != nil {
-					return status.Errorf(codes.Internal, "failed to send batch: %v", err)
-				}
-
-				// Log the number of pairs retrieved.
-				logrus.Infof("Retrieved %d pairs from the "+
-					"database", len(pairs))
-
-				// Clear the pairs slice for the next batch
-				// while maintaining the same original capacity.
-				pairs = pairs[:0]
-			}
+	// Reject clients which already reached their daily egress cap.
+	client := clientIdentity(stream.Context())
+	if err := s.checkEgressCap(stream.Context(), client); err != nil {
+		logrus.Infof("Query rejected: %v", err)
+		return err
+	}
 
-			return nil
+	// If the query is scoped to a node group, load its members so that
+	// pairs not involving any of them can be skipped.
+	var filter func(nodeFrom, nodeTo []byte) bool
+	if req.GetGroup() != "" {
+		var members nodeGroupMembers
+		err := s.db.View(func(tx *bbolt.Tx) error {
+			var err error
+			members, err = loadNodeGroup(tx, req.GetGroup())
+			return err
 		})
+		if status.Code(err) == codes.NotFound {
+			return err
+		}
 		if err != nil {
-			msg := "error while iterating through bucket: %v"
+			msg := "query failed: %v"
 			logrus.Errorf(msg, err)
-			return status.Errorf(codes.Internal, msg, err)
+			return status.Errorf(storageErrorCode(err), msg, err)
 		}
 
-		// Send any remaining pairs as the final batch.
-		if len(pairs) > 0 {
-			response := &ecrpc.QueryAggregatedMissionControlResponse{
-				Pairs: pairs,
-			}
-			if err := stream.Send(response); err != nil {
-				return status.Errorf(codes.Internal, "failed "+
-					"to send final batch: %v", err)
-			}
-			// Log the number of pairs retrieved.
-			logrus.Infof("Retrieved %d pairs from the database",
-				len(pairs))
+		filter = func(nodeFrom, nodeTo []byte) bool {
+			return members.contains(nodeFrom) ||
+				members.contains(nodeTo)
 		}
+	}
+
+	// Queries with an access token limited to some nodes only return the
+	// pairs involving them.
+	filter = accessScopeFromContext(stream.Context()).filter(filter)
 
+	// Queries limited to the pairs from or to a node, e.g. to the peers of
+	// a routing node, skip all other pairs. Full queries only read the
+	// pairs of these nodes.
+	nodeFrom, nodeTo := req.GetNodeFrom(), req.GetNodeTo()
+	if err := validateNodeFilter(nodeFrom, nodeTo); err != nil {
 		return err
-	})
+	}
+	filter = nodeFilter(nodeFrom, nodeTo, filter)
+
+	// Only pairs updated within the maximum age are returned. Queries of
+	// a past revision judge the age by the pairs of that revision instead.
+	asOf := req.GetAsOfRevision()
+	var maxAge time.Duration
+	var err error
+	if asOf == 0 {
+		filter, err = s.freshnessFilter(req.GetMaxAgeSeconds(), filter)
+	} else {
+		maxAge, err = s.queryMaxAge(req.GetMaxAgeSeconds())
+	}
+	if err != nil {
+		return err
+	}
+
+	// Only pairs relevant to payments of the minimum amount are returned,
+	// again judged by the pairs of the revision queried.
+	minAmt := req.GetMinAmtMsat()
+	if err := validateMinAmount(minAmt); err != nil {
+		return err
+	}
+	if asOf == 0 {
+		filter, err = s.amountFilter(minAmt, filter)
+		if err != nil {
+			msg := "query failed: %v"
+			logrus.Errorf(msg, err)
+			return status.Errorf(storageErrorCode(err), msg, err)
+		}
+	}
+
+	// If the query is scoped to a node pair, only both directions of the
+	// pair are looked up instead of scanning all pairs.
+	nodeA, nodeB := req.GetNodeA(), req.GetNodeB()
+	pairQuery := len(nodeA) > 0 || len(nodeB) > 0
+	if pairQuery {
+		if err := validateNodePair(nodeA, nodeB); err != nil {
+			return err
+		}
+	}
+
+	// Results of queries on behalf of a node are ranked by their graph
+	// distance from that node.
+	sourceNode := req.GetSourceNode()
+	if !pairQuery && len(sourceNode) > 0 {
+		if err := validateSourceNode(sourceNode); err != nil {
+			return err
+		}
+	}
+
+	// Research queries may only request a random sample of the pairs.
+	sampleSize := int(req.GetSampleSize())
+	if !pairQuery && sampleSize > 0 && len(sourceNode) > 0 {
+		return status.Errorf(codes.InvalidArgument, "a sample cannot be "+
+			"ranked by graph distance")
+	}
+
+	// Results may be requested in another order than by node public keys,
+	// except for ranked queries which are ordered by graph distance.
+	sortOrder := req.GetSortOrder()
+	if !pairQuery {
+		if err := validateSortOrder(sortOrder); err != nil {
+			return err
+		}
+		if sortOrder != ecrpc.SortOrder_SORT_ORDER_NODE_PUBKEY &&
+			len(sourceNode) > 0 {
+
+			return status.Errorf(codes.InvalidArgument, "ranked "+
+				"results cannot be sorted")
+		}
+	}
+
+	// Pairs updated within a time range are looked up through the update
+	// index. This cannot be combined with ranked or sampled queries.
+	updatedSince, updatedUntil := req.GetUpdatedSince(),
+		req.GetUpdatedUntil()
+	updateRange := updatedSince != 0 || updatedUntil != 0
+	if !pairQuery && updateRange {
+		err := validateUpdateRange(updatedSince, updatedUntil)
+		if err != nil {
+			return err
+		}
+		if len(sourceNode) > 0 || sampleSize > 0 {
+			return status.Errorf(codes.InvalidArgument, "ranked "+
+				"or sampled queries cannot be limited to "+
+				"recently updated pairs")
+		}
+	}
+
+	// Past revisions are only reconstructed in full, so they cannot be
+	// looked up by the indexes of the current dataset.
+	if asOf != 0 && (pairQuery || sampleSize > 0 || len(sourceNode) > 0 ||
+		updateRange ||
+		sortOrder != ecrpc.SortOrder_SORT_ORDER_NODE_PUBKEY) {
+
+		return status.Errorf(codes.InvalidArgument, "queries of a "+
+			"past revision cannot be combined with node pair, ranked, "+
+			"sampled, sorted or recently updated queries")
+	}
+
+	// Results may be requested in another format than as pairs.
+	format := req.GetFormat()
+	if err := validateQueryFormat(format); err != nil {
+		return err
+	}
+
+	// Queries may request another number of pairs per response.
+	metered := &meteredQueryStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: s.
+			withQueryBatchSize(stream, req.GetBatchSize()),
+	}
+
+	// The serve hook of the operator transforms the pairs first, which
+	// are then watermarked for their recipient. LDK based clients receive
+	// them as the liquidity bounds of their channels.
+	out := s.transforms.transformServed(
+		s.watermarkServed(stream.Context(), metered),
+	)
+	if format == ecrpc.QueryFormat_QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS {
+		out, err = s.newLiquidityBoundsStream(out)
+		if err != nil {
+			msg := "query failed: %v"
+			logrus.Errorf(msg, err)
+			return status.Errorf(storageErrorCode(err), msg, err)
+		}
+	}
+
+	var sent int
+	switch {
+	case asOf != 0:
+		sent, err = s.streamRevisionPairs(
+			out, asOf, maxAge, minAmt, filter,
+		)
+
+	case pairQuery:
+		sent, err = s.sendPairDirections(out, nodeA, nodeB, filter)
+
+	case sampleSize > 0:
+		sent, err = s.streamSampledPairs(
+			out, sampleSize, sortOrder, filter,
+		)
+
+	case len(sourceNode) > 0:
+		sent, err = s.streamRankedPairs(
+			out, sourceNode, int(req.GetMaxDistance()), filter,
+		)
+
+	case updateRange,
+		sortOrder == ecrpc.SortOrder_SORT_ORDER_FRESHNESS:
+
+		sent, err = s.streamUpdatedPairs(
+			out, updatedSince, updatedUntil, sortOrder, filter,
+		)
+
+	case sortOrder != ecrpc.SortOrder_SORT_ORDER_NODE_PUBKEY:
+		sent, err = s.streamSortedPairs(out, sortOrder, filter)
+
+	default:
+		sent, err = s.streamAggregatedPairs(
+			out, nodeFrom, nodeTo, filter,
+		)
+	}
+
+	// Queries without results send an explicit empty response, so that
+	// clients can tell them from failed streams. Queries of a past revision
+	// send their own describing that revision.
+	if err == nil && sent == 0 && asOf == 0 {
+		err = s.sendEmptyQueryResponse(out)
+	}
+	s.recordEgress(client, metered.bytes.Load())
+	switch status.Code(err) {
+	case codes.OutOfRange:
+		return err
+
+	case codes.DeadlineExceeded, codes.Canceled:
+		logrus.Warnf("Query aborted: %v", err)
+		return err
+	}
 	if err != nil {
 		msg := "query failed: %v"
 		logrus.Errorf(msg, err)
-		return status.Errorf(codes.Internal, msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
 	}
 
+	// Record the query for accountability if the audit is enabled.
+	s.recordQueryAudit(stream.Context(), req.GetGroup(), sent)
+
 	return nil
 }
 
@@ -292,64 +929,138 @@ func (s *externalCoordinatorServer) RunCleanupRoutine(ctx context.Context,
 }
 
 // cleanupStaleData cleans up stale mission control data from the database.
-// It iterates through the database and removes stale data entries.
-func (s *externalCoordinatorServer) cleanupStaleData() {
+// It iterates through the database and removes stale data entries. It returns
+// the number of pairs removed, either as stale, as contributed solely by stale
+// submitters or as between nodes whose channels were all closed.
+func (s *externalCoordinatorServer) cleanupStaleData() (int, error) {
 	logrus.Infof("Running cleanup routine to remove stale mission " +
 		"control data from the database...")
+	s.lastCleanup.Store(s.clock.Now().Unix())
 
-	// Initialize a counter to track the number of stale pairs removed.
-	stalePairsRemoved := 0
+	// Track the keys of the stale pairs removed.
+	var removedKeys [][]byte
 
 	// Start a read-write transaction to the database.
 	err := s.db.Update(func(tx *bbolt.Tx) error {
-		b := tx.Bucket([]byte(DatabaseBucketName))
+		// The pairs are indexed by the time of their last update, so
+		// only the stale pairs are scanned.
+		cutoff := s.clock.Now().Add(
+			-s.config.Server.HistoryThresholdDuration,
+		)
+		for _, indexKey := range stalePairIndexKeys(tx, cutoff) {
+			k := indexKey[updateTimeSize:]
 
-		// Iterate through all key-value pairs in the bucket.
-		err := b.ForEach(func(k, v []byte) error {
-			history := &ecrpc.PairData{}
-			if err := json.Unmarshal(v, history); err != nil {
-				msg := "failed to unmarshal history data: %v"
-				logrus.Errorf(msg, err)
-				return status.Errorf(codes.Internal, msg, err)
+			// Delete the stale pair together with its index and
+			// Merkle tree entries and everything tracked for it,
+			// since it is all as stale as its history.
+			if err := removePairData(tx, k); err != nil {
+				return status.Errorf(codes.Internal, "failed "+
+					"to delete stale pair: %v", err)
 			}
+			logrus.Debugf("Stale data removed for key: %s",
+				hex.EncodeToString(k))
 
-			isStale := isHistoryStale(
-				history,
-				s.config.Server.HistoryThresholdDuration,
-			)
-			if isStale {
-				// If the pair is stale, delete it from the
-				// bucket.
-				if err := b.Delete(k); err != nil {
-					logrus.Errorf("failed to delete "+
-						"stale mission control data "+
-						"from the bucket: %v", err)
-					return nil
-				}
-				logrus.Debugf("Stale data removed for key: %s",
-					hex.EncodeToString(k))
-
-				stalePairsRemoved += 1
-			}
+			removedKeys = append(removedKeys, k)
+		}
 
-			return nil
-		})
+		// Deltas since before the cutoff no longer report the pairs
+		// removed before it, since they return the entire dataset.
+		if err := pruneModifiedIndex(tx, cutoff); err != nil {
+			return status.Errorf(codes.Internal, "failed to prune "+
+				"modified index: %v", err)
+		}
 
-		if err != nil {
-			return status.Errorf(codes.Internal, "error while "+
-				"iterating through bucket: %v", err)
+		if len(removedKeys) == 0 {
+			return nil
 		}
 
-		return nil
+		return bumpDatasetRevision(tx)
 	})
 
+	s.recordCleanupResult(err)
 	if err != nil {
 		logrus.Errorf("cleanup routine failed: %v", err)
-		return
+		return 0, err
 	}
+	removed := len(removedKeys)
 
 	logrus.Infof("Cleanup routine completed successfully and %d pairs "+
-		"were removed", stalePairsRemoved)
+		"were removed", len(removedKeys))
+
+	// Ship the removals with the next snapshot to the standby coordinator
+	// if configured.
+	s.snapshots.markDirty(removedKeys...)
+
+	// Remove the private pairs as stale as the public ones removed.
+	privatePairsRemoved, err := s.prunePrivatePairs()
+	if err != nil {
+		logrus.Errorf("failed to prune private pairs: %v", err)
+	} else if privatePairsRemoved > 0 {
+		logrus.Infof("%d stale private pairs were removed",
+			privatePairsRemoved)
+	}
+
+	// Remove the pairs contributed solely by stale submitters if
+	// configured.
+	if s.staleSubmitters != nil {
+		prunedKeys, err := s.pruneStaleSubmitters()
+		if err != nil {
+			logrus.Errorf("failed to prune pairs of stale "+
+				"submitters: %v", err)
+		} else if len(prunedKeys) > 0 {
+			logrus.Infof("%d pairs of stale submitters were "+
+				"removed", len(prunedKeys))
+			s.snapshots.markDirty(prunedKeys...)
+			removed += len(prunedKeys)
+		}
+	}
+
+	// Remove the pairs between nodes whose channels were all closed
+	// longer than the retention ago if configured.
+	if s.config.Server.ClosedChannelRetention > 0 {
+		prunedKeys, err := s.pruneClosedChannelPairs()
+		if err != nil {
+			logrus.Errorf("failed to prune pairs of closed "+
+				"channels: %v", err)
+		} else if len(prunedKeys) > 0 {
+			logrus.Infof("%d pairs of closed channels were "+
+				"removed", len(prunedKeys))
+			s.snapshots.markDirty(prunedKeys...)
+			removed += len(prunedKeys)
+		}
+	}
+
+	// Remove the journaled registrations as stale as the pairs.
+	journalRemoved, err := s.pruneJournal()
+	if err != nil {
+		logrus.Errorf("failed to prune registration journal: %v", err)
+	} else if journalRemoved > 0 {
+		logrus.Infof("%d stale journaled registrations were removed",
+			journalRemoved)
+	}
+
+	// Forget the shift checkpoints of the submitters whose window
+	// elapsed.
+	if s.shiftLimiter != nil {
+		pruned := s.shiftLimiter.prune(s.clock.Now())
+		if pruned > 0 {
+			logrus.Debugf("%d expired shift checkpoints were "+
+				"removed", pruned)
+		}
+	}
+
+	// Remove the query audit records exceeding the retention.
+	auditRecordsRemoved, err := s.pruneQueryAudit()
+	if err != nil {
+		logrus.Errorf("failed to prune query audit: %v", err)
+		return removed, nil
+	}
+	if auditRecordsRemoved > 0 {
+		logrus.Infof("%d expired query audit records were removed",
+			auditRecordsRemoved)
+	}
+
+	return removed, nil
 }
 
 // validateRegisterMissionControlRequest checks the integrity and correctness
@@ -365,42 +1076,24 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 			"include at least one pair")
 	}
 
+	// Reject pairs observed on another network.
+	if err := s.validateRequestNetwork(req.Network); err != nil {
+		return err
+	}
+
 	// Flag to track if all pairs are older than the configured threshold.
 	allStale := true
 
-	for _, pair := range req.Pairs {
-		// Validate that NodeFrom is exactly 33 bytes i.e compressed sec
-		// pub key.
-		if len(pair.NodeFrom) != PubKeyCompressedSize {
-			return status.Errorf(codes.InvalidArgument, "NodeFrom "+
-				"must be exactly %d bytes",
-				PubKeyCompressedSize,
-			)
-		}
-
-		// Validate that NodeTo is exactly 33 bytes i.e compressed sec
-		// pub key.
-		if len(pair.NodeTo) != PubKeyCompressedSize {
-			return status.Errorf(codes.InvalidArgument, "NodeTo "+
-				"must be exactly %d bytes",
-				PubKeyCompressedSize,
-			)
-		}
-
-		// Validate the NodeFrom public key.
-		_, err := btcec.ParsePubKey(pair.NodeFrom)
-		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid "+
-				"NodeFrom public key: %v", err,
-			)
+	for i, pair := range req.Pairs {
+		// Validate the NodeFrom and NodeTo identifiers against the
+		// key scheme of the database.
+		if err := pairKeys.validateNode(pair.NodeFrom); err != nil {
+			return ecerrors.InvalidPair(i, "invalid NodeFrom: %v",
+				err)
 		}
-
-		// Validate the NodeTo public key.
-		_, err = btcec.ParsePubKey(pair.NodeTo)
-		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid "+
-				"NodeTo public key: %v", err,
-			)
+		if err := pairKeys.validateNode(pair.NodeTo); err != nil {
+			return ecerrors.InvalidPair(i, "invalid NodeTo: %v",
+				err)
 		}
 
 		// Prettify the nodeFrom and nodeTo pairs.
@@ -410,14 +1103,14 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 		)
 		// Validate that NodeFrom and NodeTo pairs are not equal.
 		if bytes.Equal(pair.NodeFrom, pair.NodeTo) {
-			return status.Errorf(codes.InvalidArgument, "%s: "+
-				"source and destination node must differ", pairPrefix)
+			return ecerrors.InvalidPair(i, "%s: source and "+
+				"destination node must differ", pairPrefix)
 		}
 
 		// Validate the history data.
 		if pair.History == nil {
-			return status.Errorf(codes.InvalidArgument, "%s: "+
-				"History cannot be nil", pairPrefix)
+			return ecerrors.InvalidPair(i, "%s: History cannot "+
+				"be nil", pairPrefix)
 		}
 
 		// Validate fail and success amounts are non-negative.
@@ -425,10 +1118,9 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 			pair.History.SuccessAmtSat < 0 ||
 			pair.History.FailAmtMsat < 0 ||
 			pair.History.SuccessAmtMsat < 0 {
-			return status.Errorf(
-				codes.InvalidArgument, "%s: Fail and success "+
-					"amounts must be non-negative", pairPrefix,
-			)
+			return ecerrors.InvalidPair(i, "%s: Fail and "+
+				"success amounts must be non-negative",
+				pairPrefix)
 		}
 
 		// Check if failure timestamp and amount are consistent with
@@ -438,8 +1130,8 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 			pair.History.FailTime, true,
 		)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "%s: "+
-				"invalid failure: %v", pairPrefix, err)
+			return ecerrors.InvalidPair(i, "%s: invalid "+
+				"failure: %v", pairPrefix, err)
 		}
 
 		// Check if success timestamp and amount are consistent with
@@ -449,14 +1141,14 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 			pair.History.SuccessTime, false,
 		)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "%s: "+
-				"invalid success: %v", pairPrefix, err)
+			return ecerrors.InvalidPair(i, "%s: invalid "+
+				"success: %v", pairPrefix, err)
 		}
 
 		// Throw an error if both successAmt and failAmt are not set.
 		if successMsat == 0 && failMsat == 0 {
-			return status.Errorf(codes.InvalidArgument, "%s: "+
-				"either success or failure result required",
+			return ecerrors.InvalidPair(i, "%s: either "+
+				"success or failure result required",
 				pairPrefix)
 		}
 
@@ -470,10 +1162,19 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 		pair.History.SuccessAmtSat = successMsat / mSatScale
 		pair.History.SuccessTime = successTime
 
+		// The latency percentiles and failure streak are aggregated by
+		// the coordinator, so ignore any values provided by the
+		// submitter.
+		pair.History.LatencyP50Ms = 0
+		pair.History.LatencyP95Ms = 0
+		pair.History.FailureStreak = 0
+		pair.History.SuccessGapSeconds = 0
+
 		// Validate History data is not stale according to configured
 		// threshold duration.
 		isStale := isHistoryStale(
 			pair.History, s.config.Server.HistoryThresholdDuration,
+			s.clock.Now(),
 		)
 		if !isStale {
 			// At least one pair is within the threshold.
@@ -488,9 +1189,9 @@ func (s *externalCoordinatorServer) validateRegisterMissionControlRequest(req *e
 		historyThresholdDurationFormatted := formatDuration(
 			s.config.Server.HistoryThresholdDuration,
 		)
-		return status.Errorf(codes.InvalidArgument, "All history data "+
-			"pairs exceed the configured threshold of %s "+
-			"and cannot be registered", historyThresholdDurationFormatted,
+		return ecerrors.StaleData("All history data pairs exceed the "+
+			"configured threshold of %s and cannot be registered",
+			historyThresholdDurationFormatted,
 		)
 	}
 
@@ -510,6 +1211,7 @@ func (s *externalCoordinatorServer) sanitizeRegisterMissionControlRequest(req *e
 
 		isStale := isHistoryStale(
 			pair.History, s.config.Server.HistoryThresholdDuration,
+			s.clock.Now(),
 		)
 		if isStale {
 			// If the pair is stale, remove it from the slice.
@@ -590,9 +1292,70 @@ func validateMsatPairValue(msatValue int64, satValue int64) (int64, error) {
 		"values not equal", msatValue, satValue)
 }
 
-// isHistoryStale checks if the history data pair is stale according to the
-// configured threshold.
-func isHistoryStale(history *ecrpc.PairData, threshold time.Duration) bool {
+// queryMaxAge returns the maximum age of the pairs returned by a query
+// requesting the given maximum age in seconds, defaulting to the query
+// threshold.
+func (s *externalCoordinatorServer) queryMaxAge(
+	maxAgeSeconds int64) (time.Duration, error) {
+	switch {
+	case maxAgeSeconds < 0:
+		return 0, status.Errorf(codes.InvalidArgument, "MaxAgeSeconds "+
+			"must not be negative")
+
+	case maxAgeSeconds == 0:
+		return s.queryThreshold(), nil
+	}
+
+	maxAge := time.Duration(maxAgeSeconds) * time.Second
+	if maxAgeSeconds > int64(math.MaxInt64/time.Second) ||
+		maxAge > s.config.Server.HistoryThresholdDuration {
+
+		return s.config.Server.HistoryThresholdDuration, nil
+	}
+
+	return maxAge, nil
+}
+
+// freshnessFilter returns a filter accepting the pairs accepted by the given
+// filter which were updated within the maximum age of a query. Pairs older than
+// the history threshold are removed by the cleanup routine, so only shorter
+// ages need to be enforced.
+func (s *externalCoordinatorServer) freshnessFilter(maxAgeSeconds int64,
+	next func(nodeFrom, nodeTo []byte) bool) (func(nodeFrom,
+	nodeTo []byte) bool, error) {
+	maxAge, err := s.queryMaxAge(maxAgeSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if maxAge >= s.config.Server.HistoryThresholdDuration {
+		return next, nil
+	}
+
+	var fresh map[string]struct{}
+	cutoff := s.clock.Now().Add(-maxAge)
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		fresh = freshPairKeys(tx, cutoff)
+		return nil
+	})
+	if err != nil {
+		msg := "query failed: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return func(nodeFrom, nodeTo []byte) bool {
+		if _, ok := fresh[string(nodeFrom)+string(nodeTo)]; !ok {
+			return false
+		}
+
+		return next == nil || next(nodeFrom, nodeTo)
+	}, nil
+}
+
+// isHistoryStale checks if the history data pair is stale at the given time
+// according to the configured threshold.
+func isHistoryStale(history *ecrpc.PairData, threshold time.Duration,
+	now time.Time) bool {
 	// Obtain the most recent UNIX timestamp reflecting temporal
 	// locality from the fail_time and success_time fields of the
 	// pair's history data. This timestamp will be used to
@@ -603,7 +1366,7 @@ func isHistoryStale(history *ecrpc.PairData, threshold time.Duration) bool {
 
 	// Check if the current history data pair is stale according
 	// to the configured threshold duration.
-	return time.Unix(recentTimestamp, 0).Before(time.Now().Add(-threshold))
+	return time.Unix(recentTimestamp, 0).Before(now.Add(-threshold))
 }
 
 // mergePairData merges the pair data from two pairs based on the most recent
@@ -621,18 +1384,38 @@ func isHistoryStale(history *ecrpc.PairData, threshold time.Duration) bool {
 // - existingData: The existing pair data to merge with.
 // - newData: The new pair data to merge with.
 func mergePairData(existingData, newData *ecrpc.PairData) {
+	explainMergePairData(existingData, newData, nil)
+}
+
+// explainMergePairData merges the pair data from two pairs like mergePairData
+// and passes the rules it applies to explain, if not nil.
+func explainMergePairData(existingData, newData *ecrpc.PairData,
+	explain func(ecadminrpc.MergeRule)) {
+
+	if explain == nil {
+		explain = func(ecadminrpc.MergeRule) {}
+	}
 
-	if newData.SuccessTime > existingData.SuccessTime {
+	switch {
+	case newData.SuccessTime > existingData.SuccessTime:
 		// Update success time and amounts if newer, retaining max
 		// success amount to avoid shrinking success range
 		// unnecessarily.
 		existingData.SuccessTime = newData.SuccessTime
 		if newData.SuccessAmtMsat > existingData.SuccessAmtMsat {
 			existingData.SuccessAmtMsat = newData.SuccessAmtMsat
+			explain(ecadminrpc.MergeRule_MERGE_RULE_NEWER_SUCCESS)
+		} else {
+			explain(ecadminrpc.
+				MergeRule_MERGE_RULE_SUCCESS_AMOUNT_RETAINED)
 		}
+
+	case newData.SuccessTime != 0:
+		explain(ecadminrpc.MergeRule_MERGE_RULE_OLDER_SUCCESS)
 	}
 
-	if newData.FailTime > existingData.FailTime {
+	switch {
+	case newData.FailTime > existingData.FailTime:
 		// Drop result if it would increase the failure amount too soon
 		// after a previous failure. This can happen if htlc results
 		// come in out of order. This check makes it easier for payment
@@ -647,11 +1430,14 @@ func mergePairData(existingData, newData *ecrpc.PairData) {
 				"prev_fail_amt=%v, fail_amt=%v, interval=%v",
 				existingData.FailAmtMsat, newData.FailAmtMsat,
 				failInterval)
+			explain(ecadminrpc.
+				MergeRule_MERGE_RULE_FAILURE_RELAXATION)
 			return
 		}
 
 		existingData.FailTime = newData.FailTime
 		existingData.FailAmtMsat = newData.FailAmtMsat
+		explain(ecadminrpc.MergeRule_MERGE_RULE_NEWER_FAILURE)
 
 		switch {
 		// The failure amount is set to zero when the failure is
@@ -660,21 +1446,30 @@ func mergePairData(existingData, newData *ecrpc.PairData) {
 		// success amount to zero.
 		case newData.FailAmtMsat == 0:
 			existingData.SuccessAmtMsat = 0
+			explain(ecadminrpc.
+				MergeRule_MERGE_RULE_SUCCESS_AMOUNT_RESET)
 
 		// If the failure range goes into the success range, move the
 		// success range down.
 		case newData.FailAmtMsat <= existingData.SuccessAmtMsat:
 			existingData.SuccessAmtMsat = newData.FailAmtMsat - 1
+			explain(ecadminrpc.
+				MergeRule_MERGE_RULE_SUCCESS_RANGE_LOWERED)
 		}
+
+	case newData.FailTime != 0:
+		explain(ecadminrpc.MergeRule_MERGE_RULE_OLDER_FAILURE)
 	}
 
 	// Move the failure range up if the success amount goes into the
 	// failure range. We don't want to clear the failure completely
 	// because we haven't learnt much for amounts above the current
-	// success amount.
-	if existingData.FailTime != 0 &&
+	// success amount. Like lnd, only a reported success does so, so that
+	// an amount-independent failure is kept as is.
+	if existingData.FailTime != 0 && newData.SuccessTime != 0 &&
 		newData.SuccessAmtMsat >= existingData.FailAmtMsat {
 		existingData.FailAmtMsat = newData.SuccessAmtMsat + 1
+		explain(ecadminrpc.MergeRule_MERGE_RULE_FAILURE_RANGE_RAISED)
 	}
 
 	// Update Success and Failure Satoshi amounts based on the
@@ -2,16 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	btcec "github.com/btcsuite/btcd/btcec/v2"
 	"github.com/stretchr/testify/require"
+	ecerrors "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecerrors"
 	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
 	"go.etcd.io/bbolt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // mockQueryAggregatedMissionControlServer is a mock implementation of the
@@ -216,6 +219,11 @@ func TestExternalCoordinatorServer(t *testing.T) {
 			require.Equal(
 				t, codes.InvalidArgument, status.Code(err),
 			)
+
+			// Clients match the index of the invalid pair.
+			var invalid *ecerrors.InvalidPairError
+			require.ErrorAs(t, err, &invalid)
+			require.Zero(t, invalid.Index)
 		})
 
 		// Case 7: Negative fail amount.
@@ -378,7 +386,7 @@ func TestExternalCoordinatorServer(t *testing.T) {
 			_, err := server.RegisterMissionControl(
 				context.Background(), req,
 			)
-			require.Error(t, err)
+			require.ErrorIs(t, err, ecerrors.ErrStaleData)
 
 			// Creating a mock stream to capture the responses.
 			mockStream := &mockQueryAggregatedMissionControlServer{
@@ -392,7 +400,11 @@ func TestExternalCoordinatorServer(t *testing.T) {
 
 			// Check that there are no data in the db since all
 			// the data was stale.
-			require.Empty(t, mockStream.Responses)
+			require.Len(t, mockStream.Responses, 1)
+			require.Empty(t, mockStream.Responses[0].Pairs)
+			require.Zero(
+				t, mockStream.Responses[0].Dataset.TotalPairs,
+			)
 		})
 
 		// Case 12: Register new pair with old success and fail times
@@ -629,7 +641,9 @@ func TestExternalCoordinatorServer(t *testing.T) {
 				mockStream,
 			)
 			require.NoError(t, err)
-			require.Len(t, mockStream.Responses, 0)
+			require.Len(t, mockStream.Responses, 1)
+			require.Empty(t, mockStream.Responses[0].Pairs)
+			require.NotNil(t, mockStream.Responses[0].Dataset)
 		})
 	})
 
@@ -1205,6 +1219,7 @@ func TestExternalCoordinatorServer(t *testing.T) {
 			}
 			stale := isHistoryStale(
 				history, config.Server.HistoryThresholdDuration,
+				time.Now(),
 			)
 			require.False(t, stale)
 		})
@@ -1223,6 +1238,7 @@ func TestExternalCoordinatorServer(t *testing.T) {
 			}
 			stale := isHistoryStale(
 				history, config.Server.HistoryThresholdDuration,
+				time.Now(),
 			)
 			require.True(t, stale)
 
@@ -1238,8 +1254,125 @@ func TestExternalCoordinatorServer(t *testing.T) {
 			}
 			stale = isHistoryStale(
 				history, config.Server.HistoryThresholdDuration,
+				time.Now(),
 			)
 			require.False(t, stale)
 		})
 	})
 }
+
+// TestAggregatePairsRetry tests that aggregating pairs again after the
+// transaction was rolled back, as bbolt does when retrying batch functions,
+// yields the same result as aggregating them once.
+func TestAggregatePairsRetry(t *testing.T) {
+	nodeFrom, nodeTo := generateTestKeys(t)
+	now := time.Now().Unix()
+	existing := []*ecrpc.PairHistory{{
+		NodeFrom: nodeFrom,
+		NodeTo:   nodeTo,
+		History: &ecrpc.PairData{
+			SuccessTime:    now - 20,
+			SuccessAmtMsat: 2000,
+		},
+	}}
+	pairs := []*ecrpc.PairHistory{
+		{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				FailTime:            now,
+				FailAmtMsat:         1500,
+				ResolutionLatencyMs: 100,
+			},
+		},
+		{
+			NodeFrom: nodeTo,
+			NodeTo:   nodeFrom,
+			History: &ecrpc.PairData{
+				SuccessTime:         now,
+				SuccessAmtMsat:      1000,
+				ResolutionLatencyMs: 200,
+			},
+		},
+	}
+	original := clonePairs(pairs)
+
+	// aggregate aggregates the existing pairs and then the pairs under
+	// test, which are rolled back the given number of times first.
+	aggregate := func(rollbacks int) []*ecrpc.PairHistory {
+		db, err := setupDatabase(MockConfig(t.TempDir()))
+		require.NoError(t, err)
+		defer cleanupDB(db)
+
+		ctx := context.Background()
+		err = db.Update(func(tx *bbolt.Tx) error {
+			_, err := aggregatePairs(ctx, tx, existing, nil)
+			return err
+		})
+		require.NoError(t, err)
+
+		errRollback := errors.New("rollback")
+		for i := 0; i < rollbacks; i++ {
+			err := db.Update(func(tx *bbolt.Tx) error {
+				_, err := aggregatePairs(ctx, tx, pairs, nil)
+				require.NoError(t, err)
+
+				return errRollback
+			})
+			require.ErrorIs(t, err, errRollback)
+		}
+
+		err = db.Update(func(tx *bbolt.Tx) error {
+			_, err := aggregatePairs(ctx, tx, pairs, nil)
+			return err
+		})
+		require.NoError(t, err)
+
+		var stored []*ecrpc.PairHistory
+		err = db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(DatabaseBucketName))
+			return b.ForEach(func(k, v []byte) error {
+				history, err := decodePairData(v)
+				if err != nil {
+					return err
+				}
+				stored = append(stored, &ecrpc.PairHistory{
+					NodeFrom: k[:PubKeyCompressedSize],
+					NodeTo:   k[PubKeyCompressedSize:],
+					History:  history,
+				})
+
+				return nil
+			})
+		})
+		require.NoError(t, err)
+
+		return stored
+	}
+
+	once := aggregate(0)
+	retried := aggregate(2)
+	require.Len(t, once, 2)
+	for i := range once {
+		require.True(t, proto.Equal(once[i], retried[i]))
+		require.NotZero(t, once[i].History.LatencyP50Ms)
+	}
+
+	// The aggregated pairs themselves are never modified.
+	for i := range pairs {
+		require.True(t, proto.Equal(original[i], pairs[i]))
+	}
+
+	// The aggregation is aborted once the context is done.
+	db, err := setupDatabase(MockConfig(t.TempDir()))
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := aggregatePairs(ctx, tx, pairs, nil)
+		return err
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"sync/atomic"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// loadTracker keeps track of the number of operations currently in flight.
+type loadTracker struct {
+	inFlight atomic.Int64
+}
+
+// start marks the beginning of an operation and returns a function which must
+// be called once the operation is done.
+func (l *loadTracker) start() func() {
+	l.inFlight.Add(1)
+
+	return func() {
+		l.inFlight.Add(-1)
+	}
+}
+
+// current returns the number of operations currently in flight.
+func (l *loadTracker) current() int64 {
+	return l.inFlight.Load()
+}
+
+// submissionBackoffFactor returns the factor by which clients should slow down
+// their submissions given the number of registrations in flight and the busy
+// threshold. The factor grows by one for every full threshold of in-flight
+// registrations and is capped at MaxSubmissionBackoffFactor. A non-positive
+// threshold disables the backoff.
+func submissionBackoffFactor(inFlight int64, threshold int) int64 {
+	if threshold <= 0 {
+		return 1
+	}
+
+	factor := 1 + inFlight/int64(threshold)
+	if factor > MaxSubmissionBackoffFactor {
+		factor = MaxSubmissionBackoffFactor
+	}
+
+	return factor
+}
+
+// submissionHints derives the submission hints for clients from the configured
+// defaults and the current registration load of the server.
+func (s *externalCoordinatorServer) submissionHints() *ecrpc.SubmissionHints {
+	factor := submissionBackoffFactor(
+		s.registrations.current(), s.config.Server.BusyRegistrationThreshold,
+	)
+
+	interval := s.config.Server.SyncIntervalHint * time.Duration(factor)
+	batchSize := int64(s.config.Server.RegisterBatchSizeHint) / factor
+	if batchSize < 1 && s.config.Server.RegisterBatchSizeHint > 0 {
+		batchSize = 1
+	}
+
+	return &ecrpc.SubmissionHints{
+		SyncIntervalSeconds: int64(interval.Seconds()),
+		MaxBatchSize:        uint32(batchSize),
+		Busy:                factor > 1,
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestSubmissionBackoffFactor tests the backoff factor derived from the
+// number of registrations in flight.
+func TestSubmissionBackoffFactor(t *testing.T) {
+	tests := []struct {
+		name      string
+		inFlight  int64
+		threshold int
+		expected  int64
+	}{
+		{"Idle", 0, 8, 1},
+		{"BelowThreshold", 7, 8, 1},
+		{"AtThreshold", 8, 8, 2},
+		{"TwiceThreshold", 17, 8, 3},
+		{"Capped", 1000, 8, MaxSubmissionBackoffFactor},
+		{"Disabled", 1000, 0, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := submissionBackoffFactor(tc.inFlight, tc.threshold)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// TestSubmissionHints tests that the submission hints returned by the server
+// back off while the coordinator is busy.
+func TestSubmissionHints(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.SyncIntervalHint = time.Minute
+	config.Server.RegisterBatchSizeHint = 1000
+	config.Server.BusyRegistrationThreshold = 2
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// The registration itself is in flight while the hints are computed
+	// and stays below the busy threshold.
+	nodeFrom, nodeTo := generateTestKeys(t)
+	resp, err := server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &ecrpc.SubmissionHints{
+		SyncIntervalSeconds: 60,
+		MaxBatchSize:        1000,
+	}, resp.Hints)
+
+	// Simulate four concurrent registrations.
+	for i := 0; i < 4; i++ {
+		done := server.registrations.start()
+		defer done()
+	}
+
+	info, err := server.GetInfo(
+		context.Background(), &ecrpc.GetInfoRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &ecrpc.SubmissionHints{
+		SyncIntervalSeconds: 180,
+		MaxBatchSize:        333,
+		Busy:                true,
+	}, info.SubmissionHints)
+}
@@ -0,0 +1,221 @@
+//go:build !minimal
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/pprof"
+	"os"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// serveREST initializes and starts the HTTP server of the gRPC REST gateway
+// unless it is disabled, in which case it returns nil. Responses served from
+// the REST cache are accounted to the given talker tracker.
+func serveREST(ctx context.Context, tlsConfig *tls.Config, config *Config,
+	startup *startupProgress, talkers *talkerTracker) (HTTPServer, error) {
+	if config.Server.DisableREST {
+		logrus.Info("REST server disabled")
+		return nil, nil
+	}
+
+	httpServer, err := initializeHTTPServer(
+		ctx, tlsConfig, config, startup, talkers,
+	)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := startHTTPServer(config, httpServer); err != nil {
+			logrus.Fatalf("Failed to start HTTP server: %v", err)
+		}
+	}()
+
+	return httpServer, nil
+}
+
+// servePProf initializes and starts the pprof server unless it is disabled,
+// in which case it returns nil.
+func servePProf(config *Config, tlsConfig *tls.Config) HTTPServer {
+	if config.PProf.DisablePProf {
+		logrus.Info("pprof server disabled")
+		return nil
+	}
+
+	pprofServer := initializePProfServer(config, tlsConfig)
+	go func() {
+		if err := startPProfServer(config, pprofServer); err != nil {
+			logrus.Fatalf("Failed to start pprof server: %v", err)
+		}
+	}()
+
+	return pprofServer
+}
+
+// initializeHTTPServer prepares and returns a configured HTTP server without
+// starting it.
+func initializeHTTPServer(ctx context.Context,
+	tlsConfig *tls.Config, config *Config, startup *startupProgress,
+	talkers *talkerTracker) (*http.Server, error) {
+	// Create a new ServeMux to route incoming requests.
+	marshaler, err := newRESTMarshaler(&config.Server)
+	if err != nil {
+		return nil, err
+	}
+	// Exports are always rendered like LND renders its messages.
+	exportMarshaler := &restExportMarshaler{Marshaler: marshaler}
+	marshalerOption := runtime.WithMarshalerOption(
+		runtime.MIMEWildcard, exportMarshaler,
+	)
+
+	// Clients accepting protobuf receive binary responses instead.
+	protoOption := runtime.WithMarshalerOption(
+		restProtobufMIME, &restProtoMarshaler{},
+	)
+	mux := runtime.NewServeMux(
+		marshalerOption, protoOption,
+		runtime.WithMetadata(restRouteAnnotator),
+		runtime.WithOutgoingHeaderMatcher(restOutgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(restCountEgress),
+	)
+
+	// Read the certificate file.
+	certBytes, err := os.ReadFile(config.TLS.TLSCertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a new certificate pool and add the certificate to it.
+	// This certificate pool is used to establish a trusted root CA set,
+	// which ensures that the gRPC client verifies the server's certificate
+	// during the TLS handshake, thereby securing the communication channel.
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(certBytes) {
+		return nil, fmt.Errorf("failed to append certificate")
+	}
+
+	// Define gRPC dial options with transport credentials using the
+	// certificate pool.
+	// Unary responses such as exports and full polls hold the entire
+	// dataset, so the gateway accepts responses of any size.
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(
+			credentials.NewClientTLSFromCert(
+				certPool, "",
+			),
+		),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(math.MaxInt32),
+		),
+	}
+
+	// The gateway dials the gRPC server by the TLS domain name, which may
+	// also be an IPv6 address such as ::1 on IPv6-only hosts.
+	err = ecrpc.RegisterExternalCoordinatorHandlerFromEndpoint(
+		ctx, mux, joinHostPort(
+			config.TLS.TLSDomainName, config.Server.GRPCServerPort,
+		), opts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Configure HTTP Server settings for the server.
+	httpServer := &http.Server{
+		Addr: joinHostPort(
+			config.Server.RESTServerHost,
+			config.Server.RESTServerPort,
+		),
+		Handler: withRESTObservability(
+			withRESTPathPrefix(
+				withReadinessEndpoint(
+					withRESTContentNegotiation(
+						withRESTCache(
+							mux, &config.Server,
+							talkers,
+						),
+					),
+					startup,
+				),
+				config.Server.RESTBasePath,
+			),
+			config.Log.RESTAccessLog, config.Server.PrivacyMode,
+		),
+		TLSConfig: tlsConfig,
+	}
+
+	return httpServer, nil
+}
+
+// startHTTPServer starts the provided HTTP server for the gRPC REST gateway.
+func startHTTPServer(config *Config, httpServer *http.Server) error {
+	logrus.Infof("Starting HTTP/1.1 REST server on https://%s",
+		httpServer.Addr)
+
+	lis, err := listeners.listen(restListenerName, httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	err = httpServer.ServeTLS(
+		lis, config.TLS.TLSCertFile, config.TLS.TLSKeyFile,
+	)
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
+
+// initializePProfServer initializes the pprof server but doesn't start it. The
+// server also exposes the Prometheus metrics of the coordinator.
+func initializePProfServer(config *Config, tlsConfig *tls.Config) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/metrics", metricsHandler())
+
+	// Configure TLS settings for the server.
+	pprofServer := &http.Server{
+		Addr: joinHostPort(
+			config.PProf.PProfServerHost,
+			config.PProf.PProfServerPort,
+		),
+		Handler:   mux,
+		TLSConfig: tlsConfig,
+	}
+
+	return pprofServer
+}
+
+// startPProfServer starts the pprof server.
+func startPProfServer(config *Config, server *http.Server) error {
+	logrus.Infof("Starting pprof server on https://%s", server.Addr)
+
+	lis, err := listeners.listen(pprofListenerName, server.Addr)
+	if err != nil {
+		return err
+	}
+
+	err = server.ServeTLS(
+		lis, config.TLS.TLSCertFile, config.TLS.TLSKeyFile,
+	)
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
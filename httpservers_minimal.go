@@ -0,0 +1,31 @@
+//go:build minimal
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+
+	logrus "github.com/sirupsen/logrus"
+)
+
+// serveREST does not start the REST server, which is not built into minimal
+// binaries. It always returns nil.
+func serveREST(_ context.Context, _ *tls.Config, config *Config,
+	_ *startupProgress) (HTTPServer, error) {
+	if !config.Server.DisableREST {
+		logrus.Warn("REST server not built into this minimal binary")
+	}
+
+	return nil, nil
+}
+
+// servePProf does not start the pprof server, which is not built into minimal
+// binaries. It always returns nil.
+func servePProf(config *Config, _ *tls.Config) HTTPServer {
+	if !config.PProf.DisablePProf {
+		logrus.Warn("pprof server not built into this minimal binary")
+	}
+
+	return nil
+}
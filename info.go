@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/status"
+)
+
+// version and commit identify the build of the coordinator. They are set at
+// build time through -ldflags "-X main.version=... -X main.commit=...".
+var (
+	version = "dev"
+	commit  = ""
+)
+
+// commitHash returns the hash of the commit the coordinator was built from,
+// falling back to the one recorded by the Go toolchain if none was set at
+// build time.
+func commitHash() string {
+	if commit != "" {
+		return commit
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+
+	return ""
+}
+
+// GetInfo returns information about the coordinator including its build, the
+// dataset it serves and hints on how clients should pace their submissions.
+func (s *externalCoordinatorServer) GetInfo(ctx context.Context,
+	req *ecrpc.GetInfoRequest) (*ecrpc.GetInfoResponse, error) {
+
+	var dataset *ecrpc.DatasetInfo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		dataset = currentDatasetInfo(tx)
+		return nil
+	})
+	if err != nil {
+		msg := "failed to read dataset: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return &ecrpc.GetInfoResponse{
+		SubmissionHints: s.submissionHints(),
+		Network:         s.network(),
+		HistoryThresholdSeconds: int64(
+			s.config.Server.HistoryThresholdDuration.Seconds(),
+		),
+		QueryThresholdSeconds: int64(s.queryThreshold().Seconds()),
+		Startup:               s.startup.info(),
+		CapacityForecast:      s.capacity.Load(),
+		Version:               version,
+		CommitHash:            commitHash(),
+		StartTime:             s.startTime.Unix(),
+		Dataset:               dataset,
+		LastCleanupTime:       s.lastCleanup.Load(),
+	}, nil
+}
+
+// queryThreshold returns the age after which pairs are no longer returned by
+// queries by default, which never exceeds the history threshold.
+func (s *externalCoordinatorServer) queryThreshold() time.Duration {
+	threshold := s.config.Server.QueryThresholdDuration
+	if threshold <= 0 ||
+		threshold > s.config.Server.HistoryThresholdDuration {
+
+		return s.config.Server.HistoryThresholdDuration
+	}
+
+	return threshold
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryThreshold tests that queries only return the pairs updated within
+// the query threshold by default, that clients can request another maximum
+// age up to the history threshold, and that both thresholds are reported in
+// GetInfo.
+func TestQueryThreshold(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = 24 * time.Hour
+	config.Server.QueryThresholdDuration = 2 * time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	now := time.Unix(1700000000, 0)
+	server.clock = newManualClock(now)
+
+	info, err := server.GetInfo(
+		context.Background(), &ecrpc.GetInfoRequest{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 24*60*60, info.HistoryThresholdSeconds)
+	require.EqualValues(t, 2*60*60, info.QueryThresholdSeconds)
+
+	// Store a recent pair and one updated before the query threshold.
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, pair := range []struct {
+			nodeFrom, nodeTo []byte
+			updated          time.Time
+		}{
+			{nodeA, nodeB, now.Add(-time.Hour)},
+			{nodeC, nodeD, now.Add(-10 * time.Hour)},
+		} {
+			value, err := encodePairData(&ecrpc.PairData{
+				SuccessTime: pair.updated.Unix(),
+			})
+			if err != nil {
+				return err
+			}
+			key := pairKey(pair.nodeFrom, pair.nodeTo)
+			if err := putPair(tx, key, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	query := func(maxAgeSeconds int64) ([]*ecrpc.PairHistory, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				MaxAgeSeconds: maxAgeSeconds,
+			}, stream,
+		)
+
+		var pairs []*ecrpc.PairHistory
+		for _, resp := range stream.Responses {
+			pairs = append(pairs, resp.Pairs...)
+		}
+
+		return pairs, err
+	}
+
+	// Only the recent pair is returned by default.
+	pairs, err := query(0)
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	require.Equal(t, nodeA, pairs[0].NodeFrom)
+
+	// Clients can request older pairs, up to the history threshold.
+	pairs, err = query(12 * 60 * 60)
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+
+	pairs, err = query(365 * 24 * 60 * 60)
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+
+	_, err = query(-1)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The query threshold defaults to the history threshold.
+	config.Server.QueryThresholdDuration = 0
+	pairs, err = query(0)
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+}
+
+// TestGetInfo tests that GetInfo reports the build, the start time, the
+// dataset and the last cleanup run of the coordinator.
+func TestGetInfo(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	now := time.Unix(1700000000, 0)
+	server.clock = newManualClock(now)
+
+	getInfo := func() *ecrpc.GetInfoResponse {
+		info, err := server.GetInfo(
+			context.Background(), &ecrpc.GetInfoRequest{},
+		)
+		require.NoError(t, err)
+
+		return info
+	}
+
+	info := getInfo()
+	require.Equal(t, "dev", info.Version)
+	require.Equal(t, server.startTime.Unix(), info.StartTime)
+	require.Zero(t, info.Dataset.TotalPairs)
+	require.Zero(t, info.LastCleanupTime)
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeB,
+				History: &ecrpc.PairData{
+					SuccessTime:    now.Unix(),
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+	server.cleanupStaleData()
+
+	info = getInfo()
+	require.EqualValues(t, 1, info.Dataset.TotalPairs)
+	require.Equal(t, now.Unix(), info.LastCleanupTime)
+}
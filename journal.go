@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxJournaledRegistrations is the maximum number of journaled registrations
+// listed by a single request.
+const maxJournaledRegistrations = 1000
+
+// journalKeySize is the size in bytes of the time and sequence number
+// prefixing the keys of journaled registrations.
+const journalKeySize = 16
+
+// journalKey returns the key of a journaled registration. Keys are ordered by
+// the time the registration was stored at, the sequence number keeps the order
+// of registrations stored at the same time. The identity of the submitter
+// follows them within the keys of appendJournal.
+func journalKey(stored time.Time, seq uint64) []byte {
+	key := make([]byte, journalKeySize)
+	binary.BigEndian.PutUint64(key[:8], uint64(stored.Unix()))
+	binary.BigEndian.PutUint64(key[8:], seq)
+
+	return key
+}
+
+// appendJournal journals the pairs of a registration of the submitter as
+// received, before they are aggregated with the stored data. The submitter is
+// empty if it is not recorded.
+func appendJournal(tx *bbolt.Tx, stored time.Time, submitter string,
+	pairs []*ecrpc.PairHistory) error {
+
+	b := tx.Bucket([]byte(JournalBucketName))
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	if err != nil {
+		return err
+	}
+
+	key := append(journalKey(stored, seq), submitter...)
+
+	return b.Put(key, data)
+}
+
+// journaledSubmitter returns the identity of the submitter recorded with its
+// journaled registrations, which is omitted in privacy mode.
+func (s *externalCoordinatorServer) journaledSubmitter(
+	submitter string) string {
+
+	if s.config.Server.PrivacyMode {
+		return ""
+	}
+
+	return submitter
+}
+
+// journaledRegistrations returns the registrations of the submitter journaled
+// at or after start and before end, oldest first. A zero end does not limit
+// the time. At most the given number of registrations is returned, together
+// with whether there are more.
+func journaledRegistrations(tx *bbolt.Tx, submitter string, start, end int64,
+	limit int) ([]*ecadminrpc.JournaledRegistration, bool, error) {
+
+	var registrations []*ecadminrpc.JournaledRegistration
+	c := tx.Bucket([]byte(JournalBucketName)).Cursor()
+	k, v := c.Seek(journalKey(time.Unix(start, 0), 0))
+	for ; k != nil; k, v = c.Next() {
+		stored := int64(binary.BigEndian.Uint64(k[:8]))
+		if end > 0 && stored >= end {
+			break
+		}
+		if string(k[journalKeySize:]) != submitter {
+			continue
+		}
+		if len(registrations) == limit {
+			return registrations, true, nil
+		}
+
+		req := &ecrpc.RegisterMissionControlRequest{}
+		if err := proto.Unmarshal(v, req); err != nil {
+			return nil, false, status.Errorf(codes.DataLoss,
+				"failed to decode journaled registration: %v",
+				err)
+		}
+		registrations = append(
+			registrations, &ecadminrpc.JournaledRegistration{
+				JournaledAt:  stored,
+				Registration: bytes.Clone(v),
+				Pairs:        uint32(len(req.Pairs)),
+			},
+		)
+	}
+
+	return registrations, false, nil
+}
+
+// pruneJournal removes the journaled registrations older than the history
+// threshold, since the pairs they contributed to are removed by then.
+func (s *externalCoordinatorServer) pruneJournal() (int, error) {
+	cutoff := journalKey(
+		s.clock.Now().Add(-s.config.Server.HistoryThresholdDuration), 0,
+	)
+
+	removed := 0
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(JournalBucketName)).Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.First() {
+			if bytes.Compare(k, cutoff) >= 0 {
+				break
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+			removed++
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
+
+// reaggregateJournal recomputes the stored pairs by replaying the journaled
+// registrations in the order they were stored through the current
+// aggregation. Only pairs still stored are recomputed, so that pairs deleted
+// or evicted since are not resurrected, and pairs without journaled
+// registrations are kept as they are. It returns the number of registrations
+// replayed and the keys of the pairs recomputed.
+func reaggregateJournal(ctx context.Context, tx *bbolt.Tx,
+	conflicts *conflictResolver) (int, [][]byte, error) {
+
+	b := tx.Bucket([]byte(DatabaseBucketName))
+	journal := tx.Bucket([]byte(JournalBucketName))
+
+	// Collect the journaled pairs of the stored pairs.
+	var registrations [][]*ecrpc.PairHistory
+	var keys [][]byte
+	stored := make(map[string]bool)
+	err := journal.ForEach(func(k, v []byte) error {
+		req := &ecrpc.RegisterMissionControlRequest{}
+		if err := proto.Unmarshal(v, req); err != nil {
+			return status.Errorf(codes.DataLoss, "failed to "+
+				"decode journaled registration: %v", err)
+		}
+
+		var pairs []*ecrpc.PairHistory
+		for _, pair := range req.Pairs {
+			key := pairKey(pair.NodeFrom, pair.NodeTo)
+			replayed, seen := stored[string(key)]
+			if !seen {
+				replayed = b.Get(key) != nil
+				stored[string(key)] = replayed
+				if replayed {
+					keys = append(keys, key)
+				}
+			}
+			if replayed {
+				pairs = append(pairs, pair)
+			}
+		}
+		registrations = append(registrations, pairs)
+
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Drop the pairs computed under the previous rules together with their
+	// latency samples and observations, which are rebuilt by the replay.
+	for _, key := range keys {
+		if err := deletePair(tx, key); err != nil {
+			return 0, nil, err
+		}
+		err := tx.Bucket([]byte(LatencySamplesBucketName)).Delete(key)
+		if err != nil {
+			return 0, nil, err
+		}
+		err = tx.Bucket([]byte(PairObservationsBucketName)).Delete(key)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	for _, pairs := range registrations {
+		if len(pairs) == 0 {
+			continue
+		}
+		_, err := aggregatePairs(ctx, tx, pairs, conflicts)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return len(registrations), keys, nil
+}
+
+// ReaggregateMissionControl recomputes the stored pairs by replaying the
+// journaled registrations through the current aggregation. All pairs are
+// recomputed within a single transaction, so queries either see the previous
+// or the recomputed dataset.
+func (a *adminServer) ReaggregateMissionControl(ctx context.Context,
+	req *ecadminrpc.ReaggregateMissionControlRequest) (
+	*ecadminrpc.ReaggregateMissionControlResponse, error) {
+
+	if a.coordinator == nil || !a.config.Server.JournalRegistrations {
+		return nil, status.Errorf(codes.FailedPrecondition, "the "+
+			"registration journal is not enabled")
+	}
+
+	start := time.Now()
+	var replayed int
+	var keys [][]byte
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		replayed, keys, err = reaggregateJournal(
+			ctx, tx, a.coordinator.conflicts,
+		)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		return bumpDatasetRevision(tx)
+	})
+	if err != nil {
+		msg := "failed to reaggregate mission control data: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Reaggregated %d pairs from %d journaled registrations "+
+		"in %v", len(keys), replayed, time.Since(start))
+
+	// Ship the recomputed pairs with the next snapshot to the standby
+	// coordinator if configured.
+	a.coordinator.snapshots.markDirty(keys...)
+
+	return &ecadminrpc.ReaggregateMissionControlResponse{
+		RegistrationsReplayed: uint64(replayed),
+		PairsReaggregated:     uint64(len(keys)),
+	}, nil
+}
+
+// ListJournaledRegistrations lists the registrations of a submitter journaled
+// within a time window, so that operators can reproduce what the submitter
+// sent.
+func (a *adminServer) ListJournaledRegistrations(ctx context.Context,
+	req *ecadminrpc.ListJournaledRegistrationsRequest) (
+	*ecadminrpc.ListJournaledRegistrationsResponse, error) {
+
+	if !a.config.Server.JournalRegistrations {
+		return nil, status.Errorf(codes.FailedPrecondition, "the "+
+			"registration journal is not enabled")
+	}
+	if a.config.Server.PrivacyMode {
+		return nil, status.Errorf(codes.FailedPrecondition, "the "+
+			"submitters of registrations are not recorded in "+
+			"privacy mode")
+	}
+	if req.Submitter == "" {
+		return nil, status.Error(codes.InvalidArgument, "submitter "+
+			"must be set")
+	}
+	if req.StartTime < 0 || req.EndTime < 0 {
+		return nil, status.Error(codes.InvalidArgument, "start and "+
+			"end time must not be negative")
+	}
+
+	var registrations []*ecadminrpc.JournaledRegistration
+	var truncated bool
+	err := a.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		registrations, truncated, err = journaledRegistrations(
+			tx, req.Submitter, req.StartTime, req.EndTime,
+			maxJournaledRegistrations,
+		)
+
+		return err
+	})
+	if err != nil {
+		msg := "failed to list journaled registrations: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return &ecadminrpc.ListJournaledRegistrationsResponse{
+		Registrations: registrations,
+		Truncated:     truncated,
+	}, nil
+}
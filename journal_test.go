@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestReaggregateMissionControl tests that the stored pairs are recomputed
+// from the journaled registrations, that deleted pairs are not resurrected
+// and that stale registrations are pruned from the journal.
+func TestReaggregateMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+	admin := NewAdminServer(config, db)
+	admin.coordinator = server
+
+	// Recomputing requires the journal.
+	reaggregate := func() (*ecadminrpc.ReaggregateMissionControlResponse,
+		error) {
+
+		return admin.ReaggregateMissionControl(
+			context.Background(),
+			&ecadminrpc.ReaggregateMissionControlRequest{},
+		)
+	}
+	_, err = reaggregate()
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	config.Server.JournalRegistrations = true
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	now := clock.Now().Unix()
+	register := func(nodeTo []byte, amtMsat int64, timestamp int64) {
+		req := &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    timestamp,
+					SuccessAmtMsat: amtMsat,
+				},
+			}},
+		}
+		_, err := server.RegisterMissionControl(
+			context.Background(), req,
+		)
+		require.NoError(t, err)
+	}
+	register(nodeB, 2_000_000, now-20)
+	register(nodeB, 1_000_000, now-10)
+	register(nodeC, 3_000_000, now)
+
+	stored := func(nodeTo []byte) *ecrpc.PairData {
+		var history *ecrpc.PairData
+		err := db.View(func(tx *bbolt.Tx) error {
+			v := tx.Bucket([]byte(DatabaseBucketName)).Get(
+				pairKey(nodeA, nodeTo),
+			)
+			if v == nil {
+				return nil
+			}
+			var err error
+			history, err = decodePairData(v)
+
+			return err
+		})
+		require.NoError(t, err)
+
+		return history
+	}
+	expected := stored(nodeB)
+	require.NotNil(t, expected)
+
+	// Pretend the pair was computed under different rules.
+	outdated := stored(nodeB)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		outdated.SuccessAmtMsat = 42_000
+		outdated.SuccessAmtSat = 42
+		v, err := encodePairData(outdated)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket([]byte(DatabaseBucketName)).Put(
+			pairKey(nodeA, nodeB), v,
+		)
+	})
+	require.NoError(t, err)
+
+	// Deleted pairs stay deleted.
+	_, err = admin.DeletePairs(
+		context.Background(), &ecadminrpc.DeletePairsRequest{
+			Node: nodeC,
+		},
+	)
+	require.NoError(t, err)
+
+	resp, err := reaggregate()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, resp.RegistrationsReplayed)
+	require.EqualValues(t, 1, resp.PairsReaggregated)
+	require.Equal(
+		t, expected.SuccessAmtMsat, stored(nodeB).SuccessAmtMsat,
+	)
+	require.Nil(t, stored(nodeC))
+
+	// The journal is pruned together with the stale pairs.
+	clock.Advance(2 * time.Hour)
+	removed, err := server.pruneJournal()
+	require.NoError(t, err)
+	require.Equal(t, 3, removed)
+}
+
+// TestListJournaledRegistrations tests that the journaled registrations of a
+// submitter are listed within the requested time window.
+func TestListJournaledRegistrations(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.JournalRegistrations = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+	admin := NewAdminServer(config, db)
+	admin.coordinator = server
+
+	nodeA, nodeB := generateTestKeys(t)
+	register := func(addr string) {
+		history := &ecrpc.PairData{
+			SuccessTime:    clock.Now().Unix(),
+			SuccessAmtMsat: 1000,
+		}
+		_, err := server.RegisterMissionControl(
+			submitterContext(addr),
+			&ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeB,
+					History:  history,
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+	start := clock.Now().Unix()
+	register("203.0.113.1")
+	register("203.0.113.2")
+	clock.Advance(time.Minute)
+	register("203.0.113.1")
+
+	list := func(req *ecadminrpc.ListJournaledRegistrationsRequest) (
+		*ecadminrpc.ListJournaledRegistrationsResponse, error) {
+
+		return admin.ListJournaledRegistrations(
+			context.Background(), req,
+		)
+	}
+	resp, err := list(&ecadminrpc.ListJournaledRegistrationsRequest{
+		Submitter: "203.0.113.1",
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Truncated)
+	require.Len(t, resp.Registrations, 2)
+	require.Equal(t, start, resp.Registrations[0].JournaledAt)
+	require.EqualValues(t, 1, resp.Registrations[0].Pairs)
+
+	// The registrations are journaled as received.
+	req := &ecrpc.RegisterMissionControlRequest{}
+	require.NoError(t, proto.Unmarshal(
+		resp.Registrations[1].Registration, req,
+	))
+	require.Len(t, req.Pairs, 1)
+	require.Equal(t, nodeB, req.Pairs[0].NodeTo)
+
+	// Only the registrations within the time window are listed.
+	resp, err = list(&ecadminrpc.ListJournaledRegistrationsRequest{
+		Submitter: "203.0.113.1",
+		StartTime: start + 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Registrations, 1)
+	resp, err = list(&ecadminrpc.ListJournaledRegistrationsRequest{
+		Submitter: "203.0.113.2",
+		EndTime:   start,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Registrations)
+
+	_, err = list(&ecadminrpc.ListJournaledRegistrationsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Submitters are not recorded in privacy mode.
+	config.Server.PrivacyMode = true
+	_, err = list(&ecadminrpc.ListJournaledRegistrationsRequest{
+		Submitter: "203.0.113.1",
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	logrus "github.com/sirupsen/logrus"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// keyScheme is the tag identifying the encoding of the pair keys of a
+// database. It is stored in the metadata bucket, so that a database is never
+// read with another encoding than the one it was written with.
+type keyScheme byte
+
+const (
+	// keySchemeCompressedPubKey encodes a pair as the concatenation of the
+	// 33 byte compressed pubkeys of its nodes.
+	keySchemeCompressedPubKey keyScheme = 1
+)
+
+// keySchemeKey is the key of the tag of the pair key encoding within the
+// metadata bucket.
+var keySchemeKey = []byte("key_scheme")
+
+// pairKeyCodec encodes the directed pairs of two node identifiers into the
+// keys of the pairs in the database. Each codec supports one kind of node
+// identifier, e.g. compressed pubkeys.
+type pairKeyCodec interface {
+	// scheme returns the tag of the encoding stored in the database.
+	scheme() keyScheme
+
+	// name returns the name of the encoding in the configuration.
+	name() string
+
+	// validateNode returns an error if the node identifier is invalid.
+	validateNode(node []byte) error
+
+	// encode returns the key of the directed pair from nodeFrom to nodeTo.
+	// The node identifiers must be valid. Keys must start with nodeFrom,
+	// so that the pairs of a source node can be scanned by prefix.
+	encode(nodeFrom, nodeTo []byte) []byte
+
+	// split returns the node identifiers of a key produced by encode. The
+	// identifiers share the memory of the key.
+	split(key []byte) (nodeFrom, nodeTo []byte)
+
+	// validateKey returns an error if the key was not produced by encode.
+	validateKey(key []byte) error
+}
+
+// compressedPubKeyCodec encodes pairs of compressed pubkeys.
+type compressedPubKeyCodec struct{}
+
+// scheme returns the tag of the compressed pubkey encoding.
+func (compressedPubKeyCodec) scheme() keyScheme {
+	return keySchemeCompressedPubKey
+}
+
+// name returns the name of the compressed pubkey encoding.
+func (compressedPubKeyCodec) name() string {
+	return "compressed_pubkey"
+}
+
+// validateNode ensures that the node is a valid compressed pubkey.
+func (compressedPubKeyCodec) validateNode(node []byte) error {
+	if len(node) != PubKeyCompressedSize {
+		return fmt.Errorf("must be exactly %d bytes",
+			PubKeyCompressedSize)
+	}
+	if _, err := btcec.ParsePubKey(node); err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+
+	return nil
+}
+
+// encode concatenates the pubkeys of the nodes.
+func (compressedPubKeyCodec) encode(nodeFrom, nodeTo []byte) []byte {
+	key := make([]byte, 0, PubKeyCompressedSizeDouble)
+	key = append(key, nodeFrom...)
+
+	return append(key, nodeTo...)
+}
+
+// split splits the key into the pubkeys of the nodes.
+func (compressedPubKeyCodec) split(key []byte) ([]byte, []byte) {
+	return key[:PubKeyCompressedSize], key[PubKeyCompressedSize:]
+}
+
+// validateKey ensures that the key holds two compressed pubkeys.
+func (compressedPubKeyCodec) validateKey(key []byte) error {
+	if len(key) != PubKeyCompressedSizeDouble {
+		return fmt.Errorf("key must be exactly %d bytes",
+			PubKeyCompressedSizeDouble)
+	}
+
+	return nil
+}
+
+// pairKeyCodecs are the pair key encodings the coordinator supports.
+var pairKeyCodecs = []pairKeyCodec{compressedPubKeyCodec{}}
+
+// pairKeys is the pair key encoding of the open database. It is set to the
+// configured encoding when the database is set up.
+var pairKeys pairKeyCodec = compressedPubKeyCodec{}
+
+// pairKeyCodecByName returns the pair key encoding of the given name.
+func pairKeyCodecByName(name string) (pairKeyCodec, error) {
+	names := make([]string, 0, len(pairKeyCodecs))
+	for _, codec := range pairKeyCodecs {
+		if codec.name() == name {
+			return codec, nil
+		}
+		names = append(names, codec.name())
+	}
+
+	return nil, fmt.Errorf("unsupported key scheme %q, options are %s",
+		name, strings.Join(names, ", "))
+}
+
+// pairKeyCodecByScheme returns the pair key encoding of the given tag.
+func pairKeyCodecByScheme(scheme keyScheme) (pairKeyCodec, error) {
+	for _, codec := range pairKeyCodecs {
+		if codec.scheme() == scheme {
+			return codec, nil
+		}
+	}
+
+	return nil, fmt.Errorf("database uses key scheme %d unknown to this "+
+		"coordinator", scheme)
+}
+
+// pairKey returns the database key of the directed pair from nodeFrom to
+// nodeTo.
+func pairKey(nodeFrom, nodeTo []byte) []byte {
+	return pairKeys.encode(nodeFrom, nodeTo)
+}
+
+// splitPairKey returns the nodes of the directed pair of a database key.
+func splitPairKey(key []byte) (nodeFrom, nodeTo []byte) {
+	return pairKeys.split(key)
+}
+
+// checkKeyScheme tags the database with the pair key encoding on first use and
+// ensures that a tagged database is only read with the encoding it was
+// written with. Databases without a tag predate the tags and hold compressed
+// pubkeys. If the database uses another encoding, its keys are migrated to the
+// given one if allowed and else an error is returned.
+func checkKeyScheme(tx *bbolt.Tx, codec pairKeyCodec, migrate bool) error {
+	b := tx.Bucket([]byte(MetadataBucketName))
+	stored := keySchemeCompressedPubKey
+	if v := b.Get(keySchemeKey); len(v) == 1 {
+		stored = keyScheme(v[0])
+	}
+
+	if stored != codec.scheme() {
+		from, err := pairKeyCodecByScheme(stored)
+		if err != nil {
+			return err
+		}
+		if !migrate {
+			return fmt.Errorf("database holds %s pair keys and "+
+				"cannot be used with %s keys, set "+
+				"migrate_key_scheme to migrate them",
+				from.name(), codec.name())
+		}
+
+		migrated, err := migratePairKeys(tx, from, codec)
+		if err != nil {
+			return fmt.Errorf("failed to migrate pair keys "+
+				"from %s to %s: %v", from.name(), codec.name(),
+				err)
+		}
+		logrus.Infof("Migrated %d pair keys from %s to %s", migrated,
+			from.name(), codec.name())
+	}
+
+	return b.Put(keySchemeKey, []byte{byte(codec.scheme())})
+}
+
+// migratePairKeys re-encodes the keys of all buckets keyed by pairs from one
+// encoding to another. Keys whose nodes are invalid in the new encoding fail
+// the migration, so that no pair is lost silently. The indexes of the pairs
+// are dropped and rebuilt when the database is set up. The channel graph and
+// the closed channels hold node identifiers of the old encoding, so they are
+// dropped as well and the graph has to be imported again. It returns the
+// number of pairs migrated.
+func migratePairKeys(tx *bbolt.Tx, from, to pairKeyCodec) (int, error) {
+	buckets := []*bbolt.Bucket{
+		tx.Bucket([]byte(DatabaseBucketName)),
+		tx.Bucket([]byte(LatencySamplesBucketName)),
+		tx.Bucket([]byte(AggregationExperimentBucketName)),
+		tx.Bucket([]byte(PairObservationsBucketName)),
+		tx.Bucket([]byte(PairSubmittersBucketName)),
+	}
+
+	// The archived epochs and the private pairs of each owner are held in
+	// nested buckets.
+	archive := tx.Bucket([]byte(ArchiveBucketName))
+	err := archive.ForEachBucket(func(epoch []byte) error {
+		pairs := archive.Bucket(epoch).Bucket(archivePairsKey)
+		if pairs != nil {
+			buckets = append(buckets, pairs)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	private := tx.Bucket([]byte(PrivatePairsBucketName))
+	err = private.ForEachBucket(func(owner []byte) error {
+		buckets = append(buckets, private.Bucket(owner))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for i, b := range buckets {
+		n, err := rekeyBucket(b, from, to)
+		if err != nil {
+			return 0, err
+		}
+
+		// Only the first bucket holds the current pairs.
+		if i == 0 {
+			migrated = n
+		}
+	}
+
+	for _, name := range []string{
+		UpdateIndexBucketName, NodeIndexBucketName,
+		RevisionLogBucketName, PairModifiedBucketName,
+		ModifiedIndexBucketName, MerkleEntriesBucketName,
+		MerkleLeavesBucketName,
+	} {
+		err := tx.DeleteBucket([]byte(name))
+		if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return 0, err
+		}
+	}
+
+	for _, name := range []string{
+		ChannelGraphBucketName, ClosedChannelsBucketName,
+	} {
+		if err := tx.DeleteBucket([]byte(name)); err != nil {
+			return 0, err
+		}
+		if _, err := tx.CreateBucket([]byte(name)); err != nil {
+			return 0, err
+		}
+	}
+
+	return migrated, nil
+}
+
+// rekeyBucket re-encodes the keys of a bucket keyed by pairs from one encoding
+// to another. Keys which are no pair keys, e.g. the policy of the aggregation
+// experiment, are kept as they are. It returns the number of keys re-encoded.
+func rekeyBucket(b *bbolt.Bucket, from, to pairKeyCodec) (int, error) {
+	var keys, values [][]byte
+	err := b.ForEach(func(k, v []byte) error {
+		if v == nil || from.validateKey(k) != nil {
+			return nil
+		}
+
+		nodeFrom, nodeTo := from.split(k)
+		for _, node := range [][]byte{nodeFrom, nodeTo} {
+			if err := to.validateNode(node); err != nil {
+				return fmt.Errorf("pair %x: node %x: %v", k,
+					node, err)
+			}
+		}
+
+		keys = append(keys, bytes.Clone(k))
+		values = append(values, bytes.Clone(v))
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+	for i, k := range keys {
+		nodeFrom, nodeTo := from.split(k)
+		err := b.Put(to.encode(nodeFrom, nodeTo), values[i])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(keys), nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// paddedPubKeyCodec encodes pairs of compressed pubkeys separated by a zero
+// byte. It only exists to test migrations between encodings.
+type paddedPubKeyCodec struct {
+	compressedPubKeyCodec
+}
+
+// scheme returns an unused tag.
+func (paddedPubKeyCodec) scheme() keyScheme {
+	return 0xff
+}
+
+// name returns the name of the padded encoding.
+func (paddedPubKeyCodec) name() string {
+	return "padded_pubkey"
+}
+
+// encode joins the pubkeys of the nodes with a zero byte.
+func (paddedPubKeyCodec) encode(nodeFrom, nodeTo []byte) []byte {
+	key := append([]byte(nil), nodeFrom...)
+	key = append(key, 0)
+
+	return append(key, nodeTo...)
+}
+
+// split splits the key into the pubkeys of the nodes.
+func (paddedPubKeyCodec) split(key []byte) ([]byte, []byte) {
+	return key[:PubKeyCompressedSize], key[PubKeyCompressedSize+1:]
+}
+
+// validateKey ensures that the key holds two padded pubkeys.
+func (paddedPubKeyCodec) validateKey(key []byte) error {
+	if len(key) != PubKeyCompressedSizeDouble+1 {
+		return fmt.Errorf("invalid padded key")
+	}
+
+	return nil
+}
+
+// TestKeySchemeMigration tests that databases are tagged with the encoding of
+// their pair keys, can only be opened with it, and are migrated to another
+// encoding on request.
+func TestKeySchemeMigration(t *testing.T) {
+	defer func(codecs []pairKeyCodec) {
+		pairKeyCodecs = codecs
+		pairKeys = compressedPubKeyCodec{}
+	}(pairKeyCodecs)
+	pairKeyCodecs = append(pairKeyCodecs, paddedPubKeyCodec{})
+
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+
+	nodeA, nodeB := generateTestKeys(t)
+	value, err := encodePairData(&ecrpc.PairData{SuccessTime: 1})
+	require.NoError(t, err)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return putPair(tx, pairKey(nodeA, nodeB), value)
+	})
+	require.NoError(t, err)
+	cleanupDB(db)
+
+	// The database cannot be opened with another encoding unless its keys
+	// are migrated.
+	config.Database.KeyScheme = paddedPubKeyCodec{}.name()
+	_, err = setupDatabase(config)
+	require.ErrorContains(t, err, "migrate_key_scheme")
+
+	config.Database.MigrateKeyScheme = true
+	db, err = setupDatabase(config)
+	require.NoError(t, err)
+
+	keys := storedPairKeys(t, db)
+	require.Len(t, keys, 1)
+	require.Len(t, keys[0], PubKeyCompressedSizeDouble+1)
+	nodeFrom, nodeTo := splitPairKey([]byte(keys[0]))
+	require.Equal(t, nodeA, nodeFrom)
+	require.Equal(t, nodeB, nodeTo)
+
+	// The indexes are rebuilt with the migrated keys.
+	err = db.View(func(tx *bbolt.Tx) error {
+		require.EqualValues(t, 2, countNodes(tx))
+		require.Len(t, freshPairKeys(tx, time.Unix(0, 0)), 1)
+
+		return nil
+	})
+	require.NoError(t, err)
+	cleanupDB(db)
+
+	// Databases tagged with an unknown encoding are never opened.
+	pairKeyCodecs = pairKeyCodecs[:1]
+	config.Database.KeyScheme = DefaultKeyScheme
+	_, err = setupDatabase(config)
+	require.ErrorContains(t, err, "unknown")
+}
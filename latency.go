@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	bbolt "go.etcd.io/bbolt"
+)
+
+// latencySampleSize is the size in bytes of a single encoded latency sample.
+const latencySampleSize = 4
+
+// recordLatencySample appends the given latency observation to the samples
+// stored for the pair key, discarding the oldest observations once
+// MaxLatencySamples is exceeded. It returns the updated list of samples.
+func recordLatencySample(b *bbolt.Bucket, key []byte,
+	latencyMs uint32) ([]uint32, error) {
+
+	samples, err := decodeLatencySamples(b.Get(key))
+	if err != nil {
+		return nil, err
+	}
+
+	samples = append(samples, latencyMs)
+	if len(samples) > MaxLatencySamples {
+		samples = samples[len(samples)-MaxLatencySamples:]
+	}
+
+	if err := b.Put(key, encodeLatencySamples(samples)); err != nil {
+		return nil, err
+	}
+
+	return samples, nil
+}
+
+// encodeLatencySamples encodes the latency samples as a sequence of big-endian
+// uint32 values.
+func encodeLatencySamples(samples []uint32) []byte {
+	data := make([]byte, len(samples)*latencySampleSize)
+	for i, sample := range samples {
+		binary.BigEndian.PutUint32(data[i*latencySampleSize:], sample)
+	}
+
+	return data
+}
+
+// decodeLatencySamples decodes a sequence of big-endian uint32 latency
+// samples.
+func decodeLatencySamples(data []byte) ([]uint32, error) {
+	if len(data)%latencySampleSize != 0 {
+		return nil, fmt.Errorf("invalid latency samples length: %d",
+			len(data))
+	}
+
+	samples := make([]uint32, 0, len(data)/latencySampleSize)
+	for i := 0; i < len(data); i += latencySampleSize {
+		samples = append(samples, binary.BigEndian.Uint32(data[i:]))
+	}
+
+	return samples, nil
+}
+
+// latencyPercentile returns the p-th percentile (0-100) of the given samples
+// using the nearest-rank method. It returns zero if there are no samples.
+func latencyPercentile(samples []uint32, p float64) uint32 {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	sorted := append([]uint32{}, samples...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
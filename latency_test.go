@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestLatencyPercentile tests the nearest-rank percentile computation.
+func TestLatencyPercentile(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []uint32
+		p        float64
+		expected uint32
+	}{
+		{"NoSamples", nil, 50, 0},
+		{"SingleSample", []uint32{42}, 95, 42},
+		{"Median", []uint32{50, 10, 40, 20, 30}, 50, 30},
+		{"P95", []uint32{50, 10, 40, 20, 30}, 95, 50},
+		{"ZeroPercentile", []uint32{50, 10}, 0, 10},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := latencyPercentile(tc.samples, tc.p)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// TestLatencySamplesEncoding tests the encoding and decoding of latency
+// samples.
+func TestLatencySamplesEncoding(t *testing.T) {
+	samples := []uint32{1, 250, 1 << 31}
+	decoded, err := decodeLatencySamples(encodeLatencySamples(samples))
+	require.NoError(t, err)
+	require.Equal(t, samples, decoded)
+
+	// A truncated sample must be rejected.
+	_, err = decodeLatencySamples([]byte{0x01, 0x02, 0x03})
+	require.Error(t, err)
+}
+
+// TestRecordLatencySample tests that the latency samples of a pair are bounded
+// by MaxLatencySamples and that the oldest samples are discarded first.
+func TestRecordLatencySample(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	key := []byte("pair")
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(LatencySamplesBucketName))
+		var samples []uint32
+		for i := 1; i <= MaxLatencySamples+5; i++ {
+			samples, err = recordLatencySample(b, key, uint32(i))
+			if err != nil {
+				return err
+			}
+		}
+
+		require.Len(t, samples, MaxLatencySamples)
+		require.Equal(t, uint32(6), samples[0])
+		require.Equal(t, uint32(MaxLatencySamples+5), samples[len(samples)-1])
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestRegisterMissionControlLatency tests that registered latency observations
+// are aggregated into per pair percentiles.
+func TestRegisterMissionControlLatency(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 100
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	nodeFrom, nodeTo := generateTestKeys(t)
+
+	var pairs []*ecrpc.PairHistory
+	for _, latency := range []uint32{100, 200, 300, 400, 1000} {
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:         time.Now().Unix(),
+				SuccessAmtMsat:      1000,
+				ResolutionLatencyMs: latency,
+				// Submitted percentiles must be ignored.
+				LatencyP50Ms: 1,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	mockStream := &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{}, mockStream,
+	)
+	require.NoError(t, err)
+	require.Len(t, mockStream.Responses, 1)
+	require.Len(t, mockStream.Responses[0].Pairs, 1)
+
+	history := mockStream.Responses[0].Pairs[0].History
+	require.Equal(t, uint32(300), history.LatencyP50Ms)
+	require.Equal(t, uint32(1000), history.LatencyP95Ms)
+	require.Zero(t, history.ResolutionLatencyMs)
+}
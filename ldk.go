@@ -0,0 +1,120 @@
+package main
+
+import (
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateQueryFormat validates the format requested by a query.
+func validateQueryFormat(format ecrpc.QueryFormat) error {
+	if _, ok := ecrpc.QueryFormat_name[int32(format)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown query "+
+			"format %d", format)
+	}
+
+	return nil
+}
+
+// loadChannelIndex returns the short channel ids of the channels of the
+// channel graph by the canonically ordered pubkeys of their nodes.
+func loadChannelIndex(tx *bbolt.Tx) (map[string][]uint64, error) {
+	index := make(map[string][]uint64)
+	b := tx.Bucket([]byte(ChannelGraphBucketName))
+	err := b.ForEach(func(k, v []byte) error {
+		index[string(v)] = append(index[string(v)], decodeUint64(k))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// pairLiquidityBounds returns the liquidity bounds of the channels between the
+// nodes of the pair as estimated by the ProbabilisticScorer of LDK: a success
+// raises the lower bound to the amount forwarded, while a failure lowers the
+// upper bound to the amount that failed to forward.
+func pairLiquidityBounds(pair *ecrpc.PairHistory,
+	channels map[string][]uint64) []*ecrpc.LiquidityBounds {
+
+	history := pair.GetHistory()
+	var maxLiquidity uint64
+	if history.GetFailTime() != 0 && history.GetFailAmtMsat() > 0 {
+		maxLiquidity = uint64(history.GetFailAmtMsat())
+	}
+
+	scids := channels[string(channelNodes(pair.NodeFrom, pair.NodeTo))]
+	if len(scids) == 0 {
+		scids = []uint64{0}
+	}
+
+	bounds := make([]*ecrpc.LiquidityBounds, 0, len(scids))
+	for _, scid := range scids {
+		bounds = append(bounds, &ecrpc.LiquidityBounds{
+			ShortChannelId:   scid,
+			SourceNode:       pair.NodeFrom,
+			TargetNode:       pair.NodeTo,
+			MinLiquidityMsat: uint64(history.GetSuccessAmtMsat()),
+			MaxLiquidityMsat: maxLiquidity,
+			LastUpdated: max(
+				history.GetSuccessTime(), history.GetFailTime(),
+			),
+		})
+	}
+
+	return bounds
+}
+
+// liquidityBoundsStream converts the pairs sent on a query stream to the
+// liquidity bounds of their channels.
+type liquidityBoundsStream struct {
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer
+
+	// channels are the short channel ids of the channels of the channel
+	// graph by the canonically ordered pubkeys of their nodes.
+	channels map[string][]uint64
+}
+
+// Send converts the pairs of the response to the liquidity bounds of their
+// channels and sends it.
+func (l *liquidityBoundsStream) Send(
+	resp *ecrpc.QueryAggregatedMissionControlResponse) error {
+	var bounds []*ecrpc.LiquidityBounds
+	for _, pair := range resp.Pairs {
+		bounds = append(bounds, pairLiquidityBounds(pair, l.channels)...)
+	}
+
+	return l.ExternalCoordinator_QueryAggregatedMissionControlServer.Send(
+		&ecrpc.QueryAggregatedMissionControlResponse{
+			Dataset:         resp.Dataset,
+			LiquidityBounds: bounds,
+		},
+	)
+}
+
+// newLiquidityBoundsStream returns a stream sending the pairs of a query as
+// the liquidity bounds of their channels on the given stream.
+func (s *externalCoordinatorServer) newLiquidityBoundsStream(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer) (
+	*liquidityBoundsStream, error) {
+
+	var channels map[string][]uint64
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		channels, err = loadChannelIndex(tx)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &liquidityBoundsStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: stream,
+		channels: channels,
+	}, nil
+}
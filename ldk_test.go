@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryLiquidityBounds tests that pairs are returned as the liquidity
+// bounds of their channels if requested.
+func TestQueryLiquidityBounds(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	// The nodes A and B share two parallel channels, while the graph does
+	// not know a channel between C and D.
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	err = admin.ImportChannelGraph(&mockImportChannelGraphServer{
+		Chunks: []*ecadminrpc.ImportChannelGraphRequest{{
+			Full: true,
+			Channels: []*ecadminrpc.Channel{
+				{ShortChannelId: 1, Node1: nodeB, Node2: nodeA},
+				{ShortChannelId: 2, Node1: nodeA, Node2: nodeB},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeB,
+				History: &ecrpc.PairData{
+					SuccessTime:    now - 10,
+					SuccessAmtMsat: 2_000_000,
+					FailTime:       now,
+					FailAmtMsat:    5_000_000,
+				},
+			}, {
+				NodeFrom: nodeC,
+				NodeTo:   nodeD,
+				History: &ecrpc.PairData{
+					SuccessTime:    now,
+					SuccessAmtMsat: 1_000_000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(format ecrpc.QueryFormat) (
+		*mockQueryAggregatedMissionControlServer, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				Format: format,
+			}, stream,
+		)
+
+		return stream, err
+	}
+
+	_, err = query(ecrpc.QueryFormat(42))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err := query(
+		ecrpc.QueryFormat_QUERY_FORMAT_LDK_LIQUIDITY_BOUNDS,
+	)
+	require.NoError(t, err)
+
+	bounds := make(map[uint64]*ecrpc.LiquidityBounds)
+	for _, resp := range stream.Responses {
+		require.Empty(t, resp.Pairs)
+		for _, b := range resp.LiquidityBounds {
+			bounds[b.ShortChannelId] = b
+		}
+	}
+	require.Len(t, bounds, 3)
+
+	// Parallel channels share the bounds of their pair.
+	for _, scid := range []uint64{1, 2} {
+		require.Equal(t, nodeA, bounds[scid].SourceNode)
+		require.Equal(t, nodeB, bounds[scid].TargetNode)
+		require.EqualValues(t, 2_000_000, bounds[scid].MinLiquidityMsat)
+		require.EqualValues(t, 5_000_000, bounds[scid].MaxLiquidityMsat)
+		require.Equal(t, now, bounds[scid].LastUpdated)
+	}
+
+	// Pairs without a known channel are only bounded by their successes.
+	require.Equal(t, nodeC, bounds[0].SourceNode)
+	require.EqualValues(t, 1_000_000, bounds[0].MinLiquidityMsat)
+	require.Zero(t, bounds[0].MaxLiquidityMsat)
+}
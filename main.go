@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
@@ -56,6 +57,9 @@ func EnsureAppPathExists(path string) error {
 }
 
 func main() {
+	// Parse the command line flags.
+	flag.Parse()
+
 	// Get the user home directory depending on the OS.
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -77,12 +81,51 @@ func main() {
 		logrus.Fatalf("Failed to initialize configuration: %v", err)
 	}
 
+	// Generate the configuration of the sync client instead of running the
+	// coordinator if requested.
+	if *genSyncConfig != "" {
+		sync, err := newSyncClientConfig(config, homeDir)
+		if err != nil {
+			logrus.Fatalf("Failed to generate sync client "+
+				"configuration: %v", err)
+		}
+		err = writeSyncClientConfig(*genSyncConfig, sync)
+		if err != nil {
+			logrus.Fatalf("Failed to write sync client "+
+				"configuration: %v", err)
+		}
+		fmt.Print(syncClientInstructions(*genSyncConfig, sync))
+
+		return
+	}
+
 	// Setup logging.
 	err = setupLogging(config)
 	if err != nil {
 		logrus.Fatalf("Failed to set up logging : %v", err)
 	}
 	logrus.Info("Logging setup complete")
+	logrus.Infof("Starting coordinator version %s, commit %s", version,
+		commitHash())
+
+	// Take over the listeners of the previous process if this process was
+	// started for a zero-downtime upgrade. The previous process releases
+	// the database once it drained its connections, so wait for it for up
+	// to the upgrade timeout.
+	upgrading, err := listeners.inherit()
+	if err != nil {
+		logrus.Fatalf("Failed to take over listeners: %v", err)
+	}
+	if upgrading {
+		config.Database.FileLockTimeout = max(
+			config.Database.FileLockTimeout,
+			config.Server.UpgradeTimeout,
+		)
+		if err := listeners.notifyReady(); err != nil {
+			logrus.Fatalf("Failed to report upgrade readiness: %v",
+				err)
+		}
+	}
 
 	// Setup the database.
 	db, err := setupDatabase(config)
@@ -104,30 +147,14 @@ func main() {
 	}
 	logrus.Info("TLS configurations loaded")
 
-	// Create the external coordinator server.
+	// Create the external coordinator server. It reports itself as not
+	// serving until the recovery steps below completed, so that the
+	// servers can already be started and probed while they are running.
 	server := NewExternalCoordinatorServer(config, db)
+	server.startup.start()
 
-	// Create a ticker that ticks every interval specified in the server
-	// configuration.
-	staleDataCleanupTicker := time.NewTicker(
-		server.config.Server.StaleDataCleanupInterval,
-	)
-	defer staleDataCleanupTicker.Stop()
-
-	// Create a cancellable context for the cleanup routine.
-	cleanupCtx, cleanupCancel := context.WithCancel(context.Background())
-	defer cleanupCancel()
-
-	// Run the cleanup routine.
-	server.RunCleanupRoutine(cleanupCtx, staleDataCleanupTicker)
-
-	// Initialize and start the pprof server.
-	pprofServer := initializePProfServer(config, tlsCreds)
-	go func() {
-		if err := startPProfServer(config, pprofServer); err != nil {
-			logrus.Fatalf("Failed to start pprof server: %v", err)
-		}
-	}()
+	// Initialize and start the pprof server unless disabled.
+	pprofServer := servePProf(config, tlsCreds)
 
 	// Initialize and start the gRPC server.
 	grpcServer, lis, err := initializeGRPCServer(config, tlsCreds, server)
@@ -140,20 +167,211 @@ func main() {
 		}
 	}()
 
+	// Initialize and start the admin gRPC server, which reports the
+	// volumes and active streams of the clients tracked by the coordinator
+	// and recomputes its pairs. Its requests are authenticated with the
+	// admin macaroon.
+	admin := NewAdminServer(config, db)
+	admin.talkers = server.talkers
+	admin.streams = server.streams
+	admin.coordinator = server
+	admin.macaroonRootKey, err = setupAdminMacaroon(&config.Server, db)
+	if err != nil {
+		logrus.Fatalf("Failed to set up admin macaroon: %v", err)
+	}
+	adminGRPCServer, adminLis, err := initializeAdminGRPCServer(
+		config, tlsCreds, admin,
+	)
+	if err != nil {
+		logrus.Fatalf("Failed to initialize admin gRPC server: %v", err)
+	}
+	go func() {
+		err := startAdminGRPCServer(config, adminGRPCServer, adminLis)
+		if err != nil {
+			logrus.Fatalf("Failed to start admin gRPC server: %v",
+				err)
+		}
+	}()
+
 	// Create a cancellable context for the gRPC REST gateway.
 	restCtx, restCancel := context.WithCancel(context.Background())
 	defer restCancel()
 
-	// Initialize and start the HTTP server for the gRPC REST gateway.
-	httpServer, err := initializeHTTPServer(restCtx, tlsCreds, config)
+	// Initialize and start the HTTP server for the gRPC REST gateway
+	// unless disabled.
+	httpServer, err := serveREST(
+		restCtx, tlsCreds, config, &server.startup, server.talkers,
+	)
 	if err != nil {
 		logrus.Fatalf("Failed to initialize HTTP server: %v", err)
 	}
-	go func() {
-		if err := startHTTPServer(config, httpServer); err != nil {
-			logrus.Fatalf("Failed to start HTTP server: %v", err)
+
+	// Seed a fresh database with the data of another coordinator if
+	// requested, before any other client request is served.
+	if *bootstrapFrom != "" {
+		ctx, cancel := context.WithTimeout(
+			context.Background(), *bootstrapTimeout,
+		)
+		server.startup.begin(startupPhaseBootstrap, 0)
+		stored, err := bootstrapDatabase(
+			ctx, db, config, *bootstrapFrom, *bootstrapTLSCert,
+			&server.startup,
+		)
+		cancel()
+		if err != nil {
+			logrus.Fatalf("Failed to bootstrap from %s: %v",
+				*bootstrapFrom, err)
 		}
-	}()
+		logrus.Infof("Bootstrapped %d pairs from %s", stored,
+			*bootstrapFrom)
+		server.startup.begin(startupPhaseStarting, 0)
+	}
+
+	// Start shipping snapshots to the standby coordinator if configured.
+	// It is stopped after the write queue, so the final snapshot contains
+	// the registrations applied while draining it.
+	if config.Server.StandbyTarget != "" {
+		if err := server.StartSnapshotShipping(); err != nil {
+			logrus.Fatalf("Failed to start snapshot shipping: %v", err)
+		}
+		defer func() {
+			if err := server.StopSnapshotShipping(); err != nil {
+				logrus.Errorf("Failed to stop snapshot shipping: "+
+					"%v", err)
+			}
+		}()
+	}
+
+	// Start relaying registrations to the upstream coordinator if
+	// configured. It is stopped after the write queue, so the final relay
+	// contains the registrations applied while draining it.
+	if config.Server.RelayTarget != "" {
+		if err := server.StartRelaying(); err != nil {
+			logrus.Fatalf("Failed to start relaying: %v", err)
+		}
+		defer func() {
+			if err := server.StopRelaying(); err != nil {
+				logrus.Errorf("Failed to stop relaying: %v",
+					err)
+			}
+		}()
+	}
+
+	// Start resolving conflicting reports if configured. This precedes
+	// the replay of queued registrations, so that they are resolved too.
+	if config.Server.ConflictWindow > 0 {
+		if err := server.StartConflictResolution(); err != nil {
+			logrus.Fatalf("Failed to start conflict resolution: %v",
+				err)
+		}
+	}
+
+	// Start pruning the pairs of stale submitters if configured.
+	if config.Server.StaleSubmitterThreshold > 0 {
+		if err := server.StartStaleSubmitterPruning(); err != nil {
+			logrus.Fatalf("Failed to start stale submitter "+
+				"pruning: %v", err)
+		}
+	}
+
+	// Start capping the shift of pair amounts by a single submitter if
+	// configured.
+	if config.Server.SubmitterShiftCap != 0 {
+		if err := server.StartShiftLimiting(); err != nil {
+			logrus.Fatalf("Failed to start shift limiting: %v", err)
+		}
+	}
+
+	// Start authorizing requests with the external policy if configured.
+	if config.Server.AuthzURL != "" {
+		if err := server.StartAuthzHook(); err != nil {
+			logrus.Fatalf("Failed to start authz hook: %v", err)
+		}
+	}
+
+	// Start transforming the pairs registered and served if configured.
+	if config.Server.TransformScript != "" {
+		if err := server.StartTransformHooks(); err != nil {
+			logrus.Fatalf("Failed to start transform hooks: %v",
+				err)
+		}
+	}
+
+	// Start watermarking the data served to each client if enabled.
+	if config.Server.WatermarkServedData {
+		if err := server.StartWatermarking(); err != nil {
+			logrus.Fatalf("Failed to start watermarking: %v", err)
+		}
+	}
+
+	// Start applying registrations asynchronously if enabled. Any
+	// registrations left unapplied by a previous run are replayed before
+	// the coordinator reports itself as ready.
+	if config.Database.AsyncWrites {
+		if err := server.StartWriteQueue(); err != nil {
+			logrus.Fatalf("Failed to start write queue: %v", err)
+		}
+		defer func() {
+			if err := server.StopWriteQueue(); err != nil {
+				logrus.Errorf("Failed to stop write queue: %v",
+					err)
+			}
+		}()
+	}
+
+	// Start the aggregation experiment if configured.
+	if config.Server.ExperimentalAggregationPolicy != "" {
+		if err := server.StartAggregationExperiment(); err != nil {
+			logrus.Fatalf("Failed to start aggregation experiment: "+
+				"%v", err)
+		}
+	}
+
+	// Start mirroring registrations to the shadow coordinator if
+	// configured.
+	if config.Server.ShadowTarget != "" {
+		if err := server.StartShadowing(); err != nil {
+			logrus.Fatalf("Failed to start shadowing: %v", err)
+		}
+		defer func() {
+			if err := server.StopShadowing(); err != nil {
+				logrus.Errorf("Failed to stop shadowing: %v", err)
+			}
+		}()
+	}
+
+	// Create a ticker that ticks every interval specified in the server
+	// configuration.
+	staleDataCleanupTicker := time.NewTicker(
+		server.config.Server.StaleDataCleanupInterval,
+	)
+	defer staleDataCleanupTicker.Stop()
+
+	// Create a cancellable context for the cleanup routine.
+	cleanupCtx, cleanupCancel := context.WithCancel(context.Background())
+	defer cleanupCancel()
+
+	// Start notifying the operator about issues before the cleanup
+	// routine, so that its failures are reported as well.
+	if err := server.StartNotifications(cleanupCtx); err != nil {
+		logrus.Fatalf("Failed to start notifications: %v", err)
+	}
+
+	// Run the cleanup routine.
+	server.RunCleanupRoutine(cleanupCtx, staleDataCleanupTicker)
+
+	// Run the epoch routine archiving the data at the end of each epoch if
+	// enabled.
+	server.RunEpochRoutine(cleanupCtx)
+
+	// Run the backup routine writing backups of the database if enabled.
+	if err := server.RunBackupRoutine(cleanupCtx); err != nil {
+		logrus.Fatalf("Failed to start backup routine: %v", err)
+	}
+
+	// The coordinator is ready to answer requests.
+	server.startup.finish()
+	logrus.Info("Startup complete, serving requests")
 
 	// Create a channel to listen for interrupt or termination signals from
 	// the OS.
@@ -162,6 +380,15 @@ func main() {
 	// Notify sigChan on os.Interrupt or syscall.SIGTERM.
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
-	// Handle graceful shutdown for the gRPC, HTTP, and pprof servers.
-	gracefulShutdown(sigChan, grpcServer, httpServer, pprofServer)
+	// Hand the listeners over to a new process of the binary on the
+	// upgrade signal and shut down once it is ready to take over.
+	watchUpgradeSignal(sigChan, config.Server.UpgradeTimeout)
+
+	// Handle graceful shutdown for the gRPC, admin gRPC, HTTP, and pprof
+	// servers. The subscriptions are ended first, since the gRPC server
+	// would wait for them forever.
+	gracefulShutdown(
+		sigChan, &subscriptionEndingServer{grpcServer, server},
+		adminGRPCServer, httpServer, pprofServer,
+	)
 }
@@ -0,0 +1,435 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// merkleFanout is the number of children of each inner node of the
+	// Merkle tree.
+	merkleFanout = 16
+
+	// merkleDepth is the level of the leaves of the Merkle tree, the root
+	// being at level zero.
+	merkleDepth = 4
+
+	// merkleLeaves is the number of leaves of the Merkle tree.
+	merkleLeaves = 1 << 16
+
+	// merkleLeafKeySize is the size in bytes of the big-endian leaf index
+	// prefixing the keys of the Merkle entries.
+	merkleLeafKeySize = 2
+
+	// maxMerkleNodes is the maximum number of nodes read by a single
+	// request.
+	maxMerkleNodes = 4096
+)
+
+// standbyReconciledPairs counts the pairs found to differ from the standby
+// coordinator by reconciling it.
+var standbyReconciledPairs = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Subsystem: "standby",
+	Name:      "reconciled_pairs_total",
+	Help: "Pairs found to differ from the standby coordinator by " +
+		"reconciling it through the Merkle tree.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(standbyReconciledPairs)
+}
+
+// merkleLeaf returns the leaf of the Merkle tree the pair falls into. Pair keys
+// start with the few prefixes of public keys, so the leaf is derived from the
+// hash of the key to spread the pairs evenly.
+func merkleLeaf(key []byte) uint32 {
+	hash := sha256.Sum256(key)
+
+	return uint32(binary.BigEndian.Uint16(hash[:merkleLeafKeySize]))
+}
+
+// merkleEntryKey returns the key of the entry of a pair within the Merkle
+// entries bucket.
+func merkleEntryKey(leaf uint32, key []byte) []byte {
+	entryKey := make([]byte, merkleLeafKeySize, merkleLeafKeySize+len(key))
+	binary.BigEndian.PutUint16(entryKey, uint16(leaf))
+
+	return append(entryKey, key...)
+}
+
+// merkleEntryHash returns the hash of the key and data of a pair.
+func merkleEntryHash(key, value []byte) []byte {
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(value)))
+
+	h := sha256.New()
+	h.Write(key)
+	h.Write(size[:])
+	h.Write(value)
+
+	return h.Sum(nil)
+}
+
+// updateMerkleTree replaces the entry of the pair within the Merkle tree with
+// the one of the given data, or removes it if the data is nil. The digest of a
+// leaf is the XOR of the hashes of its entries, so it is updated without
+// reading the other entries of the leaf.
+func updateMerkleTree(tx *bbolt.Tx, key, value []byte) error {
+	entries := tx.Bucket([]byte(MerkleEntriesBucketName))
+	leaves := tx.Bucket([]byte(MerkleLeavesBucketName))
+
+	leaf := merkleLeaf(key)
+	entryKey := merkleEntryKey(leaf, key)
+	leafKey := entryKey[:merkleLeafKeySize]
+
+	digest := make([]byte, sha256.Size)
+	var pairs uint64
+	if stored := leaves.Get(leafKey); stored != nil {
+		copy(digest, stored[:sha256.Size])
+		pairs = decodeUint64(stored[sha256.Size:])
+	}
+
+	if previous := entries.Get(entryKey); previous != nil {
+		for i := range digest {
+			digest[i] ^= previous[i]
+		}
+		pairs--
+	}
+
+	if value == nil {
+		if err := entries.Delete(entryKey); err != nil {
+			return err
+		}
+	} else {
+		hash := merkleEntryHash(key, value)
+		for i := range digest {
+			digest[i] ^= hash[i]
+		}
+		pairs++
+
+		if err := entries.Put(entryKey, hash); err != nil {
+			return err
+		}
+	}
+
+	if pairs == 0 {
+		return leaves.Delete(leafKey)
+	}
+
+	return leaves.Put(leafKey, append(digest, encodeUint64(pairs)...))
+}
+
+// initMerkleTree builds the Merkle tree from the stored pairs if the database
+// does not have one yet, e.g. because it was created by an older version.
+func initMerkleTree(tx *bbolt.Tx) error {
+	if tx.Bucket([]byte(MerkleEntriesBucketName)) != nil &&
+		tx.Bucket([]byte(MerkleLeavesBucketName)) != nil {
+
+		return nil
+	}
+
+	for _, name := range []string{
+		MerkleEntriesBucketName, MerkleLeavesBucketName,
+	} {
+		err := tx.DeleteBucket([]byte(name))
+		if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return err
+		}
+		if _, err := tx.CreateBucket([]byte(name)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, v []byte) error {
+			return updateMerkleTree(tx, k, v)
+		},
+	)
+}
+
+// merkleNode is a node of the Merkle tree over the stored pairs.
+type merkleNode struct {
+	pairs  uint64
+	digest []byte
+}
+
+// merkleTree holds the nodes of the Merkle tree by level, the root being at
+// level zero.
+type merkleTree [merkleDepth + 1][]merkleNode
+
+// loadMerkleTree reads the leaves of the Merkle tree and derives its inner
+// nodes. The digest of an inner node is the hash of the digests of its
+// children, so nodes holding the same pairs have the same digest.
+func loadMerkleTree(tx *bbolt.Tx) *merkleTree {
+	tree := &merkleTree{}
+	tree[merkleDepth] = make([]merkleNode, merkleLeaves)
+
+	c := tx.Bucket([]byte(MerkleLeavesBucketName)).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		tree[merkleDepth][binary.BigEndian.Uint16(k)] = merkleNode{
+			pairs:  decodeUint64(v[sha256.Size:]),
+			digest: bytes.Clone(v[:sha256.Size]),
+		}
+	}
+
+	empty := make([]byte, sha256.Size)
+	for level := merkleDepth - 1; level >= 0; level-- {
+		children := tree[level+1]
+		tree[level] = make([]merkleNode, len(children)/merkleFanout)
+		for i := range tree[level] {
+			node := &tree[level][i]
+			first := i * merkleFanout
+			siblings := children[first : first+merkleFanout]
+			h := sha256.New()
+			for _, child := range siblings {
+				node.pairs += child.pairs
+				if child.pairs == 0 {
+					h.Write(empty)
+					continue
+				}
+				h.Write(child.digest)
+			}
+			if node.pairs > 0 {
+				node.digest = h.Sum(nil)
+			}
+		}
+	}
+
+	return tree
+}
+
+// merkleEntries lists the entries of the pairs within the leaf.
+func merkleEntries(tx *bbolt.Tx, leaf uint32) []*ecadminrpc.MerkleEntry {
+	var entries []*ecadminrpc.MerkleEntry
+
+	prefix := merkleEntryKey(leaf, nil)
+	c := tx.Bucket([]byte(MerkleEntriesBucketName)).Cursor()
+	k, v := c.Seek(prefix)
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		nodeFrom, nodeTo := splitPairKey(k[merkleLeafKeySize:])
+		entries = append(entries, &ecadminrpc.MerkleEntry{
+			NodeFrom: bytes.Clone(nodeFrom),
+			NodeTo:   bytes.Clone(nodeTo),
+			Hash:     bytes.Clone(v),
+		})
+	}
+
+	return entries
+}
+
+// merkleNodes reads the nodes of the Merkle tree with the given indices within
+// the level in a single transaction.
+func merkleNodes(db *bbolt.DB, level uint32, indices []uint32,
+	includeEntries bool) ([]*ecadminrpc.MerkleNode, error) {
+
+	nodes := make([]*ecadminrpc.MerkleNode, 0, len(indices))
+	err := db.View(func(tx *bbolt.Tx) error {
+		tree := loadMerkleTree(tx)
+		for _, index := range indices {
+			node := tree[level][index]
+			resp := &ecadminrpc.MerkleNode{
+				Index:  index,
+				Pairs:  node.pairs,
+				Digest: node.digest,
+			}
+			if includeEntries && node.pairs > 0 {
+				resp.Entries = merkleEntries(tx, index)
+			}
+			nodes = append(nodes, resp)
+		}
+
+		return nil
+	})
+
+	return nodes, err
+}
+
+// GetMerkleNodes returns the requested nodes of the Merkle tree over the
+// stored pairs.
+func (a *adminServer) GetMerkleNodes(ctx context.Context,
+	req *ecadminrpc.GetMerkleNodesRequest) (
+	*ecadminrpc.GetMerkleNodesResponse, error) {
+
+	if req.Level > merkleDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "the Merkle "+
+			"tree has %d levels", merkleDepth+1)
+	}
+	if req.IncludeEntries && req.Level != merkleDepth {
+		return nil, status.Error(codes.InvalidArgument, "only leaves "+
+			"list their entries")
+	}
+	if len(req.Indices) > maxMerkleNodes {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d "+
+			"nodes can be read at once", maxMerkleNodes)
+	}
+	width := uint32(1)
+	for i := uint32(0); i < req.Level; i++ {
+		width *= merkleFanout
+	}
+	for _, index := range req.Indices {
+		if index >= width {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"level %d holds %d nodes", req.Level, width)
+		}
+	}
+
+	nodes, err := merkleNodes(
+		a.db, req.Level, req.Indices, req.IncludeEntries,
+	)
+	if err != nil {
+		msg := "failed to read Merkle nodes: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return &ecadminrpc.GetMerkleNodesResponse{Nodes: nodes}, nil
+}
+
+// standbyMerkleNodes reads the nodes with the given indices within the level
+// from the standby coordinator, in batches of the maximum number of nodes read
+// by a single request.
+func (p *snapshotShipper) standbyMerkleNodes(ctx context.Context,
+	level uint32, indices []uint32,
+	includeEntries bool) ([]*ecadminrpc.MerkleNode, error) {
+
+	var nodes []*ecadminrpc.MerkleNode
+	for start := 0; start < len(indices); start += maxMerkleNodes {
+		end := min(start+maxMerkleNodes, len(indices))
+
+		resp, err := p.client.GetMerkleNodes(
+			ctx, &ecadminrpc.GetMerkleNodesRequest{
+				Level:          level,
+				Indices:        indices[start:end],
+				IncludeEntries: includeEntries,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Nodes) != end-start {
+			return nil, fmt.Errorf("standby returned %d Merkle "+
+				"nodes for %d indices", len(resp.Nodes),
+				end-start)
+		}
+		nodes = append(nodes, resp.Nodes...)
+	}
+
+	return nodes, nil
+}
+
+// reconcilePairs compares the Merkle tree over the stored pairs with the one
+// of the standby coordinator and returns the keys of the pairs which differ.
+// Starting at the root, only the children of nodes whose digests differ are
+// exchanged, so the amount of data transferred grows with the number of
+// differences rather than the number of pairs. The entries of the leaves the
+// standby holds no pairs of are not requested from it. If the standby holds
+// no pairs at all, it reports that a full snapshot is due instead, which ships
+// all pairs without collecting their keys first.
+func (p *snapshotShipper) reconcilePairs(
+	ctx context.Context) ([][]byte, bool, error) {
+
+	var tree *merkleTree
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		tree = loadMerkleTree(tx)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	pending := []uint32{0}
+	for level := uint32(0); level < merkleDepth; level++ {
+		standby, err := p.standbyMerkleNodes(ctx, level, pending, false)
+		if err != nil {
+			return nil, false, err
+		}
+		if level == 0 && standby[0].Pairs == 0 {
+			return nil, tree[0][0].pairs > 0, nil
+		}
+
+		var next []uint32
+		for i, index := range pending {
+			local := tree[level][index]
+			if local.pairs == standby[i].Pairs &&
+				bytes.Equal(local.digest, standby[i].Digest) {
+
+				continue
+			}
+			for child := uint32(0); child < merkleFanout; child++ {
+				next = append(next, index*merkleFanout+child)
+			}
+		}
+		pending = next
+	}
+
+	// Compare the entries of the divergent leaves.
+	standby, err := p.standbyMerkleNodes(ctx, merkleDepth, pending, false)
+	if err != nil {
+		return nil, false, err
+	}
+	var divergent, populated []uint32
+	for i, index := range pending {
+		local := tree[merkleDepth][index]
+		if local.pairs == standby[i].Pairs &&
+			bytes.Equal(local.digest, standby[i].Digest) {
+
+			continue
+		}
+		divergent = append(divergent, index)
+		if standby[i].Pairs > 0 {
+			populated = append(populated, index)
+		}
+	}
+	standby, err = p.standbyMerkleNodes(ctx, merkleDepth, populated, true)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hashes := make(map[string][]byte)
+	for _, node := range standby {
+		for _, entry := range node.Entries {
+			key := pairKey(entry.NodeFrom, entry.NodeTo)
+			hashes[string(key)] = entry.Hash
+		}
+	}
+
+	var keys [][]byte
+	err = p.db.View(func(tx *bbolt.Tx) error {
+		for _, leaf := range divergent {
+			for _, entry := range merkleEntries(tx, leaf) {
+				key := pairKey(entry.NodeFrom, entry.NodeTo)
+				hash, ok := hashes[string(key)]
+				delete(hashes, string(key))
+				if ok && bytes.Equal(hash, entry.Hash) {
+					continue
+				}
+				keys = append(keys, key)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The pairs left are only stored by the standby.
+	for key := range hashes {
+		keys = append(keys, []byte(key))
+	}
+	standbyReconciledPairs.Add(float64(len(keys)))
+
+	return keys, false, nil
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// merkleRoot returns the root of the Merkle tree over the stored pairs.
+func merkleRoot(t *testing.T, db *bbolt.DB) merkleNode {
+	var root merkleNode
+	err := db.View(func(tx *bbolt.Tx) error {
+		root = loadMerkleTree(tx)[0][0]
+		return nil
+	})
+	require.NoError(t, err)
+
+	return root
+}
+
+// TestMerkleTree tests that the Merkle tree only depends on the stored pairs,
+// not on the order they were stored in.
+func TestMerkleTree(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	require.Zero(t, merkleRoot(t, db).pairs)
+	require.Empty(t, merkleRoot(t, db).digest)
+
+	var keys [][]byte
+	for i := 0; i < 3; i++ {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		keys = append(keys, pairKey(nodeFrom, nodeTo))
+	}
+	data := func(amtSat int64) []byte {
+		data, err := encodePairData(&ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtSat:  amtSat,
+			SuccessAmtMsat: amtSat * mSatScale,
+		})
+		require.NoError(t, err)
+
+		return data
+	}
+	store := func(order ...int) {
+		err := db.Update(func(tx *bbolt.Tx) error {
+			for _, i := range order {
+				err := putPair(tx, keys[i], data(int64(i+1)))
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	store(0, 1, 2)
+	root := merkleRoot(t, db)
+	require.EqualValues(t, 3, root.pairs)
+	require.NotEmpty(t, root.digest)
+
+	// Storing the same pairs again or in another order leaves the tree
+	// unchanged, while changing the data of a pair does not.
+	store(2, 0, 1)
+	require.Equal(t, root, merkleRoot(t, db))
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return putPair(tx, keys[1], data(5))
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, root.digest, merkleRoot(t, db).digest)
+	store(1)
+	require.Equal(t, root, merkleRoot(t, db))
+
+	// A tree built from the stored pairs equals the maintained one.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket([]byte(MerkleLeavesBucketName))
+		if err != nil {
+			return err
+		}
+
+		return initMerkleTree(tx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, root, merkleRoot(t, db))
+
+	// Removing all pairs empties the tree.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, key := range keys {
+			if err := deletePair(tx, key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Zero(t, merkleRoot(t, db).pairs)
+	require.Empty(t, merkleRoot(t, db).digest)
+}
+
+// TestMerkleTreeCleanup tests that the Merkle tree drops the pairs removed by
+// the stale data cleanup, so that it matches a tree built from the remaining
+// pairs.
+func TestMerkleTreeCleanup(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// Store two stale pairs and a fresh one.
+	now := time.Now()
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, updated := range []time.Time{
+			now.Add(-2 * time.Hour), now.Add(-3 * time.Hour), now,
+		} {
+			nodeFrom, nodeTo := generateTestKeys(t)
+			data, err := encodePairData(&ecrpc.PairData{
+				SuccessTime:    updated.Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: mSatScale,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = putPair(tx, pairKey(nodeFrom, nodeTo), data)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, merkleRoot(t, db).pairs)
+
+	removed, err := server.cleanupStaleData()
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	root := merkleRoot(t, db)
+	require.EqualValues(t, 1, root.pairs)
+
+	// Only the entry of the fresh pair is left and a tree built from the
+	// stored pairs equals the maintained one.
+	err = db.View(func(tx *bbolt.Tx) error {
+		entries := tx.Bucket([]byte(MerkleEntriesBucketName)).Stats()
+		require.Equal(t, 1, entries.KeyN)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket([]byte(MerkleLeavesBucketName))
+		if err != nil {
+			return err
+		}
+
+		return initMerkleTree(tx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, root, merkleRoot(t, db))
+}
+
+// TestReconcileStandby tests that the pairs a standby coordinator missed are
+// found through the Merkle tree and shipped with the next snapshot.
+func TestReconcileStandby(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Start the admin server of the standby coordinator.
+	standbyConfig := MockConfig(filepath.Join(tempDir, "standby"))
+	standbyConfig.Server.StandbyMode = true
+	standbyConfig.Server.HistoryThresholdDuration = time.Hour
+	standbyDB, err := setupDatabase(standbyConfig)
+	require.NoError(t, err)
+	defer cleanupDB(standbyDB)
+
+	standbyAdmin := NewAdminServer(standbyConfig, standbyDB)
+	standbyAddr, certFile, macaroonPath := startAdminTestServer(t, standbyAdmin)
+
+	// Start the primary coordinator shipping to the standby.
+	config := MockConfig(filepath.Join(tempDir, "primary"))
+	config.Server.StandbyTarget = standbyAddr
+	config.Server.StandbyTLSCertFile = certFile
+	config.Server.StandbyAdminMacaroonPath = macaroonPath
+	config.Server.SnapshotInterval = time.Hour
+	config.Server.SnapshotTimeout = 10 * time.Second
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartSnapshotShipping())
+	defer server.StopSnapshotShipping()
+
+	var pairs []*ecrpc.PairHistory
+	for i := 0; i < 100; i++ {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	// The standby holds no pairs yet, so the first snapshot is full.
+	keys, full, err := server.snapshots.reconcilePairs(
+		context.Background(),
+	)
+	require.NoError(t, err)
+	require.True(t, full)
+	require.Empty(t, keys)
+
+	require.NoError(t, server.snapshots.ship())
+	require.Equal(t, storedPairKeys(t, db), storedPairKeys(t, standbyDB))
+	require.Equal(t, merkleRoot(t, db), merkleRoot(t, standbyDB))
+
+	// Remove, modify and insert a pair behind the back of the primary.
+	extraFrom, extraTo := generateTestKeys(t)
+	extra := pairKey(extraFrom, extraTo)
+	removed := pairKey(pairs[0].NodeFrom, pairs[0].NodeTo)
+	modified := pairKey(pairs[1].NodeFrom, pairs[1].NodeTo)
+	err = standbyDB.Update(func(tx *bbolt.Tx) error {
+		if err := deletePair(tx, removed); err != nil {
+			return err
+		}
+
+		data, err := encodePairData(&ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtSat:  2,
+			SuccessAmtMsat: 2000,
+		})
+		if err != nil {
+			return err
+		}
+		if err := putPair(tx, modified, data); err != nil {
+			return err
+		}
+
+		return putPair(tx, extra, data)
+	})
+	require.NoError(t, err)
+
+	// Exactly the pairs which differ are found.
+	keys, full, err = server.snapshots.reconcilePairs(
+		context.Background(),
+	)
+	require.NoError(t, err)
+	require.False(t, full)
+	require.ElementsMatch(t, [][]byte{removed, modified, extra}, keys)
+
+	// Reconciling ships them with the next snapshot.
+	server.snapshots.mu.Lock()
+	server.snapshots.reconcile = true
+	server.snapshots.mu.Unlock()
+	require.NoError(t, server.snapshots.ship())
+	require.Equal(t, storedPairKeys(t, db), storedPairKeys(t, standbyDB))
+	require.Equal(t, merkleRoot(t, db), merkleRoot(t, standbyDB))
+
+	// Only the leaves list their entries, and at most a limited number of
+	// nodes is read at once.
+	for _, req := range []*ecadminrpc.GetMerkleNodesRequest{
+		{Level: merkleDepth + 1},
+		{Level: 1, Indices: []uint32{merkleFanout}},
+		{Level: 0, Indices: []uint32{0}, IncludeEntries: true},
+		{Level: merkleDepth, Indices: make([]uint32, maxMerkleNodes+1)},
+	} {
+		_, err := standbyAdmin.GetMerkleNodes(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
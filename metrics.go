@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	logrus "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// metricsNamespace is the namespace of all Prometheus metrics exported
+	// by the external coordinator.
+	metricsNamespace = "ec"
+
+	// unmatchedRESTRoute is the path label used for REST requests not
+	// matching any route of the gateway. It keeps the label cardinality
+	// bounded regardless of the requested paths.
+	unmatchedRESTRoute = "unmatched"
+)
+
+var (
+	// metricsRegistry is the Prometheus registry holding all metrics
+	// exported by the external coordinator.
+	metricsRegistry = prometheus.NewRegistry()
+
+	// restRequestDuration observes the duration of REST requests.
+	restRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "rest",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of REST requests in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "path", "status"},
+	)
+
+	// restResponseSize observes the size of REST responses.
+	restResponseSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "rest",
+			Name:      "response_size_bytes",
+			Help:      "Size of REST responses in bytes.",
+			Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+		},
+		[]string{"method", "path", "status"},
+	)
+)
+
+func init() {
+	metricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(
+			collectors.ProcessCollectorOpts{},
+		),
+		restRequestDuration,
+		restResponseSize,
+	)
+}
+
+// metricsHandler returns the HTTP handler exposing the metrics of the
+// external coordinator in the Prometheus text format. Scrapers negotiating the
+// OpenMetrics format also receive the request IDs attached as exemplars.
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+	})
+}
+
+// restRouteKey is the context key of the route recorded for a REST request.
+type restRouteKey struct{}
+
+// restRoute holds the route pattern matched by the gateway for a REST request.
+type restRoute struct {
+	pattern string
+}
+
+// restRouteAnnotator is a gateway metadata annotator recording the route
+// pattern matched for the request. The gateway only exposes the pattern within
+// its handlers, so it is passed back to the middleware through the route
+// stored in the request context.
+func restRouteAnnotator(ctx context.Context, _ *http.Request) metadata.MD {
+	route, ok := ctx.Value(restRouteKey{}).(*restRoute)
+	if !ok {
+		return nil
+	}
+
+	if pattern, ok := runtime.HTTPPathPattern(ctx); ok {
+		route.pattern = pattern
+	}
+
+	return nil
+}
+
+// responseRecorder wraps an http.ResponseWriter to record the status code and
+// the number of bytes written.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+// WriteHeader records the status code and forwards it.
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the number of bytes written and forwards them.
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+
+	return n, err
+}
+
+// Flush forwards the flush to the underlying writer if supported. It is
+// required for streamed responses of the gateway.
+func (r *responseRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// withRESTObservability wraps the REST gateway handler to produce structured
+// access logs and Prometheus metrics for every request. In privacy mode the
+// address of the client is omitted from the access logs.
+func withRESTObservability(next http.Handler, accessLog,
+	privacyMode bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		route := &restRoute{pattern: unmatchedRESTRoute}
+		recorder := &responseRecorder{ResponseWriter: w}
+
+		ctx := context.WithValue(r.Context(), restRouteKey{}, route)
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+		duration := time.Since(start)
+		statusLabel := strconv.Itoa(recorder.status)
+
+		restRequestDuration.WithLabelValues(
+			r.Method, route.pattern, statusLabel,
+		).Observe(duration.Seconds())
+		restResponseSize.WithLabelValues(
+			r.Method, route.pattern, statusLabel,
+		).Observe(float64(recorder.bytes))
+
+		if !accessLog {
+			return
+		}
+
+		fields := logrus.Fields{
+			"method":   r.Method,
+			"path":     r.URL.Path,
+			"status":   recorder.status,
+			"bytes":    recorder.bytes,
+			"duration": duration,
+		}
+		if id := recorder.Header().Get(requestIDHeader); id != "" {
+			fields["request_id"] = id
+		}
+		if !privacyMode {
+			fields["client"] = r.RemoteAddr
+		}
+		logrus.WithFields(fields).Info("REST request")
+	})
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	logrus "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestRESTObservability tests that REST requests are logged and observed by
+// the Prometheus metrics labeled with the matched route.
+func TestRESTObservability(t *testing.T) {
+	var logs bytes.Buffer
+	logrus.SetOutput(&logs)
+	defer logrus.SetOutput(io.Discard)
+
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	mux := runtime.NewServeMux(runtime.WithMetadata(restRouteAnnotator))
+	err = ecrpc.RegisterExternalCoordinatorHandlerServer(
+		context.Background(), mux,
+		NewExternalCoordinatorServer(config, db),
+	)
+	require.NoError(t, err)
+	handler := withRESTObservability(mux, true, false)
+
+	// A request matching a route is labeled with its pattern.
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/info", nil)
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.EqualValues(t, 1, restRequestCount(t, "/v1/info", "200"))
+	require.Contains(t, logs.String(), "path=/v1/info")
+	require.Contains(t, logs.String(), "status=200")
+
+	// A request not matching any route is labeled as unmatched.
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/v1/unknown", nil)
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	require.EqualValues(
+		t, 1, restRequestCount(t, unmatchedRESTRoute, "404"),
+	)
+
+	// The metrics are exposed in the Prometheus text format.
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	metricsHandler().ServeHTTP(rec, req)
+	require.Contains(
+		t, rec.Body.String(), "ec_rest_request_duration_seconds",
+	)
+}
+
+// restRequestCount returns the number of REST requests observed for the given
+// route and status.
+func restRequestCount(t *testing.T, path, status string) uint64 {
+	t.Helper()
+
+	observer := restRequestDuration.WithLabelValues(
+		http.MethodGet, path, status,
+	)
+	metric := &dto.Metric{}
+	require.NoError(t, observer.(prometheus.Metric).Write(metric))
+
+	return metric.GetHistogram().GetSampleCount()
+}
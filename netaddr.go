@@ -0,0 +1,19 @@
+package main
+
+import (
+	"net"
+	"strings"
+)
+
+// joinHostPort combines a configured host and port into a network address.
+// Hosts may be given as IPv6 addresses with or without brackets, such as
+// "[::1]" or "::1", and ports with or without the leading colon, such as
+// ":50050" or "50050". Plain concatenation breaks for IPv6 addresses without
+// brackets, since their colons cannot be told apart from the port separator.
+// An empty host yields an address on all interfaces.
+func joinHostPort(host, port string) string {
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	port = strings.TrimPrefix(port, ":")
+
+	return net.JoinHostPort(host, port)
+}
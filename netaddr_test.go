@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestJoinHostPort tests that configured hosts and ports are combined into
+// valid network addresses, including IPv6 addresses.
+func TestJoinHostPort(t *testing.T) {
+	tests := []struct {
+		host, port, addr string
+	}{
+		{"localhost", ":50050", "localhost:50050"},
+		{"127.0.0.1", "50050", "127.0.0.1:50050"},
+		{"[::]", ":50050", "[::]:50050"},
+		{"::", ":50050", "[::]:50050"},
+		{"::1", "8081", "[::1]:8081"},
+		{"[2001:db8::1]", ":8081", "[2001:db8::1]:8081"},
+		{"", ":6060", ":6060"},
+	}
+	for _, test := range tests {
+		require.Equal(
+			t, test.addr, joinHostPort(test.host, test.port),
+			test.host,
+		)
+	}
+}
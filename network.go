@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// networkKey is the key of the network the stored data belongs to within the
+// metadata bucket.
+var networkKey = []byte("network")
+
+// supportedNetworks are the networks the coordinator can collect mission
+// control data for.
+var supportedNetworks = []string{"mainnet", "testnet", "signet", "regtest"}
+
+// parseNetwork returns the normalized name of the given network. An empty
+// name refers to the default network.
+func parseNetwork(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return DefaultNetwork, nil
+	}
+
+	for _, network := range supportedNetworks {
+		if name == network {
+			return network, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported network %q, options are %s", name,
+		strings.Join(supportedNetworks, ", "))
+}
+
+// checkDatabaseNetwork labels the database with the given network on first
+// use and ensures that a labeled database is only ever opened for the network
+// it belongs to. This prevents mixing data of different networks.
+func checkDatabaseNetwork(tx *bbolt.Tx, network string) error {
+	b := tx.Bucket([]byte(MetadataBucketName))
+	stored := b.Get(networkKey)
+	if stored == nil {
+		return b.Put(networkKey, []byte(network))
+	}
+
+	if string(stored) != network {
+		return fmt.Errorf("database holds %s data and cannot be used "+
+			"for %s, use a separate database directory per network",
+			stored, network)
+	}
+
+	return nil
+}
+
+// network returns the network the coordinator collects mission control data
+// for.
+func (s *externalCoordinatorServer) network() string {
+	// The network is validated when the database is set up.
+	network, _ := parseNetwork(s.config.Server.Network)
+
+	return network
+}
+
+// validateRequestNetwork rejects submissions for another network than the one
+// of the coordinator. Submissions without a network are assumed to belong to
+// the network of the coordinator.
+func (s *externalCoordinatorServer) validateRequestNetwork(
+	name string) error {
+	if name == "" {
+		return nil
+	}
+
+	network, err := parseNetwork(name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if network != s.network() {
+		return status.Errorf(codes.InvalidArgument, "coordinator "+
+			"collects %s data, %s submissions are not accepted",
+			s.network(), network)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestParseNetwork tests parsing the supported networks.
+func TestParseNetwork(t *testing.T) {
+	network, err := parseNetwork("")
+	require.NoError(t, err)
+	require.Equal(t, DefaultNetwork, network)
+
+	network, err = parseNetwork(" Signet ")
+	require.NoError(t, err)
+	require.Equal(t, "signet", network)
+
+	_, err = parseNetwork("simnet")
+	require.Error(t, err)
+}
+
+// TestDatabaseNetwork tests that the database is labeled with its network and
+// refuses to open for another one.
+func TestDatabaseNetwork(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.Network = "testnet"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	cleanupDB(db)
+
+	// Reopening the database for the same network succeeds.
+	db, err = setupDatabase(config)
+	require.NoError(t, err)
+	cleanupDB(db)
+
+	// Opening it for another network fails.
+	config.Server.Network = "mainnet"
+	_, err = setupDatabase(config)
+	require.ErrorContains(t, err, "database holds testnet data")
+
+	config.Server.Network = "simnet"
+	_, err = setupDatabase(config)
+	require.Error(t, err)
+}
+
+// TestRegistrationNetwork tests that registrations for another network are
+// rejected and that the network is reported in GetInfo.
+func TestRegistrationNetwork(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.Network = "signet"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	info, err := server.GetInfo(
+		context.Background(), &ecrpc.GetInfoRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "signet", info.Network)
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	register := func(network string) error {
+		_, err := server.RegisterMissionControl(
+			context.Background(),
+			&ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    time.Now().Unix(),
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+				Network: network,
+			},
+		)
+
+		return err
+	}
+
+	require.NoError(t, register("signet"))
+	require.NoError(t, register(""))
+
+	err = register("mainnet")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = register("simnet")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
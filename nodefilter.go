@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateNodeFilter validates the source and destination nodes a query is
+// limited to. Either of them may be unset.
+func validateNodeFilter(nodeFrom, nodeTo []byte) error {
+	nodes := []struct {
+		name string
+		key  []byte
+	}{{"NodeFrom", nodeFrom}, {"NodeTo", nodeTo}}
+	for _, node := range nodes {
+		if len(node.key) == 0 {
+			continue
+		}
+		if err := pairKeys.validateNode(node.key); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid "+
+				"%s: %v", node.name, err)
+		}
+	}
+
+	if len(nodeFrom) > 0 && bytes.Equal(nodeFrom, nodeTo) {
+		return status.Errorf(codes.InvalidArgument, "NodeFrom and "+
+			"NodeTo must be different nodes")
+	}
+
+	return nil
+}
+
+// nodeFilter returns a filter only accepting the pairs from nodeFrom and to
+// nodeTo, whichever are set, that are accepted by the next filter as well.
+func nodeFilter(nodeFrom, nodeTo []byte,
+	next func(nodeFrom, nodeTo []byte) bool) func(nodeFrom,
+	nodeTo []byte) bool {
+
+	if len(nodeFrom) == 0 && len(nodeTo) == 0 {
+		return next
+	}
+
+	return func(from, to []byte) bool {
+		if len(nodeFrom) > 0 && !bytes.Equal(from, nodeFrom) {
+			return false
+		}
+		if len(nodeTo) > 0 && !bytes.Equal(to, nodeTo) {
+			return false
+		}
+
+		return next == nil || next(from, to)
+	}
+}
+
+// pairCursor iterates over the pairs of the database in the order of their
+// keys.
+type pairCursor interface {
+	// First returns the first pair, or a nil key if there is none.
+	First() (key, value []byte)
+
+	// Next returns the next pair, or a nil key if there is none.
+	Next() (key, value []byte)
+}
+
+// newPairCursor returns a cursor over the pairs from nodeFrom and to nodeTo,
+// whichever are set, which only reads the pairs involved. The pairs of a
+// source node share the prefix of their keys, so they are scanned by prefix.
+// The pairs to a destination node are looked up for every source node of the
+// node index. Without any node, all pairs are iterated.
+func newPairCursor(tx *bbolt.Tx, nodeFrom, nodeTo []byte) pairCursor {
+	b := tx.Bucket([]byte(DatabaseBucketName))
+	switch {
+	case len(nodeFrom) > 0 && len(nodeTo) > 0:
+		return &prefixCursor{
+			cursor: b.Cursor(),
+			prefix: pairKey(nodeFrom, nodeTo),
+		}
+
+	case len(nodeFrom) > 0:
+		return &prefixCursor{cursor: b.Cursor(), prefix: nodeFrom}
+
+	case len(nodeTo) > 0:
+		return &destinationCursor{
+			pairs:  b,
+			nodes:  tx.Bucket([]byte(NodeIndexBucketName)).Cursor(),
+			nodeTo: nodeTo,
+		}
+
+	default:
+		return b.Cursor()
+	}
+}
+
+// prefixCursor iterates over the pairs whose keys start with a prefix.
+type prefixCursor struct {
+	cursor *bbolt.Cursor
+	prefix []byte
+}
+
+// First seeks the first pair with the prefix.
+func (c *prefixCursor) First() ([]byte, []byte) {
+	return c.match(c.cursor.Seek(c.prefix))
+}
+
+// Next returns the next pair with the prefix.
+func (c *prefixCursor) Next() ([]byte, []byte) {
+	return c.match(c.cursor.Next())
+}
+
+// match returns the pair if its key has the prefix and a nil key otherwise.
+func (c *prefixCursor) match(k, v []byte) ([]byte, []byte) {
+	if !bytes.HasPrefix(k, c.prefix) {
+		return nil, nil
+	}
+
+	return k, v
+}
+
+// destinationCursor iterates over the pairs to a destination node by looking
+// up the pair from every node of the node index, in the order of the source
+// nodes.
+type destinationCursor struct {
+	pairs  *bbolt.Bucket
+	nodes  *bbolt.Cursor
+	nodeTo []byte
+}
+
+// First returns the pair to the destination from the first source node.
+func (c *destinationCursor) First() ([]byte, []byte) {
+	return c.lookup(c.nodes.First())
+}
+
+// Next returns the pair to the destination from the next source node.
+func (c *destinationCursor) Next() ([]byte, []byte) {
+	return c.lookup(c.nodes.Next())
+}
+
+// lookup returns the first pair to the destination from the given node or
+// the nodes following it in the node index.
+func (c *destinationCursor) lookup(node, _ []byte) ([]byte, []byte) {
+	for ; node != nil; node, _ = c.nodes.Next() {
+		if bytes.Equal(node, c.nodeTo) {
+			continue
+		}
+
+		key := pairKey(node, c.nodeTo)
+		if v := c.pairs.Get(key); v != nil {
+			return key, v
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryNodeFilter tests that queries can be limited to the pairs from and
+// to a node.
+func TestQueryNodeFilter(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 1
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	pair := func(nodeFrom, nodeTo []byte) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB), pair(nodeA, nodeC),
+				pair(nodeB, nodeC), pair(nodeC, nodeA),
+				pair(nodeD, nodeC),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(req *ecrpc.QueryAggregatedMissionControlRequest) (
+		map[string]bool, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(req, stream)
+
+		pairs := make(map[string]bool)
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				key := pairKey(pair.NodeFrom, pair.NodeTo)
+				pairs[string(key)] = true
+			}
+		}
+
+		return pairs, err
+	}
+	keys := func(pairs ...[2][]byte) map[string]bool {
+		keys := make(map[string]bool)
+		for _, pair := range pairs {
+			keys[string(pairKey(pair[0], pair[1]))] = true
+		}
+
+		return keys
+	}
+
+	// The pairs of a source node are scanned by prefix.
+	pairs, err := query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeFrom: nodeA,
+	})
+	require.NoError(t, err)
+	require.Equal(t, keys([2][]byte{nodeA, nodeB}, [2][]byte{nodeA, nodeC}),
+		pairs)
+
+	// The pairs to a destination node are looked up by source node.
+	pairs, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeTo: nodeC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, keys(
+		[2][]byte{nodeA, nodeC}, [2][]byte{nodeB, nodeC},
+		[2][]byte{nodeD, nodeC},
+	), pairs)
+
+	// Both nodes select a single pair.
+	pairs, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeFrom: nodeC,
+		NodeTo:   nodeA,
+	})
+	require.NoError(t, err)
+	require.Equal(t, keys([2][]byte{nodeC, nodeA}), pairs)
+
+	pairs, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeFrom: nodeA,
+		NodeTo:   nodeD,
+	})
+	require.NoError(t, err)
+	require.Empty(t, pairs)
+
+	// Other queries filter the pairs they read.
+	pairs, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeTo:    nodeA,
+		SortOrder: ecrpc.SortOrder_SORT_ORDER_FRESHNESS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, keys([2][]byte{nodeC, nodeA}), pairs)
+
+	// Invalid nodes are rejected.
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeFrom: nodeA[:10],
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		NodeFrom: nodeA,
+		NodeTo:   nodeA,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
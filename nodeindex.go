@@ -0,0 +1,179 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/prometheus/client_golang/prometheus"
+	bbolt "go.etcd.io/bbolt"
+)
+
+const (
+	// nodeSketchPrecision is the number of hash bits selecting the register
+	// of the HyperLogLog sketch estimating the number of nodes seen. Its
+	// 1024 registers estimate with a standard error of about 3%.
+	nodeSketchPrecision = 10
+
+	// nodeSketchRegisters is the number of registers of the sketch.
+	nodeSketchRegisters = 1 << nodeSketchPrecision
+)
+
+// nodeSketchKey is the key of the HyperLogLog sketch of the nodes seen within
+// the metadata bucket. It holds one byte per register. The sketch outlives
+// the removal of pairs, so it estimates all nodes ever seen.
+var nodeSketchKey = []byte("node_sketch")
+
+var (
+	// datasetNodes tracks the number of distinct nodes involved in the
+	// stored pairs.
+	datasetNodes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "dataset",
+		Name:      "nodes",
+		Help:      "Number of distinct nodes involved in the stored pairs.",
+	})
+
+	// datasetNodesSeen tracks the estimated number of distinct nodes
+	// involved in any pair ever stored.
+	datasetNodesSeen = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "dataset",
+		Name:      "nodes_seen_estimate",
+		Help: "Estimated number of distinct nodes involved in any " +
+			"pair ever stored.",
+	})
+)
+
+func init() {
+	metricsRegistry.MustRegister(datasetNodes, datasetNodesSeen)
+}
+
+// addPairNodes counts a newly stored pair towards both of its nodes in the
+// node index and records them in the sketch of the nodes seen.
+func addPairNodes(tx *bbolt.Tx, key []byte) error {
+	index := tx.Bucket([]byte(NodeIndexBucketName))
+	nodeFrom, nodeTo := splitPairKey(key)
+	for _, node := range [][]byte{nodeFrom, nodeTo} {
+		pairs := decodeUint64(index.Get(node))
+		if err := index.Put(node, encodeUint64(pairs+1)); err != nil {
+			return err
+		}
+
+		if err := observeNode(tx, node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removePairNodes uncounts a removed pair from both of its nodes in the node
+// index. Nodes no longer involved in any pair are removed from the index.
+func removePairNodes(tx *bbolt.Tx, key []byte) error {
+	index := tx.Bucket([]byte(NodeIndexBucketName))
+	nodeFrom, nodeTo := splitPairKey(key)
+	for _, node := range [][]byte{nodeFrom, nodeTo} {
+		pairs := decodeUint64(index.Get(node))
+		if pairs <= 1 {
+			if err := index.Delete(node); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := index.Put(node, encodeUint64(pairs-1)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// observeNode records the node in the HyperLogLog sketch of the nodes seen.
+// The sketch is only written if the rank of the node raises its register.
+func observeNode(tx *bbolt.Tx, node []byte) error {
+	hash := sha256.Sum256(node)
+	h := binary.BigEndian.Uint64(hash[:8])
+	register := h >> (64 - nodeSketchPrecision)
+	rank := byte(bits.LeadingZeros64(
+		h<<nodeSketchPrecision|1<<(nodeSketchPrecision-1),
+	) + 1)
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	sketch := meta.Get(nodeSketchKey)
+	if len(sketch) == nodeSketchRegisters && sketch[register] >= rank {
+		return nil
+	}
+
+	// The sketch is only valid for the lifetime of the transaction, so it
+	// is copied before being modified.
+	updated := make([]byte, nodeSketchRegisters)
+	copy(updated, sketch)
+	updated[register] = rank
+
+	return meta.Put(nodeSketchKey, updated)
+}
+
+// estimateNodesSeen returns the estimated number of distinct nodes recorded in
+// the sketch of the nodes seen.
+func estimateNodesSeen(tx *bbolt.Tx) uint64 {
+	sketch := tx.Bucket([]byte(MetadataBucketName)).Get(nodeSketchKey)
+	if len(sketch) != nodeSketchRegisters {
+		return 0
+	}
+
+	const m = float64(nodeSketchRegisters)
+	sum, zeros := 0.0, 0
+	for _, rank := range sketch {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+
+	// Small cardinalities are estimated more accurately by counting the
+	// empty registers.
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(math.Round(estimate))
+}
+
+// countNodes returns the number of distinct nodes involved in the stored
+// pairs.
+func countNodes(tx *bbolt.Tx) uint64 {
+	return uint64(tx.Bucket([]byte(NodeIndexBucketName)).Stats().KeyN)
+}
+
+// recordNodeMetrics updates the node metrics once the transaction is
+// committed.
+func recordNodeMetrics(tx *bbolt.Tx) {
+	nodes, seen := countNodes(tx), estimateNodesSeen(tx)
+	tx.OnCommit(func() {
+		datasetNodes.Set(float64(nodes))
+		datasetNodesSeen.Set(float64(seen))
+	})
+}
+
+// initNodeIndex builds the node index and the sketch of the nodes seen from
+// the stored pairs if the database does not have an index yet, e.g. because
+// it was created by an older version.
+func initNodeIndex(tx *bbolt.Tx) error {
+	if tx.Bucket([]byte(NodeIndexBucketName)) != nil {
+		return nil
+	}
+
+	if _, err := tx.CreateBucket([]byte(NodeIndexBucketName)); err != nil {
+		return err
+	}
+
+	return tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, _ []byte) error {
+			return addPairNodes(tx, k)
+		},
+	)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestNodeIndex tests that the node index tracks the nodes involved in the
+// stored pairs, that the sketch keeps estimating the nodes ever seen, and
+// that the index is built for databases without one.
+func TestNodeIndex(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	value, err := encodePairData(&ecrpc.PairData{SuccessTime: 1})
+	require.NoError(t, err)
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, _ := generateTestKeys(t)
+	keyAB, keyAC := pairKey(nodeA, nodeB), pairKey(nodeA, nodeC)
+
+	counts := func() (uint64, uint64) {
+		var nodes, seen uint64
+		err := db.View(func(tx *bbolt.Tx) error {
+			nodes, seen = countNodes(tx), estimateNodesSeen(tx)
+			return nil
+		})
+		require.NoError(t, err)
+
+		return nodes, seen
+	}
+
+	// Nodes are counted once regardless of the number of their pairs, and
+	// overwriting a pair does not count it again.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, key := range [][]byte{keyAB, keyAC, keyAB} {
+			if err := putPair(tx, key, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	nodes, seen := counts()
+	require.EqualValues(t, 3, nodes)
+	require.EqualValues(t, 3, seen)
+
+	// Removed nodes are no longer counted, but remain seen.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return deletePair(tx, keyAC)
+	})
+	require.NoError(t, err)
+	nodes, seen = counts()
+	require.EqualValues(t, 2, nodes)
+	require.EqualValues(t, 3, seen)
+
+	// Databases without a node index get one on startup.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return tx.DeleteBucket([]byte(NodeIndexBucketName))
+	})
+	require.NoError(t, err)
+	cleanupDB(db)
+	db, err = setupDatabase(config)
+	require.NoError(t, err)
+	nodes, _ = counts()
+	require.EqualValues(t, 2, nodes)
+
+	// The sketch estimates large numbers of nodes within a few percent.
+	const numNodes = 20000
+	err = db.Update(func(tx *bbolt.Tx) error {
+		node := make([]byte, PubKeyCompressedSize)
+		for i := 0; i < numNodes; i++ {
+			if _, err := rand.Read(node); err != nil {
+				return err
+			}
+			if err := observeNode(tx, node); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	_, seen = counts()
+	require.InEpsilon(t, numNodes, seen, 0.1)
+}
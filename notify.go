@@ -0,0 +1,476 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+)
+
+// notificationSeverity is the severity of an operator notification.
+type notificationSeverity uint8
+
+const (
+	// severityOff disables the notification of an event.
+	severityOff notificationSeverity = iota
+
+	// severityInfo marks purely informational notifications.
+	severityInfo
+
+	// severityWarning marks issues requiring attention soon.
+	severityWarning
+
+	// severityCritical marks issues requiring immediate attention.
+	severityCritical
+)
+
+// String returns the configuration name of the severity.
+func (s notificationSeverity) String() string {
+	switch s {
+	case severityInfo:
+		return "info"
+	case severityWarning:
+		return "warning"
+	case severityCritical:
+		return "critical"
+	default:
+		return "off"
+	}
+}
+
+// parseNotificationSeverity parses the configuration name of a severity.
+func parseNotificationSeverity(s string) (notificationSeverity, error) {
+	switch strings.ToLower(s) {
+	case "off":
+		return severityOff, nil
+	case "info":
+		return severityInfo, nil
+	case "warning":
+		return severityWarning, nil
+	case "critical":
+		return severityCritical, nil
+	default:
+		return severityOff, fmt.Errorf("invalid notification "+
+			"severity %q, options are 'off', 'info', 'warning' "+
+			"and 'critical'", s)
+	}
+}
+
+// notificationEvent identifies the kind of issue an operator is notified
+// about.
+type notificationEvent string
+
+const (
+	// eventCertificateExpiry is raised if the TLS certificate expires
+	// soon.
+	eventCertificateExpiry notificationEvent = "certificate_expiry"
+
+	// eventLowDisk is raised if the disk holding the database runs low on
+	// free space.
+	eventLowDisk notificationEvent = "low_disk"
+
+	// eventBackupFailure is raised if a backup of the database failed.
+	eventBackupFailure notificationEvent = "backup_failure"
+
+	// eventCleanupFailures is raised if the cleanup routine failed
+	// repeatedly.
+	eventCleanupFailures notificationEvent = "cleanup_failures"
+
+	// eventCapacityForecast is raised if the database is forecast to run
+	// out of space soon.
+	eventCapacityForecast notificationEvent = "capacity_forecast"
+
+	// eventCanaryViolation is raised if canary pairs were modified,
+	// deleted or inserted.
+	eventCanaryViolation notificationEvent = "canary_violation"
+)
+
+// Notification is an operator notification about an issue of the coordinator.
+type Notification struct {
+	Event    string    `json:"event"`
+	Severity string    `json:"severity"`
+	Message  string    `json:"message"`
+	Time     time.Time `json:"time"`
+}
+
+// Notifier defines an interface for delivering operator notifications.
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// logNotifier delivers notifications to the log.
+type logNotifier struct{}
+
+// Notify logs the notification at the level matching its severity.
+func (logNotifier) Notify(_ context.Context, n *Notification) error {
+	entry := logrus.WithField("event", n.Event)
+	switch n.Severity {
+	case severityCritical.String():
+		entry.Error(n.Message)
+	case severityWarning.String():
+		entry.Warn(n.Message)
+	default:
+		entry.Info(n.Message)
+	}
+
+	return nil
+}
+
+// webhookNotifier delivers notifications as JSON to a webhook.
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// Notify posts the notification to the webhook.
+func (w *webhookNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, w.url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %s",
+			resp.Status)
+	}
+
+	return nil
+}
+
+// emailNotifier delivers notifications by email.
+type emailNotifier struct {
+	server   string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+// Notify sends the notification by email.
+func (e *emailNotifier) Notify(_ context.Context, n *Notification) error {
+	var auth smtp.Auth
+	if e.username != "" {
+		host, _, err := net.SplitHostPort(e.server)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", e.username, e.password, host)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: [%s] External "+
+		"coordinator %s\r\n\r\n%s\r\n", e.from,
+		strings.Join(e.to, ", "), n.Severity, n.Event, n.Message)
+
+	return smtp.SendMail(e.server, auth, e.from, e.to, []byte(msg))
+}
+
+// notificationTarget is a notifier together with the minimum severity of the
+// notifications it delivers.
+type notificationTarget struct {
+	name        string
+	notifier    Notifier
+	minSeverity notificationSeverity
+}
+
+// notificationDispatcher delivers operator notifications to all configured
+// notifiers according to the configured severity of each event. Repeated
+// notifications of the same event are suppressed for the configured repeat
+// interval.
+type notificationDispatcher struct {
+	config     *NotifyConfig
+	severities map[notificationEvent]notificationSeverity
+	targets    []notificationTarget
+
+	mu       sync.Mutex
+	lastSent map[notificationEvent]time.Time
+}
+
+// newNotificationDispatcher creates a dispatcher for the given configuration.
+// The log notifier is always enabled, the webhook and email notifiers only if
+// configured.
+func newNotificationDispatcher(
+	config *NotifyConfig) (*notificationDispatcher, error) {
+	d := &notificationDispatcher{
+		config:     config,
+		severities: make(map[notificationEvent]notificationSeverity),
+		targets: []notificationTarget{{
+			name:        "log",
+			notifier:    logNotifier{},
+			minSeverity: severityInfo,
+		}},
+		lastSent: make(map[notificationEvent]time.Time),
+	}
+
+	events := map[notificationEvent]string{
+		eventCertificateExpiry: config.CertificateExpirySeverity,
+		eventLowDisk:           config.LowDiskSeverity,
+		eventBackupFailure:     config.BackupFailureSeverity,
+		eventCleanupFailures:   config.CleanupFailureSeverity,
+		eventCapacityForecast:  config.CapacitySeverity,
+		eventCanaryViolation:   config.CanarySeverity,
+	}
+	for event, name := range events {
+		severity, err := parseNotificationSeverity(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", event, err)
+		}
+		d.severities[event] = severity
+	}
+
+	if config.WebhookURL != "" {
+		minSeverity, err := parseNotificationSeverity(
+			config.WebhookMinSeverity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("webhook: %v", err)
+		}
+		d.targets = append(d.targets, notificationTarget{
+			name: "webhook",
+			notifier: &webhookNotifier{
+				url: config.WebhookURL,
+				client: &http.Client{
+					Timeout: config.WebhookTimeout,
+				},
+			},
+			minSeverity: minSeverity,
+		})
+	}
+
+	if config.SMTPServer != "" && config.EmailTo != "" {
+		minSeverity, err := parseNotificationSeverity(
+			config.EmailMinSeverity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("email: %v", err)
+		}
+
+		var to []string
+		for _, addr := range strings.Split(config.EmailTo, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				to = append(to, addr)
+			}
+		}
+		d.targets = append(d.targets, notificationTarget{
+			name: "email",
+			notifier: &emailNotifier{
+				server:   config.SMTPServer,
+				username: config.SMTPUsername,
+				password: config.SMTPPassword,
+				from:     config.EmailFrom,
+				to:       to,
+			},
+			minSeverity: minSeverity,
+		})
+	}
+
+	return d, nil
+}
+
+// notify delivers a notification of the event with its configured severity.
+// Delivery failures are only logged. It is a no-op on a nil dispatcher.
+func (d *notificationDispatcher) notify(event notificationEvent,
+	format string, args ...interface{}) {
+	if d == nil {
+		return
+	}
+
+	severity := d.severities[event]
+	if severity == severityOff {
+		return
+	}
+
+	now := time.Now()
+	d.mu.Lock()
+	last, ok := d.lastSent[event]
+	if ok && now.Sub(last) < d.config.RepeatInterval {
+		d.mu.Unlock()
+		return
+	}
+	d.lastSent[event] = now
+	d.mu.Unlock()
+
+	n := &Notification{
+		Event:    string(event),
+		Severity: severity.String(),
+		Message:  fmt.Sprintf(format, args...),
+		Time:     now,
+	}
+	for _, target := range d.targets {
+		if target.minSeverity == severityOff ||
+			severity < target.minSeverity {
+			continue
+		}
+
+		err := target.notifier.Notify(context.Background(), n)
+		if err != nil {
+			logrus.Errorf("Failed to deliver %s notification via "+
+				"%s: %v", event, target.name, err)
+		}
+	}
+}
+
+// resolve marks the event as resolved, so that it is notified right away if
+// it occurs again. It is a no-op on a nil dispatcher.
+func (d *notificationDispatcher) resolve(event notificationEvent) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	delete(d.lastSent, event)
+	d.mu.Unlock()
+}
+
+// certificateExpiry returns the expiry time of the first certificate in the
+// given PEM file.
+func certificateExpiry(certFile string) (time.Time, error) {
+	data, err := os.ReadFile(certFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, fmt.Errorf("no PEM data found in %s",
+			certFile)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+// checkCertificateExpiry notifies the operator if the TLS certificate in use
+// expires within the configured warning period.
+func (s *externalCoordinatorServer) checkCertificateExpiry() {
+	certFile := s.config.TLS.TLSCertFile
+	if certFile == "" {
+		return
+	}
+
+	expiry, err := certificateExpiry(certFile)
+	if err != nil {
+		logrus.Errorf("Failed to check certificate expiry: %v", err)
+		return
+	}
+
+	remaining := time.Until(expiry)
+	if remaining > s.config.Notify.CertificateExpiryWarning {
+		s.notifications.resolve(eventCertificateExpiry)
+		return
+	}
+
+	if remaining <= 0 {
+		s.notifications.notify(eventCertificateExpiry, "The TLS "+
+			"certificate %s expired on %s", certFile,
+			expiry.Format(time.RFC3339))
+		return
+	}
+	s.notifications.notify(eventCertificateExpiry, "The TLS certificate "+
+		"%s expires in %s on %s", certFile, formatDuration(remaining),
+		expiry.Format(time.RFC3339))
+}
+
+// checkDiskSpace notifies the operator if the free space on the disk holding
+// the database drops below the configured percentage.
+func (s *externalCoordinatorServer) checkDiskSpace() {
+	dir := s.config.Database.DatabaseDirPath
+	free, err := freeDiskPercent(dir)
+	if err != nil {
+		logrus.Errorf("Failed to check free disk space: %v", err)
+		return
+	}
+
+	if free >= s.config.Notify.MinFreeDiskPercent {
+		s.notifications.resolve(eventLowDisk)
+		return
+	}
+
+	s.notifications.notify(eventLowDisk, "Only %.1f%% of the disk "+
+		"holding the database directory %s is free", free, dir)
+}
+
+// recordCleanupResult tracks consecutive failures of the cleanup routine and
+// notifies the operator once they reach the configured threshold.
+func (s *externalCoordinatorServer) recordCleanupResult(err error) {
+	if err == nil {
+		s.cleanupFailures.Store(0)
+		s.notifications.resolve(eventCleanupFailures)
+		return
+	}
+
+	failures := s.cleanupFailures.Add(1)
+	threshold := s.config.Notify.CleanupFailureThreshold
+	if threshold > 0 && failures >= int64(threshold) {
+		s.notifications.notify(eventCleanupFailures, "The cleanup "+
+			"routine failed %d times in a row, last error: %v",
+			failures, err)
+	}
+}
+
+// StartNotifications starts notifying the operator about issues of the
+// coordinator. The certificate, the disk, the growth of the database and the
+// canary pairs are checked right away and then on the configured interval
+// until the context is canceled.
+func (s *externalCoordinatorServer) StartNotifications(
+	ctx context.Context) error {
+	notifications, err := newNotificationDispatcher(&s.config.Notify)
+	if err != nil {
+		return err
+	}
+	s.notifications = notifications
+
+	check := func() {
+		s.checkCertificateExpiry()
+		s.checkDiskSpace()
+		s.checkCapacity()
+		if s.config.Server.CanaryPairs > 0 {
+			s.checkCanaries()
+		}
+	}
+	check()
+
+	go func() {
+		ticker := time.NewTicker(s.config.Notify.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				check()
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNotifier records the notifications it delivers.
+type recordingNotifier struct {
+	mu            sync.Mutex
+	notifications []*Notification
+}
+
+func (r *recordingNotifier) Notify(_ context.Context, n *Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notifications = append(r.notifications, n)
+
+	return nil
+}
+
+// events returns the events of the delivered notifications.
+func (r *recordingNotifier) events() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var events []string
+	for _, n := range r.notifications {
+		events = append(events, n.Event)
+	}
+
+	return events
+}
+
+// testNotifyConfig returns a notification configuration with all events
+// enabled.
+func testNotifyConfig() NotifyConfig {
+	return NotifyConfig{
+		CheckInterval:             time.Hour,
+		RepeatInterval:            time.Hour,
+		CertificateExpiryWarning:  24 * time.Hour,
+		MinFreeDiskPercent:        0,
+		CleanupFailureThreshold:   2,
+		CertificateExpirySeverity: "warning",
+		LowDiskSeverity:           "critical",
+		BackupFailureSeverity:     "critical",
+		CleanupFailureSeverity:    "warning",
+		CapacitySeverity:          "warning",
+		CanarySeverity:            "critical",
+		WebhookMinSeverity:        "critical",
+		WebhookTimeout:            time.Second,
+		EmailMinSeverity:          "critical",
+	}
+}
+
+// TestParseNotificationSeverity tests parsing notification severities.
+func TestParseNotificationSeverity(t *testing.T) {
+	for _, name := range []string{"off", "info", "warning", "critical"} {
+		severity, err := parseNotificationSeverity(name)
+		require.NoError(t, err)
+		require.Equal(t, name, severity.String())
+	}
+
+	_, err := parseNotificationSeverity("urgent")
+	require.Error(t, err)
+}
+
+// TestNotificationDispatcher tests that notifications are delivered
+// according to their severity and that repeated notifications are
+// suppressed until resolved.
+func TestNotificationDispatcher(t *testing.T) {
+	var mu sync.Mutex
+	var received []*Notification
+	webhook := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			n := &Notification{}
+			err := json.NewDecoder(r.Body).Decode(n)
+			require.NoError(t, err)
+
+			mu.Lock()
+			received = append(received, n)
+			mu.Unlock()
+		},
+	))
+	defer webhook.Close()
+
+	config := testNotifyConfig()
+	config.WebhookURL = webhook.URL
+	config.BackupFailureSeverity = "off"
+	d, err := newNotificationDispatcher(&config)
+	require.NoError(t, err)
+	require.Len(t, d.targets, 2)
+
+	recorder := &recordingNotifier{}
+	d.targets = append(d.targets, notificationTarget{
+		name: "recorder", notifier: recorder,
+		minSeverity: severityInfo,
+	})
+
+	d.notify(eventLowDisk, "low disk %d", 1)
+	d.notify(eventCertificateExpiry, "expiring")
+	d.notify(eventBackupFailure, "backup failed")
+
+	// Repeated notifications are suppressed until the event is resolved.
+	d.notify(eventLowDisk, "low disk %d", 2)
+	d.resolve(eventLowDisk)
+	d.notify(eventLowDisk, "low disk %d", 3)
+
+	require.Equal(t, []string{
+		"low_disk", "certificate_expiry", "low_disk",
+	}, recorder.events())
+
+	// Only critical notifications reach the webhook.
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 2)
+	require.Equal(t, "low_disk", received[0].Event)
+	require.Equal(t, "critical", received[0].Severity)
+	require.Equal(t, "low disk 1", received[0].Message)
+	require.Equal(t, "low disk 3", received[1].Message)
+
+	// Invalid severities are rejected.
+	config.LowDiskSeverity = "urgent"
+	_, err = newNotificationDispatcher(&config)
+	require.Error(t, err)
+
+	// A nil dispatcher ignores notifications.
+	var nilDispatcher *notificationDispatcher
+	nilDispatcher.notify(eventLowDisk, "ignored")
+	nilDispatcher.resolve(eventLowDisk)
+}
+
+// TestNotificationChecks tests the checks raising notifications.
+func TestNotificationChecks(t *testing.T) {
+	tempDir := t.TempDir()
+	certFile := filepath.Join(tempDir, "tls.cert")
+	keyFile := filepath.Join(tempDir, "tls.key")
+	require.NoError(t, generateSelfSignedTLS(certFile, keyFile))
+
+	config := MockConfig(tempDir)
+	config.TLS.TLSCertFile = certFile
+	config.Notify = testNotifyConfig()
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartNotifications(context.Background()))
+
+	recorder := &recordingNotifier{}
+	server.notifications.targets = []notificationTarget{{
+		name: "recorder", notifier: recorder,
+		minSeverity: severityInfo,
+	}}
+
+	// The certificate is valid for a year and the disk check is
+	// disabled, so nothing is notified.
+	server.checkCertificateExpiry()
+	server.checkDiskSpace()
+	require.Empty(t, recorder.events())
+
+	config.Notify.CertificateExpiryWarning = 2 * 365 * 24 * time.Hour
+	config.Notify.MinFreeDiskPercent = 101
+	server.checkCertificateExpiry()
+	server.checkDiskSpace()
+	require.Equal(t, []string{"certificate_expiry", "low_disk"},
+		recorder.events())
+
+	// Cleanup failures are notified once the threshold is reached and
+	// reset by a successful cleanup.
+	server.recordCleanupResult(errors.New("disk full"))
+	require.Len(t, recorder.events(), 2)
+	server.recordCleanupResult(errors.New("disk full"))
+	require.Len(t, recorder.events(), 3)
+	require.Equal(t, "cleanup_failures", recorder.events()[2])
+
+	server.recordCleanupResult(nil)
+	require.Zero(t, server.cleanupFailures.Load())
+}
+
+// TestFreeDiskPercent tests that the free disk space is a percentage.
+func TestFreeDiskPercent(t *testing.T) {
+	free, err := freeDiskPercent(t.TempDir())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, free, 0.0)
+	require.LessOrEqual(t, free, 100.0)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// pairDataSchemaV1 is the schema version of pair data stored as its
+	// protobuf encoding. Fields added to PairData later on keep this
+	// version, since the protobuf encoding skips unknown fields when
+	// decoding and keeps them when the data is encoded again. The version
+	// only changes for incompatible encodings.
+	pairDataSchemaV1 byte = 1
+
+	// legacyPairDataPrefix is the first byte of pair data stored as plain
+	// JSON before stored values were versioned. It never collides with a
+	// schema version.
+	legacyPairDataPrefix byte = '{'
+)
+
+// errCorruptPairData is returned when stored pair data cannot be decoded.
+var errCorruptPairData = errors.New("corrupt pair data")
+
+// encodePairData encodes pair data into the envelope it is stored in, the
+// schema version followed by the encoded data.
+func encodePairData(history *ecrpc.PairData) ([]byte, error) {
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(
+		history,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{pairDataSchemaV1}, payload...), nil
+}
+
+// decodePairData decodes stored pair data of any known schema version,
+// including the plain JSON stored before values were versioned. Values that
+// cannot be decoded, e.g. because they were written by a newer version with
+// an incompatible encoding, fail with errCorruptPairData instead of being
+// misread.
+func decodePairData(value []byte) (*ecrpc.PairData, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("%w: empty value", errCorruptPairData)
+	}
+
+	history := &ecrpc.PairData{}
+	switch value[0] {
+	case pairDataSchemaV1:
+		if err := proto.Unmarshal(value[1:], history); err != nil {
+			return nil, fmt.Errorf("%w: %w", errCorruptPairData, err)
+		}
+
+	case legacyPairDataPrefix:
+		if err := json.Unmarshal(value, history); err != nil {
+			return nil, fmt.Errorf("%w: %w", errCorruptPairData, err)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: unknown schema version %d",
+			errCorruptPairData, value[0])
+	}
+
+	return history, nil
+}
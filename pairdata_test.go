@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestPairDataEnvelope tests that stored pair data is decoded regardless of
+// the schema version it was written with and that undecodable data is
+// reported as corrupt.
+func TestPairDataEnvelope(t *testing.T) {
+	history := &ecrpc.PairData{
+		FailTime:       1700000000,
+		FailAmtMsat:    5000,
+		SuccessTime:    1700000100,
+		SuccessAmtMsat: 10000,
+	}
+
+	// Encoded data round trips.
+	value, err := encodePairData(history)
+	require.NoError(t, err)
+	require.Equal(t, pairDataSchemaV1, value[0])
+	decoded, err := decodePairData(value)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(history, decoded))
+
+	// Plain JSON stored by older versions is still decoded.
+	legacy, err := json.Marshal(history)
+	require.NoError(t, err)
+	decoded, err = decodePairData(legacy)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(history, decoded))
+
+	// Fields added by newer versions are skipped.
+	newer := protowire.AppendTag(value, 1000, protowire.VarintType)
+	newer = protowire.AppendVarint(newer, 42)
+	decoded, err = decodePairData(newer)
+	require.NoError(t, err)
+	require.Equal(t, history.FailAmtMsat, decoded.FailAmtMsat)
+
+	// Empty values, unknown versions and truncated data are corrupt.
+	for _, corrupt := range [][]byte{
+		nil, {2, 8, 1}, value[:len(value)-1], []byte("{"),
+	} {
+		_, err := decodePairData(corrupt)
+		require.ErrorIs(t, err, errCorruptPairData)
+		require.Equal(t, codes.DataLoss, storageErrorCode(err))
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// pairOutcomes tracks what a registration does with each pair of its request.
+// The pairs are tracked by identity, since the steps of a registration remove
+// pairs from the request rather than copying them.
+type pairOutcomes struct {
+	// results holds the outcome of each pair by its index within the
+	// request.
+	results []*ecrpc.PairResult
+
+	// index maps the pairs still registered to their index within the
+	// request.
+	index map[*ecrpc.PairHistory]int
+}
+
+// newPairOutcomes starts tracking the given pairs of a request, all of which
+// are accepted until a step of the registration drops them.
+func newPairOutcomes(pairs []*ecrpc.PairHistory) *pairOutcomes {
+	o := &pairOutcomes{
+		results: make([]*ecrpc.PairResult, len(pairs)),
+		index:   make(map[*ecrpc.PairHistory]int, len(pairs)),
+	}
+	for i, pair := range pairs {
+		o.results[i] = &ecrpc.PairResult{
+			Index:    uint32(i),
+			NodeFrom: pair.NodeFrom,
+			NodeTo:   pair.NodeTo,
+			Outcome:  ecrpc.PairOutcome_PAIR_OUTCOME_ACCEPTED,
+		}
+		o.index[pair] = i
+	}
+
+	return o
+}
+
+// drop records the outcome of the pairs registered before a step which are no
+// longer registered after it.
+func (o *pairOutcomes) drop(before, after []*ecrpc.PairHistory,
+	outcome ecrpc.PairOutcome, reason string) {
+
+	kept := make(map[*ecrpc.PairHistory]struct{}, len(after))
+	for _, pair := range after {
+		kept[pair] = struct{}{}
+	}
+	for _, pair := range before {
+		if _, ok := kept[pair]; ok {
+			continue
+		}
+		if i, ok := o.index[pair]; ok {
+			o.results[i].Outcome = outcome
+			o.results[i].Reason = reason
+			delete(o.index, pair)
+		}
+	}
+}
+
+// replace tracks the pairs returned by a step which may replace the pairs, e.g.
+// the ingest hook. Each returned pair takes the place of the next registered
+// pair of the same nodes, and the registered pairs not taken are dropped with
+// the given outcome. Pairs the step added are not tracked.
+func (o *pairOutcomes) replace(before, after []*ecrpc.PairHistory,
+	outcome ecrpc.PairOutcome, reason string) {
+
+	occurrences := make(map[string][]*ecrpc.PairHistory)
+	for _, pair := range before {
+		key := string(pairKey(pair.NodeFrom, pair.NodeTo))
+		occurrences[key] = append(occurrences[key], pair)
+	}
+
+	index := make(map[*ecrpc.PairHistory]int, len(after))
+	taken := make(map[int]struct{}, len(after))
+	for _, pair := range after {
+		key := string(pairKey(pair.NodeFrom, pair.NodeTo))
+		if len(occurrences[key]) == 0 {
+			continue
+		}
+		previous := occurrences[key][0]
+		occurrences[key] = occurrences[key][1:]
+
+		if i, ok := o.index[previous]; ok {
+			index[pair] = i
+			taken[i] = struct{}{}
+		}
+	}
+	for _, i := range o.index {
+		if _, ok := taken[i]; !ok {
+			o.results[i].Outcome = outcome
+			o.results[i].Reason = reason
+		}
+	}
+	o.index = index
+}
+
+// merge records the pairs which occur again after their first occurrence as
+// merged into it.
+func (o *pairOutcomes) merge(pairs []*ecrpc.PairHistory) {
+	first := make(map[string]int)
+	for _, pair := range pairs {
+		i, ok := o.index[pair]
+		if !ok {
+			continue
+		}
+
+		key := string(pairKey(pair.NodeFrom, pair.NodeTo))
+		firstIndex, seen := first[key]
+		if !seen {
+			first[key] = i
+			continue
+		}
+		o.results[i].Outcome = ecrpc.PairOutcome_PAIR_OUTCOME_MERGED
+		o.results[i].Reason = fmt.Sprintf("merged into the pair at "+
+			"index %d", firstIndex)
+	}
+}
+
+// alter records a reason for the pairs a step replaced by altered copies,
+// which are accepted nonetheless.
+func (o *pairOutcomes) alter(before, after []*ecrpc.PairHistory,
+	reason string) {
+
+	for i, pair := range before {
+		if after[i] == pair {
+			continue
+		}
+		if j, ok := o.index[pair]; ok {
+			o.results[j].Reason = reason
+			delete(o.index, pair)
+			o.index[after[i]] = j
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestRegisterPairResults tests that a registration reports what it did with
+// each pair of the request.
+func TestRegisterPairResults(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RequireChannelProof = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, _ := generateTestKeys(t)
+	stream := &mockImportChannelGraphServer{
+		Chunks: []*ecadminrpc.ImportChannelGraphRequest{{
+			Full: true,
+			Channels: []*ecadminrpc.Channel{
+				{ShortChannelId: 1, Node1: nodeA, Node2: nodeB},
+				{ShortChannelId: 2, Node1: nodeB, Node2: nodeC},
+			},
+		}},
+	}
+	require.NoError(t, admin.ImportChannelGraph(stream))
+
+	pair := func(nodeFrom, nodeTo []byte, scid uint64,
+		resultTime time.Time) *ecrpc.PairHistory {
+
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    resultTime.Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+			ShortChannelId: scid,
+		}
+	}
+	stale := time.Now().Add(-2 * time.Hour)
+	resp, err := server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB, 1, time.Now()),
+				pair(nodeB, nodeC, 2, stale),
+				pair(nodeA, nodeC, 3, time.Now()),
+				pair(nodeA, nodeB, 1, time.Now()),
+				pair(nodeC, nodeB, 2, time.Now()),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	expected := []ecrpc.PairOutcome{
+		ecrpc.PairOutcome_PAIR_OUTCOME_ACCEPTED,
+		ecrpc.PairOutcome_PAIR_OUTCOME_REJECTED_STALE,
+		ecrpc.PairOutcome_PAIR_OUTCOME_REJECTED_INVALID,
+		ecrpc.PairOutcome_PAIR_OUTCOME_MERGED,
+		ecrpc.PairOutcome_PAIR_OUTCOME_ACCEPTED,
+	}
+	require.Len(t, resp.PairResults, len(expected))
+	for i, result := range resp.PairResults {
+		require.EqualValues(t, i, result.Index)
+		require.Equal(t, expected[i], result.Outcome)
+	}
+	require.Equal(t, nodeC, resp.PairResults[2].NodeTo)
+	require.Equal(t, "channel proof required", resp.PairResults[2].Reason)
+	require.Equal(t, "merged into the pair at index 0",
+		resp.PairResults[3].Reason)
+	require.Empty(t, resp.PairResults[4].Reason)
+	require.ElementsMatch(t, []string{
+		string(pairKey(nodeA, nodeB)), string(pairKey(nodeC, nodeB)),
+	}, storedPairKeys(t, db))
+}
+
+// TestPairOutcomesReplace tests that the pairs returned by a step replacing
+// them are tracked in place of the pairs of the same nodes.
+func TestPairOutcomesReplace(t *testing.T) {
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	pairs := []*ecrpc.PairHistory{
+		{NodeFrom: nodeA, NodeTo: nodeB},
+		{NodeFrom: nodeA, NodeTo: nodeC},
+		{NodeFrom: nodeA, NodeTo: nodeB},
+	}
+	outcomes := newPairOutcomes(pairs)
+
+	// Replace the pairs by copies, dropping the second one.
+	replaced := []*ecrpc.PairHistory{
+		proto.Clone(pairs[0]).(*ecrpc.PairHistory),
+		proto.Clone(pairs[2]).(*ecrpc.PairHistory),
+	}
+	invalid := ecrpc.PairOutcome_PAIR_OUTCOME_REJECTED_INVALID
+	outcomes.replace(pairs, replaced, invalid, "dropped")
+	outcomes.merge(replaced)
+
+	require.Equal(t, []ecrpc.PairOutcome{
+		ecrpc.PairOutcome_PAIR_OUTCOME_ACCEPTED, invalid,
+		ecrpc.PairOutcome_PAIR_OUTCOME_MERGED,
+	}, []ecrpc.PairOutcome{
+		outcomes.results[0].Outcome, outcomes.results[1].Outcome,
+		outcomes.results[2].Outcome,
+	})
+	require.Equal(t, "dropped", outcomes.results[1].Reason)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// pathfindingParametersKey is the key of the pathfinding parameters
+// recommended by the operator within the metadata bucket. They are stored as
+// an encoded PathfindingParameters message.
+var pathfindingParametersKey = []byte("pathfinding_parameters")
+
+// storedPathfindingParameters returns the pathfinding parameters recommended
+// by the operator, or nil if there are none. Parameters which cannot be
+// decoded are logged and treated as missing, so that they never fail a query.
+func storedPathfindingParameters(tx *bbolt.Tx) *ecrpc.PathfindingParameters {
+	v := tx.Bucket([]byte(MetadataBucketName)).Get(pathfindingParametersKey)
+	if v == nil {
+		return nil
+	}
+
+	params := &ecrpc.PathfindingParameters{}
+	if err := proto.Unmarshal(v, params); err != nil {
+		logrus.Errorf("Failed to decode pathfinding parameters: %v",
+			err)
+		return nil
+	}
+
+	return params
+}
+
+// validatePathfindingParameters checks that the parameters are accepted by
+// the mission control of LND.
+func validatePathfindingParameters(
+	req *ecadminrpc.SetPathfindingParametersRequest) error {
+
+	switch {
+	case req.HalfLifeSeconds == 0:
+		return status.Error(codes.InvalidArgument, "half life must be "+
+			"positive")
+
+	// The ranges are checked in their negated form so that NaN, which
+	// fails every comparison, is rejected as well.
+	case !(req.HopProbability >= 0 && req.HopProbability <= 1):
+		return status.Errorf(codes.InvalidArgument, "hop probability "+
+			"%v must be between 0 and 1", req.HopProbability)
+
+	case !(req.Weight >= 0 && req.Weight <= 1):
+		return status.Errorf(codes.InvalidArgument, "weight %v must "+
+			"be between 0 and 1", req.Weight)
+	}
+
+	return nil
+}
+
+// SetPathfindingParameters stores the pathfinding parameters served to clients
+// alongside the dataset, or clears them.
+func (a *adminServer) SetPathfindingParameters(ctx context.Context,
+	req *ecadminrpc.SetPathfindingParametersRequest) (
+	*ecadminrpc.SetPathfindingParametersResponse, error) {
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request "+
+			"cannot be nil")
+	}
+
+	var value []byte
+	if !req.Clear {
+		if err := validatePathfindingParameters(req); err != nil {
+			return nil, err
+		}
+
+		var err error
+		value, err = proto.Marshal(&ecrpc.PathfindingParameters{
+			HalfLifeSeconds: req.HalfLifeSeconds,
+			HopProbability:  req.HopProbability,
+			Weight:          req.Weight,
+			UpdatedAt:       time.Now().Unix(),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to "+
+				"encode pathfinding parameters: %v", err)
+		}
+	}
+
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket([]byte(MetadataBucketName))
+		if value == nil {
+			return meta.Delete(pathfindingParametersKey)
+		}
+
+		return meta.Put(pathfindingParametersKey, value)
+	})
+	if err != nil {
+		msg := "failed to store pathfinding parameters: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	if req.Clear {
+		logrus.Info("Pathfinding parameters cleared")
+	} else {
+		logrus.Infof("Pathfinding parameters set to a half life of "+
+			"%ds, a hop probability of %v and a weight of %v",
+			req.HalfLifeSeconds, req.HopProbability, req.Weight)
+	}
+
+	return &ecadminrpc.SetPathfindingParametersResponse{}, nil
+}
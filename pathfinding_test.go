@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestPathfindingParameters tests that the pathfinding parameters set by the
+// operator are served alongside the dataset until they are cleared.
+func TestPathfindingParameters(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	getInfo := func() *ecrpc.GetInfoResponse {
+		info, err := server.GetInfo(ctx, &ecrpc.GetInfoRequest{})
+		require.NoError(t, err)
+
+		return info
+	}
+	require.Nil(t, getInfo().Dataset.PathfindingParameters)
+
+	_, err = admin.SetPathfindingParameters(
+		ctx, &ecadminrpc.SetPathfindingParametersRequest{
+			HalfLifeSeconds: 3600,
+			HopProbability:  0.6,
+			Weight:          0.5,
+		},
+	)
+	require.NoError(t, err)
+
+	params := getInfo().Dataset.PathfindingParameters
+	require.NotNil(t, params)
+	require.EqualValues(t, 3600, params.HalfLifeSeconds)
+	require.Equal(t, 0.6, params.HopProbability)
+	require.Equal(t, 0.5, params.Weight)
+	require.NotZero(t, params.UpdatedAt)
+
+	// Syncing clients receive the parameters with the dataset too.
+	delta, err := server.QueryMissionControlDelta(
+		ctx, &ecrpc.QueryMissionControlDeltaRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0.6, delta.Dataset.PathfindingParameters.
+		HopProbability)
+
+	// Parameters LND would reject are rejected.
+	for _, req := range []*ecadminrpc.SetPathfindingParametersRequest{
+		{HopProbability: 0.6, Weight: 0.5},
+		{HalfLifeSeconds: 3600, HopProbability: 1.5, Weight: 0.5},
+		{HalfLifeSeconds: 3600, HopProbability: 0.6, Weight: -0.1},
+		{HalfLifeSeconds: 3600, HopProbability: math.NaN(), Weight: 0.5},
+		{HalfLifeSeconds: 3600, HopProbability: 0.6, Weight: math.NaN()},
+	} {
+		_, err := admin.SetPathfindingParameters(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	require.Equal(t, 0.6, getInfo().Dataset.PathfindingParameters.
+		HopProbability)
+
+	_, err = admin.SetPathfindingParameters(
+		ctx, &ecadminrpc.SetPathfindingParametersRequest{Clear: true},
+	)
+	require.NoError(t, err)
+	require.Nil(t, getInfo().Dataset.PathfindingParameters)
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// datasetChanges wakes the long polls waiting for the mission control data to
+// change whenever a transaction bumping its revision is committed.
+var datasetChanges = newChangeNotifier()
+
+// changeNotifier wakes any number of waiters on the next change.
+type changeNotifier struct {
+	mu      sync.Mutex
+	changed chan struct{}
+}
+
+// newChangeNotifier creates a notifier without any waiters.
+func newChangeNotifier() *changeNotifier {
+	return &changeNotifier{changed: make(chan struct{})}
+}
+
+// wait returns a channel which is closed on the next change.
+func (n *changeNotifier) wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.changed
+}
+
+// notify wakes all waiters.
+func (n *changeNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	close(n.changed)
+	n.changed = make(chan struct{})
+}
+
+// collectedQueryStream collects the pairs sent on a query stream, so that the
+// pairs of a unary response are decoded and transformed like the ones of a
+// query.
+type collectedQueryStream struct {
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer
+	ctx   context.Context
+	pairs []*ecrpc.PairHistory
+}
+
+// Context returns the context of the request.
+func (c *collectedQueryStream) Context() context.Context {
+	return c.ctx
+}
+
+// Send collects the pairs of the response.
+func (c *collectedQueryStream) Send(
+	resp *ecrpc.QueryAggregatedMissionControlResponse) error {
+
+	c.pairs = append(c.pairs, resp.Pairs...)
+
+	return nil
+}
+
+// pollTimeout returns the time a poll waits for the dataset to change, which
+// defaults to and is capped by the configured maximum.
+func (s *externalCoordinatorServer) pollTimeout(seconds uint32) time.Duration {
+	limit := s.config.Server.MaxPollTimeout
+	timeout := time.Duration(seconds) * time.Second
+	if timeout == 0 || timeout > limit {
+		return limit
+	}
+
+	return timeout
+}
+
+// waitForRevision waits until the revision of the dataset differs from the
+// given one or the timeout elapses. Revisions ahead of the current one, e.g.
+// of another coordinator, differ as well, so that the client receives the
+// entire dataset. A timeout of zero waits until the context is done.
+func (s *externalCoordinatorServer) waitForRevision(ctx context.Context,
+	revision uint64, timeout time.Duration) error {
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		// Subscribe before reading the revision, so that no change
+		// is missed in between.
+		changed := datasetChanges.wait()
+
+		var current uint64
+		err := s.db.View(func(tx *bbolt.Tx) error {
+			meta := tx.Bucket([]byte(MetadataBucketName))
+			current = decodeUint64(meta.Get(datasetRevisionKey))
+			return nil
+		})
+		if err != nil || current != revision {
+			return err
+		}
+
+		select {
+		case <-changed:
+		case <-expired:
+			return nil
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// pairChanges are the changes of the pairs since a revision.
+type pairChanges struct {
+	// keys and values are the raw pairs stored or updated since the
+	// revision in key order.
+	keys   [][]byte
+	values [][]byte
+
+	// removed are the keys of the pairs removed since the revision in key
+	// order.
+	removed [][]byte
+
+	// full is whether the changes are all pairs, because the revision log
+	// or the modified index does not cover the revision or time.
+	full bool
+}
+
+// allPairs returns all pairs as changes replacing the data of the client.
+func allPairs(tx *bbolt.Tx) (*pairChanges, error) {
+	changes := &pairChanges{full: true}
+	err := tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, v []byte) error {
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			changes.keys = append(changes.keys, bytes.Clone(k))
+			changes.values = append(changes.values, bytes.Clone(v))
+			return nil
+		},
+	)
+
+	return changes, err
+}
+
+// changedPairs returns the changes of the pairs since the given revision. They
+// are found by a range scan over the revision log. If the log does not cover
+// the revision, all pairs are returned instead.
+func changedPairs(tx *bbolt.Tx, revision uint64) (*pairChanges, error) {
+	b := tx.Bucket([]byte(DatabaseBucketName))
+	current := decodeUint64(
+		tx.Bucket([]byte(MetadataBucketName)).Get(datasetRevisionKey),
+	)
+	covered := revision >= oldestRevision(tx) && revision <= current
+	if revision == 0 || !covered {
+		return allPairs(tx)
+	}
+	changes := &pairChanges{}
+
+	// Every change of a pair from the revision on is logged at the
+	// revision it was changed at.
+	changed := make(map[string]struct{})
+	c := tx.Bucket([]byte(RevisionLogBucketName)).Cursor()
+	for k, _ := c.Seek(encodeUint64(revision)); k != nil; k, _ = c.Next() {
+		changed[string(k[8:])] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(changed))
+	for key := range changed {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		v := b.Get([]byte(key))
+		if v == nil {
+			changes.removed = append(changes.removed, []byte(key))
+			continue
+		}
+		changes.keys = append(changes.keys, []byte(key))
+		changes.values = append(changes.values, bytes.Clone(v))
+	}
+
+	return changes, nil
+}
+
+// PollMissionControl waits until the mission control data changes from the
+// revision known to the client or the timeout elapses and returns the pairs
+// changed since. The pairs are filtered, transformed and accounted like the
+// ones of a query.
+func (s *externalCoordinatorServer) PollMissionControl(ctx context.Context,
+	req *ecrpc.PollMissionControlRequest) (
+	*ecrpc.PollMissionControlResponse, error) {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return nil, err
+	}
+
+	// Reject clients which already reached their daily egress cap.
+	client := clientIdentity(ctx)
+	if err := s.checkEgressCap(ctx, client); err != nil {
+		logrus.Infof("Poll rejected: %v", err)
+		return nil, err
+	}
+
+	revision := req.GetAfterRevision()
+	timeout := s.pollTimeout(req.GetTimeoutSeconds())
+	if err := s.waitForRevision(ctx, revision, timeout); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.collectChanges(ctx, revision)
+	if err != nil {
+		msg := "poll failed: %v"
+		st := status.Convert(err)
+		logrus.Errorf(msg, st.Message())
+		return nil, status.Errorf(st.Code(), msg, st.Message())
+	}
+
+	s.recordEgress(client, int64(proto.Size(resp)))
+	logrus.Infof("Poll after revision %d returned %d changed and %d "+
+		"removed pairs at revision %d", revision, len(resp.Pairs),
+		len(resp.RemovedPairs), resp.Dataset.Revision)
+
+	return resp, nil
+}
+
+// collectChanges returns the pairs changed since the given revision, filtered
+// and transformed like the ones of a query.
+func (s *externalCoordinatorServer) collectChanges(ctx context.Context,
+	revision uint64) (*ecrpc.PollMissionControlResponse, error) {
+
+	return s.collectPairChanges(
+		ctx, func(tx *bbolt.Tx) (*pairChanges, error) {
+			return changedPairs(tx, revision)
+		},
+	)
+}
+
+// collectPairChanges returns the changes of the pairs read by the given
+// function, filtered and transformed like the ones of a query. The filters are
+// built on every call, so that they cover the pairs stored meanwhile.
+func (s *externalCoordinatorServer) collectPairChanges(ctx context.Context,
+	read func(tx *bbolt.Tx) (*pairChanges, error)) (
+	*ecrpc.PollMissionControlResponse, error) {
+
+	scope := accessScopeFromContext(ctx).filter(nil)
+	filter, err := s.freshnessFilter(0, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var changes *pairChanges
+	resp := &ecrpc.PollMissionControlResponse{}
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		resp.Dataset = currentDatasetInfo(tx)
+
+		var err error
+		changes, err = read(tx)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(storageErrorCode(err), "%v", err)
+	}
+	keys, values := changes.keys, changes.values
+	s.observeOperation(
+		operationQuery, start, len(keys)+len(changes.removed),
+	)
+	resp.Full = changes.full
+
+	accepted := 0
+	for i, k := range keys {
+		nodeFrom, nodeTo := splitPairKey(k)
+		if filter != nil && !filter(nodeFrom, nodeTo) {
+			continue
+		}
+		keys[accepted], values[accepted] = k, values[i]
+		accepted++
+	}
+	for _, k := range changes.removed {
+		nodeFrom, nodeTo := splitPairKey(k)
+		if scope != nil && !scope(nodeFrom, nodeTo) {
+			continue
+		}
+		resp.RemovedPairs = append(resp.RemovedPairs, &ecrpc.NodePair{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+		})
+	}
+
+	// The pairs are decoded, run through the serve hook of the operator
+	// and watermarked like the pairs of a query.
+	collected := &collectedQueryStream{ctx: ctx}
+	_, err = s.sendPairBatches(
+		s.transforms.transformServed(s.watermarkServed(ctx, collected)),
+		keys[:accepted], values[:accepted],
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp.Pairs = collected.pairs
+
+	return resp, nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestPollMissionControl tests that polls wait for the dataset to change and
+// return the pairs changed since the revision of the client.
+func TestPollMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RevisionLogRetention = 10
+	config.Server.MaxPollTimeout = 10 * time.Second
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	register := func(nodeTo []byte) {
+		_, err := server.RegisterMissionControl(
+			context.Background(), &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    time.Now().Unix(),
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+	poll := func(ctx context.Context, revision uint64,
+		timeout uint32) (*ecrpc.PollMissionControlResponse, error) {
+
+		return server.PollMissionControl(
+			ctx, &ecrpc.PollMissionControlRequest{
+				AfterRevision:  revision,
+				TimeoutSeconds: timeout,
+			},
+		)
+	}
+
+	// Clients without a revision receive the entire dataset.
+	register(nodeB)
+	resp, err := poll(context.Background(), 0, 1)
+	require.NoError(t, err)
+	require.True(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+	require.Equal(t, nodeB, resp.Pairs[0].NodeTo)
+	revision := resp.Dataset.Revision
+
+	// Polls time out if the dataset does not change.
+	config.Server.MaxPollTimeout = 50 * time.Millisecond
+	resp, err = poll(context.Background(), revision, 0)
+	require.NoError(t, err)
+	require.False(t, resp.Full)
+	require.Empty(t, resp.Pairs)
+	require.Equal(t, revision, resp.Dataset.Revision)
+	config.Server.MaxPollTimeout = 10 * time.Second
+
+	// A waiting poll returns the changes once the dataset changes.
+	type result struct {
+		resp *ecrpc.PollMissionControlResponse
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := poll(context.Background(), revision, 0)
+		done <- result{resp, err}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	register(nodeC)
+
+	res := <-done
+	require.NoError(t, res.err)
+	require.False(t, res.resp.Full)
+	require.Len(t, res.resp.Pairs, 1)
+	require.Equal(t, nodeC, res.resp.Pairs[0].NodeTo)
+	require.Greater(t, res.resp.Dataset.Revision, revision)
+	revision = res.resp.Dataset.Revision
+
+	// Removed pairs are reported by their nodes.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if err := deletePair(tx, pairKey(nodeA, nodeB)); err != nil {
+			return err
+		}
+
+		return bumpDatasetRevision(tx)
+	})
+	require.NoError(t, err)
+	resp, err = poll(context.Background(), revision, 0)
+	require.NoError(t, err)
+	require.Empty(t, resp.Pairs)
+	require.Len(t, resp.RemovedPairs, 1)
+	require.Equal(t, nodeA, resp.RemovedPairs[0].NodeFrom)
+	require.Equal(t, nodeB, resp.RemovedPairs[0].NodeTo)
+
+	// Revisions unknown to the coordinator are answered with the entire
+	// dataset.
+	resp, err = poll(context.Background(), revision+100, 0)
+	require.NoError(t, err)
+	require.True(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+
+	// Polls end with their request.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = poll(ctx, resp.Dataset.Revision, 0)
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// privatePairsOwner returns the owner of the private pairs of the request,
+// which is derived from its access token. Private pairs require access tokens,
+// since there is no other way to tell who may query them.
+func privatePairsOwner(ctx context.Context) (string, error) {
+	scope := accessScopeFromContext(ctx)
+	if scope == nil || scope.owner == "" {
+		return "", status.Error(codes.FailedPrecondition, "private "+
+			"pairs require access tokens")
+	}
+
+	return scope.owner, nil
+}
+
+// storePrivatePairs aggregates the given pairs with the private data of the
+// owner and stores the aggregated data. Private pairs never affect the public
+// aggregate, its indexes or its revision.
+func (s *externalCoordinatorServer) storePrivatePairs(owner string,
+	pairs []*ecrpc.PairHistory) error {
+	err := s.db.Batch(func(tx *bbolt.Tx) error {
+		return aggregatePrivatePairs(tx, owner, pairs)
+	})
+	if err != nil {
+		msg := "failed to store private pairs: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("%d private pairs were processed and stored "+
+		"successfully", len(pairs))
+
+	return nil
+}
+
+// aggregatePrivatePairs aggregates the given pairs into the private bucket of
+// the owner like aggregatePairs does for the public ones. The given pairs are
+// never modified, so the function can safely be run again after its
+// transaction was rolled back.
+func aggregatePrivatePairs(tx *bbolt.Tx, owner string,
+	pairs []*ecrpc.PairHistory) error {
+	b, err := tx.Bucket([]byte(PrivatePairsBucketName)).
+		CreateBucketIfNotExists([]byte(owner))
+	if err != nil {
+		return err
+	}
+
+	merged, _ := mergeDuplicatePairs(pairs)
+	for _, pair := range merged {
+		key := pairKey(pair.NodeFrom, pair.NodeTo)
+		history := proto.Clone(pair.History).(*ecrpc.PairData)
+
+		if v := b.Get(key); v != nil {
+			existingData, err := decodePairData(v)
+			if err != nil {
+				return status.Errorf(codes.DataLoss, "failed "+
+					"to decode history data: %v", err)
+			}
+
+			prevFailTime := existingData.FailTime
+			prevSuccessTime := existingData.SuccessTime
+			mergePairData(existingData, history)
+			updateFailureStreak(
+				existingData, prevFailTime, prevSuccessTime,
+			)
+			history = existingData
+		} else {
+			updateFailureStreak(history, 0, 0)
+		}
+
+		// Latency percentiles and conflicts are only aggregated across
+		// the public data, so private pairs carry none.
+		history.ResolutionLatencyMs = 0
+		history.LatencyP50Ms = 0
+		history.LatencyP95Ms = 0
+		history.ConflictingReports = 0
+
+		data, err := encodePairData(history)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to "+
+				"encode history data: %v", err)
+		}
+		if err := b.Put(key, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// QueryPrivateMissionControl queries the private mission control data of the
+// owner of the access token of the request.
+func (s *externalCoordinatorServer) QueryPrivateMissionControl(
+	req *ecrpc.QueryPrivateMissionControlRequest,
+	stream ecrpc.ExternalCoordinator_QueryPrivateMissionControlServer) error {
+	logrus.Info("Received QueryPrivateMissionControl request")
+
+	owner, err := privatePairsOwner(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	client := clientIdentity(stream.Context())
+	if err := s.checkEgressCap(stream.Context(), client); err != nil {
+		logrus.Infof("Query rejected: %v", err)
+		return err
+	}
+
+	metered := &meteredQueryStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: stream,
+	}
+	filter := accessScopeFromContext(stream.Context()).filter(nil)
+	sent, err := s.streamPrivatePairs(metered, owner, filter)
+	s.recordEgress(client, metered.bytes.Load())
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled:
+		logrus.Warnf("Query aborted: %v", err)
+		return err
+	}
+	if err != nil {
+		msg := "query failed: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	s.recordQueryAudit(stream.Context(), "", sent)
+
+	return nil
+}
+
+// streamPrivatePairs streams the private pairs of the owner accepted by the
+// filter in chunks of the configured batch size. The query is aborted once
+// the configured execution timeout elapses or the client goes away. It
+// returns the number of pairs sent.
+func (s *externalCoordinatorServer) streamPrivatePairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	owner string, filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	var keys, values [][]byte
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(PrivatePairsBucketName)).
+			Bucket([]byte(owner))
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			keys = append(keys, bytes.Clone(k))
+			values = append(values, bytes.Clone(v))
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		return 0, s.abortedQueryError(err)
+	}
+
+	// Private data is not part of any dataset, so the empty response does
+	// not describe one.
+	if len(keys) == 0 {
+		return 0, sendEmptyResponse(stream, &ecrpc.DatasetInfo{})
+	}
+
+	return s.sendPairBatches(stream, keys, values)
+}
+
+// prunePrivatePairs removes the private pairs not updated within the history
+// threshold along with the buckets of owners left without any pairs. It
+// returns the number of pairs removed.
+func (s *externalCoordinatorServer) prunePrivatePairs() (int, error) {
+	cutoff := s.clock.Now().Add(
+		-s.config.Server.HistoryThresholdDuration,
+	).Unix()
+
+	removed := 0
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		removed = 0
+		private := tx.Bucket([]byte(PrivatePairsBucketName))
+
+		var emptyOwners [][]byte
+		err := private.ForEachBucket(func(owner []byte) error {
+			b := private.Bucket(owner)
+
+			var stale [][]byte
+			err := b.ForEach(func(k, v []byte) error {
+				history, err := decodePairData(v)
+				if err != nil {
+					return err
+				}
+				updated := mostRecentUnixTimestamp(
+					history.FailTime, history.SuccessTime,
+				)
+				if updated < cutoff {
+					stale = append(stale, bytes.Clone(k))
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, k := range stale {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+			}
+			removed += len(stale)
+
+			if k, _ := b.Cursor().First(); k == nil {
+				emptyOwners = append(
+					emptyOwners, bytes.Clone(owner),
+				)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, owner := range emptyOwners {
+			if err := private.DeleteBucket(owner); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestPrivatePairs tests that private pairs are never part of the public
+// aggregate and are only returned to the tokens of their owner.
+func TestPrivatePairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RequireAccessToken = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+	admin := NewAdminServer(config, db)
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.authorizeUnary),
+		grpc.StreamInterceptor(server.authorizeStream),
+	)
+	ecrpc.RegisterExternalCoordinatorServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := ecrpc.NewExternalCoordinatorClient(conn)
+
+	mint := func(req *ecadminrpc.MintAccessTokenRequest) context.Context {
+		req.ExpirySeconds = 3600
+		resp, err := admin.MintAccessToken(context.Background(), req)
+		require.NoError(t, err)
+
+		return metadata.AppendToOutgoingContext(
+			context.Background(), accessTokenHeader, resp.Token,
+		)
+	}
+	submitter := mint(&ecadminrpc.MintAccessTokenRequest{
+		Tenant: "acme",
+	})
+	colleague := mint(&ecadminrpc.MintAccessTokenRequest{
+		Tenant:   "acme",
+		ReadOnly: true,
+	})
+	stranger := mint(&ecadminrpc.MintAccessTokenRequest{})
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	now := time.Now().Unix()
+	register := func(ctx context.Context, private bool,
+		nodeFrom, nodeTo []byte) {
+		_, err := client.RegisterMissionControl(
+			ctx, &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    now,
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+				Private: private,
+			},
+		)
+		require.NoError(t, err)
+	}
+	recv := func(stream interface {
+		Recv() (*ecrpc.QueryAggregatedMissionControlResponse, error)
+	}) []*ecrpc.PairHistory {
+		var pairs []*ecrpc.PairHistory
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return pairs
+			}
+			require.NoError(t, err)
+			pairs = append(pairs, resp.Pairs...)
+		}
+	}
+	queryPublic := func(ctx context.Context) []*ecrpc.PairHistory {
+		stream, err := client.QueryAggregatedMissionControl(
+			ctx, &ecrpc.QueryAggregatedMissionControlRequest{},
+		)
+		require.NoError(t, err)
+
+		return recv(stream)
+	}
+	queryPrivate := func(ctx context.Context) []*ecrpc.PairHistory {
+		stream, err := client.QueryPrivateMissionControl(
+			ctx, &ecrpc.QueryPrivateMissionControlRequest{},
+		)
+		require.NoError(t, err)
+
+		return recv(stream)
+	}
+
+	register(submitter, true, nodeA, nodeB)
+	register(stranger, false, nodeC, nodeD)
+
+	// Private pairs are not part of the public aggregate.
+	pairs := queryPublic(stranger)
+	require.Len(t, pairs, 1)
+	require.Equal(t, nodeC, pairs[0].NodeFrom)
+
+	// Private pairs are returned to all tokens of the tenant only.
+	for _, ctx := range []context.Context{submitter, colleague} {
+		pairs = queryPrivate(ctx)
+		require.Len(t, pairs, 1)
+		require.Equal(t, nodeA, pairs[0].NodeFrom)
+		require.EqualValues(t, 1000, pairs[0].History.SuccessAmtMsat)
+	}
+	require.Empty(t, queryPrivate(stranger))
+
+	// Private pairs cannot be attributed to anybody without access tokens.
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeB,
+				History: &ecrpc.PairData{
+					SuccessTime:    now,
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			}},
+			Private: true,
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Stale private pairs are pruned along with their empty owners.
+	clock.Advance(2 * time.Hour)
+	removed, err := server.prunePrivatePairs()
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	err = db.View(func(tx *bbolt.Tx) error {
+		private := tx.Bucket([]byte(PrivatePairsBucketName))
+		k, _ := private.Cursor().First()
+		require.Nil(t, k)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// querymcOutput is the JSON output of `lncli querymc`. lncli prints the node
+// pubkeys hex encoded and the integers as strings, but numbers are accepted
+// as well, e.g. if the output was edited by hand.
+type querymcOutput struct {
+	Pairs []querymcPair `json:"pairs"`
+}
+
+// querymcPair is the history of a pair as printed by `lncli querymc`.
+type querymcPair struct {
+	NodeFrom string          `json:"node_from"`
+	NodeTo   string          `json:"node_to"`
+	History  querymcPairData `json:"history"`
+}
+
+// querymcPairData is the history data of a pair as printed by `lncli querymc`.
+type querymcPairData struct {
+	FailTime       json.Number `json:"fail_time"`
+	FailAmtSat     json.Number `json:"fail_amt_sat"`
+	FailAmtMsat    json.Number `json:"fail_amt_msat"`
+	SuccessTime    json.Number `json:"success_time"`
+	SuccessAmtSat  json.Number `json:"success_amt_sat"`
+	SuccessAmtMsat json.Number `json:"success_amt_msat"`
+}
+
+// pairData converts the history data into its RPC representation. Missing
+// fields are zero.
+func (d *querymcPairData) pairData() (*ecrpc.PairData, error) {
+	history := &ecrpc.PairData{}
+	fields := []struct {
+		name  string
+		value json.Number
+		dest  *int64
+	}{
+		{"fail_time", d.FailTime, &history.FailTime},
+		{"fail_amt_sat", d.FailAmtSat, &history.FailAmtSat},
+		{"fail_amt_msat", d.FailAmtMsat, &history.FailAmtMsat},
+		{"success_time", d.SuccessTime, &history.SuccessTime},
+		{"success_amt_sat", d.SuccessAmtSat, &history.SuccessAmtSat},
+		{"success_amt_msat", d.SuccessAmtMsat, &history.SuccessAmtMsat},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+
+		value, err := field.value.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", field.name,
+				err)
+		}
+		*field.dest = value
+	}
+
+	return history, nil
+}
+
+// querymcPairHistories converts the output of `lncli querymc` into the pairs
+// of a registration.
+func querymcPairHistories(output string) ([]*ecrpc.PairHistory, error) {
+	var parsed querymcOutput
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"querymc output: %v", err)
+	}
+	if len(parsed.Pairs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "querymc "+
+			"output does not contain any pairs")
+	}
+
+	pairs := make([]*ecrpc.PairHistory, 0, len(parsed.Pairs))
+	for i, pair := range parsed.Pairs {
+		nodeFrom, err := hex.DecodeString(pair.NodeFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"pair %d: invalid node_from: %v", i, err)
+		}
+		nodeTo, err := hex.DecodeString(pair.NodeTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"pair %d: invalid node_to: %v", i, err)
+		}
+		history, err := pair.History.pairData()
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"pair %d: %v", i, err)
+		}
+
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History:  history,
+		})
+	}
+
+	return pairs, nil
+}
+
+// ImportMissionControl registers the mission control data of an LND node as
+// printed by `lncli querymc`. The output is converted into pairs, which are
+// registered like by RegisterMissionControl.
+func (s *externalCoordinatorServer) ImportMissionControl(ctx context.Context,
+	req *ecrpc.ImportMissionControlRequest) (
+	*ecrpc.RegisterMissionControlResponse, error) {
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request "+
+			"cannot be nil")
+	}
+
+	pairs, err := querymcPairHistories(req.QuerymcOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs:   pairs,
+			Network: req.Network,
+			Private: req.Private,
+		},
+	)
+}
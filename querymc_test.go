@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestImportMissionControl tests that the output of `lncli querymc` is
+// registered like the equivalent RegisterMissionControl request.
+func TestImportMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	ctx := context.Background()
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	now := time.Now().Unix()
+	output := fmt.Sprintf(`{
+    "pairs": [
+        {
+            "node_from": "%x",
+            "node_to": "%x",
+            "history": {
+                "fail_time": "%d",
+                "fail_amt_sat": "5",
+                "fail_amt_msat": "5000",
+                "success_time": "%d",
+                "success_amt_sat": "2",
+                "success_amt_msat": "2000"
+            }
+        }
+    ]
+}`, nodeFrom, nodeTo, now, now-10)
+
+	resp, err := server.ImportMissionControl(
+		ctx, &ecrpc.ImportMissionControlRequest{QuerymcOutput: output},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "Successfully registered 1 pairs", resp.SuccessMessage)
+
+	err = db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket([]byte(DatabaseBucketName)).Get(
+			pairKey(nodeFrom, nodeTo),
+		)
+		require.NotNil(t, v)
+
+		history, err := decodePairData(v)
+		require.NoError(t, err)
+		require.Equal(t, now, history.FailTime)
+		require.EqualValues(t, 5000, history.FailAmtMsat)
+		require.Equal(t, now-10, history.SuccessTime)
+		require.EqualValues(t, 2000, history.SuccessAmtMsat)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Outputs which cannot be converted are rejected.
+	for _, output := range []string{
+		"",
+		`{"pairs": []}`,
+		`{"pairs": [{"node_from": "zz", "node_to": "` +
+			hex.EncodeToString(nodeTo) + `"}]}`,
+		fmt.Sprintf(`{"pairs": [{"node_from": "%x", "node_to": "%x", `+
+			`"history": {"fail_time": "soon"}}]}`, nodeFrom,
+			nodeTo),
+	} {
+		_, err := server.ImportMissionControl(
+			ctx, &ecrpc.ImportMissionControlRequest{
+				QuerymcOutput: output,
+			},
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
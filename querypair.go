@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// decodeHexNode decodes and validates the hex encoded public key of the named
+// field of a request.
+func decodeHexNode(name, node string) ([]byte, error) {
+	pubKey, err := hex.DecodeString(node)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: "+
+			"not hex encoded", name)
+	}
+	if err := pairKeys.validateNode(pubKey); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: "+
+			"%v", name, err)
+	}
+
+	return pubKey, nil
+}
+
+// QueryPair returns the history of the pair between the nodes with the given
+// hex encoded public keys. The pair is filtered, transformed and accounted
+// like the pairs of a query, and is reported as not found if it is not served.
+func (s *externalCoordinatorServer) QueryPair(ctx context.Context,
+	req *ecrpc.QueryPairRequest) (*ecrpc.QueryPairResponse, error) {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return nil, err
+	}
+
+	nodeFrom, err := decodeHexNode("NodeFrom", req.GetNodeFrom())
+	if err != nil {
+		return nil, err
+	}
+	nodeTo, err := decodeHexNode("NodeTo", req.GetNodeTo())
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(nodeFrom, nodeTo) {
+		return nil, status.Error(codes.InvalidArgument, "NodeFrom "+
+			"and NodeTo must differ")
+	}
+
+	// Reject clients which already reached their daily egress cap.
+	client := clientIdentity(ctx)
+	if err := s.checkEgressCap(ctx, client); err != nil {
+		logrus.Infof("Pair query rejected: %v", err)
+		return nil, err
+	}
+
+	// Queries with an access token limited to some nodes only return the
+	// pairs involving them, and only pairs updated within the maximum age
+	// are returned.
+	filter, err := s.freshnessFilter(
+		req.GetMaxAgeSeconds(), accessScopeFromContext(ctx).filter(nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp := &ecrpc.QueryPairResponse{}
+	key := pairKey(nodeFrom, nodeTo)
+	var value []byte
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		resp.Dataset = currentDatasetInfo(tx)
+
+		// The value is only valid for the lifetime of the transaction,
+		// so it is copied.
+		value = bytes.Clone(
+			tx.Bucket([]byte(DatabaseBucketName)).Get(key),
+		)
+		return nil
+	})
+	s.observeOperation(operationQuery, start, 1)
+	if err != nil {
+		msg := "pair query failed: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+	if value == nil || (filter != nil && !filter(nodeFrom, nodeTo)) {
+		return nil, status.Errorf(codes.NotFound, "pair %x -> %x not "+
+			"found", nodeFrom, nodeTo)
+	}
+
+	// The pair is decoded, run through the serve hook of the operator and
+	// watermarked like the pairs of a query.
+	collected := &collectedQueryStream{ctx: ctx}
+	_, err = s.sendPairBatches(
+		s.transforms.transformServed(s.watermarkServed(ctx, collected)),
+		[][]byte{key}, [][]byte{value},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The serve hook of the operator may drop the pair.
+	if len(collected.pairs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "pair %x -> %x not "+
+			"found", nodeFrom, nodeTo)
+	}
+	resp.Pair = collected.pairs[0]
+
+	s.recordEgress(client, int64(proto.Size(resp)))
+
+	return resp, nil
+}
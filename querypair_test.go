@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryPair tests that a single pair is looked up by the hex encoded
+// public keys of its nodes, and that pairs not served are reported as not
+// found.
+func TestQueryPair(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	now := time.Now().Unix()
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeB,
+				History: &ecrpc.PairData{
+					SuccessTime:    now - 600,
+					SuccessAmtSat:  2,
+					SuccessAmtMsat: 2000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(nodeFrom, nodeTo string,
+		maxAge int64) (*ecrpc.QueryPairResponse, error) {
+
+		return server.QueryPair(ctx, &ecrpc.QueryPairRequest{
+			NodeFrom:      nodeFrom,
+			NodeTo:        nodeTo,
+			MaxAgeSeconds: maxAge,
+		})
+	}
+	hexA, hexB := hex.EncodeToString(nodeA), hex.EncodeToString(nodeB)
+
+	resp, err := query(hexA, hexB, 0)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Dataset)
+	require.Equal(t, nodeA, resp.Pair.NodeFrom)
+	require.Equal(t, nodeB, resp.Pair.NodeTo)
+	require.Equal(t, now-600, resp.Pair.History.SuccessTime)
+
+	// The reverse direction was never registered, and the pair is too old
+	// for the requested maximum age.
+	_, err = query(hexB, hexA, 0)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = query(hexA, hexB, 60)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Keys which are not hex encoded public keys are rejected.
+	for _, nodes := range [][2]string{
+		{"zz", hexB},
+		{hexA, hexA[:10]},
+		{hexA, hexA},
+	} {
+		_, err := query(nodes[0], nodes[1], 0)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errQueryAborted is returned by the chunk reader if the query was aborted
+// before all chunks were read.
+var errQueryAborted = errors.New("query aborted")
+
+// queryChunk is a chunk of raw pairs read from the database. It is decoded into
+// a query response by one of the query workers.
+type queryChunk struct {
+	keys   [][]byte
+	values [][]byte
+	result chan queryChunkResult
+}
+
+// queryChunkResult is the decoded query response of a chunk or the error
+// encountered while decoding it.
+type queryChunkResult struct {
+	response *ecrpc.QueryAggregatedMissionControlResponse
+	err      error
+}
+
+// newQueryChunk creates an empty chunk with capacity for the given number of
+// pairs.
+func newQueryChunk(size int) *queryChunk {
+	return &queryChunk{
+		keys:   make([][]byte, 0, size),
+		values: make([][]byte, 0, size),
+		result: make(chan queryChunkResult, 1),
+	}
+}
+
+// decode decodes the raw pairs of the chunk into a query response.
+func (c *queryChunk) decode() queryChunkResult {
+	pairs := make([]*ecrpc.PairHistory, 0, len(c.keys))
+	for i, k := range c.keys {
+		history, err := decodePairData(c.values[i])
+		if err != nil {
+			msg := "failed to decode history data: %v"
+			logrus.Errorf(msg, err)
+			return queryChunkResult{
+				err: status.Errorf(codes.DataLoss, msg, err),
+			}
+		}
+
+		nodeFrom, nodeTo := splitPairKey(k)
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History:  history,
+		})
+	}
+
+	return queryChunkResult{
+		response: &ecrpc.QueryAggregatedMissionControlResponse{
+			Pairs: pairs,
+		},
+	}
+}
+
+// queryBatchSizeKey is the context key of the batch size requested by a query.
+type queryBatchSizeKey struct{}
+
+// batchSizedQueryStream wraps a query stream to carry the batch size requested
+// by the query in its context, so that it reaches every way of sending the
+// pairs.
+type batchSizedQueryStream struct {
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer
+	ctx context.Context
+}
+
+// Context returns the context of the stream carrying the batch size.
+func (b *batchSizedQueryStream) Context() context.Context {
+	return b.ctx
+}
+
+// withQueryBatchSize returns the stream carrying the batch size requested by
+// a query, or the stream itself if the query did not request any. Requested
+// batch sizes are capped by the configured maximum and ignored if there is
+// none.
+func (s *externalCoordinatorServer) withQueryBatchSize(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	requested uint32) (
+	sized ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer) {
+
+	limit := s.config.Server.MaxQueryBatchSize
+	if requested == 0 || limit <= 0 {
+		return stream
+	}
+
+	ctx := context.WithValue(
+		stream.Context(), queryBatchSizeKey{},
+		int(min(int64(requested), int64(limit))),
+	)
+
+	return &batchSizedQueryStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: stream,
+		ctx: ctx,
+	}
+}
+
+// queryBatchSize returns the number of pairs to send per response of the
+// query with the given context, which is the configured batch size unless
+// the query requested another one.
+func (s *externalCoordinatorServer) queryBatchSize(ctx context.Context) int {
+	if size, ok := ctx.Value(queryBatchSizeKey{}).(int); ok {
+		return size
+	}
+
+	return s.config.Server.QueryMissionControlBatchSize
+}
+
+// streamAggregatedPairs streams all pairs accepted by the filter in chunks of
+// the batch size of the query. A reader goroutine pre-fetches the next chunks
+// from the database while a pool of query workers decodes them into
+// responses, hiding the database read latency behind the transmission of the
+// current chunk. Chunks are sent in the order they are stored in. Only the
+// pairs from nodeFrom and to nodeTo are read if either is set. A nil filter
+// accepts all pairs. The query is aborted once the configured execution
+// timeout elapses or the client goes away. It returns the number of pairs
+// sent.
+func (s *externalCoordinatorServer) streamAggregatedPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	nodeFrom, nodeTo []byte,
+	filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	workers := s.config.Server.QueryWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	batchSize := s.queryBatchSize(ctx)
+
+	// The capacity of the ordered channel bounds the number of chunks
+	// pre-fetched ahead of the one currently being sent.
+	work := make(chan *queryChunk)
+	ordered := make(chan *queryChunk, workers)
+	quit := ctx.Done()
+
+	// Start the query workers decoding the chunks.
+	done := make(chan struct{}, workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+
+			for chunk := range work {
+				chunk.result <- chunk.decode()
+			}
+		}()
+	}
+
+	// Start the reader splitting the pairs in the database into chunks.
+	readErr := make(chan error, 1)
+	scanned := 0
+	go func() {
+		err := s.db.View(func(tx *bbolt.Tx) error {
+			var err error
+			cursor := newPairCursor(tx, nodeFrom, nodeTo)
+			scanned, err = readQueryChunks(
+				tx, cursor, batchSize, filter, ordered, work,
+				quit,
+			)
+			return err
+		})
+		close(work)
+		close(ordered)
+		readErr <- err
+	}()
+
+	// wait waits for the reader and the workers to exit and returns the
+	// error of the reader if any. An aborted query is reported as timed out
+	// or canceled depending on the cause.
+	wait := func() error {
+		err := <-readErr
+		for i := 0; i < workers; i++ {
+			<-done
+		}
+		s.observeOperation(operationQuery, start, scanned)
+
+		if !errors.Is(err, errQueryAborted) {
+			return err
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return status.Errorf(codes.DeadlineExceeded, "query "+
+				"exceeded the execution timeout of %v",
+				s.config.Server.OperationTimeout)
+		}
+
+		return status.Error(codes.Canceled, "query canceled")
+	}
+
+	// Send the decoded chunks in order.
+	sent := 0
+	for chunk := range ordered {
+		result := <-chunk.result
+		err := result.err
+		if err == nil {
+			err = stream.Send(result.response)
+			if err != nil {
+				err = status.Errorf(codes.Internal, "failed to "+
+					"send batch: %v", err)
+			}
+		}
+		if err != nil {
+			cancel()
+			wait()
+
+			return sent, err
+		}
+		sent += len(result.response.Pairs)
+
+		// Log the number of pairs retrieved.
+		logrus.Infof("Retrieved %d pairs from the database",
+			len(result.response.Pairs))
+	}
+
+	return sent, wait()
+}
+
+// readQueryChunks reads the pairs of the cursor accepted by the filter and
+// dispatches them in chunks of the given batch size both to the ordered
+// channel and to the query workers. A non-positive batch size results in a
+// single chunk. It returns the number of keys scanned and errQueryAborted if
+// the quit channel is closed.
+func readQueryChunks(tx *bbolt.Tx, c pairCursor, batchSize int,
+	filter func(nodeFrom, nodeTo []byte) bool,
+	ordered, work chan<- *queryChunk, quit <-chan struct{}) (int, error) {
+	dispatch := func(chunk *queryChunk) error {
+		select {
+		case ordered <- chunk:
+		case <-quit:
+			return errQueryAborted
+		}
+
+		select {
+		case work <- chunk:
+		case <-quit:
+			return errQueryAborted
+		}
+
+		return nil
+	}
+
+	capacity := batchSize
+	if capacity <= 0 {
+		capacity = tx.Bucket([]byte(DatabaseBucketName)).Stats().KeyN
+	}
+
+	scanned := 0
+	chunk := newQueryChunk(capacity)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		// Abort runaway scans even if no chunk is dispatched for a
+		// long time because the filter skips most pairs.
+		scanned++
+		select {
+		case <-quit:
+			return scanned, errQueryAborted
+		default:
+		}
+
+		nodeFrom, nodeTo := splitPairKey(k)
+		if filter != nil && !filter(nodeFrom, nodeTo) {
+			continue
+		}
+
+		// The keys and values are only valid for the lifetime of the
+		// transaction, so they are copied.
+		chunk.keys = append(chunk.keys, bytes.Clone(k))
+		chunk.values = append(chunk.values, bytes.Clone(v))
+
+		// If the batch size is reached, dispatch the chunk.
+		if len(chunk.keys) == batchSize {
+			if err := dispatch(chunk); err != nil {
+				return scanned, err
+			}
+			chunk = newQueryChunk(capacity)
+		}
+	}
+
+	// Dispatch any remaining pairs as the final chunk.
+	if len(chunk.keys) > 0 {
+		return scanned, dispatch(chunk)
+	}
+
+	return scanned, nil
+}
+
+// sendPairBatches sends the given raw pairs in chunks of the batch size of the
+// query. It is used by queries which need to read all pairs before sending
+// any of them. It returns the number of pairs sent.
+func (s *externalCoordinatorServer) sendPairBatches(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	keys, values [][]byte) (int, error) {
+	batchSize := s.queryBatchSize(stream.Context())
+	if batchSize <= 0 {
+		batchSize = len(keys)
+	}
+
+	sent := 0
+	for sent < len(keys) {
+		n := min(batchSize, len(keys)-sent)
+		chunk := newQueryChunk(n)
+		chunk.keys = append(chunk.keys, keys[sent:sent+n]...)
+		chunk.values = append(chunk.values, values[sent:sent+n]...)
+
+		result := chunk.decode()
+		if result.err != nil {
+			return sent, result.err
+		}
+		if err := stream.Send(result.response); err != nil {
+			return sent, status.Errorf(codes.Internal, "failed to "+
+				"send batch: %v", err)
+		}
+		sent += n
+
+		logrus.Infof("Retrieved %d pairs from the database", n)
+	}
+
+	return sent, nil
+}
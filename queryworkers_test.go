@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingQueryStream is a query stream failing after a given number of sent
+// responses.
+type failingQueryStream struct {
+	mockQueryAggregatedMissionControlServer
+	failAfter int
+}
+
+func (f *failingQueryStream) Send(
+	resp *ecrpc.QueryAggregatedMissionControlResponse) error {
+	if len(f.Responses) == f.failAfter {
+		return errors.New("connection lost")
+	}
+
+	return f.mockQueryAggregatedMissionControlServer.Send(resp)
+}
+
+// TestStreamAggregatedPairs tests that the query workers stream all pairs in
+// order and that a failing stream aborts the query.
+func TestStreamAggregatedPairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 3
+	config.Server.MaxQueryBatchSize = 8
+	config.Server.QueryWorkers = 4
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	const numPairs = 20
+	var pairs []*ecrpc.PairHistory
+	for i := 0; i < numPairs; i++ {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtMsat: 1000,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	t.Run("AllPairsInOrder", func(t *testing.T) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{}, stream,
+		)
+		require.NoError(t, err)
+		require.Len(t, stream.Responses, 7)
+
+		var keys [][]byte
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				keys = append(keys, append(
+					append([]byte{}, pair.NodeFrom...),
+					pair.NodeTo...,
+				))
+			}
+		}
+		require.Len(t, keys, numPairs)
+		for i := 1; i < len(keys); i++ {
+			require.Negative(t, bytes.Compare(keys[i-1], keys[i]))
+		}
+	})
+
+	// Queries may request another batch size up to the maximum, also if
+	// the pairs are read before any is sent.
+	t.Run("RequestedBatchSize", func(t *testing.T) {
+		for _, test := range []struct {
+			batchSize uint32
+			sortOrder ecrpc.SortOrder
+			responses int
+		}{
+			{5, ecrpc.SortOrder_SORT_ORDER_NODE_PUBKEY, 4},
+			{100, ecrpc.SortOrder_SORT_ORDER_NODE_PUBKEY, 3},
+			{5, ecrpc.SortOrder_SORT_ORDER_FRESHNESS, 4},
+		} {
+			stream := &mockQueryAggregatedMissionControlServer{}
+			err := server.QueryAggregatedMissionControl(
+				&ecrpc.QueryAggregatedMissionControlRequest{
+					BatchSize: test.batchSize,
+					SortOrder: test.sortOrder,
+				}, stream,
+			)
+			require.NoError(t, err)
+			require.Len(t, stream.Responses, test.responses)
+		}
+	})
+
+	t.Run("SendFailureAbortsQuery", func(t *testing.T) {
+		stream := &failingQueryStream{failAfter: 1}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{}, stream,
+		)
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Len(t, stream.Responses, 1)
+	})
+}
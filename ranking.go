@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unreachableDistance is the graph distance of pairs not connected to the
+// source node.
+const unreachableDistance = math.MaxInt
+
+// nodeKey is the identifier of a node as used in the pair keys.
+type nodeKey string
+
+// rankedPair is a raw pair read from the database together with its graph
+// distance from the source node.
+type rankedPair struct {
+	key      []byte
+	value    []byte
+	distance int
+}
+
+// validateSourceNode validates the source node of a ranked query.
+func validateSourceNode(sourceNode []byte) error {
+	if err := pairKeys.validateNode(sourceNode); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid "+
+			"SourceNode: %v", err)
+	}
+
+	return nil
+}
+
+// nodeDistances returns the number of hops from the source node to every node
+// connected to it. The coordinator does not sync the channel graph, so the
+// graph is formed by all known pairs, each of them being an undirected edge
+// between its nodes as channels can be used in both directions. It returns
+// the number of keys scanned.
+func nodeDistances(ctx context.Context, b *bbolt.Bucket,
+	source []byte) (map[nodeKey]int, int, error) {
+	scanned := 0
+	edges := make(map[nodeKey][]nodeKey)
+	c := b.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		scanned++
+		if err := ctx.Err(); err != nil {
+			return nil, scanned, err
+		}
+
+		from, to := splitPairKey(k)
+		nodeFrom, nodeTo := nodeKey(from), nodeKey(to)
+		edges[nodeFrom] = append(edges[nodeFrom], nodeTo)
+		edges[nodeTo] = append(edges[nodeTo], nodeFrom)
+	}
+
+	// Walk the graph breadth first to find the shortest distances.
+	distances := map[nodeKey]int{nodeKey(source): 0}
+	queue := []nodeKey{nodeKey(source)}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range edges[node] {
+			if _, ok := distances[neighbor]; ok {
+				continue
+			}
+			distances[neighbor] = distances[node] + 1
+			queue = append(queue, neighbor)
+		}
+	}
+
+	return distances, scanned, nil
+}
+
+// streamRankedPairs streams all pairs accepted by the filter ordered by their
+// graph distance from the source node in chunks of the configured batch size.
+// Pairs at the same distance keep the order they are stored in. If the
+// maximum distance is positive, pairs further away from the source node are
+// skipped. The query is aborted once the configured execution timeout elapses
+// or the client goes away. It returns the number of pairs sent.
+func (s *externalCoordinatorServer) streamRankedPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	source []byte, maxDistance int,
+	filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	var pairs []rankedPair
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		distances, n, err := nodeDistances(ctx, b, source)
+		scanned += n
+		if err != nil {
+			return err
+		}
+
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			distance, ok := distances[nodeKey(nodeFrom)]
+			if !ok {
+				distance = unreachableDistance
+			}
+			if maxDistance > 0 && distance > maxDistance {
+				continue
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			pairs = append(pairs, rankedPair{
+				key:      bytes.Clone(k),
+				value:    bytes.Clone(v),
+				distance: distance,
+			})
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		return 0, s.abortedQueryError(err)
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].distance < pairs[j].distance
+	})
+
+	keys := make([][]byte, 0, len(pairs))
+	values := make([][]byte, 0, len(pairs))
+	for _, pair := range pairs {
+		keys = append(keys, pair.key)
+		values = append(values, pair.value)
+	}
+
+	return s.sendPairBatches(stream, keys, values)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryRankedByDistance tests that query results are ranked and filtered
+// by their graph distance from the source node.
+func TestQueryRankedByDistance(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 1
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// Build the chain A -> B -> C -> D and the unconnected pair E -> F.
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	nodeE, nodeF := generateTestKeys(t)
+	pair := func(nodeFrom, nodeTo []byte) *ecrpc.PairHistory {
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				pair(nodeA, nodeB), pair(nodeB, nodeC),
+				pair(nodeC, nodeD), pair(nodeE, nodeF),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(source []byte, maxDistance uint32) ([][]byte, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				SourceNode:  source,
+				MaxDistance: maxDistance,
+			}, stream,
+		)
+
+		var nodesFrom [][]byte
+		for _, resp := range stream.Responses {
+			require.Len(t, resp.Pairs, 1)
+			nodesFrom = append(nodesFrom, resp.Pairs[0].NodeFrom)
+		}
+
+		return nodesFrom, err
+	}
+
+	// Pairs are ranked by the distance of their source node, unconnected
+	// pairs come last.
+	nodesFrom, err := query(nodeC, 0)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{nodeC, nodeB, nodeA, nodeE}, nodesFrom)
+
+	// Pairs further away than the maximum distance are skipped.
+	nodesFrom, err = query(nodeC, 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{nodeC, nodeB}, nodesFrom)
+
+	// Invalid source nodes are rejected.
+	_, err = query(nodeC[:10], 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// startupPhaseStarting is the phase reported while the coordinator is
+	// starting up but not running any particular recovery step.
+	startupPhaseStarting = "starting"
+
+	// startupPhaseBootstrap is the phase reported while the database is
+	// seeded with the data of another coordinator. It counts the pairs
+	// stored.
+	startupPhaseBootstrap = "bootstrap"
+
+	// startupPhaseWALReplay is the phase reported while the registrations
+	// left unapplied by a previous run are replayed from the write-ahead
+	// log. It counts the registrations replayed.
+	startupPhaseWALReplay = "wal_replay"
+
+	// healthServicePrefix is the prefix of the methods of the gRPC health
+	// service, which answers while the coordinator is starting up.
+	healthServicePrefix = "/grpc.health.v1.Health/"
+
+	// readinessPath is the path of the REST endpoint answering with an
+	// error while the coordinator is starting up, for orchestrators probing
+	// over HTTP.
+	readinessPath = "/readyz"
+
+	// getInfoMethod is the full name of the RPC reporting the startup
+	// progress, which answers while the coordinator is starting up.
+	getInfoMethod = ecrpc.ExternalCoordinator_GetInfo_FullMethodName
+)
+
+// startupProgress tracks the recovery steps run on startup. While the
+// coordinator is starting up, its health service reports it as not serving
+// and requests other than GetInfo are rejected, so that orchestrators do not
+// route traffic to it before it can answer. Its zero value is ready.
+type startupProgress struct {
+	mu sync.Mutex
+
+	// starting is set from the start of the startup until it finished.
+	starting bool
+
+	// phase is the recovery step currently running.
+	phase string
+
+	// completed and total count the items of the current step. A zero
+	// total means unknown.
+	completed uint64
+	total     uint64
+
+	// health holds the health services reporting the readiness.
+	health []*health.Server
+}
+
+// start marks the coordinator as starting up.
+func (p *startupProgress) start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.starting = true
+	p.phase = startupPhaseStarting
+	p.completed, p.total = 0, 0
+	p.updateHealth()
+}
+
+// begin records the start of a recovery step with the given total number of
+// items, zero if unknown.
+func (p *startupProgress) begin(phase string, total uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.phase = phase
+	p.completed, p.total = 0, total
+}
+
+// advance records the number of items the current step completed.
+func (p *startupProgress) advance(completed uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.completed = completed
+}
+
+// finish marks the coordinator as ready to serve requests.
+func (p *startupProgress) finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.starting = false
+	p.updateHealth()
+}
+
+// watch reports the readiness through the given health service from now on.
+func (p *startupProgress) watch(h *health.Server) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.health = append(p.health, h)
+	p.updateHealth()
+}
+
+// updateHealth sets the serving status of the health services according to
+// the readiness. The caller must hold the mutex.
+func (p *startupProgress) updateHealth() {
+	serving := healthpb.HealthCheckResponse_SERVING
+	if p.starting {
+		serving = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	service := ecrpc.ExternalCoordinator_ServiceDesc.ServiceName
+	for _, h := range p.health {
+		h.SetServingStatus("", serving)
+		h.SetServingStatus(service, serving)
+	}
+}
+
+// info returns the progress of the startup, or nil if the coordinator is
+// ready.
+func (p *startupProgress) info() *ecrpc.StartupProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.starting {
+		return nil
+	}
+
+	return &ecrpc.StartupProgress{
+		Phase:     p.phase,
+		Completed: p.completed,
+		Total:     p.total,
+	}
+}
+
+// checkReady returns an error if requests to the given method cannot be
+// answered yet because the coordinator is starting up.
+func (p *startupProgress) checkReady(method string) error {
+	if method == getInfoMethod ||
+		strings.HasPrefix(method, healthServicePrefix) {
+
+		return nil
+	}
+
+	progress := p.info()
+	if progress == nil {
+		return nil
+	}
+
+	return status.Errorf(codes.Unavailable, "coordinator is starting "+
+		"up (%s)", progress.Phase)
+}
+
+// readyUnary is a unary interceptor rejecting requests while the coordinator
+// is starting up.
+func (s *externalCoordinatorServer) readyUnary(ctx context.Context,
+	req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+	if err := s.startup.checkReady(info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// readyStream is a stream interceptor rejecting requests while the
+// coordinator is starting up.
+func (s *externalCoordinatorServer) readyStream(srv any,
+	ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := s.startup.checkReady(info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// withReadinessEndpoint wraps the REST gateway handler to serve the readiness
+// endpoint, which answers with 503 Service Unavailable while the coordinator
+// is starting up and with 200 OK once it is ready.
+func withReadinessEndpoint(next http.Handler,
+	startup *startupProgress) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != readinessPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if progress := startup.info(); progress != nil {
+			http.Error(w, fmt.Sprintf("starting up (%s)",
+				progress.Phase), http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprintln(w, "ok")
+	})
+}
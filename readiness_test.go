@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// TestStartupReadiness tests that the coordinator reports itself as not
+// serving and only answers GetInfo with its progress while starting up.
+func TestStartupReadiness(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	server.startup.start()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.readyUnary),
+		grpc.StreamInterceptor(server.readyStream),
+	)
+	ecrpc.RegisterExternalCoordinatorServer(grpcServer, server)
+	healthServer := health.NewServer()
+	server.startup.watch(healthServer)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := ecrpc.NewExternalCoordinatorClient(conn)
+	healthClient := healthpb.NewHealthClient(conn)
+	ctx := context.Background()
+
+	checkReadiness := func() int {
+		handler := withReadinessEndpoint(
+			http.NotFoundHandler(), &server.startup,
+		)
+		req := httptest.NewRequest(http.MethodGet, readinessPath, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+	checkHealth := func() healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := healthClient.Check(
+			ctx, &healthpb.HealthCheckRequest{
+				Service: ecrpc.ExternalCoordinator_ServiceDesc.
+					ServiceName,
+			},
+		)
+		require.NoError(t, err)
+
+		return resp.Status
+	}
+
+	// While recovering, only GetInfo is answered and reports the progress.
+	server.startup.begin(startupPhaseWALReplay, 10)
+	server.startup.advance(3)
+	require.Equal(
+		t, healthpb.HealthCheckResponse_NOT_SERVING, checkHealth(),
+	)
+	require.Equal(t, http.StatusServiceUnavailable, checkReadiness())
+
+	info, err := client.GetInfo(ctx, &ecrpc.GetInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, startupPhaseWALReplay, info.Startup.Phase)
+	require.EqualValues(t, 3, info.Startup.Completed)
+	require.EqualValues(t, 10, info.Startup.Total)
+
+	_, err = client.GetStats(ctx, &ecrpc.GetStatsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	stream, err := client.QueryAggregatedMissionControl(
+		ctx, &ecrpc.QueryAggregatedMissionControlRequest{},
+	)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Once started, all requests are answered.
+	server.startup.finish()
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, checkHealth())
+	require.Equal(t, http.StatusOK, checkReadiness())
+
+	info, err = client.GetInfo(ctx, &ecrpc.GetInfoRequest{})
+	require.NoError(t, err)
+	require.Nil(t, info.Startup)
+
+	_, err = client.GetStats(ctx, &ecrpc.GetStatsRequest{})
+	require.NoError(t, err)
+}
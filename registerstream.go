@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	logrus "github.com/sirupsen/logrus"
+	ecerrors "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecerrors"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// registerStreamBatchSize is the number of pairs received on a
+	// registration stream after which they are registered as a batch.
+	registerStreamBatchSize = 10000
+)
+
+// streamRegistration registers the pairs of a stream of requests in batches
+// while they are received, so that uploads of any size are never held in
+// memory at once. Each batch is registered like by RegisterMissionControl,
+// except that batches of only stale pairs are counted as removed instead of
+// failing the upload. The batches registered before an error remain
+// registered.
+type streamRegistration struct {
+	server *externalCoordinatorServer
+	ctx    context.Context
+
+	// first is the first request of the stream, whose network and
+	// private flag all requests must share.
+	first *ecrpc.RegisterMissionControlRequest
+
+	// batch holds the pairs received since the last batch was
+	// registered.
+	batch []*ecrpc.PairHistory
+
+	// result accumulates the results of the registered batches.
+	result *registrationResult
+
+	// offset is the index of the first pair of the batch within the
+	// upload.
+	offset int
+}
+
+// newStreamRegistration creates a registration of the pairs uploaded as a
+// stream with the given context.
+func (s *externalCoordinatorServer) newStreamRegistration(
+	ctx context.Context) *streamRegistration {
+
+	return &streamRegistration{
+		server: s,
+		ctx:    ctx,
+		result: &registrationResult{action: "registered"},
+	}
+}
+
+// add adds the pairs of the next request of the stream and registers them
+// once a batch is complete.
+func (r *streamRegistration) add(
+	req *ecrpc.RegisterMissionControlRequest) error {
+
+	switch {
+	case r.first == nil:
+		r.first = req
+
+	case req.Network != r.first.Network ||
+		req.Private != r.first.Private:
+
+		return status.Error(codes.InvalidArgument, "all requests of a "+
+			"stream must share the same network and private flag")
+	}
+
+	r.batch = append(r.batch, req.Pairs...)
+	if len(r.batch) >= registerStreamBatchSize {
+		return r.flush()
+	}
+
+	return nil
+}
+
+// pairs returns the number of pairs received so far.
+func (r *streamRegistration) pairs() int {
+	return r.offset + len(r.batch)
+}
+
+// flush registers the pairs of the current batch.
+func (r *streamRegistration) flush() error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+	n := len(r.batch)
+
+	batchResult, err := r.server.registerPairs(
+		r.ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs:   r.batch,
+			Network: r.first.Network,
+			Private: r.first.Private,
+		},
+	)
+	var invalid *ecerrors.InvalidPairError
+	switch {
+	case errors.Is(err, ecerrors.ErrStaleData):
+		r.result.staleRemoved += n
+
+	// Invalid pairs are reported by their index within the upload.
+	case errors.As(err, &invalid):
+		return ecerrors.InvalidPair(
+			r.offset+invalid.Index, "%s", invalid.Message,
+		)
+
+	case err != nil:
+		return err
+
+	default:
+		r.result.add(batchResult)
+	}
+	r.offset += n
+	r.batch = nil
+
+	return nil
+}
+
+// RegisterMissionControlStream registers the pairs uploaded as a stream of
+// requests. The pairs are registered in batches while they are received, see
+// streamRegistration.
+func (s *externalCoordinatorServer) RegisterMissionControlStream(
+	stream ecrpc.ExternalCoordinator_RegisterMissionControlStreamServer) error {
+
+	// Track the upload as a single registration for as long as it is
+	// being processed.
+	done := s.registrations.start()
+	defer done()
+
+	registration := s.newStreamRegistration(stream.Context())
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := registration.add(req); err != nil {
+			return err
+		}
+	}
+
+	if registration.pairs() == 0 {
+		return status.Error(codes.InvalidArgument, "stream must "+
+			"include at least one pair")
+	}
+	if err := registration.flush(); err != nil {
+		return err
+	}
+
+	logrus.Infof("Received RegisterMissionControlStream upload with %d "+
+		"pairs", registration.offset)
+
+	return stream.SendAndClose(s.registrationResponse(registration.result))
+}
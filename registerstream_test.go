@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecerrors "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecerrors"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// registerStream is a registration stream of a client uploading the given
+// requests.
+type registerStream struct {
+	grpc.ServerStream
+	reqs []*ecrpc.RegisterMissionControlRequest
+	resp *ecrpc.RegisterMissionControlResponse
+}
+
+func (r *registerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (r *registerStream) Recv() (*ecrpc.RegisterMissionControlRequest,
+	error) {
+
+	if len(r.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := r.reqs[0]
+	r.reqs = r.reqs[1:]
+
+	return req, nil
+}
+
+func (r *registerStream) SendAndClose(
+	resp *ecrpc.RegisterMissionControlResponse) error {
+
+	r.resp = resp
+	return nil
+}
+
+// TestRegisterMissionControlStream tests that uploads streamed in many
+// requests are registered in batches and that their invalid pairs are
+// reported by their index within the upload.
+func TestRegisterMissionControlStream(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// Derive more distinct pairs than fit into a single batch from a few
+	// nodes.
+	var nodes [][]byte
+	for i := 0; i < 55; i++ {
+		nodeA, nodeB := generateTestKeys(t)
+		nodes = append(nodes, nodeA, nodeB)
+	}
+	var pairs []*ecrpc.PairHistory
+	for i, nodeFrom := range nodes {
+		for j, nodeTo := range nodes {
+			if len(pairs) == registerStreamBatchSize+1000 {
+				break
+			}
+			if i == j {
+				continue
+			}
+			pairs = append(pairs, &ecrpc.PairHistory{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			})
+		}
+	}
+	require.Len(t, pairs, registerStreamBatchSize+1000)
+	upload := func(reqs ...*ecrpc.RegisterMissionControlRequest) (
+		*ecrpc.RegisterMissionControlResponse, error) {
+
+		stream := &registerStream{reqs: reqs}
+		err := server.RegisterMissionControlStream(stream)
+
+		return stream.resp, err
+	}
+	stale := &ecrpc.PairHistory{
+		NodeFrom: nodes[0],
+		NodeTo:   nodes[1],
+		History: &ecrpc.PairData{
+			SuccessTime:    time.Now().Add(-2 * time.Hour).Unix(),
+			SuccessAmtSat:  1,
+			SuccessAmtMsat: 1000,
+		},
+	}
+
+	// The pairs of all requests are registered, and a final batch of only
+	// stale pairs is counted as removed.
+	resp, err := upload(
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs[:6000]},
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs[6000:]},
+		&ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{stale},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "Successfully registered 11000 pairs and removed 1 "+
+		"stale pairs", resp.SuccessMessage)
+	require.Len(t, storedPairKeys(t, db), len(pairs))
+
+	// Invalid pairs are reported by their index within the upload, even
+	// if they are in a later batch.
+	invalid := &ecrpc.PairHistory{NodeFrom: nodes[0], NodeTo: nodes[1]}
+	_, err = upload(
+		&ecrpc.RegisterMissionControlRequest{
+			Pairs: pairs[:registerStreamBatchSize],
+		},
+		&ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{pairs[0], invalid},
+		},
+	)
+	var invalidPair *ecerrors.InvalidPairError
+	require.ErrorAs(t, err, &invalidPair)
+	require.Equal(t, registerStreamBatchSize+1, invalidPair.Index)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// All requests must share the same network and private flag.
+	_, err = upload(
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs[:1]},
+		&ecrpc.RegisterMissionControlRequest{
+			Pairs:   pairs[1:2],
+			Private: true,
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Uploads without pairs are rejected.
+	_, err = upload(&ecrpc.RegisterMissionControlRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
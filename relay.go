@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// relayUploads counts the uploads relayed to the upstream coordinator by
+// result.
+var relayUploads = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "relay",
+		Name:      "uploads_total",
+		Help:      "Uploads relayed to the upstream coordinator.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	metricsRegistry.MustRegister(relayUploads)
+}
+
+// submissionRelay periodically relays the pairs registered by the local nodes
+// of a fleet to an upstream coordinator. It tracks the pairs changed since the
+// previous relay and uploads their aggregated data as a single registration
+// stream, so that the upstream coordinator only sees the relay instead of the
+// individual nodes.
+type submissionRelay struct {
+	conn   *grpc.ClientConn
+	client ecrpc.ExternalCoordinatorClient
+	config *ServerConfig
+	db     *bbolt.DB
+
+	mu    sync.Mutex
+	dirty map[string]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSubmissionRelay creates a relay to the upstream coordinator configured in
+// the given server configuration and starts relaying to it.
+func newSubmissionRelay(config *ServerConfig,
+	db *bbolt.DB) (*submissionRelay, error) {
+
+	if config.RelayInterval <= 0 {
+		return nil, fmt.Errorf("relay interval must be positive")
+	}
+	if config.RelayTimeout <= 0 {
+		return nil, fmt.Errorf("relay timeout must be positive")
+	}
+
+	creds := credentials.NewTLS(&tls.Config{})
+	if config.RelayTLSCertFile != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(
+			config.RelayTLSCertFile, "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load relay TLS "+
+				"certificate: %v", err)
+		}
+	}
+
+	conn, err := grpc.NewClient(
+		config.RelayTarget, grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create relay client: %v", err)
+	}
+
+	r := &submissionRelay{
+		conn:   conn,
+		client: ecrpc.NewExternalCoordinatorClient(conn),
+		config: config,
+		db:     db,
+		dirty:  make(map[string]struct{}),
+		quit:   make(chan struct{}),
+	}
+
+	r.wg.Add(1)
+	go r.run()
+
+	return r, nil
+}
+
+// markDirty records that the pairs with the given keys were registered, so
+// that they are part of the next relay.
+func (r *submissionRelay) markDirty(keys ...[]byte) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, key := range keys {
+		r.dirty[string(key)] = struct{}{}
+	}
+}
+
+// run relays the registered pairs on every interval until the relay is
+// stopped. A final relay runs when stopping, so that no registration accepted
+// before a graceful shutdown is lost.
+func (r *submissionRelay) run() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.RelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-r.quit:
+			if err := r.relay(); err != nil {
+				logrus.Errorf("Failed to relay final upload "+
+					"to upstream coordinator: %v", err)
+			}
+			return
+		}
+
+		if err := r.relay(); err != nil {
+			logrus.Errorf("Failed to relay upload to upstream "+
+				"coordinator: %v", err)
+		}
+	}
+}
+
+// relay uploads the aggregated data of the pairs registered since the previous
+// relay to the upstream coordinator. The pairs of a failed relay are retried
+// with the next one.
+func (r *submissionRelay) relay() error {
+	r.mu.Lock()
+	dirty := r.dirty
+	r.dirty = make(map[string]struct{})
+	r.mu.Unlock()
+
+	if len(dirty) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), r.config.RelayTimeout,
+	)
+	defer cancel()
+	if r.config.RelayAccessToken != "" {
+		ctx = metadata.AppendToOutgoingContext(
+			ctx, accessTokenHeader, r.config.RelayAccessToken,
+		)
+	}
+
+	sent, resp, err := r.upload(ctx, dirty)
+	if err != nil {
+		r.mu.Lock()
+		for key := range dirty {
+			r.dirty[key] = struct{}{}
+		}
+		r.mu.Unlock()
+
+		relayUploads.WithLabelValues("failed").Inc()
+		return err
+	}
+	if sent == 0 {
+		return nil
+	}
+
+	relayUploads.WithLabelValues("sent").Inc()
+	logrus.Infof("Relayed %d pairs to upstream coordinator: %s", sent,
+		resp.SuccessMessage)
+
+	return nil
+}
+
+// upload streams the pairs with the given keys in chunks of the registration
+// stream batch size. Each chunk is read in its own transaction. Pairs no longer
+// stored are skipped, since the upstream coordinator removes stale pairs by
+// itself. The stream is only opened once there is a pair to upload. It
+// returns the number of pairs uploaded and the response of the upstream
+// coordinator.
+func (r *submissionRelay) upload(ctx context.Context,
+	dirty map[string]struct{}) (int, *ecrpc.RegisterMissionControlResponse,
+	error) {
+
+	keys := make([][]byte, 0, len(dirty))
+	for key := range dirty {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	var (
+		stream ecrpc.
+			ExternalCoordinator_RegisterMissionControlStreamClient
+		sent int
+	)
+	for start := 0; start < len(keys); start += registerStreamBatchSize {
+		end := min(start+registerStreamBatchSize, len(keys))
+
+		req := &ecrpc.RegisterMissionControlRequest{
+			Network: r.config.Network,
+		}
+		err := r.db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(DatabaseBucketName))
+			for _, key := range keys[start:end] {
+				v := b.Get(key)
+				if v == nil {
+					continue
+				}
+
+				history, err := decodePairData(v)
+				if err != nil {
+					logrus.Warnf("Not relaying pair %x: %v",
+						key, err)
+					continue
+				}
+
+				nodeFrom, nodeTo := splitPairKey(key)
+				pair := &ecrpc.PairHistory{
+					NodeFrom: bytes.Clone(nodeFrom),
+					NodeTo:   bytes.Clone(nodeTo),
+					History:  history,
+				}
+				req.Pairs = append(req.Pairs, pair)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(req.Pairs) == 0 {
+			continue
+		}
+
+		if stream == nil {
+			stream, err = r.client.RegisterMissionControlStream(ctx)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+
+		// A failed send only reports that the stream was closed, the
+		// actual error is returned when receiving the response.
+		err = stream.Send(req)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		sent += len(req.Pairs)
+	}
+	if stream == nil {
+		return 0, nil, nil
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return sent, resp, nil
+}
+
+// stop runs a final relay, stops relaying and closes the connection to the
+// upstream coordinator.
+func (r *submissionRelay) stop() error {
+	close(r.quit)
+	r.wg.Wait()
+
+	return r.conn.Close()
+}
+
+// StartRelaying starts relaying the registered pairs to the configured
+// upstream coordinator.
+func (s *externalCoordinatorServer) StartRelaying() error {
+	if s.config.Server.StandbyMode {
+		return errors.New("a standby coordinator cannot relay " +
+			"registrations")
+	}
+
+	relay, err := newSubmissionRelay(&s.config.Server, s.db)
+	if err != nil {
+		return err
+	}
+	s.relay = relay
+
+	logrus.Infof("Relaying registrations to upstream coordinator %s "+
+		"every %v", s.config.Server.RelayTarget,
+		s.config.Server.RelayInterval)
+
+	return nil
+}
+
+// StopRelaying runs a final relay and stops relaying if it is running.
+func (s *externalCoordinatorServer) StopRelaying() error {
+	if s.relay == nil {
+		return nil
+	}
+
+	return s.relay.stop()
+}
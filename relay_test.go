@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// upstreamCoordinator is an external coordinator recording the requests of
+// the registration streams it receives, together with their access tokens.
+type upstreamCoordinator struct {
+	recordingCoordinator
+	uploads int
+	tokens  []string
+	fail    bool
+}
+
+func (u *upstreamCoordinator) RegisterMissionControlStream(
+	stream ecrpc.ExternalCoordinator_RegisterMissionControlStreamServer) error {
+
+	u.mu.Lock()
+	fail := u.fail
+	u.mu.Unlock()
+	if fail {
+		return status.Error(codes.Unavailable, "upstream unavailable")
+	}
+
+	var reqs []*ecrpc.RegisterMissionControlRequest
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(stream.Context())
+
+	u.mu.Lock()
+	u.uploads++
+	u.requests = append(u.requests, reqs...)
+	u.tokens = append(u.tokens, md.Get(accessTokenHeader)...)
+	u.mu.Unlock()
+
+	return stream.SendAndClose(&ecrpc.RegisterMissionControlResponse{})
+}
+
+// TestSubmissionRelay tests that the pairs registered since the previous relay
+// are relayed as a single upload of their aggregated data, and that the pairs
+// of a failed relay are retried with the next one.
+func TestSubmissionRelay(t *testing.T) {
+	tempDir := t.TempDir()
+	certFile := filepath.Join(tempDir, "tls.cert")
+	keyFile := filepath.Join(tempDir, "tls.key")
+	require.NoError(t, generateSelfSignedTLS(certFile, keyFile))
+
+	// Start the upstream coordinator.
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	port, err := getFreePort()
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	require.NoError(t, err)
+
+	upstream := &upstreamCoordinator{}
+	upstreamServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(
+		&tls.Config{Certificates: []tls.Certificate{cert}},
+	)))
+	ecrpc.RegisterExternalCoordinatorServer(upstreamServer, upstream)
+	go upstreamServer.Serve(lis)
+	defer upstreamServer.Stop()
+
+	config := MockConfig(tempDir)
+	config.Server.RelayTarget = fmt.Sprintf("localhost:%d", port)
+	config.Server.RelayTLSCertFile = certFile
+	config.Server.RelayAccessToken = "token"
+	config.Server.RelayInterval = time.Hour
+	config.Server.RelayTimeout = 10 * time.Second
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartRelaying())
+
+	register := func(pairs ...*ecrpc.PairHistory) {
+		_, err := server.RegisterMissionControl(
+			context.Background(),
+			&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+		)
+		require.NoError(t, err)
+	}
+
+	// Two nodes report the same pair, another node a different one.
+	now := time.Now().Unix()
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	register(&ecrpc.PairHistory{
+		NodeFrom: nodeA,
+		NodeTo:   nodeB,
+		History: &ecrpc.PairData{
+			SuccessTime:    now - 10,
+			SuccessAmtSat:  2,
+			SuccessAmtMsat: 2000,
+		},
+	})
+	register(&ecrpc.PairHistory{
+		NodeFrom: nodeA,
+		NodeTo:   nodeB,
+		History: &ecrpc.PairData{
+			FailTime:    now,
+			FailAmtSat:  5,
+			FailAmtMsat: 5000,
+		},
+	})
+	register(&ecrpc.PairHistory{
+		NodeFrom: nodeC,
+		NodeTo:   nodeD,
+		History: &ecrpc.PairData{
+			SuccessTime:    now,
+			SuccessAmtSat:  1,
+			SuccessAmtMsat: 1000,
+		},
+	})
+
+	// The pairs are relayed in a single upload of their aggregated data.
+	require.NoError(t, server.relay.relay())
+	require.Equal(t, 1, upstream.uploads)
+	require.Equal(t, []string{"token"}, upstream.tokens)
+
+	received := upstream.received()
+	require.Len(t, received, 1)
+	require.Equal(t, config.Server.Network, received[0].Network)
+	require.Len(t, received[0].Pairs, 2)
+	for _, pair := range received[0].Pairs {
+		if string(pair.NodeFrom) != string(nodeA) {
+			continue
+		}
+		require.Equal(t, nodeB, pair.NodeTo)
+		require.Equal(t, now-10, pair.History.SuccessTime)
+		require.Equal(t, now, pair.History.FailTime)
+	}
+
+	// Nothing is relayed without new registrations.
+	require.NoError(t, server.relay.relay())
+	require.Equal(t, 1, upstream.uploads)
+
+	// The pairs of a failed relay are retried with the next one.
+	upstream.mu.Lock()
+	upstream.fail = true
+	upstream.mu.Unlock()
+
+	register(&ecrpc.PairHistory{
+		NodeFrom: nodeB,
+		NodeTo:   nodeC,
+		History: &ecrpc.PairData{
+			SuccessTime:    now,
+			SuccessAmtSat:  1,
+			SuccessAmtMsat: 1000,
+		},
+	})
+	require.Error(t, server.relay.relay())
+
+	upstream.mu.Lock()
+	upstream.fail = false
+	upstream.mu.Unlock()
+
+	require.NoError(t, server.StopRelaying())
+	require.Equal(t, 2, upstream.uploads)
+
+	received = upstream.received()
+	require.Len(t, received, 2)
+	require.Len(t, received[1].Pairs, 1)
+	require.Equal(t, nodeB, received[1].Pairs[0].NodeFrom)
+	require.Equal(t, nodeC, received[1].Pairs[0].NodeTo)
+}
+
+// TestSubmissionRelayConfig tests that relaying is refused to start with a
+// non-positive interval or timeout.
+func TestSubmissionRelayConfig(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.RelayTarget = "localhost:1"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	for _, c := range []struct {
+		interval, timeout time.Duration
+	}{
+		{0, time.Second},
+		{-time.Second, time.Second},
+		{time.Hour, 0},
+		{time.Hour, -time.Second},
+	} {
+		config.Server.RelayInterval = c.interval
+		config.Server.RelayTimeout = c.timeout
+		require.Error(t, server.StartRelaying())
+		require.Nil(t, server.relay)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateMinAmount validates the minimum payment amount of a query.
+func validateMinAmount(minAmtMsat int64) error {
+	if minAmtMsat < 0 {
+		return status.Errorf(codes.InvalidArgument, "MinAmtMsat must "+
+			"not be negative")
+	}
+
+	return nil
+}
+
+// isAmountRelevant returns whether the pair data tells anything about payments
+// of at least the given amount. A success only does if it forwarded at least
+// the amount, since smaller successes are implied by the capacity of the
+// channel anyway. A failure always does, since it either rules such payments
+// out or bounds the amounts they can forward.
+func isAmountRelevant(history *ecrpc.PairData, minAmtMsat int64) bool {
+	if history.SuccessTime != 0 && history.SuccessAmtMsat >= minAmtMsat {
+		return true
+	}
+
+	return history.FailTime != 0
+}
+
+// relevantPairKeys returns the keys of the stored pairs relevant to payments of
+// at least the given amount.
+func relevantPairKeys(tx *bbolt.Tx, minAmtMsat int64) (map[string]struct{},
+	error) {
+
+	keys := make(map[string]struct{})
+	err := tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, v []byte) error {
+			history, err := decodePairData(v)
+			if err != nil {
+				return status.Errorf(codes.DataLoss, "failed "+
+					"to decode history data: %v", err)
+			}
+			if isAmountRelevant(history, minAmtMsat) {
+				keys[string(k)] = struct{}{}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// amountFilter returns a filter accepting the pairs accepted by the given
+// filter which are relevant to payments of at least the minimum amount of a
+// query. A minimum amount of zero accepts all pairs.
+func (s *externalCoordinatorServer) amountFilter(minAmtMsat int64,
+	next func(nodeFrom, nodeTo []byte) bool) (func(nodeFrom,
+	nodeTo []byte) bool, error) {
+
+	if minAmtMsat == 0 {
+		return next, nil
+	}
+
+	var relevant map[string]struct{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		relevant, err = relevantPairKeys(tx, minAmtMsat)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(nodeFrom, nodeTo []byte) bool {
+		if _, ok := relevant[string(pairKey(nodeFrom, nodeTo))]; !ok {
+			return false
+		}
+
+		return next == nil || next(nodeFrom, nodeTo)
+	}, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryMinAmount tests that queries with a minimum amount only return the
+// pairs relevant to payments of at least that amount.
+func TestQueryMinAmount(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	now := time.Now().Unix()
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeB,
+				History: &ecrpc.PairData{
+					SuccessTime:    now,
+					SuccessAmtMsat: 5_000_000,
+				},
+			}, {
+				NodeFrom: nodeA,
+				NodeTo:   nodeC,
+				History: &ecrpc.PairData{
+					SuccessTime:    now,
+					SuccessAmtMsat: 500_000,
+				},
+			}, {
+				NodeFrom: nodeA,
+				NodeTo:   nodeD,
+				History: &ecrpc.PairData{
+					FailTime:    now,
+					FailAmtMsat: 100_000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(minAmtMsat int64) (map[string]bool, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(
+			&ecrpc.QueryAggregatedMissionControlRequest{
+				MinAmtMsat: minAmtMsat,
+			}, stream,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes := make(map[string]bool)
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				nodes[string(pair.NodeTo)] = true
+			}
+		}
+
+		return nodes, nil
+	}
+
+	_, err = query(-1)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	nodes, err := query(0)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	// Small successes tell nothing about large payments, while failures
+	// always do.
+	nodes, err = query(1_000_000)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{
+		string(nodeB): true,
+		string(nodeD): true,
+	}, nodes)
+}
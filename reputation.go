@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nodeReputation aggregates the pairs to the node accepted by the filter whose
+// last success or failure happened at or after the cutoff. Pairs whose most
+// recent result is a failure are counted as failed. It returns the number of
+// keys scanned as well.
+func nodeReputation(ctx context.Context, tx *bbolt.Tx, node []byte,
+	cutoff time.Time, filter func(nodeFrom, nodeTo []byte) bool) (
+	*ecrpc.QueryNodeReputationResponse, int, error) {
+
+	resp := &ecrpc.QueryNodeReputationResponse{}
+	var successes, successAmt int64
+	scanned := 0
+	c := newPairCursor(tx, nil, node)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		scanned++
+		if err := ctx.Err(); err != nil {
+			return nil, scanned, err
+		}
+
+		nodeFrom, nodeTo := splitPairKey(k)
+		if filter != nil && !filter(nodeFrom, nodeTo) {
+			continue
+		}
+
+		history, err := decodePairData(v)
+		if err != nil {
+			return nil, scanned, status.Errorf(codes.DataLoss,
+				"failed to decode history data: %v", err)
+		}
+		updated := mostRecentUnixTimestamp(
+			history.FailTime, history.SuccessTime,
+		)
+		if updated < cutoff.Unix() {
+			continue
+		}
+
+		resp.Pairs++
+		if history.FailTime > history.SuccessTime {
+			resp.FailedPairs++
+		}
+		if history.SuccessTime > 0 {
+			successes++
+			successAmt += history.SuccessAmtMsat
+		}
+		resp.LastSuccessTime = max(
+			resp.LastSuccessTime, history.SuccessTime,
+		)
+		resp.LastFailTime = max(resp.LastFailTime, history.FailTime)
+	}
+
+	if resp.Pairs > 0 {
+		failed := failureRate(resp.FailedPairs, resp.Pairs)
+		resp.SuccessRatio = 1 - failed
+	}
+	if successes > 0 {
+		resp.AvgSuccessAmtMsat = successAmt / successes
+	}
+
+	return resp, scanned, nil
+}
+
+// QueryNodeReputation returns the reliability metrics of a node aggregated over
+// the pairs to the node. Only aggregates are returned, so it is served even if
+// the coordinator withholds the pairs, but only the pairs within the scope of
+// the access token of the request are aggregated.
+func (s *externalCoordinatorServer) QueryNodeReputation(ctx context.Context,
+	req *ecrpc.QueryNodeReputationRequest) (
+	*ecrpc.QueryNodeReputationResponse, error) {
+
+	if err := pairKeys.validateNode(req.GetNode()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"Node: %v", err)
+	}
+
+	maxAge, err := s.queryMaxAge(req.GetMaxAgeSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	opCtx, cancel := s.operationContext(ctx)
+	defer cancel()
+
+	scope := accessScopeFromContext(ctx).filter(nil)
+	cutoff := s.clock.Now().Add(-maxAge)
+	var resp *ecrpc.QueryNodeReputationResponse
+	scanned := 0
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		resp, scanned, err = nodeReputation(
+			opCtx, tx, req.GetNode(), cutoff, scope,
+		)
+
+		return err
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		err = s.abortedQueryError(err)
+		switch status.Code(err) {
+		case codes.DeadlineExceeded, codes.Canceled:
+			return nil, err
+		}
+
+		msg := "failed to compute node reputation: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryNodeReputation tests that the pairs to a node are aggregated into
+// its reliability metrics.
+func TestQueryNodeReputation(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.StatsOnly = true
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	nodeE, _ := generateTestKeys(t)
+	now := time.Now().Unix()
+	older := now - 1800
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeD,
+				History: &ecrpc.PairData{
+					SuccessTime:    now,
+					SuccessAmtSat:  100,
+					SuccessAmtMsat: 100_000,
+				},
+			}, {
+				NodeFrom: nodeB,
+				NodeTo:   nodeD,
+				History: &ecrpc.PairData{
+					SuccessTime:    older,
+					SuccessAmtSat:  300,
+					SuccessAmtMsat: 300_000,
+				},
+			}, {
+				NodeFrom: nodeC,
+				NodeTo:   nodeD,
+				History: &ecrpc.PairData{
+					SuccessTime:    older - 60,
+					SuccessAmtSat:  500,
+					SuccessAmtMsat: 500_000,
+					FailTime:       older,
+					FailAmtSat:     600,
+					FailAmtMsat:    600_000,
+				},
+			}, {
+				NodeFrom: nodeD,
+				NodeTo:   nodeE,
+				History: &ecrpc.PairData{
+					FailTime:    now,
+					FailAmtSat:  1,
+					FailAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	reputation := func(
+		maxAgeSeconds int64) *ecrpc.QueryNodeReputationResponse {
+
+		req := &ecrpc.QueryNodeReputationRequest{
+			Node:          nodeD,
+			MaxAgeSeconds: maxAgeSeconds,
+		}
+		resp, err := server.QueryNodeReputation(ctx, req)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	// Only the pairs to the node are aggregated, even if the coordinator
+	// withholds the pairs themselves.
+	resp := reputation(0)
+	require.EqualValues(t, 3, resp.Pairs)
+	require.EqualValues(t, 1, resp.FailedPairs)
+	require.InDelta(t, 2.0/3, resp.SuccessRatio, 1e-9)
+	require.EqualValues(t, 300_000, resp.AvgSuccessAmtMsat)
+	require.Equal(t, now, resp.LastSuccessTime)
+	require.Equal(t, older, resp.LastFailTime)
+
+	// Pairs older than the maximum age are left out.
+	resp = reputation(600)
+	require.EqualValues(t, 1, resp.Pairs)
+	require.Zero(t, resp.FailedPairs)
+	require.EqualValues(t, 1, resp.SuccessRatio)
+	require.EqualValues(t, 100_000, resp.AvgSuccessAmtMsat)
+	require.Zero(t, resp.LastFailTime)
+
+	// Nodes without pairs have no metrics.
+	resp, err = server.QueryNodeReputation(
+		ctx, &ecrpc.QueryNodeReputationRequest{Node: nodeA},
+	)
+	require.NoError(t, err)
+	require.Zero(t, resp.Pairs)
+	require.Zero(t, resp.SuccessRatio)
+
+	_, err = server.QueryNodeReputation(
+		ctx, &ecrpc.QueryNodeReputationRequest{},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// requestIDHeader is the header metadata key carrying the ID assigned
+	// to each RPC. The REST gateway forwards it as the X-Request-Id header.
+	requestIDHeader = "x-request-id"
+
+	// requestIDSize is the number of random bytes of a request ID.
+	requestIDSize = 8
+)
+
+var (
+	// grpcRequestDuration observes the duration of RPCs. Each observation
+	// carries the ID of the request as exemplar, so slow or failed
+	// requests seen in the metrics can be looked up in the logs.
+	grpcRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of RPCs in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+)
+
+func init() {
+	metricsRegistry.MustRegister(grpcRequestDuration)
+}
+
+// requestIDKey is the context key of the ID assigned to a request.
+type requestIDKey struct{}
+
+// newRequestID generates a random request ID.
+func newRequestID() string {
+	id := make([]byte, requestIDSize)
+	if _, err := rand.Read(id); err != nil {
+		// The ID only serves to correlate logs, so a failure to
+		// generate one must not fail the request.
+		return "unknown"
+	}
+
+	return hex.EncodeToString(id)
+}
+
+// requestIDFromContext returns the ID of the request the context belongs to,
+// or an empty string if it has none.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// observeRequest logs the completed RPC together with its request ID and
+// observes its duration. Failed RPCs are logged at the warning level, so the
+// request ID reported by a user points to the cause of the failure.
+func observeRequest(method, id string, start time.Time, err error) {
+	duration := time.Since(start)
+	code := status.Code(err)
+
+	observer := grpcRequestDuration.WithLabelValues(method, code.String())
+	observer.(prometheus.ExemplarObserver).ObserveWithExemplar(
+		duration.Seconds(), prometheus.Labels{"request_id": id},
+	)
+
+	entry := logrus.WithFields(logrus.Fields{
+		"request_id": id,
+		"method":     method,
+		"code":       code,
+		"duration":   duration,
+	})
+	if err != nil {
+		entry.WithError(err).Warn("RPC failed")
+		return
+	}
+	entry.Debug("RPC completed")
+}
+
+// requestIDUnaryInterceptor assigns an ID to each unary RPC. The ID is stored
+// in the context of the request, returned to the client as header metadata
+// and logged once the RPC completed.
+func requestIDUnaryInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	id := newRequestID()
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+
+	md := metadata.Pairs(requestIDHeader, id)
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		logrus.Warnf("Failed to set request ID header: %v", err)
+	}
+
+	resp, err := handler(ctx, req)
+	observeRequest(info.FullMethod, id, start, err)
+
+	return resp, err
+}
+
+// contextServerStream wraps a server stream to expose a context derived from
+// the one of the stream to the handler, e.g. carrying the request ID.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the derived context of the stream.
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestIDStreamInterceptor assigns an ID to each streaming RPC like
+// requestIDUnaryInterceptor does for unary RPCs.
+func requestIDStreamInterceptor(srv any, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	id := newRequestID()
+	ctx := context.WithValue(ss.Context(), requestIDKey{}, id)
+
+	md := metadata.Pairs(requestIDHeader, id)
+	if err := ss.SetHeader(md); err != nil {
+		logrus.Warnf("Failed to set request ID header: %v", err)
+	}
+
+	err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	observeRequest(info.FullMethod, id, start, err)
+
+	return err
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	logrus "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestRequestID tests that every RPC is assigned an ID that is returned to the
+// client and logged by the coordinator.
+func TestRequestID(t *testing.T) {
+	var logs bytes.Buffer
+	level := logrus.GetLevel()
+	logrus.SetOutput(&logs)
+	logrus.SetLevel(logrus.DebugLevel)
+	defer func() {
+		logrus.SetOutput(io.Discard)
+		logrus.SetLevel(level)
+	}()
+
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(requestIDUnaryInterceptor),
+		grpc.StreamInterceptor(requestIDStreamInterceptor),
+	)
+	ecrpc.RegisterExternalCoordinatorServer(
+		grpcServer, NewExternalCoordinatorServer(config, db),
+	)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := ecrpc.NewExternalCoordinatorClient(conn)
+
+	// Unary RPCs return their ID as header metadata.
+	var header metadata.MD
+	_, err = client.GetInfo(
+		context.Background(), &ecrpc.GetInfoRequest{},
+		grpc.Header(&header),
+	)
+	require.NoError(t, err)
+	unaryIDs := header.Get(requestIDHeader)
+	require.Len(t, unaryIDs, 1)
+
+	// Streaming RPCs do so as well, each RPC with its own ID.
+	stream, err := client.QueryAggregatedMissionControl(
+		context.Background(),
+		&ecrpc.QueryAggregatedMissionControlRequest{},
+	)
+	require.NoError(t, err)
+	header, err = stream.Header()
+	require.NoError(t, err)
+	streamIDs := header.Get(requestIDHeader)
+	require.Len(t, streamIDs, 1)
+	require.NotEqual(t, unaryIDs[0], streamIDs[0])
+	for {
+		if _, err := stream.Recv(); err != nil {
+			require.ErrorIs(t, err, io.EOF)
+			break
+		}
+	}
+
+	// Failed RPCs are logged with their ID.
+	_, err = client.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{},
+		grpc.Header(&header),
+	)
+	require.Error(t, err)
+	failedIDs := header.Get(requestIDHeader)
+	require.Len(t, failedIDs, 1)
+
+	grpcServer.GracefulStop()
+	for _, id := range []string{unaryIDs[0], streamIDs[0], failedIDs[0]} {
+		require.Contains(t, logs.String(), "request_id="+id)
+	}
+	require.Contains(t, logs.String(), "RPC failed")
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// restCacheHeader is the response header indicating how a REST
+	// response was served by the cache.
+	restCacheHeader = "X-Cache"
+
+	// restCacheHit marks a fresh response served from the cache.
+	restCacheHit = "HIT"
+
+	// restCacheStale marks a stale response served from the cache while it
+	// is being regenerated in the background.
+	restCacheStale = "STALE"
+
+	// restCacheMiss marks a response generated for the request.
+	restCacheMiss = "MISS"
+)
+
+// restUncachedPaths are the paths of the REST requests never served from the
+// cache. Long polls wait for the mission control data to change, which a
+// cached response would not, and subscriptions never complete.
+var restUncachedPaths = map[string]bool{
+	"/v1/poll_mission_control":      true,
+	"/v1/subscribe_mission_control": true,
+}
+
+// restCacheEntry is a fully rendered REST response.
+type restCacheEntry struct {
+	status  int
+	header  http.Header
+	body    []byte
+	pattern string
+	created time.Time
+
+	// egressBytes is the size of the messages the response was rendered
+	// from, which is accounted to the clients served the cached response.
+	egressBytes uint64
+}
+
+// restEgressKey is the context key of the counter of the size of the messages
+// forwarded by the gateway while generating a response for the cache.
+type restEgressKey struct{}
+
+// restCountEgress is a forward response option of the gateway adding the size
+// of every forwarded message to the counter of the request, if any.
+func restCountEgress(ctx context.Context, _ http.ResponseWriter,
+	resp proto.Message) error {
+	if counter, ok := ctx.Value(restEgressKey{}).(*uint64); ok {
+		*counter += uint64(proto.Size(resp))
+	}
+
+	return nil
+}
+
+// restCacheFill is the generation of a response in progress. Concurrent
+// requests for the same response wait for the same generation.
+type restCacheFill struct {
+	done  chan struct{}
+	entry *restCacheEntry
+
+	// requestID is the ID assigned to the request generating the
+	// response. It is not part of the cached entry since it only
+	// identifies the request which started the generation.
+	requestID string
+}
+
+// restCache caches the rendered responses of GET requests to the REST gateway.
+// Responses are served from the cache for the configured TTL. Afterwards stale
+// responses are still served for the configured stale TTL while they are
+// regenerated in the background. This protects the database from thundering
+// herds of identical requests, e.g. dashboards refreshing at the same time.
+type restCache struct {
+	next       http.Handler
+	ttl        time.Duration
+	staleTTL   time.Duration
+	maxEntries int
+
+	// talkers accounts the responses served from the cache to the volumes
+	// of the clients, since they never reach the gRPC server. It may be
+	// nil.
+	talkers *talkerTracker
+
+	mu       sync.Mutex
+	entries  map[string]*restCacheEntry
+	inflight map[string]*restCacheFill
+}
+
+// newRESTCache creates a cache in front of the given handler.
+func newRESTCache(next http.Handler, ttl, staleTTL time.Duration,
+	maxEntries int) *restCache {
+	return &restCache{
+		next:       next,
+		ttl:        ttl,
+		staleTTL:   staleTTL,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*restCacheEntry),
+		inflight:   make(map[string]*restCacheFill),
+	}
+}
+
+// withRESTCache wraps the REST gateway handler with a response cache if the
+// cache TTL is positive. Responses served from the cache never reach the
+// interceptors of the gRPC server, so the cache is bypassed while the egress
+// cap is enforced, an external policy authorizes the clients or their queries
+// are audited. Only the volumes of the clients are accounted by the cache
+// itself to the given talker tracker.
+func withRESTCache(next http.Handler, config *ServerConfig,
+	talkers *talkerTracker) http.Handler {
+	if config.RESTCacheTTL <= 0 {
+		return next
+	}
+
+	var reason string
+	switch {
+	case config.ClientDailyEgressCap > 0:
+		reason = "the client daily egress cap is enforced"
+
+	case config.AuthzURL != "":
+		reason = "requests are authorized by an external policy"
+
+	case config.QueryAudit && !config.PrivacyMode:
+		reason = "queries are audited"
+	}
+	if reason != "" {
+		logrus.Warnf("REST response cache disabled since %s", reason)
+		return next
+	}
+
+	cache := newRESTCache(
+		next, config.RESTCacheTTL, config.RESTCacheStaleTTL,
+		config.RESTCacheMaxEntries,
+	)
+	cache.talkers = talkers
+
+	return cache
+}
+
+// ServeHTTP serves the request from the cache if possible.
+func (c *restCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Responses to requests with an access token depend on the token, so
+	// they are never shared through the cache.
+	if r.Method != http.MethodGet || r.Header.Get("Authorization") != "" ||
+		restUncachedPaths[r.URL.Path] {
+
+		c.next.ServeHTTP(w, r)
+		return
+	}
+
+	// Protobuf and JSON responses are cached separately.
+	key := r.URL.RequestURI()
+	if r.Header.Get("Accept") == restProtobufMIME {
+		key += " " + restProtobufMIME
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		age := time.Since(entry.created)
+		switch {
+		case age < c.ttl:
+			c.mu.Unlock()
+			c.account(r, entry)
+			c.serve(w, r, entry, restCacheHit, newRequestID())
+			return
+
+		case age < c.ttl+c.staleTTL:
+			// The regeneration is accounted to the request starting
+			// it by the gRPC server.
+			_, started := c.startFillLocked(key, r)
+			c.mu.Unlock()
+			if !started {
+				c.account(r, entry)
+			}
+			c.serve(w, r, entry, restCacheStale, newRequestID())
+			return
+		}
+	}
+	fill, started := c.startFillLocked(key, r)
+	c.mu.Unlock()
+
+	select {
+	case <-fill.done:
+		// Only the request which started the generation is reported
+		// the ID of the RPC generating the response, requests waiting
+		// for it are assigned their own.
+		id := fill.requestID
+		if !started || id == "" {
+			id = newRequestID()
+		}
+		if !started {
+			c.account(r, fill.entry)
+		}
+		c.serve(w, r, fill.entry, restCacheMiss, id)
+
+	case <-r.Context().Done():
+	}
+}
+
+// startFillLocked starts generating the response for the given key unless it
+// is already being generated and returns the generation together with whether
+// it was started by this call. The mutex must be held.
+func (c *restCache) startFillLocked(key string,
+	r *http.Request) (*restCacheFill, bool) {
+	if fill, ok := c.inflight[key]; ok {
+		return fill, false
+	}
+
+	fill := &restCacheFill{done: make(chan struct{})}
+	c.inflight[key] = fill
+
+	// The generation outlives the request which started it, since other
+	// requests may be waiting for it.
+	ctx := context.WithoutCancel(r.Context())
+	go c.fill(key, r.WithContext(ctx), fill)
+
+	return fill, true
+}
+
+// fill generates the response for the given key and stores it if successful.
+// Failed responses are handed to the waiting requests but never cached, so
+// a stale response keeps being served until it expires.
+func (c *restCache) fill(key string, r *http.Request, fill *restCacheFill) {
+	route := &restRoute{pattern: unmatchedRESTRoute}
+	ctx := context.WithValue(r.Context(), restRouteKey{}, route)
+	var egressBytes uint64
+	ctx = context.WithValue(ctx, restEgressKey{}, &egressBytes)
+	recorder := &restCacheRecorder{header: make(http.Header)}
+	c.next.ServeHTTP(recorder, r.WithContext(ctx))
+
+	if recorder.status == 0 {
+		recorder.status = http.StatusOK
+	}
+
+	// The request ID identifies a single request, so it is never replayed
+	// to the requests served from the cache.
+	fill.requestID = recorder.header.Get(requestIDHeader)
+	recorder.header.Del(requestIDHeader)
+
+	fill.entry = &restCacheEntry{
+		status:      recorder.status,
+		header:      recorder.header,
+		body:        recorder.body.Bytes(),
+		pattern:     route.pattern,
+		created:     time.Now(),
+		egressBytes: egressBytes,
+	}
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	if fill.entry.status == http.StatusOK {
+		c.storeLocked(key, fill.entry)
+	}
+	c.mu.Unlock()
+
+	close(fill.done)
+}
+
+// storeLocked stores the entry, evicting expired entries and, if the cache is
+// still full, the oldest one. The mutex must be held.
+func (c *restCache) storeLocked(key string, entry *restCacheEntry) {
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		var oldestKey string
+		var oldest *restCacheEntry
+		for k, e := range c.entries {
+			if time.Since(e.created) >= c.ttl+c.staleTTL {
+				delete(c.entries, k)
+				continue
+			}
+			if oldest == nil || e.created.Before(oldest.created) {
+				oldestKey, oldest = k, e
+			}
+		}
+		if len(c.entries) >= c.maxEntries && oldest != nil {
+			delete(c.entries, oldestKey)
+		}
+	}
+
+	// A cache without room for any entry stays empty.
+	if c.maxEntries > 0 {
+		c.entries[key] = entry
+	}
+}
+
+// account accounts the size of the messages of the cached response to the
+// volume of the client of the request. Like the gateway, the client is
+// identified by the remote address of the request.
+func (c *restCache) account(r *http.Request, entry *restCacheEntry) {
+	if c.talkers == nil || entry.egressBytes == 0 {
+		return
+	}
+
+	client, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		client = r.RemoteAddr
+	}
+	c.talkers.add(
+		client, time.Now(), talkerVolume{egressBytes: entry.egressBytes},
+	)
+}
+
+// serve writes the cached response with the given request ID. The route
+// pattern of the response is recorded for the observability middleware, since
+// the gateway is not invoked for cached responses.
+func (c *restCache) serve(w http.ResponseWriter, r *http.Request,
+	entry *restCacheEntry, result, requestID string) {
+	if route, ok := r.Context().Value(restRouteKey{}).(*restRoute); ok {
+		route.pattern = entry.pattern
+	}
+
+	header := w.Header()
+	for k, v := range entry.header {
+		header[k] = append([]string(nil), v...)
+	}
+	header.Set(restCacheHeader, result)
+	header.Set(requestIDHeader, requestID)
+	if result != restCacheMiss {
+		age := time.Since(entry.created) / time.Second
+		header.Set("Age", strconv.FormatInt(int64(age), 10))
+	}
+
+	w.WriteHeader(entry.status)
+	w.Write(entry.body)
+}
+
+// restCacheRecorder is an http.ResponseWriter buffering the response to be
+// cached.
+type restCacheRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+// Header returns the header of the buffered response.
+func (r *restCacheRecorder) Header() http.Header {
+	return r.header
+}
+
+// WriteHeader records the status code.
+func (r *restCacheRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+// Write buffers the response body.
+func (r *restCacheRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+
+	return r.body.Write(b)
+}
+
+// Flush is a no-op since the response is only written once it is complete. It
+// is required for streamed responses of the gateway.
+func (r *restCacheRecorder) Flush() {}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// countingHandler responds with the number of requests it handled so far.
+type countingHandler struct {
+	calls   atomic.Int64
+	status  int
+	release chan struct{}
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := h.calls.Add(1)
+	if h.release != nil {
+		<-h.release
+	}
+
+	status := h.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"call": %d}`, n)
+}
+
+// get issues a request to the handler and returns the response.
+func get(handler http.Handler, method, target string) *http.Response {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+
+	return recorder.Result()
+}
+
+// TestRESTCache tests that responses are served from the cache while fresh
+// and served stale while being regenerated in the background.
+func TestRESTCache(t *testing.T) {
+	next := &countingHandler{}
+	cache := newRESTCache(next, 50*time.Millisecond, time.Hour, 10)
+
+	resp := get(cache, http.MethodGet, "/v1/info")
+	require.Equal(t, restCacheMiss, resp.Header.Get(restCacheHeader))
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	resp = get(cache, http.MethodGet, "/v1/info")
+	require.Equal(t, restCacheHit, resp.Header.Get(restCacheHeader))
+	require.EqualValues(t, 1, next.calls.Load())
+
+	// Other queries are cached separately.
+	resp = get(cache, http.MethodGet, "/v1/info?x=1")
+	require.Equal(t, restCacheMiss, resp.Header.Get(restCacheHeader))
+	require.EqualValues(t, 2, next.calls.Load())
+
+	// Once expired, the stale response is served and regenerated.
+	time.Sleep(60 * time.Millisecond)
+	resp = get(cache, http.MethodGet, "/v1/info")
+	require.Equal(t, restCacheStale, resp.Header.Get(restCacheHeader))
+	require.Eventually(t, func() bool {
+		resp := get(cache, http.MethodGet, "/v1/info")
+		return resp.Header.Get(restCacheHeader) == restCacheHit
+	}, time.Second, 5*time.Millisecond)
+	require.EqualValues(t, 3, next.calls.Load())
+
+	// Requests other than GET are never cached.
+	get(cache, http.MethodPost, "/v1/info")
+	get(cache, http.MethodPost, "/v1/info")
+	require.EqualValues(t, 5, next.calls.Load())
+}
+
+// TestRESTCacheRequestIDs tests that the request ID of the response generated
+// for the cache is never replayed, but each served response carries its own ID
+// which is also the one logged by the observability middleware.
+func TestRESTCacheRequestIDs(t *testing.T) {
+	var logs bytes.Buffer
+	logrus.SetOutput(&logs)
+	defer logrus.SetOutput(io.Discard)
+
+	var calls atomic.Int64
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := fmt.Sprintf("fill-%d", calls.Add(1))
+		w.Header().Set(requestIDHeader, id)
+		fmt.Fprint(w, `{}`)
+	})
+	handler := withRESTObservability(
+		newRESTCache(next, time.Hour, time.Hour, 10), true, false,
+	)
+
+	// The request generating the response is reported the ID of the
+	// request to the gateway.
+	resp := get(handler, http.MethodGet, "/v1/info")
+	require.Equal(t, restCacheMiss, resp.Header.Get(restCacheHeader))
+	require.Equal(t, "fill-1", resp.Header.Get(requestIDHeader))
+	require.Contains(t, logs.String(), "request_id=fill-1")
+
+	// Responses served from the cache are assigned their own IDs.
+	seen := map[string]bool{"fill-1": true}
+	for i := 0; i < 3; i++ {
+		logs.Reset()
+		resp := get(handler, http.MethodGet, "/v1/info")
+		require.Equal(t, restCacheHit, resp.Header.Get(restCacheHeader))
+
+		id := resp.Header.Get(requestIDHeader)
+		require.NotEmpty(t, id)
+		require.False(t, seen[id])
+		seen[id] = true
+
+		require.Contains(t, logs.String(), "request_id="+id)
+	}
+	require.EqualValues(t, 1, calls.Load())
+}
+
+// TestRESTCacheCoalescesMisses tests that concurrent requests for the same
+// uncached response are served by a single generation.
+func TestRESTCacheCoalescesMisses(t *testing.T) {
+	next := &countingHandler{release: make(chan struct{})}
+	cache := newRESTCache(next, time.Hour, time.Hour, 10)
+
+	var wg sync.WaitGroup
+	statuses := make([]int, 10)
+	for i := range statuses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp := get(cache, http.MethodGet, "/v1/info")
+			statuses[i] = resp.StatusCode
+		}(i)
+	}
+
+	require.Eventually(t, func() bool {
+		return next.calls.Load() == 1
+	}, time.Second, 5*time.Millisecond)
+	close(next.release)
+	wg.Wait()
+
+	require.EqualValues(t, 1, next.calls.Load())
+	for _, status := range statuses {
+		require.Equal(t, http.StatusOK, status)
+	}
+}
+
+// TestRESTCacheErrorsNotCached tests that failed responses are not cached.
+func TestRESTCacheErrorsNotCached(t *testing.T) {
+	next := &countingHandler{status: http.StatusInternalServerError}
+	cache := newRESTCache(next, time.Hour, time.Hour, 10)
+
+	for i := 0; i < 2; i++ {
+		resp := get(cache, http.MethodGet, "/v1/info")
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+	require.EqualValues(t, 2, next.calls.Load())
+}
+
+// TestRESTCacheEviction tests that the oldest response is evicted when the
+// cache is full.
+func TestRESTCacheEviction(t *testing.T) {
+	next := &countingHandler{}
+	cache := newRESTCache(next, time.Hour, time.Hour, 2)
+
+	get(cache, http.MethodGet, "/a")
+	get(cache, http.MethodGet, "/b")
+	get(cache, http.MethodGet, "/c")
+	require.Len(t, cache.entries, 2)
+	require.NotContains(t, cache.entries, "/a")
+
+	resp := get(cache, http.MethodGet, "/c")
+	require.Equal(t, restCacheHit, resp.Header.Get(restCacheHeader))
+}
+
+// TestWithRESTCache tests that the cache is only used if enabled.
+func TestWithRESTCache(t *testing.T) {
+	next := &countingHandler{}
+
+	handler := withRESTCache(next, &ServerConfig{}, nil)
+	require.Equal(t, next, handler)
+
+	handler = withRESTCache(next, &ServerConfig{
+		RESTCacheTTL:        time.Second,
+		RESTCacheMaxEntries: 1,
+	}, nil)
+	require.IsType(t, &restCache{}, handler)
+}
+
+// TestRESTCacheBypassedWithEgressCap tests that every request reaches the
+// gateway while the egress cap is enforced, so that no client is served
+// without being accounted.
+func TestRESTCacheBypassedWithEgressCap(t *testing.T) {
+	next := &countingHandler{}
+	handler := withRESTCache(next, &ServerConfig{
+		RESTCacheTTL:         time.Hour,
+		RESTCacheMaxEntries:  10,
+		ClientDailyEgressCap: 1024,
+	}, nil)
+
+	for i := 0; i < 3; i++ {
+		resp := get(handler, http.MethodGet, "/v1/info")
+		require.Empty(t, resp.Header.Get(restCacheHeader))
+	}
+	require.EqualValues(t, 3, next.calls.Load())
+}
+
+// policyHandler emulates the gateway in front of a policy rejecting the
+// requests of the denied client.
+type policyHandler struct {
+	denied string
+}
+
+func (h *policyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.RemoteAddr, h.denied+":") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	restCountEgress(r.Context(), w, &ecrpc.GetInfoResponse{
+		Network: "regtest",
+	})
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, `{"network": "regtest"}`)
+}
+
+// getFrom issues a GET request from the given client to the handler and
+// returns the response.
+func getFrom(handler http.Handler, client, target string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.RemoteAddr = client + ":1234"
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	return recorder.Result()
+}
+
+// TestRESTCacheBypassedWithAuthz tests that a client denied by the external
+// policy is rejected even if the path was cached for an allowed client, and
+// that the cache is also bypassed while queries are audited.
+func TestRESTCacheBypassedWithAuthz(t *testing.T) {
+	next := &policyHandler{denied: "203.0.113.2"}
+	handler := withRESTCache(next, &ServerConfig{
+		RESTCacheTTL:        time.Hour,
+		RESTCacheMaxEntries: 10,
+		AuthzURL:            "http://localhost:8181/v1/data/ec/allow",
+	}, nil)
+
+	resp := getFrom(handler, "203.0.113.1", "/v1/info")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = getFrom(handler, "203.0.113.2", "/v1/info")
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	handler = withRESTCache(next, &ServerConfig{
+		RESTCacheTTL:        time.Hour,
+		RESTCacheMaxEntries: 10,
+		QueryAudit:          true,
+	}, nil)
+	require.Equal(t, next, handler)
+}
+
+// TestRESTCacheAccountsTalkers tests that the responses served from the cache
+// are accounted to the volumes of the clients served, while a response
+// generated for a client is left to the gRPC server to account.
+func TestRESTCacheAccountsTalkers(t *testing.T) {
+	talkers := newTalkerTracker()
+	handler := withRESTCache(&policyHandler{}, &ServerConfig{
+		RESTCacheTTL:        time.Hour,
+		RESTCacheMaxEntries: 10,
+	}, talkers)
+
+	resp := getFrom(handler, "203.0.113.1", "/v1/info")
+	require.Equal(t, restCacheMiss, resp.Header.Get(restCacheHeader))
+	resp = getFrom(handler, "203.0.113.2", "/v1/info")
+	require.Equal(t, restCacheHit, resp.Header.Get(restCacheHeader))
+
+	size := proto.Size(&ecrpc.GetInfoResponse{Network: "regtest"})
+	top := talkers.top(
+		time.Now(), 10, ecadminrpc.TalkerOrder_TALKER_ORDER_EGRESS,
+	)
+	require.Len(t, top, 1)
+	require.Equal(t, "203.0.113.2", top[0].Client)
+	require.EqualValues(t, size, top[0].EgressBytes)
+}
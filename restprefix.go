@@ -0,0 +1,62 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+// forwardedPrefixHeader is the header set by reverse proxies to the path
+// prefix under which they expose the REST API.
+const forwardedPrefixHeader = "X-Forwarded-Prefix"
+
+// normalizeBasePath returns the base path with a leading and without a
+// trailing slash, or an empty string if the API is served at the root.
+func normalizeBasePath(basePath string) string {
+	basePath = strings.Trim(strings.TrimSpace(basePath), "/")
+	if basePath == "" {
+		return ""
+	}
+
+	return "/" + basePath
+}
+
+// hasPathPrefix returns true if the path lies under the given prefix, which
+// must be a normalized base path.
+func hasPathPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// withRESTPathPrefix wraps the REST gateway handler to serve the API under the
+// given base path, e.g. /mission-control/v1/info instead of /v1/info.
+// Requests outside of the base path are rejected.
+//
+// Reverse proxies announcing the prefix they expose the API under through the
+// X-Forwarded-Prefix header take precedence over the base path. Proxies
+// stripping their prefix before forwarding are served as is, while the prefix
+// is removed for proxies forwarding the full path.
+func withRESTPathPrefix(next http.Handler, basePath string) http.Handler {
+	basePath = normalizeBasePath(basePath)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefix := basePath
+		forwarded := normalizeBasePath(r.Header.Get(forwardedPrefixHeader))
+		if forwarded != "" {
+			if !hasPathPrefix(r.URL.Path, forwarded) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			prefix = forwarded
+		}
+
+		if prefix == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !hasPathPrefix(r.URL.Path, prefix) {
+			http.NotFound(w, r)
+			return
+		}
+
+		http.StripPrefix(prefix, next).ServeHTTP(w, r)
+	})
+}
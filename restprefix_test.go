@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNormalizeBasePath tests the normalization of REST base paths.
+func TestNormalizeBasePath(t *testing.T) {
+	tests := []struct {
+		basePath string
+		expected string
+	}{
+		{"", ""},
+		{"/", ""},
+		{"mission-control", "/mission-control"},
+		{"/mission-control/", "/mission-control"},
+		{" /a/b ", "/a/b"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, normalizeBasePath(tc.basePath))
+	}
+}
+
+// TestRESTPathPrefix tests that the REST API is served under the configured
+// base path and the prefix forwarded by reverse proxies.
+func TestRESTPathPrefix(t *testing.T) {
+	var served string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = r.URL.Path
+	})
+
+	tests := []struct {
+		name      string
+		basePath  string
+		path      string
+		forwarded string
+		status    int
+		served    string
+	}{
+		{"NoBasePath", "", "/v1/info", "", 200, "/v1/info"},
+		{"BasePath", "/mc", "/mc/v1/info", "", 200, "/v1/info"},
+		{"OutsideBasePath", "/mc", "/v1/info", "", 404, ""},
+		{"SimilarPrefix", "/mc", "/mcx/v1/info", "", 404, ""},
+		{"ForwardedFullPath", "", "/mc/v1/info", "/mc", 200,
+			"/v1/info"},
+		{"ForwardedStripped", "/mc", "/v1/info", "/mc", 200,
+			"/v1/info"},
+		{"ForwardedOverridesBasePath", "/mc", "/proxy/v1/info",
+			"/proxy/", 200, "/v1/info"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			served = ""
+			handler := withRESTPathPrefix(next, tc.basePath)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.forwarded != "" {
+				req.Header.Set(forwardedPrefixHeader, tc.forwarded)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.status, recorder.Code)
+			require.Equal(t, tc.served, served)
+		})
+	}
+}
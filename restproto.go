@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// restProtobufMIME is the media type of the protobuf encoded requests
+	// and responses of the REST server.
+	restProtobufMIME = "application/x-protobuf"
+
+	// restFrameResult is the kind of a frame of a streamed protobuf
+	// response holding a response message.
+	restFrameResult byte = 0
+
+	// restFrameError is the kind of a frame of a streamed protobuf
+	// response holding the google.rpc.Status ending the stream with an
+	// error.
+	restFrameError byte = 1
+
+	// restFrameHeaderSize is the size of the header of a frame, one byte
+	// for its kind and four bytes for the length of its message.
+	restFrameHeaderSize = 5
+)
+
+// restProtoMarshaler encodes the requests and responses of the REST server as
+// binary protobuf for clients asking for it by their Accept header. Unary
+// responses are the plain messages. Protobuf messages do not delimit
+// themselves, so the messages of streamed responses are framed instead: each
+// frame consists of its kind, the big-endian uint32 length of its message and
+// the message. The units and timestamps configured for the JSON responses do
+// not apply.
+type restProtoMarshaler struct{}
+
+// ContentType returns the protobuf media type.
+func (*restProtoMarshaler) ContentType(any) string {
+	return restProtobufMIME
+}
+
+// Marshal encodes a message, or frames the response or error chunk of a
+// stream.
+func (*restProtoMarshaler) Marshal(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case proto.Message:
+		return proto.Marshal(v)
+
+	// The gateway wraps the responses of streams as results.
+	case map[string]any:
+		if msg, ok := v["result"].(proto.Message); ok {
+			return marshalRESTFrame(restFrameResult, msg)
+		}
+
+	// The gateway wraps the status ending a stream as an error.
+	case map[string]proto.Message:
+		if msg, ok := v["error"]; ok {
+			return marshalRESTFrame(restFrameError, msg)
+		}
+	}
+
+	return nil, fmt.Errorf("unable to marshal %T as protobuf", v)
+}
+
+// marshalRESTFrame encodes a message as a frame of the given kind.
+func marshalRESTFrame(kind byte, msg proto.Message) ([]byte, error) {
+	frame := make([]byte, restFrameHeaderSize, restFrameHeaderSize+
+		proto.Size(msg))
+	frame[0] = kind
+	frame, err := proto.MarshalOptions{}.MarshalAppend(frame, msg)
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint32(
+		frame[1:restFrameHeaderSize],
+		uint32(len(frame)-restFrameHeaderSize),
+	)
+
+	return frame, nil
+}
+
+// Unmarshal decodes a protobuf message.
+func (*restProtoMarshaler) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unable to unmarshal protobuf into %T", v)
+	}
+
+	return proto.Unmarshal(data, msg)
+}
+
+// NewDecoder returns a decoder reading a single message from the reader.
+func (m *restProtoMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v any) error {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		return m.Unmarshal(data, v)
+	})
+}
+
+// NewEncoder returns an encoder writing messages to the writer.
+func (m *restProtoMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v any) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+
+		return err
+	})
+}
+
+// Delimiter returns no delimiter, since the streamed messages are framed.
+func (*restProtoMarshaler) Delimiter() []byte {
+	return []byte{}
+}
+
+// acceptsRESTProtobuf returns whether the Accept header of the request lists
+// the protobuf media type without ruling it out by a quality of zero.
+func acceptsRESTProtobuf(r *http.Request) bool {
+	for _, value := range r.Header.Values("Accept") {
+		for _, accepted := range strings.Split(value, ",") {
+			mediaType, params, err := mime.ParseMediaType(accepted)
+			if err != nil || mediaType != restProtobufMIME {
+				continue
+			}
+
+			q, err := strconv.ParseFloat(params["q"], 64)
+			return err != nil || q > 0
+		}
+	}
+
+	return false
+}
+
+// withRESTContentNegotiation serves the requests accepting protobuf with
+// protobuf encoded responses. The gateway only picks the marshaler of a media
+// type if it makes up an entire Accept header, so the header is reduced to
+// the protobuf media type. Responses vary by the Accept header, so caches
+// are told to key them by it.
+func withRESTContentNegotiation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+		if acceptsRESTProtobuf(r) {
+			r = r.Clone(r.Context())
+			r.Header.Set("Accept", restProtobufMIME)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// readRESTFrame reads a frame of a streamed protobuf response and returns its
+// kind and message.
+func readRESTFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	var header [restFrameHeaderSize]byte
+	_, err := io.ReadFull(r, header[:])
+	require.NoError(t, err)
+
+	msg := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	_, err = io.ReadFull(r, msg)
+	require.NoError(t, err)
+
+	return header[0], msg
+}
+
+// TestRESTProtoMarshaler tests that messages are encoded as plain protobuf and
+// the chunks of streams as frames.
+func TestRESTProtoMarshaler(t *testing.T) {
+	marshaler := &restProtoMarshaler{}
+	resp := &ecrpc.QueryAggregatedMissionControlResponse{
+		Dataset: &ecrpc.DatasetInfo{Revision: 7},
+	}
+
+	// Unary responses are plain messages.
+	data, err := marshaler.Marshal(resp)
+	require.NoError(t, err)
+	var decoded ecrpc.QueryAggregatedMissionControlResponse
+	require.NoError(t, marshaler.Unmarshal(data, &decoded))
+	require.True(t, proto.Equal(resp, &decoded))
+
+	// The chunks of a stream are framed by their kind and length.
+	var stream bytes.Buffer
+	encoder := marshaler.NewEncoder(&stream)
+	require.NoError(t, encoder.Encode(map[string]any{"result": resp}))
+	require.NoError(t, encoder.Encode(map[string]proto.Message{
+		"error": status.New(codes.Unavailable, "shutting down").Proto(),
+	}))
+
+	kind, msg := readRESTFrame(t, &stream)
+	require.Equal(t, restFrameResult, kind)
+	require.NoError(t, proto.Unmarshal(msg, &decoded))
+	require.True(t, proto.Equal(resp, &decoded))
+
+	kind, msg = readRESTFrame(t, &stream)
+	require.Equal(t, restFrameError, kind)
+	st := status.New(codes.OK, "").Proto()
+	require.NoError(t, proto.Unmarshal(msg, st))
+	require.EqualValues(t, codes.Unavailable, st.Code)
+	require.Zero(t, stream.Len())
+
+	_, err = marshaler.Marshal(map[string]any{"result": 1})
+	require.Error(t, err)
+	require.Error(t, marshaler.Unmarshal(data, &struct{}{}))
+}
+
+// TestRESTContentNegotiation tests that requests listing protobuf among the
+// accepted media types are served protobuf.
+func TestRESTContentNegotiation(t *testing.T) {
+	var accept string
+	handler := withRESTContentNegotiation(http.HandlerFunc(
+		func(_ http.ResponseWriter, r *http.Request) {
+			accept = r.Header.Get("Accept")
+		},
+	))
+
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", ""},
+		{"application/json", "application/json"},
+		{restProtobufMIME, restProtobufMIME},
+		{"application/json;q=0.5, application/x-protobuf",
+			restProtobufMIME},
+		{"application/x-protobuf;q=0, application/json",
+			"application/x-protobuf;q=0, application/json"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/v1/info", nil)
+		if test.accept != "" {
+			r.Header.Set("Accept", test.accept)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, r)
+
+		require.Equal(t, test.want, accept, test.accept)
+		require.Equal(t, "Accept", recorder.Header().Get("Vary"))
+	}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// restAmountsBoth returns amounts both in sats and in millisats.
+	restAmountsBoth = "both"
+
+	// restAmountsSat only returns amounts in sats.
+	restAmountsSat = "sat"
+
+	// restAmountsMsat only returns amounts in millisats.
+	restAmountsMsat = "msat"
+
+	// restRFC3339Suffix is the suffix of the fields holding the RFC 3339
+	// representation of a timestamp next to its unix seconds.
+	restRFC3339Suffix = "Rfc3339"
+)
+
+// restTimestampFields are the JSON fields of the REST responses holding unix
+// timestamps in seconds, both by their JSON and by their proto names.
+var restTimestampFields = map[string]bool{
+	"failTime":     true,
+	"fail_time":    true,
+	"successTime":  true,
+	"success_time": true,
+	"startTime":    true,
+	"start_time":   true,
+	"endTime":      true,
+	"end_time":     true,
+	"lastUpdated":  true,
+	"last_updated": true,
+}
+
+// restFieldSuffixes are the suffixes of the JSON fields holding amounts and
+// the RFC 3339 representation of timestamps.
+type restFieldSuffixes struct {
+	sat     string
+	msat    string
+	rfc3339 string
+}
+
+var (
+	// restJSONSuffixes are the suffixes of fields named by their JSON
+	// names in lowerCamelCase.
+	restJSONSuffixes = restFieldSuffixes{
+		sat:     "Sat",
+		msat:    "Msat",
+		rfc3339: restRFC3339Suffix,
+	}
+
+	// restProtoSuffixes are the suffixes of fields named by their proto
+	// names in snake_case.
+	restProtoSuffixes = restFieldSuffixes{
+		sat:     "_sat",
+		msat:    "_msat",
+		rfc3339: "_rfc3339",
+	}
+)
+
+// restUnitsMarshaler renders the JSON representation of the REST responses
+// in the configured units. Amounts are represented by fields suffixed with
+// Sat and Msat, which are reduced to the configured unit, and timestamps
+// are complemented by their RFC 3339 representation if configured.
+type restUnitsMarshaler struct {
+	*runtime.JSONPb
+
+	// units are the units amounts are returned in.
+	units string
+
+	// rfc3339 is whether timestamps are complemented by their RFC 3339
+	// representation.
+	rfc3339 bool
+
+	// suffixes are the suffixes of the fields in the configured naming.
+	suffixes restFieldSuffixes
+
+	// indent is the indentation of nested JSON values, empty to render
+	// them compactly.
+	indent string
+}
+
+// restMarshalOptions returns the options the responses of the REST server are
+// rendered with, which adjust DefaultMarshalOptions to the configured field
+// names, defaults and indentation.
+func restMarshalOptions(config *ServerConfig) (protojson.MarshalOptions,
+	error) {
+
+	if strings.Trim(config.RESTJSONIndent, " \t") != "" {
+		return protojson.MarshalOptions{}, fmt.Errorf("REST JSON "+
+			"indent %q must only consist of spaces and tabs",
+			config.RESTJSONIndent)
+	}
+
+	options := DefaultMarshalOptions
+	options.EmitUnpopulated = config.RESTEmitDefaults
+	options.UseProtoNames = config.RESTUseProtoNames
+	if config.RESTJSONIndent != "" {
+		options.Multiline = true
+		options.Indent = config.RESTJSONIndent
+	}
+
+	return options, nil
+}
+
+// newRESTMarshaler returns the marshaler of the REST server rendering its
+// responses in the configured units and format.
+func newRESTMarshaler(config *ServerConfig) (runtime.Marshaler, error) {
+	options, err := restMarshalOptions(config)
+	if err != nil {
+		return nil, err
+	}
+	base := &runtime.JSONPb{MarshalOptions: options}
+
+	units := config.RESTAmountUnits
+	switch units {
+	case restAmountsBoth, restAmountsSat, restAmountsMsat:
+
+	case "":
+		units = restAmountsBoth
+
+	default:
+		return nil, fmt.Errorf("unknown REST amount units %q, options "+
+			"are %s, %s and %s", units, restAmountsBoth,
+			restAmountsSat, restAmountsMsat)
+	}
+
+	// The default representation needs no conversion.
+	if units == restAmountsBoth && !config.RESTRFC3339Timestamps {
+		return base, nil
+	}
+
+	suffixes := restJSONSuffixes
+	if config.RESTUseProtoNames {
+		suffixes = restProtoSuffixes
+	}
+
+	return &restUnitsMarshaler{
+		JSONPb:   base,
+		units:    units,
+		rfc3339:  config.RESTRFC3339Timestamps,
+		suffixes: suffixes,
+		indent:   config.RESTJSONIndent,
+	}, nil
+}
+
+// Marshal renders the value as JSON in the configured units.
+func (m *restUnitsMarshaler) Marshal(v any) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode numbers as such, so that they are rendered unchanged.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	m.convert(value)
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", m.indent)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// NewEncoder returns an encoder writing values as JSON in the configured
+// units to the writer.
+func (m *restUnitsMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v any) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		_, err = w.Write(m.Delimiter())
+
+		return err
+	})
+}
+
+// convert converts the amounts and timestamps of the decoded JSON value and
+// of all values nested in it in place.
+func (m *restUnitsMarshaler) convert(value any) {
+	switch value := value.(type) {
+	case []any:
+		for _, v := range value {
+			m.convert(v)
+		}
+
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key, v := range value {
+			m.convert(v)
+			keys = append(keys, key)
+		}
+		for _, key := range keys {
+			m.convertField(value, key)
+		}
+	}
+}
+
+// convertField converts the field of the object with the given key if it
+// holds an amount or a timestamp.
+func (m *restUnitsMarshaler) convertField(object map[string]any,
+	key string) {
+
+	suffixes := m.suffixes
+	switch {
+	case m.units == restAmountsSat && strings.HasSuffix(key, suffixes.msat):
+		// Amounts only tracked in millisats are converted to sats.
+		satKey := strings.TrimSuffix(key, suffixes.msat) + suffixes.sat
+		if _, ok := object[satKey]; !ok {
+			if msat, ok := restInt(object[key]); ok {
+				object[satKey] = strconv.FormatInt(
+					msat/mSatScale, 10,
+				)
+			}
+		}
+		delete(object, key)
+
+	case m.units == restAmountsMsat && strings.HasSuffix(key, suffixes.sat):
+		// Every amount in sats has its counterpart in millisats.
+		delete(object, key)
+
+	case m.rfc3339 && restTimestampFields[key]:
+		if seconds, ok := restInt(object[key]); ok {
+			object[key+suffixes.rfc3339] = time.Unix(seconds, 0).
+				UTC().Format(time.RFC3339)
+		}
+	}
+}
+
+// restInt returns the integer held by a decoded JSON value, which renders 64
+// bit integers as strings.
+func restInt(value any) (int64, bool) {
+	var text string
+	switch value := value.(type) {
+	case string:
+		text = value
+	case json.Number:
+		text = value.String()
+	default:
+		return 0, false
+	}
+
+	v, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return v, true
+}
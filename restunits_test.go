@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestRESTMarshalerUnits tests that the REST responses are rendered in the
+// configured units.
+func TestRESTMarshalerUnits(t *testing.T) {
+	resp := &ecrpc.QueryAggregatedMissionControlResponse{
+		Pairs: []*ecrpc.PairHistory{{
+			History: &ecrpc.PairData{
+				FailTime:       1700000000,
+				FailAmtSat:     5,
+				FailAmtMsat:    5001,
+				SuccessTime:    1700000060,
+				SuccessAmtSat:  2,
+				SuccessAmtMsat: 2000,
+			},
+		}},
+		LiquidityBounds: []*ecrpc.LiquidityBounds{{
+			MinLiquidityMsat: 2000,
+			LastUpdated:      1700000060,
+		}},
+	}
+
+	// render renders the response as a chunk of a streamed REST response
+	// and returns its decoded pair data and liquidity bounds.
+	render := func(units string, rfc3339 bool) (map[string]any,
+		map[string]any) {
+		marshaler, err := newRESTMarshaler(&ServerConfig{
+			RESTAmountUnits:       units,
+			RESTRFC3339Timestamps: rfc3339,
+		})
+		require.NoError(t, err)
+		data, err := marshaler.Marshal(map[string]any{"result": resp})
+		require.NoError(t, err)
+
+		var chunk struct {
+			Result struct {
+				Pairs []struct {
+					History map[string]any `json:"history"`
+				} `json:"pairs"`
+				LiquidityBounds []map[string]any `json:"liquidityBounds"`
+			} `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(data, &chunk))
+
+		return chunk.Result.Pairs[0].History,
+			chunk.Result.LiquidityBounds[0]
+	}
+
+	history, bounds := render("", false)
+	require.Equal(t, "5", history["failAmtSat"])
+	require.Equal(t, "5001", history["failAmtMsat"])
+	require.NotContains(t, history, "failTimeRfc3339")
+
+	history, bounds = render(restAmountsSat, false)
+	require.Equal(t, "5", history["failAmtSat"])
+	require.NotContains(t, history, "failAmtMsat")
+	require.Equal(t, "2", bounds["minLiquiditySat"])
+	require.NotContains(t, bounds, "minLiquidityMsat")
+
+	history, bounds = render(restAmountsMsat, true)
+	require.Equal(t, "5001", history["failAmtMsat"])
+	require.NotContains(t, history, "failAmtSat")
+	require.Equal(t, "1700000000", history["failTime"])
+	require.Equal(t, "2023-11-14T22:13:20Z", history["failTimeRfc3339"])
+	require.Equal(
+		t, "2023-11-14T22:14:20Z", history["successTimeRfc3339"],
+	)
+	require.Equal(t, "2000", bounds["minLiquidityMsat"])
+	require.Equal(t, "2023-11-14T22:14:20Z", bounds["lastUpdatedRfc3339"])
+
+	_, err := newRESTMarshaler(&ServerConfig{RESTAmountUnits: "btc"})
+	require.ErrorContains(t, err, "unknown REST amount units")
+}
+
+// TestRESTMarshalerFormat tests that the REST responses are rendered with the
+// configured field names, defaults and indentation.
+func TestRESTMarshalerFormat(t *testing.T) {
+	resp := &ecrpc.QueryAggregatedMissionControlResponse{
+		Pairs: []*ecrpc.PairHistory{{
+			History: &ecrpc.PairData{
+				FailTime:    1700000000,
+				FailAmtSat:  5,
+				FailAmtMsat: 5001,
+			},
+		}},
+	}
+
+	render := func(config *ServerConfig) (string, map[string]any) {
+		marshaler, err := newRESTMarshaler(config)
+		require.NoError(t, err)
+		data, err := marshaler.Marshal(map[string]any{"result": resp})
+		require.NoError(t, err)
+
+		var chunk struct {
+			Result struct {
+				Pairs []struct {
+					History map[string]any `json:"history"`
+				} `json:"pairs"`
+			} `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(data, &chunk))
+
+		return string(data), chunk.Result.Pairs[0].History
+	}
+
+	// Default values are omitted unless requested.
+	data, history := render(&ServerConfig{})
+	require.NotContains(t, history, "successAmtMsat")
+	require.NotContains(t, data, "\n")
+
+	_, history = render(&ServerConfig{RESTEmitDefaults: true})
+	require.Equal(t, "0", history["successAmtMsat"])
+
+	// Fields are renamed, including the ones added for the configured
+	// units and timestamps.
+	_, history = render(&ServerConfig{
+		RESTUseProtoNames:     true,
+		RESTAmountUnits:       restAmountsSat,
+		RESTRFC3339Timestamps: true,
+	})
+	require.Equal(t, "5", history["fail_amt_sat"])
+	require.NotContains(t, history, "fail_amt_msat")
+	require.NotContains(t, history, "failAmtSat")
+	require.Equal(t, "2023-11-14T22:13:20Z", history["fail_time_rfc3339"])
+
+	// Responses are indented with and without the units being converted.
+	data, _ = render(&ServerConfig{RESTJSONIndent: "  "})
+	require.Contains(t, data, "\n  ")
+	data, _ = render(&ServerConfig{
+		RESTJSONIndent:  "\t",
+		RESTAmountUnits: restAmountsMsat,
+	})
+	require.Contains(t, data, "\n\t")
+
+	_, err := newRESTMarshaler(&ServerConfig{RESTJSONIndent: "--"})
+	require.ErrorContains(t, err, "must only consist of spaces and tabs")
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// revisionLogRetentionKey is the key of the number of past revisions
+	// kept in the revision log within the metadata bucket.
+	revisionLogRetentionKey = []byte("revision_log_retention")
+
+	// revisionLogStartKey is the key of the oldest revision covered by the
+	// revision log within the metadata bucket.
+	revisionLogStartKey = []byte("revision_log_start")
+)
+
+const (
+	// revisionAbsent marks a logged pair which was not stored at the
+	// revision.
+	revisionAbsent byte = 0
+
+	// revisionPresent marks a logged pair which was stored at the
+	// revision, followed by its data.
+	revisionPresent byte = 1
+)
+
+// revisionLogKey returns the key of a pair within the revision log. Keys are
+// ordered by the revision first, so that the entries of the revisions from a
+// given one on are found by a range scan.
+func revisionLogKey(revision uint64, key []byte) []byte {
+	logKey := make([]byte, 0, 8+len(key))
+	logKey = append(logKey, encodeUint64(revision)...)
+
+	return append(logKey, key...)
+}
+
+// configureRevisionLog applies the configured retention to the revision log.
+// The log only covers the revisions from the one it was enabled at on, so it
+// is restarted whenever it was disabled or dropped before.
+func configureRevisionLog(tx *bbolt.Tx, retention int) error {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	previous := decodeUint64(meta.Get(revisionLogRetentionKey))
+	if retention < 0 {
+		retention = 0
+	}
+
+	b := tx.Bucket([]byte(RevisionLogBucketName))
+	if b == nil || previous == 0 || retention == 0 {
+		if err := resetRevisionLog(tx); err != nil {
+			return err
+		}
+	}
+
+	return meta.Put(
+		revisionLogRetentionKey, encodeUint64(uint64(retention)),
+	)
+}
+
+// resetRevisionLog drops the revision log, so that it only covers the current
+// revision on. It must be called by every transaction replacing pairs without
+// going through putPair and deletePair, after their revision was bumped.
+func resetRevisionLog(tx *bbolt.Tx) error {
+	err := tx.DeleteBucket([]byte(RevisionLogBucketName))
+	if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+		return err
+	}
+	_, err = tx.CreateBucket([]byte(RevisionLogBucketName))
+	if err != nil {
+		return err
+	}
+
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	revision := decodeUint64(meta.Get(datasetRevisionKey))
+
+	return meta.Put(revisionLogStartKey, encodeUint64(revision))
+}
+
+// logPairRevision records the data of a pair at the current revision before it
+// is changed, if the revision log is enabled. Only the first change of a pair
+// within a revision is recorded, since it holds the data the pair had at the
+// revision.
+func logPairRevision(tx *bbolt.Tx, key []byte) error {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	if decodeUint64(meta.Get(revisionLogRetentionKey)) == 0 {
+		return nil
+	}
+
+	b := tx.Bucket([]byte(RevisionLogBucketName))
+	revision := decodeUint64(meta.Get(datasetRevisionKey))
+	logKey := revisionLogKey(revision, key)
+	if b.Get(logKey) != nil {
+		return nil
+	}
+
+	entry := []byte{revisionAbsent}
+	if v := tx.Bucket([]byte(DatabaseBucketName)).Get(key); v != nil {
+		entry = append([]byte{revisionPresent}, v...)
+	}
+
+	return b.Put(logKey, entry)
+}
+
+// pruneRevisionLog removes the entries of the revisions exceeding the
+// retention of the revision log.
+func pruneRevisionLog(tx *bbolt.Tx) error {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	retention := decodeUint64(meta.Get(revisionLogRetentionKey))
+	revision := decodeUint64(meta.Get(datasetRevisionKey))
+	if retention == 0 || revision <= retention {
+		return nil
+	}
+	cutoff := encodeUint64(revision - retention)
+
+	c := tx.Bucket([]byte(RevisionLogBucketName)).Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		if bytes.Compare(k[:8], cutoff) >= 0 {
+			break
+		}
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// oldestRevision returns the oldest revision the dataset can be queried at,
+// which is the current one if the revision log is disabled.
+func oldestRevision(tx *bbolt.Tx) uint64 {
+	meta := tx.Bucket([]byte(MetadataBucketName))
+	revision := decodeUint64(meta.Get(datasetRevisionKey))
+	retention := decodeUint64(meta.Get(revisionLogRetentionKey))
+	if retention == 0 {
+		return revision
+	}
+
+	oldest := decodeUint64(meta.Get(revisionLogStartKey))
+	if revision > retention {
+		oldest = max(oldest, revision-retention)
+	}
+
+	return oldest
+}
+
+// pairsAtRevision returns the keys and values of the pairs stored at the given
+// past revision in key order. A pair changed since has the data logged at the
+// first revision it was changed at from the given one on, since it was not
+// changed before.
+func pairsAtRevision(tx *bbolt.Tx, revision uint64) ([][]byte, [][]byte,
+	error) {
+
+	current := decodeUint64(
+		tx.Bucket([]byte(MetadataBucketName)).Get(datasetRevisionKey),
+	)
+	if revision > current {
+		return nil, nil, status.Errorf(codes.OutOfRange, "revision %d "+
+			"does not exist yet, the current revision is %d",
+			revision, current)
+	}
+	if oldest := oldestRevision(tx); revision < oldest {
+		return nil, nil, status.Errorf(codes.OutOfRange, "revision %d "+
+			"is no longer retained, the oldest revision is %d",
+			revision, oldest)
+	}
+
+	changed := make(map[string][]byte)
+	c := tx.Bucket([]byte(RevisionLogBucketName)).Cursor()
+	for k, v := c.Seek(encodeUint64(revision)); k != nil; k, v = c.Next() {
+		key := string(k[8:])
+		if _, ok := changed[key]; !ok {
+			changed[key] = v
+		}
+	}
+
+	var pairs []indexedPair
+	err := tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, v []byte) error {
+			if _, ok := changed[string(k)]; ok {
+				return nil
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			pairs = append(pairs, indexedPair{
+				key:   bytes.Clone(k),
+				value: bytes.Clone(v),
+			})
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for key, entry := range changed {
+		if len(entry) == 0 || entry[0] != revisionPresent {
+			continue
+		}
+		pairs = append(pairs, indexedPair{
+			key:   []byte(key),
+			value: bytes.Clone(entry[1:]),
+		})
+	}
+
+	// Restore the key order of the pairs changed since.
+	sort.Slice(pairs, func(i, j int) bool {
+		return bytes.Compare(pairs[i].key, pairs[j].key) < 0
+	})
+	keys := make([][]byte, 0, len(pairs))
+	values := make([][]byte, 0, len(pairs))
+	for _, pair := range pairs {
+		keys = append(keys, pair.key)
+		values = append(values, pair.value)
+	}
+
+	return keys, values, nil
+}
+
+// streamRevisionPairs streams the pairs stored at the given past revision
+// accepted by the filter, updated within the maximum age and relevant to
+// payments of the minimum amount in chunks of the configured batch size. It
+// returns the number of pairs sent.
+func (s *externalCoordinatorServer) streamRevisionPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	revision uint64, maxAge time.Duration, minAmtMsat int64,
+	filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+
+	start := time.Now()
+	var keys, values [][]byte
+	var dataset *ecrpc.DatasetInfo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		keys, values, err = pairsAtRevision(tx, revision)
+		if err != nil {
+			return err
+		}
+
+		dataset = &ecrpc.DatasetInfo{
+			Revision:       revision,
+			TotalPairs:     uint64(len(keys)),
+			Epoch:          currentEpoch(tx).Epoch,
+			OldestRevision: oldestRevision(tx),
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, len(keys))
+	if err != nil {
+		return 0, err
+	}
+
+	// Past revisions are judged by the update times and amounts of their
+	// own pairs.
+	cutoff := s.clock.Now().Add(-maxAge).Unix()
+	fresh := maxAge >= s.config.Server.HistoryThresholdDuration
+	accepted := 0
+	for i, k := range keys {
+		nodeFrom, nodeTo := splitPairKey(k)
+		if filter != nil && !filter(nodeFrom, nodeTo) {
+			continue
+		}
+		if !fresh || minAmtMsat > 0 {
+			history, err := decodePairData(values[i])
+			if err != nil {
+				return 0, status.Errorf(codes.DataLoss,
+					"failed to decode history data: %v",
+					err)
+			}
+			updated := mostRecentUnixTimestamp(
+				history.FailTime, history.SuccessTime,
+			)
+			if !fresh && updated < cutoff {
+				continue
+			}
+			if minAmtMsat > 0 &&
+				!isAmountRelevant(history, minAmtMsat) {
+
+				continue
+			}
+		}
+		keys[accepted], values[accepted] = k, values[i]
+		accepted++
+	}
+
+	if accepted == 0 {
+		return 0, sendEmptyResponse(stream, dataset)
+	}
+
+	return s.sendPairBatches(stream, keys[:accepted], values[:accepted])
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryAsOfRevision tests that queries can request the dataset as of a
+// past revision within the retention of the revision log.
+func TestQueryAsOfRevision(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RevisionLogRetention = 3
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	revision := func() *ecrpc.DatasetInfo {
+		var dataset *ecrpc.DatasetInfo
+		err := db.View(func(tx *bbolt.Tx) error {
+			dataset = currentDatasetInfo(tx)
+			return nil
+		})
+		require.NoError(t, err)
+
+		return dataset
+	}
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	now := time.Now().Unix()
+	register := func(nodeTo []byte, amtMsat, failTime int64) uint64 {
+		req := &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					FailTime:    failTime,
+					FailAmtMsat: amtMsat,
+				},
+			}},
+		}
+		_, err := server.RegisterMissionControl(
+			context.Background(), req,
+		)
+		require.NoError(t, err)
+
+		return revision().Revision
+	}
+	query := func(req *ecrpc.QueryAggregatedMissionControlRequest) (
+		map[string]int64, error) {
+
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(req, stream)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs := make(map[string]int64)
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				key := string(pair.NodeTo)
+				pairs[key] = pair.History.FailAmtMsat
+			}
+		}
+
+		return pairs, nil
+	}
+	queryAsOf := func(revision uint64) (map[string]int64, error) {
+		return query(&ecrpc.QueryAggregatedMissionControlRequest{
+			AsOfRevision: revision,
+		})
+	}
+
+	// The log only covers the revisions from the one it was enabled at.
+	start := revision().Revision
+	require.Equal(t, start, revision().OldestRevision)
+
+	first := register(nodeB, 5_000_000, now-20)
+	second := register(nodeC, 3_000_000, now-10)
+	third := register(nodeB, 4_000_000, now)
+	_, err = admin.DeletePairs(
+		context.Background(), &ecadminrpc.DeletePairsRequest{
+			Node: nodeC,
+		},
+	)
+	require.NoError(t, err)
+
+	pairs, err := queryAsOf(first)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{string(nodeB): 5_000_000}, pairs)
+
+	pairs, err = queryAsOf(second)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{
+		string(nodeB): 5_000_000,
+		string(nodeC): 3_000_000,
+	}, pairs)
+
+	pairs, err = queryAsOf(third)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{
+		string(nodeB): 4_000_000,
+		string(nodeC): 3_000_000,
+	}, pairs)
+
+	pairs, err = queryAsOf(revision().Revision)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{string(nodeB): 4_000_000}, pairs)
+
+	// Revisions before the retention and after the current one cannot be
+	// queried.
+	register(nodeC, 2_000_000, now)
+	require.Equal(t, revision().Revision-3, revision().OldestRevision)
+	_, err = queryAsOf(first)
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = queryAsOf(revision().Revision + 1)
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	// Past revisions are only reconstructed in full.
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		AsOfRevision: first,
+		SortOrder:    ecrpc.SortOrder_SORT_ORDER_FRESHNESS,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
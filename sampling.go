@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// streamSampledPairs streams a uniform random sample of at most the given
+// number of pairs accepted by the filter in chunks of the configured batch
+// size. The sample is drawn by reservoir sampling in a single scan, so only
+// the sampled pairs are held in memory. The sampled pairs are sent in the
+// given sort order. The query is aborted once the configured
+// execution timeout elapses or the client goes away. It returns the number of
+// pairs sent.
+func (s *externalCoordinatorServer) streamSampledPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	size int, order ecrpc.SortOrder,
+	filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	var keys, values [][]byte
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		seen := 0
+		c := tx.Bucket([]byte(DatabaseBucketName)).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+			seen++
+
+			// Fill the reservoir first, then replace a random
+			// sampled pair with a probability of size/seen.
+			i := len(keys)
+			if i == size {
+				i = rand.IntN(seen)
+				if i >= size {
+					continue
+				}
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			if i == len(keys) {
+				keys = append(keys, bytes.Clone(k))
+				values = append(values, bytes.Clone(v))
+			} else {
+				keys[i] = bytes.Clone(k)
+				values[i] = bytes.Clone(v)
+			}
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		return 0, s.abortedQueryError(err)
+	}
+
+	sort.Sort(keyValueSorter{keys: keys, values: values})
+	if err := sortPairs(keys, values, order); err != nil {
+		return 0, err
+	}
+
+	return s.sendPairBatches(stream, keys, values)
+}
+
+// keyValueSorter sorts keys together with their values by key.
+type keyValueSorter struct {
+	keys   [][]byte
+	values [][]byte
+}
+
+func (s keyValueSorter) Len() int {
+	return len(s.keys)
+}
+
+func (s keyValueSorter) Less(i, j int) bool {
+	return bytes.Compare(s.keys[i], s.keys[j]) < 0
+}
+
+func (s keyValueSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQuerySample tests that sampled queries return a uniform random sample
+// of the requested size.
+func TestQuerySample(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 2
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	const numPairs = 20
+	var pairs []*ecrpc.PairHistory
+	for i := 0; i < numPairs; i++ {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	query := func(req *ecrpc.QueryAggregatedMissionControlRequest) (
+		[][]byte, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(req, stream)
+
+		var keys [][]byte
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				keys = append(keys, pairKey(
+					pair.NodeFrom, pair.NodeTo,
+				))
+			}
+		}
+
+		return keys, err
+	}
+
+	// Every pair ends up in some sample, and each sample consists of
+	// distinct pairs in the order they are stored in.
+	sampled := make(map[string]bool)
+	for i := 0; i < 200; i++ {
+		keys, err := query(&ecrpc.QueryAggregatedMissionControlRequest{
+			SampleSize: 5,
+		})
+		require.NoError(t, err)
+		require.Len(t, keys, 5)
+		for j, key := range keys {
+			if j > 0 {
+				require.Negative(t, bytes.Compare(keys[j-1], key))
+			}
+			sampled[string(key)] = true
+		}
+	}
+	require.Len(t, sampled, numPairs)
+
+	// Samples larger than the dataset return all pairs.
+	keys, err := query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SampleSize: 100,
+	})
+	require.NoError(t, err)
+	require.Len(t, keys, numPairs)
+
+	// Samples cannot be ranked.
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SampleSize: 5,
+		SourceNode: pairs[0].NodeFrom,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -1,20 +1,17 @@
 package main
 
 import (
-	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"net"
-	"net/http"
-	"net/http/pprof"
-	"os"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
 	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -40,26 +37,53 @@ var (
 func initializeGRPCServer(config *Config,
 	tlsConfig *tls.Config,
 	server *externalCoordinatorServer) (*grpc.Server, net.Listener, error) {
-	lis, err := net.Listen(
-		"tcp",
-		config.Server.GRPCServerHost+config.Server.GRPCServerPort,
+	lis, err := listeners.listen(
+		grpcListenerName,
+		joinHostPort(
+			config.Server.GRPCServerHost,
+			config.Server.GRPCServerPort,
+		),
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to listen: %v", err)
 	}
 
-	// Create the gRPC server with TLS credentials.
-	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	// Create the gRPC server with TLS credentials, assigning an ID to each
+	// request, observing the sizes of its messages, rejecting it while the
+	// coordinator is starting up, authorizing it with its access token if
+	// required and with the external policy if configured. The policy
+	// follows the access token, so that it learns its owner. Streams are
+	// tracked last, so that cancelling them reaches the handler.
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(
+			requestIDUnaryInterceptor, server.messageSizeUnary,
+			server.readyUnary, server.authorizeUnary,
+			server.policyUnary,
+		),
+		grpc.ChainStreamInterceptor(
+			requestIDStreamInterceptor, server.messageSizeStream,
+			server.readyStream, server.authorizeStream,
+			server.policyStream, server.trackStream,
+		),
+	)
 	ecrpc.RegisterExternalCoordinatorServer(grpcServer, server)
 
+	// Report the readiness through the standard health service, which
+	// orchestrators like Kubernetes probe natively.
+	healthServer := health.NewServer()
+	server.startup.watch(healthServer)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
 	return grpcServer, lis, nil
 }
 
 // startGRPCServer handles the actual running of the gRPC server.
 func startGRPCServer(config *Config, server *grpc.Server,
 	lis net.Listener) error {
-	logrus.Infof("Starting gRPC server on https://%s%s",
-		config.Server.GRPCServerHost, config.Server.GRPCServerPort)
+	logrus.Infof("Starting gRPC server on https://%s", joinHostPort(
+		config.Server.GRPCServerHost, config.Server.GRPCServerPort,
+	))
 
 	if err := server.Serve(lis); err != nil {
 		return err
@@ -68,107 +92,47 @@ func startGRPCServer(config *Config, server *grpc.Server,
 	return nil
 }
 
-// initializeHTTPServer prepares and returns a configured HTTP server without
-// starting it.
-func initializeHTTPServer(ctx context.Context,
+// initializeAdminGRPCServer sets up the admin gRPC server but does not start
+// it.
+func initializeAdminGRPCServer(config *Config,
 	tlsConfig *tls.Config,
-	config *Config) (*http.Server, error) {
-	// Create a new ServeMux to route incoming requests.
-	marshalerOption := runtime.WithMarshalerOption(
-		runtime.MIMEWildcard, &runtime.JSONPb{
-			MarshalOptions: DefaultMarshalOptions,
-		},
-	)
-	mux := runtime.NewServeMux(marshalerOption)
-
-	// Read the certificate file.
-	certBytes, err := os.ReadFile(config.TLS.TLSCertFile)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create a new certificate pool and add the certificate to it.
-	// This certificate pool is used to establish a trusted root CA set,
-	// which ensures that the gRPC client verifies the server's certificate
-	// during the TLS handshake, thereby securing the communication channel.
-	certPool := x509.NewCertPool()
-	if !certPool.AppendCertsFromPEM(certBytes) {
-		return nil, fmt.Errorf("failed to append certificate")
-	}
-
-	// Define gRPC dial options with transport credentials using the
-	// certificate pool.
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(
-			credentials.NewClientTLSFromCert(
-				certPool, "",
-			),
+	server *adminServer) (*grpc.Server, net.Listener, error) {
+	lis, err := listeners.listen(
+		adminListenerName,
+		joinHostPort(
+			config.Server.AdminGRPCServerHost,
+			config.Server.AdminGRPCServerPort,
 		),
-	}
-
-	err = ecrpc.RegisterExternalCoordinatorHandlerFromEndpoint(
-		ctx, mux,
-		config.TLS.TLSDomainName+config.Server.GRPCServerPort, opts,
 	)
 	if err != nil {
-		return nil, err
-	}
-
-	// Configure HTTP Server settings for the server.
-	httpServer := &http.Server{
-		Addr:      config.Server.RESTServerHost + config.Server.RESTServerPort,
-		Handler:   mux,
-		TLSConfig: tlsConfig,
+		return nil, nil, fmt.Errorf("failed to listen: %v", err)
 	}
 
-	return httpServer, nil
-}
-
-// startHTTPServer starts the provided HTTP server for the gRPC REST gateway.
-func startHTTPServer(config *Config, httpServer *http.Server) error {
-	logrus.Infof("Starting HTTP/1.1 REST server on https://%s%s",
-		config.Server.RESTServerHost, config.Server.RESTServerPort)
-
-	err := httpServer.ListenAndServeTLS(
-		config.TLS.TLSCertFile, config.TLS.TLSKeyFile,
+	// Create the admin gRPC server with TLS credentials, assigning an ID
+	// to each request and authenticating it with the admin macaroon.
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(
+			requestIDUnaryInterceptor, server.authenticateUnary,
+		),
+		grpc.ChainStreamInterceptor(
+			requestIDStreamInterceptor, server.authenticateStream,
+		),
 	)
-	if err != nil && err != http.ErrServerClosed {
-		return err
-	}
-
-	return nil
-}
-
-// initializePProfServer initializes the pprof server but doesn't start it.
-func initializePProfServer(config *Config, tlsConfig *tls.Config) *http.Server {
-	mux := http.NewServeMux()
-	mux.HandleFunc("/debug/pprof/", pprof.Index)
-	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
-	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
-	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
-	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
-
-	// Configure TLS settings for the server.
-	pprofServer := &http.Server{
-		Addr: config.PProf.PProfServerHost +
-			config.PProf.PProfServerPort,
-		Handler:   mux,
-		TLSConfig: tlsConfig,
-	}
+	ecadminrpc.RegisterExternalCoordinatorAdminServer(grpcServer, server)
 
-	return pprofServer
+	return grpcServer, lis, nil
 }
 
-// startPProfServer starts the pprof server.
-func startPProfServer(config *Config, server *http.Server) error {
-	logrus.Infof("Starting pprof server on "+
-		"https://%s%s", config.PProf.PProfServerHost,
-		config.PProf.PProfServerPort)
+// startAdminGRPCServer handles the actual running of the admin gRPC server.
+func startAdminGRPCServer(config *Config, server *grpc.Server,
+	lis net.Listener) error {
+	logrus.Infof("Starting admin gRPC server on https://%s", joinHostPort(
+		config.Server.AdminGRPCServerHost,
+		config.Server.AdminGRPCServerPort,
+	))
 
-	err := server.ListenAndServeTLS(
-		config.TLS.TLSCertFile, config.TLS.TLSKeyFile,
-	)
-	if err != nil && err != http.ErrServerClosed {
+	if err := server.Serve(lis); err != nil {
 		return err
 	}
 
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// shadowRegistrations counts the registrations mirrored to the shadow
+// coordinator by result.
+var shadowRegistrations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "shadow",
+		Name:      "registrations_total",
+		Help:      "Registrations mirrored to the shadow coordinator.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	metricsRegistry.MustRegister(shadowRegistrations)
+}
+
+// shadowMirror asynchronously mirrors registrations to a secondary
+// coordinator. Mirroring is best effort: registrations are dropped if the
+// queue is full and failures never affect the primary coordinator.
+type shadowMirror struct {
+	conn    *grpc.ClientConn
+	client  ecrpc.ExternalCoordinatorClient
+	config  *ServerConfig
+	mu      sync.Mutex
+	closed  bool
+	pending chan *ecrpc.RegisterMissionControlRequest
+	wg      sync.WaitGroup
+}
+
+// newShadowMirror creates a mirror to the shadow coordinator configured in the
+// given server configuration and starts forwarding registrations to it.
+func newShadowMirror(config *ServerConfig) (*shadowMirror, error) {
+	creds := credentials.NewTLS(&tls.Config{})
+	if config.ShadowTLSCertFile != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(
+			config.ShadowTLSCertFile, "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load shadow TLS "+
+				"certificate: %v", err)
+		}
+	}
+
+	conn, err := grpc.NewClient(
+		config.ShadowTarget, grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shadow client: %v",
+			err)
+	}
+
+	m := &shadowMirror{
+		conn:   conn,
+		client: ecrpc.NewExternalCoordinatorClient(conn),
+		config: config,
+		pending: make(
+			chan *ecrpc.RegisterMissionControlRequest,
+			config.ShadowQueueSize,
+		),
+	}
+
+	m.wg.Add(1)
+	go m.forward()
+
+	return m, nil
+}
+
+// mirror queues a copy of the registration to be sent to the shadow
+// coordinator. The registration is dropped if the queue is full.
+func (m *shadowMirror) mirror(req *ecrpc.RegisterMissionControlRequest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	// The registration is copied since the primary coordinator sanitizes
+	// it in place.
+	select {
+	case m.pending <- proto.Clone(req).(*ecrpc.RegisterMissionControlRequest):
+	default:
+		shadowRegistrations.WithLabelValues("dropped").Inc()
+		logrus.Debug("Shadow queue is full, dropping registration")
+	}
+}
+
+// forward sends the queued registrations to the shadow coordinator until the
+// mirror is stopped.
+func (m *shadowMirror) forward() {
+	defer m.wg.Done()
+
+	for req := range m.pending {
+		ctx, cancel := context.WithTimeout(
+			context.Background(), m.config.ShadowTimeout,
+		)
+		if m.config.ShadowAccessToken != "" {
+			ctx = metadata.AppendToOutgoingContext(
+				ctx, accessTokenHeader, m.config.ShadowAccessToken,
+			)
+		}
+		_, err := m.client.RegisterMissionControl(ctx, req)
+		cancel()
+
+		if err != nil {
+			shadowRegistrations.WithLabelValues("failed").Inc()
+			logrus.Debugf("Failed to mirror registration to shadow "+
+				"coordinator: %v", err)
+			continue
+		}
+		shadowRegistrations.WithLabelValues("sent").Inc()
+	}
+}
+
+// stop stops accepting registrations, waits for the queued ones to be
+// forwarded and closes the connection to the shadow coordinator.
+func (m *shadowMirror) stop() error {
+	m.mu.Lock()
+	if !m.closed {
+		m.closed = true
+		close(m.pending)
+	}
+	m.mu.Unlock()
+
+	m.wg.Wait()
+
+	return m.conn.Close()
+}
+
+// StartShadowing starts mirroring all registrations to the configured shadow
+// coordinator.
+func (s *externalCoordinatorServer) StartShadowing() error {
+	shadow, err := newShadowMirror(&s.config.Server)
+	if err != nil {
+		return err
+	}
+	s.shadow = shadow
+
+	logrus.Infof("Mirroring registrations to shadow coordinator %s",
+		s.config.Server.ShadowTarget)
+
+	return nil
+}
+
+// StopShadowing forwards the remaining queued registrations and stops
+// mirroring if it is running.
+func (s *externalCoordinatorServer) StopShadowing() error {
+	if s.shadow == nil {
+		return nil
+	}
+
+	return s.shadow.stop()
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// recordingCoordinator is an external coordinator recording the
+// registrations it receives.
+type recordingCoordinator struct {
+	ecrpc.UnimplementedExternalCoordinatorServer
+	mu       sync.Mutex
+	requests []*ecrpc.RegisterMissionControlRequest
+	tokens   []string
+}
+
+func (r *recordingCoordinator) RegisterMissionControl(ctx context.Context,
+	req *ecrpc.RegisterMissionControlRequest) (*ecrpc.RegisterMissionControlResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, req)
+	r.tokens = append(r.tokens, md.Get(accessTokenHeader)...)
+
+	return &ecrpc.RegisterMissionControlResponse{}, nil
+}
+
+// received returns the registrations received so far.
+func (r *recordingCoordinator) received() []*ecrpc.RegisterMissionControlRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*ecrpc.RegisterMissionControlRequest(nil), r.requests...)
+}
+
+// TestShadowing tests that registrations are mirrored as received to the
+// shadow coordinator.
+func TestShadowing(t *testing.T) {
+	tempDir := t.TempDir()
+	certFile := filepath.Join(tempDir, "tls.cert")
+	keyFile := filepath.Join(tempDir, "tls.key")
+	require.NoError(t, generateSelfSignedTLS(certFile, keyFile))
+
+	// Start the shadow coordinator.
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	port, err := getFreePort()
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	require.NoError(t, err)
+
+	secondary := &recordingCoordinator{}
+	shadowServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(
+		&tls.Config{Certificates: []tls.Certificate{cert}},
+	)))
+	ecrpc.RegisterExternalCoordinatorServer(shadowServer, secondary)
+	go shadowServer.Serve(lis)
+	defer shadowServer.Stop()
+
+	config := MockConfig(tempDir)
+	config.Server.ShadowTarget = fmt.Sprintf("localhost:%d", port)
+	config.Server.ShadowTLSCertFile = certFile
+	config.Server.ShadowAccessToken = "token"
+	config.Server.ShadowQueueSize = 10
+	config.Server.ShadowTimeout = 10 * time.Second
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartShadowing())
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	req := &ecrpc.RegisterMissionControlRequest{
+		Pairs: []*ecrpc.PairHistory{{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				FailTime:       time.Now().Unix(),
+				FailAmtSat:     1000,
+				FailAmtMsat:    1000000,
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  2000,
+				SuccessAmtMsat: 2000000,
+			},
+		}},
+	}
+	_, err = server.RegisterMissionControl(context.Background(), req)
+	require.NoError(t, err)
+
+	// Stopping forwards the queued registrations.
+	require.NoError(t, server.StopShadowing())
+
+	received := secondary.received()
+	require.Len(t, received, 1)
+	require.Len(t, received[0].Pairs, 1)
+	require.Equal(t, nodeFrom, received[0].Pairs[0].NodeFrom)
+	require.Equal(t, nodeTo, received[0].Pairs[0].NodeTo)
+
+	// The registration is authenticated with the configured access token.
+	secondary.mu.Lock()
+	require.Equal(t, []string{"token"}, secondary.tokens)
+	secondary.mu.Unlock()
+}
+
+// TestShadowMirrorDropsWhenFull tests that registrations are dropped from
+// shadowing while the queue is full and ignored once the mirror is stopped.
+func TestShadowMirrorDropsWhenFull(t *testing.T) {
+	// The forwarder is not started, so queued registrations stay pending.
+	m := &shadowMirror{
+		pending: make(chan *ecrpc.RegisterMissionControlRequest, 1),
+	}
+
+	req := &ecrpc.RegisterMissionControlRequest{}
+	m.mirror(req)
+	m.mirror(req)
+	require.Len(t, m.pending, 1)
+
+	// The queued registration is a copy of the request.
+	queued := <-m.pending
+	require.NotSame(t, req, queued)
+
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	m.mirror(req)
+	require.Empty(t, m.pending)
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// shiftCappedPairs counts the registered pairs whose amounts were capped
+// because their submitter shifted the stored amounts too far within a window.
+var shiftCappedPairs = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Name:      "shift_capped_pairs_total",
+	Help: "Registered pairs whose amounts were capped because their " +
+		"submitter shifted the stored amounts too far within a window.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(shiftCappedPairs)
+}
+
+// shiftCheckpointKey identifies the checkpoint of a submitter for a pair.
+type shiftCheckpointKey struct {
+	submitter string
+	pair      string
+}
+
+// shiftCheckpoint holds the amounts stored for a pair when the window of a
+// submitter started, which bound how far the submitter may shift them.
+type shiftCheckpoint struct {
+	start          time.Time
+	successAmtMsat int64
+	failAmtMsat    int64
+}
+
+// shiftLimiter limits how far any single submitter can shift the amounts
+// stored for a pair within a window, so that poisoning a pair takes many
+// windows and shows up in the metrics. The checkpoints are only kept in
+// memory, so they start afresh after a restart.
+type shiftLimiter struct {
+	// maxShift is the fraction by which a submitter may shift the amounts
+	// of a pair from its checkpoint.
+	maxShift float64
+
+	// window is the duration after which the checkpoint of a submitter is
+	// taken afresh.
+	window time.Duration
+
+	mu          sync.Mutex
+	checkpoints map[shiftCheckpointKey]shiftCheckpoint
+}
+
+// StartShiftLimiting starts capping the shift of the amounts stored for a
+// pair by any single submitter within the configured window.
+func (s *externalCoordinatorServer) StartShiftLimiting() error {
+	maxShift := s.config.Server.SubmitterShiftCap
+	if maxShift < 0 || math.IsNaN(maxShift) {
+		return fmt.Errorf("submitter shift cap must not be negative")
+	}
+	window := s.config.Server.SubmitterShiftWindow
+	if window <= 0 {
+		return fmt.Errorf("submitter shift window must be positive")
+	}
+
+	s.shiftLimiter = &shiftLimiter{
+		maxShift:    maxShift / 100,
+		window:      window,
+		checkpoints: make(map[shiftCheckpointKey]shiftCheckpoint),
+	}
+
+	logrus.Infof("Capping the shift of pair amounts by a single "+
+		"submitter at %v%% per %v", maxShift, window)
+
+	return nil
+}
+
+// bounds returns the range a submitter may shift the given checkpoint amount
+// to.
+func (l *shiftLimiter) bounds(amtMsat int64) (int64, int64) {
+	shift := int64(float64(amtMsat) * l.maxShift)
+
+	return amtMsat - shift, amtMsat + shift
+}
+
+// checkpoint returns the checkpoint of the submitter for the pair, taking it
+// from the stored data if the window of the submitter elapsed. Pairs which
+// are not stored yet have no checkpoint.
+func (l *shiftLimiter) checkpoint(submitter string, key []byte,
+	stored *ecrpc.PairData, now time.Time) (shiftCheckpoint, bool) {
+
+	id := shiftCheckpointKey{submitter: submitter, pair: string(key)}
+	checkpoint, ok := l.checkpoints[id]
+	if ok && now.Sub(checkpoint.start) < l.window {
+		return checkpoint, true
+	}
+	if stored == nil {
+		delete(l.checkpoints, id)
+		return shiftCheckpoint{}, false
+	}
+
+	checkpoint = shiftCheckpoint{
+		start:          now,
+		successAmtMsat: stored.SuccessAmtMsat,
+		failAmtMsat:    stored.FailAmtMsat,
+	}
+	l.checkpoints[id] = checkpoint
+
+	return checkpoint, true
+}
+
+// capPairData caps the amounts of the reported pair data, so that merging it
+// shifts the amounts from the checkpoint by at most the maximum shift. The
+// success amount can only grow by a merge, so it is capped from above. A
+// failure replaces the failure amount and drags the success amount down to
+// it, so its amount is kept within the shift of both. Amounts without a
+// previous value are not capped. It returns whether any amount was capped.
+func (l *shiftLimiter) capPairData(checkpoint shiftCheckpoint,
+	data *ecrpc.PairData) bool {
+
+	capped := false
+	if checkpoint.successAmtMsat > 0 {
+		_, upper := l.bounds(checkpoint.successAmtMsat)
+		if data.SuccessAmtMsat > upper {
+			data.SuccessAmtMsat = upper
+			capped = true
+		}
+	}
+
+	if data.FailTime == 0 {
+		return capped
+	}
+
+	lower, upper := int64(0), int64(math.MaxInt64)
+	if checkpoint.failAmtMsat > 0 {
+		lower, upper = l.bounds(checkpoint.failAmtMsat)
+	}
+	if checkpoint.successAmtMsat > 0 {
+		successLower, _ := l.bounds(checkpoint.successAmtMsat)
+		lower = max(lower, successLower+1)
+	}
+	switch {
+	case data.FailAmtMsat < lower:
+		data.FailAmtMsat = lower
+		capped = true
+
+	case data.FailAmtMsat > upper:
+		data.FailAmtMsat = upper
+		capped = true
+	}
+
+	return capped
+}
+
+// capSubmitterShifts caps the amounts of the pairs registered by the
+// submitter, so that the submitter shifts the amounts stored for each pair by
+// at most the maximum shift within a window. The capped pairs are replaced by
+// capped copies. It returns the number of pairs capped.
+func (s *externalCoordinatorServer) capSubmitterShifts(submitter string,
+	pairs []*ecrpc.PairHistory) (int, error) {
+
+	l := s.shiftLimiter
+	now := s.clock.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	capped := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		for i, pair := range pairs {
+			key := pairKey(pair.NodeFrom, pair.NodeTo)
+
+			var stored *ecrpc.PairData
+			if v := b.Get(key); v != nil {
+				var err error
+				stored, err = decodePairData(v)
+				if err != nil {
+					return err
+				}
+			}
+
+			checkpoint, ok := l.checkpoint(
+				submitter, key, stored, now,
+			)
+			if !ok {
+				continue
+			}
+
+			// The pairs may be shared, e.g. with the shadow
+			// coordinator, so only copies are capped.
+			pair = proto.Clone(pair).(*ecrpc.PairHistory)
+			if !l.capPairData(checkpoint, pair.History) {
+				continue
+			}
+			h := pair.History
+			h.SuccessAmtSat = h.SuccessAmtMsat / mSatScale
+			h.FailAmtSat = h.FailAmtMsat / mSatScale
+			pairs[i] = pair
+			capped++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	shiftCappedPairs.Add(float64(capped))
+
+	return capped, nil
+}
+
+// prune forgets the checkpoints whose window elapsed.
+func (l *shiftLimiter) prune(now time.Time) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	pruned := 0
+	for id, checkpoint := range l.checkpoints {
+		if now.Sub(checkpoint.start) >= l.window {
+			delete(l.checkpoints, id)
+			pruned++
+		}
+	}
+
+	return pruned
+}
+
+// reset forgets all checkpoints. It is a no-op on a nil limiter.
+func (l *shiftLimiter) reset() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.checkpoints = make(map[shiftCheckpointKey]shiftCheckpoint)
+}
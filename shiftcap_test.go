@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// TestSubmitterShiftCap tests that a single submitter shifts the amounts
+// stored for a pair by at most the cap within a window, measured from the
+// amounts stored when its window started.
+func TestSubmitterShiftCap(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = 24 * time.Hour
+	config.Server.SubmitterShiftCap = 10
+	config.Server.SubmitterShiftWindow = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+	require.NoError(t, server.StartShiftLimiting())
+
+	nodeA, nodeB := generateTestKeys(t)
+	register := func(ctx context.Context, data *ecrpc.PairData) string {
+		resp, err := server.RegisterMissionControl(
+			ctx, &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeB,
+					History:  data,
+				}},
+			},
+		)
+		require.NoError(t, err)
+
+		return resp.SuccessMessage
+	}
+	// Each result is a second newer than the previous one, so that it
+	// is merged.
+	success := func(amtMsat int64) *ecrpc.PairData {
+		clock.Advance(time.Second)
+		return &ecrpc.PairData{
+			SuccessTime:    clock.Now().Unix(),
+			SuccessAmtSat:  amtMsat / 1000,
+			SuccessAmtMsat: amtMsat,
+		}
+	}
+	stored := func() *ecrpc.PairData {
+		var data *ecrpc.PairData
+		err := db.View(func(tx *bbolt.Tx) error {
+			v := tx.Bucket([]byte(DatabaseBucketName)).Get(
+				pairKey(nodeA, nodeB),
+			)
+			var err error
+			data, err = decodePairData(v)
+			return err
+		})
+		require.NoError(t, err)
+
+		return data
+	}
+	first := submitterContext("10.0.0.1")
+	second := submitterContext("10.0.0.2")
+
+	// The amounts of a new pair are not capped.
+	msg := register(first, success(100_000_000))
+	require.NotContains(t, msg, "capped")
+	require.EqualValues(t, 100_000_000, stored().SuccessAmtMsat)
+
+	// Within its window, a submitter only shifts the amounts by the cap
+	// from its checkpoint, however often it registers.
+	msg = register(first, success(1_000_000_000))
+	require.Contains(t, msg, "capped 1 pairs")
+	require.EqualValues(t, 110_000_000, stored().SuccessAmtMsat)
+	require.EqualValues(t, 110_000, stored().SuccessAmtSat)
+
+	register(first, success(1_000_000_000))
+	require.EqualValues(t, 110_000_000, stored().SuccessAmtMsat)
+
+	// Other submitters have their own checkpoints.
+	register(second, success(1_000_000_000))
+	require.EqualValues(t, 121_000_000, stored().SuccessAmtMsat)
+
+	// Failures only drag the success amount down by the cap.
+	clock.Advance(time.Hour)
+	register(first, &ecrpc.PairData{
+		FailTime:    clock.Now().Unix(),
+		FailAmtSat:  1,
+		FailAmtMsat: 1_000,
+	})
+	require.EqualValues(t, 108_900_001, stored().FailAmtMsat)
+	require.EqualValues(t, 108_900_000, stored().SuccessAmtMsat)
+
+	// Checkpoints are forgotten once their window elapsed.
+	require.Equal(t, 1, server.shiftLimiter.prune(clock.Now()))
+	clock.Advance(time.Hour)
+	require.Equal(t, 1, server.shiftLimiter.prune(clock.Now()))
+	require.Empty(t, server.shiftLimiter.checkpoints)
+}
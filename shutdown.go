@@ -18,9 +18,11 @@ type HTTPServer interface {
 	Shutdown(ctx context.Context) error
 }
 
-// gracefulShutdown handles graceful shutdown of the servers.
+// gracefulShutdown handles graceful shutdown of the servers. The HTTP and
+// pprof servers are nil if they were not started.
 func gracefulShutdown(sigChan chan os.Signal, grpcServer GRPCServer,
-	httpServer HTTPServer, pprofServer HTTPServer) {
+	adminGRPCServer GRPCServer, httpServer HTTPServer,
+	pprofServer HTTPServer) {
 	// Block until a signal is received.
 	<-sigChan
 	logrus.Info("Shutting down servers...")
@@ -29,11 +31,18 @@ func gracefulShutdown(sigChan chan os.Signal, grpcServer GRPCServer,
 	grpcServer.GracefulStop()
 	logrus.Info("gRPC server has been stopped.")
 
-	// Graceful shutdown the HTTP server.
-	if err := httpServer.Shutdown(context.Background()); err != nil {
-		logrus.Errorf("HTTP server shutdown error: %v", err)
-	} else {
-		logrus.Info("HTTP server has been stopped.")
+	// Graceful shutdown the admin gRPC server.
+	adminGRPCServer.GracefulStop()
+	logrus.Info("Admin gRPC server has been stopped.")
+
+	// Graceful shutdown the HTTP server if it was started.
+	if httpServer != nil {
+		err := httpServer.Shutdown(context.Background())
+		if err != nil {
+			logrus.Errorf("HTTP server shutdown error: %v", err)
+		} else {
+			logrus.Info("HTTP server has been stopped.")
+		}
 	}
 
 	// Create context timeout with 5 seconds for pprof server to shutdown.
@@ -42,11 +51,13 @@ func gracefulShutdown(sigChan chan os.Signal, grpcServer GRPCServer,
 	)
 	defer pprofCancel()
 
-	// Graceful shutdown the pprof server.
-	if err := pprofServer.Shutdown(pprofCtx); err != nil {
-		logrus.Errorf("PProf server shutdown error: %v", err)
-	} else {
-		logrus.Info("PProf server has been stopped.")
+	// Graceful shutdown the pprof server if it was started.
+	if pprofServer != nil {
+		if err := pprofServer.Shutdown(pprofCtx); err != nil {
+			logrus.Errorf("PProf server shutdown error: %v", err)
+		} else {
+			logrus.Info("PProf server has been stopped.")
+		}
 	}
 
 	logrus.Info("Exited gracefully")
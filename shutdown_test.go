@@ -43,11 +43,13 @@ func TestGracefulShutdown(t *testing.T) {
 
 	// Create mock servers.
 	mockGRPCServer := new(MockGRPCServer)
+	mockAdminGRPCServer := new(MockGRPCServer)
 	mockHTTPServer := new(MockHTTPServer)
 	mockPProfServer := new(MockHTTPServer)
 
 	// Setup expectations for the mock servers.
 	mockGRPCServer.On("GracefulStop").Return()
+	mockAdminGRPCServer.On("GracefulStop").Return()
 	mockHTTPServer.On("Shutdown", mock.Anything).Return(nil)
 	mockPProfServer.On("Shutdown", mock.Anything).Return(nil)
 
@@ -56,7 +58,8 @@ func TestGracefulShutdown(t *testing.T) {
 
 	// Run gracefulShutdown in a separate goroutine.
 	go gracefulShutdown(
-		sigChan, mockGRPCServer, mockHTTPServer, mockPProfServer,
+		sigChan, mockGRPCServer, mockAdminGRPCServer, mockHTTPServer,
+		mockPProfServer,
 	)
 
 	// Simulate sending an interrupt signal.
@@ -67,6 +70,7 @@ func TestGracefulShutdown(t *testing.T) {
 
 	// Assert that all expectations were met.
 	mockGRPCServer.AssertExpectations(t)
+	mockAdminGRPCServer.AssertExpectations(t)
 	mockHTTPServer.AssertExpectations(t)
 	mockPProfServer.AssertExpectations(t)
 }
@@ -79,11 +83,13 @@ func TestGracefulShutdownHTTPError(t *testing.T) {
 
 	// Create mock servers.
 	mockGRPCServer := new(MockGRPCServer)
+	mockAdminGRPCServer := new(MockGRPCServer)
 	mockHTTPServer := new(MockHTTPServer)
 	mockPProfServer := new(MockHTTPServer)
 
 	// Setup expectations for the mock servers.
 	mockGRPCServer.On("GracefulStop").Return()
+	mockAdminGRPCServer.On("GracefulStop").Return()
 	mockHTTPServer.On("Shutdown", mock.Anything).Return(
 		fmt.Errorf("HTTP shutdown error"),
 	)
@@ -94,7 +100,8 @@ func TestGracefulShutdownHTTPError(t *testing.T) {
 
 	// Run gracefulShutdown in a separate goroutine.
 	go gracefulShutdown(
-		sigChan, mockGRPCServer, mockHTTPServer, mockPProfServer,
+		sigChan, mockGRPCServer, mockAdminGRPCServer, mockHTTPServer,
+		mockPProfServer,
 	)
 
 	// Simulate sending an interrupt signal.
@@ -105,6 +112,7 @@ func TestGracefulShutdownHTTPError(t *testing.T) {
 
 	// Assert that all expectations were met.
 	mockGRPCServer.AssertExpectations(t)
+	mockAdminGRPCServer.AssertExpectations(t)
 	mockHTTPServer.AssertExpectations(t)
 	mockPProfServer.AssertExpectations(t)
 }
@@ -117,11 +125,13 @@ func TestGracefulShutdownPProfError(t *testing.T) {
 
 	// Create mock servers.
 	mockGRPCServer := new(MockGRPCServer)
+	mockAdminGRPCServer := new(MockGRPCServer)
 	mockHTTPServer := new(MockHTTPServer)
 	mockPProfServer := new(MockHTTPServer)
 
 	// Setup expectations for the mock servers.
 	mockGRPCServer.On("GracefulStop").Return()
+	mockAdminGRPCServer.On("GracefulStop").Return()
 	mockHTTPServer.On("Shutdown", mock.Anything).Return(nil)
 	mockPProfServer.On("Shutdown", mock.Anything).Return(
 		fmt.Errorf("PProf shutdown error"),
@@ -132,7 +142,8 @@ func TestGracefulShutdownPProfError(t *testing.T) {
 
 	// Run gracefulShutdown in a separate goroutine.
 	go gracefulShutdown(
-		sigChan, mockGRPCServer, mockHTTPServer, mockPProfServer,
+		sigChan, mockGRPCServer, mockAdminGRPCServer, mockHTTPServer,
+		mockPProfServer,
 	)
 
 	// Simulate sending an interrupt signal.
@@ -143,6 +154,48 @@ func TestGracefulShutdownPProfError(t *testing.T) {
 
 	// Assert that all expectations were met.
 	mockGRPCServer.AssertExpectations(t)
+	mockAdminGRPCServer.AssertExpectations(t)
 	mockHTTPServer.AssertExpectations(t)
 	mockPProfServer.AssertExpectations(t)
 }
+
+// TestGracefulShutdownWithoutHTTPServers tests gracefulShutdown when the HTTP
+// and pprof servers were not started.
+func TestGracefulShutdownWithoutHTTPServers(t *testing.T) {
+	// Suppress log output by setting the output to io.Discard.
+	logrus.SetOutput(io.Discard)
+
+	// Create mock servers.
+	mockGRPCServer := new(MockGRPCServer)
+	mockAdminGRPCServer := new(MockGRPCServer)
+
+	// Setup expectations for the mock servers.
+	mockGRPCServer.On("GracefulStop").Return()
+	mockAdminGRPCServer.On("GracefulStop").Return()
+
+	// Create a signal channel.
+	sigChan := make(chan os.Signal, 1)
+	done := make(chan struct{})
+
+	// Run gracefulShutdown in a separate goroutine.
+	go func() {
+		gracefulShutdown(
+			sigChan, mockGRPCServer, mockAdminGRPCServer, nil, nil,
+		)
+		close(done)
+	}()
+
+	// Simulate sending an interrupt signal.
+	sigChan <- os.Interrupt
+
+	// Wait for the gracefulShutdown function to complete.
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("graceful shutdown did not complete")
+	}
+
+	// Assert that all expectations were met.
+	mockGRPCServer.AssertExpectations(t)
+	mockAdminGRPCServer.AssertExpectations(t)
+}
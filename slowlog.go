@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// operationRegister labels the storage of registered pairs.
+	operationRegister = "register"
+
+	// operationQuery labels the query of aggregated pairs.
+	operationQuery = "query"
+)
+
+// slowOperations counts the operations exceeding the slow operation
+// threshold by operation.
+var slowOperations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "slow_operations_total",
+		Help:      "Operations exceeding the slow operation threshold.",
+	},
+	[]string{"operation"},
+)
+
+func init() {
+	metricsRegistry.MustRegister(slowOperations)
+}
+
+// operationContext returns a context derived from the given one which is
+// canceled once the configured execution timeout elapses. Scans over the
+// database check the context regularly and abort once it is done.
+func (s *externalCoordinatorServer) operationContext(
+	ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.config.Server.OperationTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, s.config.Server.OperationTimeout)
+}
+
+// abortedQueryError maps the error of a query scan aborted because its
+// operation context is done to a timeout or cancellation status. Any other
+// error is returned as is.
+func (s *externalCoordinatorServer) abortedQueryError(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "query exceeded "+
+			"the execution timeout of %v",
+			s.config.Server.OperationTimeout)
+
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "query canceled")
+
+	default:
+		return err
+	}
+}
+
+// observeOperation logs and counts the operation started at the given time if
+// it exceeded the configured slow operation threshold.
+func (s *externalCoordinatorServer) observeOperation(operation string,
+	start time.Time, keysScanned int) {
+	threshold := s.config.Server.SlowOperationThreshold
+	duration := time.Since(start)
+	if threshold <= 0 || duration < threshold {
+		return
+	}
+
+	slowOperations.WithLabelValues(operation).Inc()
+	logrus.WithFields(logrus.Fields{
+		"operation":    operation,
+		"duration":     duration,
+		"keys_scanned": keysScanned,
+	}).Warn("Slow operation")
+}
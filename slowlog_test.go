@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestObserveOperation tests that only operations exceeding the slow
+// operation threshold are counted.
+func TestObserveOperation(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	server := &externalCoordinatorServer{config: config}
+	counter := slowOperations.WithLabelValues(operationQuery)
+	before := testutil.ToFloat64(counter)
+
+	// Slow operation logging is disabled.
+	server.observeOperation(operationQuery, time.Now().Add(-time.Hour), 1)
+	require.Equal(t, before, testutil.ToFloat64(counter))
+
+	config.Server.SlowOperationThreshold = time.Minute
+	server.observeOperation(operationQuery, time.Now(), 1)
+	require.Equal(t, before, testutil.ToFloat64(counter))
+
+	server.observeOperation(operationQuery, time.Now().Add(-time.Hour), 1)
+	require.Equal(t, before+1, testutil.ToFloat64(counter))
+}
+
+// TestOperationTimeout tests that registrations and queries scanning the
+// database are aborted once the execution timeout elapsed.
+func TestOperationTimeout(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	register := func() error {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		_, err := server.RegisterMissionControl(
+			context.Background(), &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    time.Now().Unix(),
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+			},
+		)
+		return err
+	}
+	require.NoError(t, register())
+
+	// The timeout elapses before the stored pair is scanned.
+	config.Server.OperationTimeout = time.Nanosecond
+	err = register()
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{},
+		&mockQueryAggregatedMissionControlServer{},
+	)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// Without a timeout both succeed again.
+	config.Server.OperationTimeout = 0
+	require.NoError(t, register())
+
+	stream := &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{}, stream,
+	)
+	require.NoError(t, err)
+	require.Len(t, stream.Responses[0].Pairs, 2)
+}
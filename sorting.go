@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sortedPair is a raw pair read from the database together with its rank in
+// the requested sort order.
+type sortedPair struct {
+	key   []byte
+	value []byte
+	rank  int64
+}
+
+// validateSortOrder validates the sort order of a query.
+func validateSortOrder(order ecrpc.SortOrder) error {
+	if _, ok := ecrpc.SortOrder_name[int32(order)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown sort "+
+			"order %d", order)
+	}
+
+	return nil
+}
+
+// sortRank returns the rank of the history in the given sort order, lower
+// ranks first.
+func sortRank(history *ecrpc.PairData, order ecrpc.SortOrder) int64 {
+	switch order {
+	case ecrpc.SortOrder_SORT_ORDER_FRESHNESS:
+		return -max(history.SuccessTime, history.FailTime)
+
+	case ecrpc.SortOrder_SORT_ORDER_FAILURE_AMOUNT:
+		if history.FailTime == 0 {
+			return math.MaxInt64
+		}
+		return history.FailAmtMsat
+
+	default:
+		return 0
+	}
+}
+
+// sortPairs sorts the given raw pairs, which must be ordered by key, in the
+// given sort order. Pairs ranking equal keep their order.
+func sortPairs(keys, values [][]byte, order ecrpc.SortOrder) error {
+	if order == ecrpc.SortOrder_SORT_ORDER_NODE_PUBKEY {
+		return nil
+	}
+
+	pairs := make([]sortedPair, 0, len(keys))
+	for i, k := range keys {
+		history, err := decodePairData(values[i])
+		if err != nil {
+			return status.Errorf(codes.DataLoss, "failed to "+
+				"decode history data: %v", err)
+		}
+
+		pairs = append(pairs, sortedPair{
+			key:   k,
+			value: values[i],
+			rank:  sortRank(history, order),
+		})
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].rank < pairs[j].rank
+	})
+
+	for i, pair := range pairs {
+		keys[i] = pair.key
+		values[i] = pair.value
+	}
+
+	return nil
+}
+
+// streamSortedPairs streams all pairs accepted by the filter in the given sort
+// order in chunks of the configured batch size. All pairs are read and sorted
+// before any of them is sent. The query is aborted once the configured
+// execution timeout elapses or the client goes away. It returns the number of
+// pairs sent.
+func (s *externalCoordinatorServer) streamSortedPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	order ecrpc.SortOrder,
+	filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	var keys, values [][]byte
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(DatabaseBucketName)).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			keys = append(keys, bytes.Clone(k))
+			values = append(values, bytes.Clone(v))
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		return 0, s.abortedQueryError(err)
+	}
+
+	if err := sortPairs(keys, values, order); err != nil {
+		return 0, err
+	}
+
+	return s.sendPairBatches(stream, keys, values)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQuerySortOrder tests that query results are returned in the requested
+// sort order.
+func TestQuerySortOrder(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 2
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// Register pairs updated at different times, with and without
+	// failures.
+	now := time.Now().Unix()
+	histories := []*ecrpc.PairData{
+		{SuccessTime: now - 30, SuccessAmtSat: 1, SuccessAmtMsat: 1000},
+		{FailTime: now - 10, FailAmtSat: 5, FailAmtMsat: 5000},
+		{FailTime: now - 20, FailAmtSat: 2, FailAmtMsat: 2000},
+		{SuccessTime: now, SuccessAmtSat: 1, SuccessAmtMsat: 1000},
+	}
+	var pairs []*ecrpc.PairHistory
+	for _, history := range histories {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History:  history,
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	// query returns the indexes of the registered pairs in the order they
+	// were returned.
+	query := func(req *ecrpc.QueryAggregatedMissionControlRequest) (
+		[]int, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(req, stream)
+
+		var order []int
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				for i, registered := range pairs {
+					if string(pair.NodeFrom) ==
+						string(registered.NodeFrom) {
+
+						order = append(order, i)
+					}
+				}
+			}
+		}
+
+		return order, err
+	}
+
+	order, err := query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SortOrder: ecrpc.SortOrder_SORT_ORDER_FRESHNESS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 1, 2, 0}, order)
+
+	order, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SortOrder: ecrpc.SortOrder_SORT_ORDER_FAILURE_AMOUNT,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 1}, order[:2])
+	require.ElementsMatch(t, []int{0, 3}, order[2:])
+
+	// Samples are sorted as well.
+	order, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SampleSize: uint32(len(pairs)),
+		SortOrder:  ecrpc.SortOrder_SORT_ORDER_FRESHNESS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 1, 2, 0}, order)
+
+	// Ranked results and unknown sort orders are rejected.
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SourceNode: pairs[0].NodeFrom,
+		SortOrder:  ecrpc.SortOrder_SORT_ORDER_FRESHNESS,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		SortOrder: ecrpc.SortOrder(42),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
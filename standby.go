@@ -0,0 +1,684 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// snapshotChunkSize is the maximum number of pairs sent in a single snapshot
+// chunk.
+const snapshotChunkSize = 1000
+
+var (
+	// standbyKey marks the coordinator as a warm standby within the
+	// metadata bucket.
+	standbyKey = []byte("standby")
+
+	// promotedKey marks the coordinator as a promoted standby within the
+	// metadata bucket, so that it does not fall back to being a standby
+	// when restarted with an outdated configuration.
+	promotedKey = []byte("promoted")
+)
+
+// standbySnapshots counts the snapshots shipped to the standby coordinator by
+// result.
+var standbySnapshots = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "standby",
+		Name:      "snapshots_total",
+		Help:      "Snapshots shipped to the standby coordinator.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	metricsRegistry.MustRegister(standbySnapshots)
+}
+
+// applyStandbyRole records whether the coordinator runs as a warm standby. A
+// promoted standby stays a primary coordinator even if standby mode is still
+// configured, since falling back to a standby after a takeover would reject
+// all registrations. Disabling standby mode clears the promotion, so the
+// coordinator can be made a standby again later on.
+func applyStandbyRole(tx *bbolt.Tx, standbyMode bool) error {
+	b := tx.Bucket([]byte(MetadataBucketName))
+	if !standbyMode {
+		if err := b.Delete(standbyKey); err != nil {
+			return err
+		}
+
+		return b.Delete(promotedKey)
+	}
+
+	if b.Get(promotedKey) != nil {
+		logrus.Warn("Coordinator was promoted from a standby, ignoring " +
+			"the standby mode. Disable it in the configuration.")
+		return nil
+	}
+
+	return b.Put(standbyKey, []byte{1})
+}
+
+// isStandby returns whether the coordinator runs as a warm standby.
+func isStandby(tx *bbolt.Tx) bool {
+	return tx.Bucket([]byte(MetadataBucketName)).Get(standbyKey) != nil
+}
+
+// checkAcceptsRegistrations rejects registrations while the coordinator runs
+// as a warm standby, since its data is replaced by the snapshots of the
+// primary coordinator.
+func (s *externalCoordinatorServer) checkAcceptsRegistrations() error {
+	var standby bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		standby = isStandby(tx)
+		return nil
+	})
+	if err != nil {
+		return status.Errorf(storageErrorCode(err), "failed to read "+
+			"coordinator role: %v", err)
+	}
+
+	if standby {
+		return status.Error(codes.Unavailable, "coordinator is a warm "+
+			"standby and does not accept registrations until it is "+
+			"promoted")
+	}
+
+	return nil
+}
+
+// validateSnapshotPair validates a pair received in a snapshot.
+func validateSnapshotPair(pair *ecadminrpc.SnapshotPair) error {
+	if err := validateSnapshotPairKey(pair.NodeFrom, pair.NodeTo); err != nil {
+		return err
+	}
+
+	if _, err := decodePairData(pair.Data); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid snapshot "+
+			"pair data: %v", err)
+	}
+
+	if _, err := decodeLatencySamples(pair.LatencySamples); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid snapshot "+
+			"latency samples: %v", err)
+	}
+
+	return nil
+}
+
+// validateSnapshotPairKey validates the nodes of a pair received in a
+// snapshot.
+func validateSnapshotPairKey(nodeFrom, nodeTo []byte) error {
+	if len(nodeFrom) != PubKeyCompressedSize ||
+		len(nodeTo) != PubKeyCompressedSize {
+
+		return status.Errorf(codes.InvalidArgument, "snapshot pair "+
+			"nodes must be exactly %d bytes", PubKeyCompressedSize)
+	}
+
+	return nil
+}
+
+// ApplySnapshot applies a snapshot streamed by the primary coordinator. The
+// whole snapshot is received before it is applied in a single transaction, so
+// an interrupted snapshot never leaves the standby with partial data.
+func (a *adminServer) ApplySnapshot(
+	stream ecadminrpc.ExternalCoordinatorAdmin_ApplySnapshotServer) error {
+	var (
+		full    bool
+		first   = true
+		pairs   []*ecadminrpc.SnapshotPair
+		removed []*ecadminrpc.SnapshotPairKey
+	)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			full = chunk.Full
+			first = false
+		}
+
+		for _, pair := range chunk.Pairs {
+			if err := validateSnapshotPair(pair); err != nil {
+				return err
+			}
+		}
+		for _, key := range chunk.Removed {
+			err := validateSnapshotPairKey(key.NodeFrom, key.NodeTo)
+			if err != nil {
+				return err
+			}
+		}
+
+		pairs = append(pairs, chunk.Pairs...)
+		removed = append(removed, chunk.Removed...)
+	}
+
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		// The role is checked within the transaction, so no snapshot
+		// is applied once the standby has been promoted.
+		if !isStandby(tx) {
+			return status.Error(codes.FailedPrecondition, "coordinator "+
+				"is not a standby, snapshots are only accepted "+
+				"until it is promoted")
+		}
+
+		// A full snapshot replaces all pairs together with their
+		// latency samples. The observations of the pairs are not
+		// shipped, so they are dropped as well.
+		if full {
+			buckets := []string{
+				DatabaseBucketName, LatencySamplesBucketName,
+				UpdateIndexBucketName, NodeIndexBucketName,
+				MerkleEntriesBucketName, MerkleLeavesBucketName,
+				PairObservationsBucketName,
+				PairSubmittersBucketName, JournalBucketName,
+			}
+			for _, bucket := range buckets {
+				err := tx.DeleteBucket([]byte(bucket))
+				if err != nil {
+					return err
+				}
+				_, err = tx.CreateBucket([]byte(bucket))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		latencyBucket := tx.Bucket([]byte(LatencySamplesBucketName))
+		for _, pair := range pairs {
+			key := pairKey(pair.NodeFrom, pair.NodeTo)
+			if err := putPair(tx, key, pair.Data); err != nil {
+				return err
+			}
+
+			var err error
+			if len(pair.LatencySamples) == 0 {
+				err = latencyBucket.Delete(key)
+			} else {
+				err = latencyBucket.Put(key, pair.LatencySamples)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, k := range removed {
+			key := pairKey(k.NodeFrom, k.NodeTo)
+			if err := deletePair(tx, key); err != nil {
+				return err
+			}
+			if err := latencyBucket.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		if err := bumpDatasetRevision(tx); err != nil {
+			return err
+		}
+
+		// Past revisions are not shipped, so they cannot be queried
+		// across a full snapshot, and neither can deltas.
+		if full {
+			if err := resetModifiedIndex(tx); err != nil {
+				return err
+			}
+
+			return resetRevisionLog(tx)
+		}
+
+		return nil
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return err
+	}
+	if err != nil {
+		msg := "failed to apply snapshot: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Infof("Applied snapshot with %d stored and %d removed pairs",
+		len(pairs), len(removed))
+
+	return stream.SendAndClose(&ecadminrpc.ApplySnapshotResponse{
+		PairsStored:  uint64(len(pairs)),
+		PairsRemoved: uint64(len(removed)),
+	})
+}
+
+// PromoteStandby promotes the warm standby coordinator to a primary one. The
+// promotion is persisted and takes effect immediately.
+func (a *adminServer) PromoteStandby(ctx context.Context,
+	req *ecadminrpc.PromoteStandbyRequest) (*ecadminrpc.PromoteStandbyResponse, error) {
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		if !isStandby(tx) {
+			return status.Error(codes.FailedPrecondition, "coordinator "+
+				"is not a standby")
+		}
+
+		b := tx.Bucket([]byte(MetadataBucketName))
+		if err := b.Delete(standbyKey); err != nil {
+			return err
+		}
+
+		return b.Put(promotedKey, []byte{1})
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, err
+	}
+	if err != nil {
+		msg := "failed to promote standby: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	logrus.Warn("Standby coordinator promoted to primary, disable the " +
+		"standby mode in the configuration")
+
+	return &ecadminrpc.PromoteStandbyResponse{}, nil
+}
+
+// snapshotShipper periodically ships snapshots of the mission control data to
+// a warm standby coordinator. It tracks the pairs changed since the previous
+// snapshot, so that only those are shipped. Before the first snapshot and the
+// one after a failed shipping, the standby is reconciled through the Merkle
+// tree over the pairs, since it may have missed changes.
+type snapshotShipper struct {
+	conn   *grpc.ClientConn
+	client ecadminrpc.ExternalCoordinatorAdminClient
+	config *ServerConfig
+	db     *bbolt.DB
+
+	mu        sync.Mutex
+	full      bool
+	reconcile bool
+	dirty     map[string]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSnapshotShipper creates a shipper to the standby coordinator configured
+// in the given server configuration and starts shipping snapshots to it.
+func newSnapshotShipper(config *ServerConfig,
+	db *bbolt.DB) (*snapshotShipper, error) {
+	creds := credentials.NewTLS(&tls.Config{})
+	if config.StandbyTLSCertFile != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(
+			config.StandbyTLSCertFile, "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load standby TLS "+
+				"certificate: %v", err)
+		}
+	}
+
+	// The admin server of the standby only serves requests carrying its
+	// admin macaroon.
+	if config.StandbyAdminMacaroonPath == "" {
+		return nil, fmt.Errorf("standby_admin_macaroon_path is " +
+			"required to ship snapshots")
+	}
+	macaroon, err := newAdminMacaroonCredential(
+		config.StandbyAdminMacaroonPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(
+		config.StandbyTarget, grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(macaroon),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create standby client: %v",
+			err)
+	}
+
+	p := &snapshotShipper{
+		conn:      conn,
+		client:    ecadminrpc.NewExternalCoordinatorAdminClient(conn),
+		config:    config,
+		db:        db,
+		reconcile: true,
+		dirty:     make(map[string]struct{}),
+		quit:      make(chan struct{}),
+	}
+
+	p.wg.Add(1)
+	go p.run()
+
+	return p, nil
+}
+
+// markDirty records that the pairs with the given keys changed, so that they
+// are part of the next snapshot.
+func (p *snapshotShipper) markDirty(keys ...[]byte) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, key := range keys {
+		p.dirty[string(key)] = struct{}{}
+	}
+}
+
+// markFull records that the next snapshot must contain all pairs, e.g. since
+// all pairs were replaced.
+func (p *snapshotShipper) markFull() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.full = true
+}
+
+// run ships a snapshot on every interval and reconciles the standby on every
+// reconcile interval until the shipper is stopped. A final snapshot is shipped
+// when stopping, so the standby is up to date after a graceful shutdown.
+func (p *snapshotShipper) run() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.SnapshotInterval)
+	defer ticker.Stop()
+
+	var reconcileTicks <-chan time.Time
+	if p.config.StandbyReconcileInterval > 0 {
+		reconcileTicker := time.NewTicker(
+			p.config.StandbyReconcileInterval,
+		)
+		defer reconcileTicker.Stop()
+		reconcileTicks = reconcileTicker.C
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-reconcileTicks:
+			p.mu.Lock()
+			p.reconcile = true
+			p.mu.Unlock()
+		case <-p.quit:
+			if err := p.ship(); err != nil {
+				logrus.Errorf("Failed to ship final snapshot to "+
+					"standby coordinator: %v", err)
+			}
+			return
+		}
+
+		if err := p.ship(); err != nil {
+			logrus.Errorf("Failed to ship snapshot to standby "+
+				"coordinator: %v", err)
+		}
+	}
+}
+
+// ship ships the pairs changed since the previous snapshot, or all pairs if a
+// full snapshot is due, to the standby coordinator. If the standby is due to
+// be reconciled, the pairs found to differ are shipped as well.
+func (p *snapshotShipper) ship() error {
+	p.mu.Lock()
+	full, reconcile, dirty := p.full, p.reconcile, p.dirty
+	p.full, p.reconcile = false, false
+	p.dirty = make(map[string]struct{})
+	p.mu.Unlock()
+
+	if !full && !reconcile && len(dirty) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), p.config.SnapshotTimeout,
+	)
+	defer cancel()
+
+	var err error
+	if reconcile && !full {
+		var keys [][]byte
+		keys, full, err = p.reconcilePairs(ctx)
+		for _, key := range keys {
+			dirty[string(key)] = struct{}{}
+		}
+		if err == nil && !full && len(dirty) == 0 {
+			standbySnapshots.WithLabelValues("reconciled").Inc()
+			return nil
+		}
+	}
+
+	var resp *ecadminrpc.ApplySnapshotResponse
+	var stream ecadminrpc.ExternalCoordinatorAdmin_ApplySnapshotClient
+	if err == nil {
+		stream, err = p.client.ApplySnapshot(ctx)
+	}
+	if err == nil {
+		if full {
+			err = p.sendFull(stream)
+		} else {
+			err = p.sendIncremental(stream, dirty)
+		}
+
+		// A failed send only reports that the stream was closed, the
+		// actual error is returned when receiving the response.
+		if err == nil || errors.Is(err, io.EOF) {
+			resp, err = stream.CloseAndRecv()
+		}
+	}
+	// The standby may have missed the changes of a failed snapshot, so it
+	// is reconciled before the next one.
+	if err != nil {
+		p.mu.Lock()
+		p.reconcile = true
+		for key := range dirty {
+			p.dirty[key] = struct{}{}
+		}
+		p.mu.Unlock()
+
+		standbySnapshots.WithLabelValues("failed").Inc()
+		return err
+	}
+
+	result := "incremental"
+	if full {
+		result = "full"
+	}
+	standbySnapshots.WithLabelValues(result).Inc()
+	logrus.Debugf("Shipped %s snapshot with %d stored and %d removed "+
+		"pairs to standby coordinator", result, resp.PairsStored,
+		resp.PairsRemoved)
+
+	return nil
+}
+
+// sendFull sends all pairs in chunks. Each chunk is read in its own
+// transaction, so that shipping does not hold a long-running read transaction.
+// Pairs changed meanwhile are marked dirty and shipped with the next snapshot.
+func (p *snapshotShipper) sendFull(
+	stream ecadminrpc.ExternalCoordinatorAdmin_ApplySnapshotClient) error {
+	var last []byte
+	for {
+		chunk := &ecadminrpc.SnapshotChunk{Full: last == nil}
+		err := p.db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(DatabaseBucketName))
+			latencyBucket := tx.Bucket(
+				[]byte(LatencySamplesBucketName),
+			)
+
+			c := b.Cursor()
+			k, v := c.First()
+			if last != nil {
+				k, v = c.Seek(last)
+				if bytes.Equal(k, last) {
+					k, v = c.Next()
+				}
+			}
+			for ; k != nil; k, v = c.Next() {
+				chunk.Pairs = append(chunk.Pairs, snapshotPair(
+					k, v, latencyBucket.Get(k),
+				))
+				if len(chunk.Pairs) == snapshotChunkSize {
+					break
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// The first chunk is sent even if there are no pairs, so the
+		// standby drops all of its pairs.
+		if len(chunk.Pairs) == 0 && last != nil {
+			return nil
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		if len(chunk.Pairs) < snapshotChunkSize {
+			return nil
+		}
+
+		lastPair := chunk.Pairs[len(chunk.Pairs)-1]
+		last = pairKey(lastPair.NodeFrom, lastPair.NodeTo)
+	}
+}
+
+// sendIncremental sends the pairs with the given keys in chunks. Pairs no
+// longer stored are sent as removed.
+func (p *snapshotShipper) sendIncremental(
+	stream ecadminrpc.ExternalCoordinatorAdmin_ApplySnapshotClient,
+	dirty map[string]struct{}) error {
+	keys := make([][]byte, 0, len(dirty))
+	for key := range dirty {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	for start := 0; start < len(keys); start += snapshotChunkSize {
+		end := min(start+snapshotChunkSize, len(keys))
+
+		chunk := &ecadminrpc.SnapshotChunk{}
+		err := p.db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(DatabaseBucketName))
+			latencyBucket := tx.Bucket(
+				[]byte(LatencySamplesBucketName),
+			)
+
+			for _, key := range keys[start:end] {
+				v := b.Get(key)
+				if v == nil {
+					nodeFrom, nodeTo := splitPairKey(key)
+					chunk.Removed = append(
+						chunk.Removed,
+						&ecadminrpc.SnapshotPairKey{
+							NodeFrom: nodeFrom,
+							NodeTo:   nodeTo,
+						},
+					)
+					continue
+				}
+
+				chunk.Pairs = append(chunk.Pairs, snapshotPair(
+					key, v, latencyBucket.Get(key),
+				))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotPair creates a snapshot pair from the stored key, value and latency
+// samples. They are copied since they are only valid for the lifetime of the
+// transaction.
+func snapshotPair(key, value, latencySamples []byte) *ecadminrpc.SnapshotPair {
+	nodeFrom, nodeTo := splitPairKey(key)
+	return &ecadminrpc.SnapshotPair{
+		NodeFrom:       bytes.Clone(nodeFrom),
+		NodeTo:         bytes.Clone(nodeTo),
+		Data:           bytes.Clone(value),
+		LatencySamples: bytes.Clone(latencySamples),
+	}
+}
+
+// stop ships a final snapshot, stops shipping and closes the connection to the
+// standby coordinator.
+func (p *snapshotShipper) stop() error {
+	close(p.quit)
+	p.wg.Wait()
+
+	return p.conn.Close()
+}
+
+// StartSnapshotShipping starts shipping periodic snapshots to the configured
+// standby coordinator.
+func (s *externalCoordinatorServer) StartSnapshotShipping() error {
+	if s.config.Server.StandbyMode {
+		return errors.New("a standby coordinator cannot ship snapshots " +
+			"to another standby")
+	}
+
+	snapshots, err := newSnapshotShipper(&s.config.Server, s.db)
+	if err != nil {
+		return err
+	}
+	s.snapshots = snapshots
+
+	logrus.Infof("Shipping snapshots to standby coordinator %s every %v",
+		s.config.Server.StandbyTarget, s.config.Server.SnapshotInterval)
+
+	return nil
+}
+
+// StopSnapshotShipping ships a final snapshot and stops shipping if it is
+// running.
+func (s *externalCoordinatorServer) StopSnapshotShipping() error {
+	if s.snapshots == nil {
+		return nil
+	}
+
+	return s.snapshots.stop()
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storedPairKeys returns the keys of all pairs stored in the database.
+func storedPairKeys(t *testing.T, db *bbolt.DB) []string {
+	var keys []string
+	err := db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		return b.ForEach(func(k, v []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	return keys
+}
+
+// TestStandbySnapshotShipping tests that snapshots are shipped to a warm
+// standby coordinator, which rejects registrations until it is promoted.
+func TestStandbySnapshotShipping(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Start the admin server of the standby coordinator.
+	standbyConfig := MockConfig(filepath.Join(tempDir, "standby"))
+	standbyConfig.Server.StandbyMode = true
+	standbyConfig.Server.HistoryThresholdDuration = time.Hour
+	standbyDB, err := setupDatabase(standbyConfig)
+	require.NoError(t, err)
+	defer cleanupDB(standbyDB)
+
+	admin := NewAdminServer(standbyConfig, standbyDB)
+	standbyAddr, certFile, macaroonPath := startAdminTestServer(t, admin)
+
+	// Start the primary coordinator shipping to the standby.
+	config := MockConfig(filepath.Join(tempDir, "primary"))
+	config.Server.StandbyTarget = standbyAddr
+	config.Server.StandbyTLSCertFile = certFile
+	config.Server.StandbyAdminMacaroonPath = macaroonPath
+	config.Server.SnapshotInterval = time.Hour
+	config.Server.SnapshotTimeout = 10 * time.Second
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartSnapshotShipping())
+
+	register := func(server *externalCoordinatorServer, nodeFrom,
+		nodeTo []byte, resultTime time.Time) error {
+		_, err := server.RegisterMissionControl(
+			context.Background(), &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:         resultTime.Unix(),
+						SuccessAmtSat:       1,
+						SuccessAmtMsat:      1000,
+						ResolutionLatencyMs: 100,
+					},
+				}},
+			},
+		)
+		return err
+	}
+
+	// The first snapshot contains all pairs together with their latency
+	// samples.
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	require.NoError(t, register(server, nodeA, nodeB, time.Now()))
+	require.NoError(t, register(
+		server, nodeC, nodeD, time.Now().Add(-30*time.Minute),
+	))
+	require.NoError(t, server.snapshots.ship())
+	require.Equal(t, storedPairKeys(t, db), storedPairKeys(t, standbyDB))
+	err = standbyDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(LatencySamplesBucketName))
+		samples, err := decodeLatencySamples(
+			b.Get(pairKey(nodeA, nodeB)),
+		)
+		require.Equal(t, []uint32{100}, samples)
+		return err
+	})
+	require.NoError(t, err)
+
+	// Pairs removed as stale are removed from the standby as well.
+	config.Server.HistoryThresholdDuration = time.Minute
+	server.cleanupStaleData()
+	require.Len(t, storedPairKeys(t, db), 1)
+	require.NoError(t, server.snapshots.ship())
+	require.Equal(t, storedPairKeys(t, db), storedPairKeys(t, standbyDB))
+
+	// The standby rejects registrations.
+	standby := NewExternalCoordinatorServer(standbyConfig, standbyDB)
+	err = register(standby, nodeC, nodeD, time.Now())
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Once promoted, the standby accepts registrations but no snapshots.
+	_, err = admin.PromoteStandby(
+		context.Background(), &ecadminrpc.PromoteStandbyRequest{},
+	)
+	require.NoError(t, err)
+	require.NoError(t, register(standby, nodeC, nodeD, time.Now()))
+
+	require.NoError(t, register(server, nodeB, nodeC, time.Now()))
+	err = server.snapshots.ship()
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Promoting twice fails.
+	_, err = admin.PromoteStandby(
+		context.Background(), &ecadminrpc.PromoteStandbyRequest{},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(t, server.StopSnapshotShipping())
+}
+
+// TestStandbySnapshotShippingAuthentication tests that snapshots are only
+// shipped with the admin macaroon of the standby coordinator.
+func TestStandbySnapshotShippingAuthentication(t *testing.T) {
+	tempDir := t.TempDir()
+
+	standbyConfig := MockConfig(filepath.Join(tempDir, "standby"))
+	standbyConfig.Server.StandbyMode = true
+	standbyDB, err := setupDatabase(standbyConfig)
+	require.NoError(t, err)
+	defer cleanupDB(standbyDB)
+
+	standbyAddr, certFile, _ := startAdminTestServer(
+		t, NewAdminServer(standbyConfig, standbyDB),
+	)
+
+	// The admin macaroon of another coordinator.
+	otherConfig := MockConfig(filepath.Join(tempDir, "other"))
+	otherDB, err := setupDatabase(otherConfig)
+	require.NoError(t, err)
+	defer cleanupDB(otherDB)
+
+	_, _, otherMacaroonPath := startAdminTestServer(
+		t, NewAdminServer(otherConfig, otherDB),
+	)
+
+	config := MockConfig(filepath.Join(tempDir, "primary"))
+	config.Server.StandbyTarget = standbyAddr
+	config.Server.StandbyTLSCertFile = certFile
+	config.Server.SnapshotInterval = time.Hour
+	config.Server.SnapshotTimeout = 10 * time.Second
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	// Shipping refuses to start without the admin macaroon.
+	server := NewExternalCoordinatorServer(config, db)
+	require.Error(t, server.StartSnapshotShipping())
+
+	// A macaroon the standby did not create is rejected.
+	config.Server.StandbyAdminMacaroonPath = otherMacaroonPath
+	require.NoError(t, server.StartSnapshotShipping())
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeA,
+				NodeTo:   nodeB,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtSat:  1,
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	err = server.snapshots.ship()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Empty(t, storedPairKeys(t, standbyDB))
+
+	require.NoError(t, server.StopSnapshotShipping())
+}
+
+// TestApplyStandbyRole tests that the promotion of a standby survives restarts
+// with an outdated configuration and is cleared once standby mode is
+// disabled.
+func TestApplyStandbyRole(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	role := func(standbyMode bool) bool {
+		var standby bool
+		err := db.Update(func(tx *bbolt.Tx) error {
+			if err := applyStandbyRole(tx, standbyMode); err != nil {
+				return err
+			}
+			standby = isStandby(tx)
+			return nil
+		})
+		require.NoError(t, err)
+
+		return standby
+	}
+
+	require.False(t, role(false))
+	require.True(t, role(true))
+
+	// A promoted standby stays a primary coordinator.
+	admin := NewAdminServer(config, db)
+	_, err = admin.PromoteStandby(
+		context.Background(), &ecadminrpc.PromoteStandbyRequest{},
+	)
+	require.NoError(t, err)
+	require.False(t, role(true))
+
+	// Disabling standby mode allows becoming a standby again.
+	require.False(t, role(false))
+	require.True(t, role(true))
+}
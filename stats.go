@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// freshnessBucketAges are the maximum ages of the pairs counted by the
+// freshness buckets of the statistics. Older pairs are counted by a last
+// bucket.
+var freshnessBucketAges = []time.Duration{
+	time.Hour, 24 * time.Hour, 7 * 24 * time.Hour,
+}
+
+// failureRate returns the fraction of the pairs which failed, zero if there
+// are no pairs.
+func failureRate(failed, pairs uint64) float64 {
+	if pairs == 0 {
+		return 0
+	}
+
+	return float64(failed) / float64(pairs)
+}
+
+// checkServesPairs returns an error if the coordinator withholds the data of
+// the pairs and only serves aggregate statistics.
+func (s *externalCoordinatorServer) checkServesPairs() error {
+	if s.config.Server.StatsOnly {
+		return status.Error(codes.PermissionDenied, "coordinator only "+
+			"serves aggregate statistics")
+	}
+
+	return nil
+}
+
+// GetStats returns aggregate statistics of the mission control data. Pairs
+// whose most recent result is a failure are counted as failed, and the node
+// groups defined by the operator serve as the regions of the graph.
+func (s *externalCoordinatorServer) GetStats(ctx context.Context,
+	req *ecrpc.GetStatsRequest) (*ecrpc.GetStatsResponse, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(ctx)
+	defer cancel()
+
+	now := s.clock.Now()
+	resp := &ecrpc.GetStatsResponse{
+		Freshness: make(
+			[]*ecrpc.FreshnessBucket, len(freshnessBucketAges)+1,
+		),
+	}
+	for i := range resp.Freshness {
+		resp.Freshness[i] = &ecrpc.FreshnessBucket{}
+		if i < len(freshnessBucketAges) {
+			resp.Freshness[i].MaxAgeSeconds = int64(
+				freshnessBucketAges[i].Seconds(),
+			)
+		}
+	}
+
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		// Load the members of all node groups, ordered by name.
+		var groups []nodeGroupMembers
+		err := tx.Bucket([]byte(NodeGroupsBucketName)).ForEach(
+			func(name, _ []byte) error {
+				members, err := loadNodeGroup(tx, string(name))
+				if err != nil {
+					return err
+				}
+				groups = append(groups, members)
+				resp.Regions = append(
+					resp.Regions, &ecrpc.RegionStats{
+						Group: string(name),
+					},
+				)
+
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		c := tx.Bucket([]byte(DatabaseBucketName)).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			history, err := decodePairData(v)
+			if err != nil {
+				return status.Errorf(codes.DataLoss, "failed to "+
+					"decode history data: %v", err)
+			}
+			failed := history.FailTime > history.SuccessTime
+
+			resp.TotalPairs++
+			if failed {
+				resp.FailedPairs++
+			}
+			if history.ConflictingReports > 0 {
+				resp.ConflictingPairs++
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+
+			updated := time.Unix(mostRecentUnixTimestamp(
+				history.FailTime, history.SuccessTime,
+			), 0)
+			bucket := len(freshnessBucketAges)
+			for i, age := range freshnessBucketAges {
+				if now.Sub(updated) < age {
+					bucket = i
+					break
+				}
+			}
+			resp.Freshness[bucket].Pairs++
+
+			for i, members := range groups {
+				if !members.contains(nodeFrom) &&
+					!members.contains(nodeTo) {
+
+					continue
+				}
+
+				resp.Regions[i].Pairs++
+				if failed {
+					resp.Regions[i].FailedPairs++
+				}
+			}
+		}
+		resp.Nodes = countNodes(tx)
+		resp.NodesSeenEstimate = estimateNodesSeen(tx)
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		err = s.abortedQueryError(err)
+		switch status.Code(err) {
+		case codes.DeadlineExceeded, codes.Canceled:
+			return nil, err
+		}
+
+		msg := "failed to compute statistics: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+
+	resp.FailureRate = failureRate(resp.FailedPairs, resp.TotalPairs)
+	for _, region := range resp.Regions {
+		region.FailureRate = failureRate(region.FailedPairs, region.Pairs)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetStats tests that the statistics aggregate the pairs by outcome,
+// freshness and node group, and that stats-only coordinators withhold the
+// pairs themselves.
+func TestGetStats(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	now := time.Unix(1700000000, 0)
+	server.clock = newManualClock(now)
+	admin := NewAdminServer(config, db)
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	pairs := []struct {
+		nodeFrom, nodeTo []byte
+		history          *ecrpc.PairData
+	}{
+		{nodeA, nodeB, &ecrpc.PairData{
+			SuccessTime: now.Add(-time.Minute).Unix(),
+		}},
+		{nodeA, nodeC, &ecrpc.PairData{
+			SuccessTime: now.Add(-48 * time.Hour).Unix(),
+			FailTime:    now.Add(-2 * time.Hour).Unix(),
+		}},
+		{nodeC, nodeD, &ecrpc.PairData{
+			FailTime: now.Add(-30 * 24 * time.Hour).Unix(),
+		}},
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, pair := range pairs {
+			value, err := encodePairData(pair.history)
+			if err != nil {
+				return err
+			}
+			key := append(
+				append([]byte(nil), pair.nodeFrom...), pair.nodeTo...,
+			)
+			if err := putPair(tx, key, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = admin.SetNodeGroup(ctx, &ecadminrpc.SetNodeGroupRequest{
+		Group: &ecadminrpc.NodeGroup{
+			Name:  "europe",
+			Nodes: [][]byte{nodeD},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := server.GetStats(ctx, &ecrpc.GetStatsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, resp.TotalPairs)
+	require.EqualValues(t, 4, resp.Nodes)
+	require.EqualValues(t, 2, resp.FailedPairs)
+	require.InDelta(t, 2.0/3, resp.FailureRate, 1e-9)
+
+	var freshness []uint64
+	for _, bucket := range resp.Freshness {
+		freshness = append(freshness, bucket.Pairs)
+	}
+	require.Equal(t, []uint64{1, 1, 0, 1}, freshness)
+	require.EqualValues(t, 0, resp.Freshness[3].MaxAgeSeconds)
+
+	require.Len(t, resp.Regions, 1)
+	require.Equal(t, "europe", resp.Regions[0].Group)
+	require.EqualValues(t, 1, resp.Regions[0].Pairs)
+	require.EqualValues(t, 1, resp.Regions[0].FailedPairs)
+	require.Equal(t, 1.0, resp.Regions[0].FailureRate)
+
+	// Stats-only coordinators keep serving statistics, but withhold the
+	// pairs.
+	config.Server.StatsOnly = true
+	_, err = server.GetStats(ctx, &ecrpc.GetStatsRequest{})
+	require.NoError(t, err)
+
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{},
+		&mockQueryAggregatedMissionControlServer{},
+	)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
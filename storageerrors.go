@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storageErrorCode returns the gRPC status code matching the cause of a failed
+// storage operation, so that clients can decide whether retrying makes sense:
+//
+//   - Unavailable if the database is locked by another process or closed,
+//     which is usually resolved by retrying later.
+//   - ResourceExhausted if the disk is full.
+//   - DataLoss if the stored data is corrupted, which retrying won't fix.
+//   - DeadlineExceeded and Canceled if the operation was aborted.
+//
+// Errors already carrying a status code keep it, any other error is mapped to
+// Internal.
+func storageErrorCode(err error) codes.Code {
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		return s.Code()
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+
+	case errors.Is(err, bbolt.ErrTimeout),
+		errors.Is(err, bbolt.ErrDatabaseNotOpen):
+		return codes.Unavailable
+
+	case isDiskFull(err):
+		return codes.ResourceExhausted
+
+	case errors.Is(err, bbolt.ErrInvalid),
+		errors.Is(err, bbolt.ErrVersionMismatch),
+		errors.Is(err, bbolt.ErrChecksum),
+		errors.Is(err, errCorruptPairData),
+		errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return codes.DataLoss
+
+	default:
+		return codes.Internal
+	}
+}
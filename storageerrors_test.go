@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestStorageErrorCode tests mapping storage errors to status codes.
+func TestStorageErrorCode(t *testing.T) {
+	syntaxErr := json.Unmarshal([]byte("{"), &ecrpc.PairData{})
+	require.Error(t, syntaxErr)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{
+			name:     "LockTimeout",
+			err:      bbolt.ErrTimeout,
+			expected: codes.Unavailable,
+		},
+		{
+			name:     "DatabaseClosed",
+			err:      fmt.Errorf("batch: %w", bbolt.ErrDatabaseNotOpen),
+			expected: codes.Unavailable,
+		},
+		{
+			name: "DiskFull",
+			err: &os.PathError{
+				Op: "write", Path: "wal", Err: syscall.ENOSPC,
+			},
+			expected: codes.ResourceExhausted,
+		},
+		{
+			name:     "Corruption",
+			err:      bbolt.ErrChecksum,
+			expected: codes.DataLoss,
+		},
+		{
+			name:     "CorruptedValue",
+			err:      syntaxErr,
+			expected: codes.DataLoss,
+		},
+		{
+			name:     "Timeout",
+			err:      context.DeadlineExceeded,
+			expected: codes.DeadlineExceeded,
+		},
+		{
+			name:     "Status",
+			err:      status.Error(codes.NotFound, "not found"),
+			expected: codes.NotFound,
+		},
+		{
+			name:     "Other",
+			err:      errors.New("other"),
+			expected: codes.Internal,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, storageErrorCode(tc.err))
+		})
+	}
+}
+
+// TestStorageErrorStatus tests the status codes returned to clients for
+// corrupted data and an unavailable database.
+func TestStorageErrorStatus(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// Corrupt the stored data of a pair.
+	nodeFrom, nodeTo := generateTestKeys(t)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(DatabaseBucketName)).Put(
+			pairKey(nodeFrom, nodeTo), []byte("{"),
+		)
+	})
+	require.NoError(t, err)
+
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{},
+		&mockQueryAggregatedMissionControlServer{},
+	)
+	require.Equal(t, codes.DataLoss, status.Code(err))
+
+	req := &ecrpc.RegisterMissionControlRequest{
+		Pairs: []*ecrpc.PairHistory{{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}},
+	}
+	_, err = server.RegisterMissionControl(context.Background(), req)
+	require.Equal(t, codes.DataLoss, status.Code(err))
+
+	// Registrations fail as unavailable once the database is closed.
+	cleanupDB(db)
+	_, err = server.RegisterMissionControl(context.Background(), req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
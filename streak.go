@@ -0,0 +1,43 @@
+package main
+
+import (
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// updateFailureStreak updates the failure streak and the success gap of the
+// aggregated pair data after a merge. The previous failure and success times
+// are the ones the pair data had before the merge, or zero if the pair is new.
+//
+// A failure more recent than both the previous failure and the last success
+// extends the streak, while a success more recent than the last failure resets
+// it. This lets clients distinguish one-off failures from persistently broken
+// channels.
+func updateFailureStreak(data *ecrpc.PairData, prevFailTime,
+	prevSuccessTime int64) {
+
+	newFailure := data.FailTime > prevFailTime
+	newSuccess := data.SuccessTime > prevSuccessTime
+
+	switch {
+	// A new failure after the last success extends the streak. If a new
+	// success was recorded in the same merge the streak starts over.
+	case newFailure && data.FailTime > data.SuccessTime:
+		if newSuccess {
+			data.FailureStreak = 1
+		} else {
+			data.FailureStreak++
+		}
+
+	// A new success that is at least as recent as the last failure ends
+	// the streak.
+	case newSuccess && data.SuccessTime >= data.FailTime:
+		data.FailureStreak = 0
+	}
+
+	// The success gap is only meaningful while the pair is failing and
+	// has succeeded at least once before.
+	data.SuccessGapSeconds = 0
+	if data.FailTime > data.SuccessTime && data.SuccessTime != 0 {
+		data.SuccessGapSeconds = data.FailTime - data.SuccessTime
+	}
+}
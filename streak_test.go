@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+)
+
+// TestUpdateFailureStreak tests the failure streak and success gap updates
+// applied after merging pair data.
+func TestUpdateFailureStreak(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            *ecrpc.PairData
+		prevFailTime    int64
+		prevSuccessTime int64
+		expectedStreak  uint32
+		expectedGap     int64
+	}{
+		{
+			name:           "NewPairWithFailure",
+			data:           &ecrpc.PairData{FailTime: 100},
+			expectedStreak: 1,
+		},
+		{
+			name: "NewPairWithSuccess",
+			data: &ecrpc.PairData{SuccessTime: 100},
+		},
+		{
+			name: "FailureExtendsStreak",
+			data: &ecrpc.PairData{
+				FailTime:      200,
+				SuccessTime:   50,
+				FailureStreak: 2,
+			},
+			prevFailTime:    100,
+			prevSuccessTime: 50,
+			expectedStreak:  3,
+			expectedGap:     150,
+		},
+		{
+			name: "SuccessResetsStreak",
+			data: &ecrpc.PairData{
+				FailTime:      100,
+				SuccessTime:   200,
+				FailureStreak: 5,
+			},
+			prevFailTime:    100,
+			prevSuccessTime: 50,
+		},
+		{
+			name: "SuccessAndLaterFailureRestartsStreak",
+			data: &ecrpc.PairData{
+				FailTime:      300,
+				SuccessTime:   200,
+				FailureStreak: 5,
+			},
+			prevFailTime:    100,
+			prevSuccessTime: 50,
+			expectedStreak:  1,
+			expectedGap:     100,
+		},
+		{
+			name: "OlderResultsKeepStreak",
+			data: &ecrpc.PairData{
+				FailTime:      300,
+				SuccessTime:   200,
+				FailureStreak: 4,
+			},
+			prevFailTime:    300,
+			prevSuccessTime: 200,
+			expectedStreak:  4,
+			expectedGap:     100,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updateFailureStreak(
+				tc.data, tc.prevFailTime, tc.prevSuccessTime,
+			)
+			require.Equal(t, tc.expectedStreak, tc.data.FailureStreak)
+			require.Equal(t, tc.expectedGap, tc.data.SuccessGapSeconds)
+		})
+	}
+}
+
+// TestRegisterMissionControlFailureStreak tests that consecutive failures
+// registered for a pair are exposed in query responses.
+func TestRegisterMissionControlFailureStreak(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 100
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	nodeFrom, nodeTo := generateTestKeys(t)
+	now := time.Now()
+
+	register := func(history *ecrpc.PairData) {
+		_, err := server.RegisterMissionControl(
+			context.Background(),
+			&ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History:  history,
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// Register a success followed by three failures.
+	successTime := now.Add(-10 * time.Minute).Unix()
+	register(&ecrpc.PairData{
+		SuccessTime:    successTime,
+		SuccessAmtMsat: 1000,
+	})
+	for i := 3; i > 0; i-- {
+		failTime := now.Add(-time.Duration(i) * time.Minute)
+		register(&ecrpc.PairData{
+			FailTime:    failTime.Unix(),
+			FailAmtMsat: 2000,
+		})
+	}
+
+	mockStream := &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{}, mockStream,
+	)
+	require.NoError(t, err)
+	require.Len(t, mockStream.Responses, 1)
+	require.Len(t, mockStream.Responses[0].Pairs, 1)
+
+	history := mockStream.Responses[0].Pairs[0].History
+	require.Equal(t, uint32(3), history.FailureStreak)
+	require.Equal(
+		t, now.Add(-time.Minute).Unix()-successTime,
+		history.SuccessGapSeconds,
+	)
+}
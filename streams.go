@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcActiveStreams tracks the number of streaming RPCs currently being
+// served.
+var grpcActiveStreams = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: metricsNamespace,
+	Subsystem: "grpc",
+	Name:      "active_streams",
+	Help:      "Number of streaming RPCs currently being served.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(grpcActiveStreams)
+}
+
+// activeStream is a streaming RPC currently being served.
+type activeStream struct {
+	id        uint64
+	method    string
+	client    string
+	requestID string
+	started   time.Time
+
+	messages atomic.Uint64
+	bytes    atomic.Uint64
+
+	// cancel cancels the context of the stream.
+	cancel context.CancelFunc
+}
+
+// streamTracker tracks the streaming RPCs currently being served, so that
+// operators can see what keeps the coordinator from draining its connections
+// and cancel runaway streams. Like the volumes of the clients, the streams are
+// only kept in memory.
+type streamTracker struct {
+	mu      sync.Mutex
+	nextID  uint64
+	streams map[uint64]*activeStream
+}
+
+// newStreamTracker creates an empty stream tracker.
+func newStreamTracker() *streamTracker {
+	return &streamTracker{streams: make(map[uint64]*activeStream)}
+}
+
+// add tracks a new stream and returns it.
+func (t *streamTracker) add(ctx context.Context, method string,
+	cancel context.CancelFunc) *activeStream {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	stream := &activeStream{
+		id:        t.nextID,
+		method:    method,
+		client:    clientIdentity(ctx),
+		requestID: requestIDFromContext(ctx),
+		started:   time.Now(),
+		cancel:    cancel,
+	}
+	t.streams[stream.id] = stream
+	grpcActiveStreams.Inc()
+
+	return stream
+}
+
+// remove stops tracking the stream with the given ID.
+func (t *streamTracker) remove(id uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.streams[id]; ok {
+		delete(t.streams, id)
+		grpcActiveStreams.Dec()
+	}
+}
+
+// list returns the tracked streams, oldest first.
+func (t *streamTracker) list() []*ecadminrpc.ActiveStream {
+	t.mu.Lock()
+	streams := make([]*ecadminrpc.ActiveStream, 0, len(t.streams))
+	for _, s := range t.streams {
+		streams = append(streams, &ecadminrpc.ActiveStream{
+			Id:           s.id,
+			Method:       s.method,
+			Client:       s.client,
+			RequestId:    s.requestID,
+			StartTime:    s.started.Unix(),
+			MessagesSent: s.messages.Load(),
+			BytesSent:    s.bytes.Load(),
+		})
+	}
+	t.mu.Unlock()
+
+	// The IDs are assigned in the order the streams started.
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].Id < streams[j].Id
+	})
+
+	return streams
+}
+
+// cancel cancels the stream with the given ID. It returns false if there is
+// no such stream.
+func (t *streamTracker) cancel(id uint64) bool {
+	t.mu.Lock()
+	stream, ok := t.streams[id]
+	t.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	stream.cancel()
+
+	return true
+}
+
+// trackedServerStream counts the messages sent on a tracked stream and
+// exposes its cancellable context to the handler.
+type trackedServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	stream *activeStream
+}
+
+// Context returns the cancellable context of the stream.
+func (s *trackedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sends a message and counts it.
+func (s *trackedServerStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err != nil {
+		return err
+	}
+
+	s.stream.messages.Add(1)
+	if msg, ok := m.(proto.Message); ok {
+		s.stream.bytes.Add(uint64(proto.Size(msg)))
+	}
+
+	return nil
+}
+
+// trackStream is a stream interceptor tracking the server streaming RPCs
+// while they are being served. It must run last, so that the handler observes
+// the cancellation of the stream.
+func (s *externalCoordinatorServer) trackStream(srv any,
+	ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if !info.IsServerStream {
+		return handler(srv, ss)
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+
+	stream := s.streams.add(ctx, info.FullMethod, cancel)
+	defer s.streams.remove(stream.id)
+
+	return handler(srv, &trackedServerStream{
+		ServerStream: ss,
+		ctx:          ctx,
+		stream:       stream,
+	})
+}
+
+// ListActiveStreams lists the streaming RPCs of the public API currently
+// being served, oldest first.
+func (a *adminServer) ListActiveStreams(ctx context.Context,
+	req *ecadminrpc.ListActiveStreamsRequest) (
+	*ecadminrpc.ListActiveStreamsResponse, error) {
+	if a.streams == nil {
+		return nil, status.Error(codes.FailedPrecondition, "streams "+
+			"are not tracked")
+	}
+
+	return &ecadminrpc.ListActiveStreamsResponse{
+		Streams: a.streams.list(),
+	}, nil
+}
+
+// CancelStream cancels an active streaming RPC of the public API.
+func (a *adminServer) CancelStream(ctx context.Context,
+	req *ecadminrpc.CancelStreamRequest) (
+	*ecadminrpc.CancelStreamResponse, error) {
+	if a.streams == nil {
+		return nil, status.Error(codes.FailedPrecondition, "streams "+
+			"are not tracked")
+	}
+
+	if !a.streams.cancel(req.GetId()) {
+		return nil, status.Errorf(codes.NotFound, "stream %d is not "+
+			"active", req.GetId())
+	}
+	logrus.Infof("Cancelled stream %d", req.GetId())
+
+	return &ecadminrpc.CancelStreamResponse{}, nil
+}
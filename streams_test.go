@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeServerStream is a server stream of a client discarding the messages
+// sent.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream) SendMsg(any) error {
+	return nil
+}
+
+// TestActiveStreams tests that the server streaming RPCs are listed while
+// they are served and that they can be cancelled.
+func TestActiveStreams(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	// Without a tracker there is nothing to report.
+	_, err = admin.ListActiveStreams(
+		context.Background(), &ecadminrpc.ListActiveStreamsRequest{},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	admin.streams = server.streams
+
+	list := func() []*ecadminrpc.ActiveStream {
+		resp, err := admin.ListActiveStreams(
+			context.Background(),
+			&ecadminrpc.ListActiveStreamsRequest{},
+		)
+		require.NoError(t, err)
+
+		return resp.Streams
+	}
+
+	// The query sends a response and runs until it is cancelled.
+	ss := &fakeServerStream{
+		ctx: peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1")},
+		}),
+	}
+	method := ecrpc.
+		ExternalCoordinator_QueryAggregatedMissionControl_FullMethodName
+	resp := &ecrpc.QueryAggregatedMissionControlResponse{
+		Dataset: &ecrpc.DatasetInfo{Revision: 1},
+	}
+	sent := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- server.trackStream(
+			nil, ss, &grpc.StreamServerInfo{
+				FullMethod:     method,
+				IsServerStream: true,
+			},
+			func(_ any, stream grpc.ServerStream) error {
+				if err := stream.SendMsg(resp); err != nil {
+					return err
+				}
+				close(sent)
+				<-stream.Context().Done()
+
+				return status.FromContextError(
+					stream.Context().Err(),
+				).Err()
+			},
+		)
+	}()
+	<-sent
+
+	streams := list()
+	require.Len(t, streams, 1)
+	require.Equal(t, method, streams[0].Method)
+	require.Equal(t, "192.0.2.1", streams[0].Client)
+	require.EqualValues(t, 1, streams[0].MessagesSent)
+	require.EqualValues(t, proto.Size(resp), streams[0].BytesSent)
+
+	_, err = admin.CancelStream(
+		context.Background(), &ecadminrpc.CancelStreamRequest{
+			Id: streams[0].Id + 1,
+		},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = admin.CancelStream(
+		context.Background(), &ecadminrpc.CancelStreamRequest{
+			Id: streams[0].Id,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, codes.Canceled, status.Code(<-done))
+	require.Empty(t, list())
+
+	// Client streams are not tracked.
+	err = server.trackStream(
+		nil, ss, &grpc.StreamServerInfo{IsClientStream: true},
+		func(any, grpc.ServerStream) error {
+			require.Empty(t, list())
+			return nil
+		},
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+)
+
+const (
+	// staleSubmitterPurge removes the pairs contributed solely by stale
+	// submitters right away.
+	staleSubmitterPurge = "purge"
+
+	// staleSubmitterDecay removes the pairs contributed solely by stale
+	// submitters once they are older than the stale submitter maximum age.
+	staleSubmitterDecay = "decay"
+
+	// submitterRecordSize is the size in bytes of the record of a
+	// submitter: its big-endian id followed by the big-endian unix time it
+	// was last seen at.
+	submitterRecordSize = 16
+)
+
+// staleSubmitterPruner removes the pairs contributed solely by submitters not
+// seen for the stale submitter threshold, since their view of the network is
+// likely outdated.
+type staleSubmitterPruner struct {
+	// threshold is the duration after which a submitter not seen is
+	// stale.
+	threshold time.Duration
+
+	// maxAge is the age after which the pairs contributed solely by stale
+	// submitters are removed, zero to remove them right away.
+	maxAge time.Duration
+}
+
+// StartStaleSubmitterPruning starts tracking the submitters of the pairs and
+// removing the pairs contributed solely by stale submitters with the cleanup
+// routine according to the configured policy.
+func (s *externalCoordinatorServer) StartStaleSubmitterPruning() error {
+	pruner := &staleSubmitterPruner{
+		threshold: s.config.Server.StaleSubmitterThreshold,
+	}
+	switch s.config.Server.StaleSubmitterPolicy {
+	case staleSubmitterPurge:
+
+	case staleSubmitterDecay:
+		pruner.maxAge = s.config.Server.StaleSubmitterMaxAge
+		if pruner.maxAge <= 0 {
+			return fmt.Errorf("stale submitter max age must be " +
+				"positive")
+		}
+
+	default:
+		return fmt.Errorf("unknown stale submitter policy %q, options "+
+			"are %s and %s", s.config.Server.StaleSubmitterPolicy,
+			staleSubmitterPurge, staleSubmitterDecay)
+	}
+	s.staleSubmitters = pruner
+
+	logrus.Infof("Pruning pairs of submitters not seen for %v by %s",
+		pruner.threshold, s.config.Server.StaleSubmitterPolicy)
+
+	return nil
+}
+
+// submitterIdentity returns the identity of the submitter of a request, the
+// owner of its access token if it has one and else its client.
+func submitterIdentity(ctx context.Context) string {
+	if scope := accessScopeFromContext(ctx); scope != nil &&
+		scope.owner != "" {
+
+		return scope.owner
+	}
+
+	return clientIdentity(ctx)
+}
+
+// encodeSubmitterRecord encodes the record of a submitter.
+func encodeSubmitterRecord(id uint64, lastSeen int64) []byte {
+	record := make([]byte, submitterRecordSize)
+	binary.BigEndian.PutUint64(record, id)
+	binary.BigEndian.PutUint64(record[8:], uint64(lastSeen))
+
+	return record
+}
+
+// decodeSubmitterRecord decodes the record of a submitter encoded by
+// encodeSubmitterRecord.
+func decodeSubmitterRecord(record []byte) (uint64, int64, error) {
+	if len(record) != submitterRecordSize {
+		return 0, 0, fmt.Errorf("invalid submitter record length: %d",
+			len(record))
+	}
+
+	return binary.BigEndian.Uint64(record),
+		int64(binary.BigEndian.Uint64(record[8:])), nil
+}
+
+// recordSubmitter records that the submitter was seen now and contributed the
+// given pairs. Each pair keeps the most recent distinct submitters that
+// contributed to it.
+func (s *externalCoordinatorServer) recordSubmitter(submitter string,
+	pairs []*ecrpc.PairHistory) error {
+	now := s.clock.Now().Unix()
+
+	return s.db.Batch(func(tx *bbolt.Tx) error {
+		submitters := tx.Bucket([]byte(SubmittersBucketName))
+		var id uint64
+		if record := submitters.Get([]byte(submitter)); record != nil {
+			var err error
+			id, _, err = decodeSubmitterRecord(record)
+			if err != nil {
+				return err
+			}
+		} else {
+			var err error
+			id, err = submitters.NextSequence()
+			if err != nil {
+				return err
+			}
+		}
+		err := submitters.Put(
+			[]byte(submitter), encodeSubmitterRecord(id, now),
+		)
+		if err != nil {
+			return err
+		}
+
+		b := tx.Bucket([]byte(PairSubmittersBucketName))
+		for _, pair := range pairs {
+			key := pairKey(pair.NodeFrom, pair.NodeTo)
+			ids := appendPairSubmitter(b.Get(key), id)
+			if err := b.Put(key, ids); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// appendPairSubmitter returns the encoded ids of the submitters of a pair with
+// the given id moved to the end as the most recent one. At most
+// MaxPairSubmitters ids are kept, the least recent ones are discarded first.
+func appendPairSubmitter(ids []byte, id uint64) []byte {
+	updated := make([]byte, 0, len(ids)+8)
+	for i := 0; i+8 <= len(ids); i += 8 {
+		if binary.BigEndian.Uint64(ids[i:]) != id {
+			updated = append(updated, ids[i:i+8]...)
+		}
+	}
+	updated = binary.BigEndian.AppendUint64(updated, id)
+
+	if len(updated) > MaxPairSubmitters*8 {
+		updated = updated[len(updated)-MaxPairSubmitters*8:]
+	}
+
+	return updated
+}
+
+// pruneStaleSubmitters removes the pairs contributed solely by stale
+// submitters according to the policy. Pairs without recorded submitters are
+// kept. Submitters not seen for the history threshold are forgotten, since
+// the pairs they contributed are removed by then. It returns the keys of the
+// pairs removed.
+func (s *externalCoordinatorServer) pruneStaleSubmitters() ([][]byte,
+	error) {
+
+	pruner := s.staleSubmitters
+	now := s.clock.Now()
+	staleCutoff := now.Add(-pruner.threshold).Unix()
+	forgetCutoff := now.Add(
+		-s.config.Server.HistoryThresholdDuration,
+	).Unix()
+
+	var removed [][]byte
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		removed = nil
+
+		// Find the active submitters, forgetting the ones not seen for
+		// the history threshold. Submitters forgotten already are as
+		// stale as the ones not active.
+		active := make(map[uint64]bool)
+		var forgotten [][]byte
+		submitters := tx.Bucket([]byte(SubmittersBucketName))
+		err := submitters.ForEach(func(k, v []byte) error {
+			id, lastSeen, err := decodeSubmitterRecord(v)
+			if err != nil {
+				return err
+			}
+			if lastSeen >= staleCutoff {
+				active[id] = true
+			}
+			if lastSeen < forgetCutoff {
+				forgotten = append(forgotten, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range forgotten {
+			if err := submitters.Delete(k); err != nil {
+				return err
+			}
+		}
+		// Collect the pairs contributed solely by stale submitters
+		// which are due according to the policy.
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		pairSubmitters := tx.Bucket([]byte(PairSubmittersBucketName))
+		var keys [][]byte
+		err = pairSubmitters.ForEach(func(k, v []byte) error {
+			// The submitters of pairs removed otherwise are
+			// dropped as well.
+			data := b.Get(k)
+			if data == nil {
+				keys = append(keys, append([]byte(nil), k...))
+				return nil
+			}
+
+			for i := 0; i+8 <= len(v); i += 8 {
+				if active[binary.BigEndian.Uint64(v[i:])] {
+					return nil
+				}
+			}
+
+			if pruner.maxAge > 0 {
+				updated, err := pairUpdateTime(data)
+				if err != nil {
+					return err
+				}
+				age := now.Sub(time.Unix(updated, 0))
+				if age <= pruner.maxAge {
+					return nil
+				}
+			}
+			keys = append(keys, append([]byte(nil), k...))
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range keys {
+			if b.Get(k) != nil {
+				removed = append(removed, k)
+			}
+			if err := removePairData(tx, k); err != nil {
+				return err
+			}
+		}
+		if len(removed) == 0 {
+			return nil
+		}
+
+		return bumpDatasetRevision(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/peer"
+)
+
+// submitterContext returns the context of a request submitted from the given
+// address.
+func submitterContext(addr string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 4242},
+	})
+}
+
+// TestStaleSubmitterPruning tests that the pairs contributed solely by stale
+// submitters are removed according to the policy, while pairs with an active
+// or without a tracked submitter are kept.
+func TestStaleSubmitterPruning(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = 48 * time.Hour
+	config.Server.StaleSubmitterThreshold = time.Hour
+	config.Server.StaleSubmitterPolicy = staleSubmitterDecay
+	config.Server.StaleSubmitterMaxAge = 3 * time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	clock := newManualClock(time.Now())
+	server.clock = clock
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	register := func(ctx context.Context, nodes ...[]byte) {
+		req := &ecrpc.RegisterMissionControlRequest{}
+		for i := 0; i+1 < len(nodes); i += 2 {
+			req.Pairs = append(req.Pairs, &ecrpc.PairHistory{
+				NodeFrom: nodes[i],
+				NodeTo:   nodes[i+1],
+				History: &ecrpc.PairData{
+					SuccessTime:    clock.Now().Unix(),
+					SuccessAmtMsat: 1_000_000,
+				},
+			})
+		}
+		_, err := server.RegisterMissionControl(ctx, req)
+		require.NoError(t, err)
+	}
+	stored := func(nodeFrom, nodeTo []byte) bool {
+		var found bool
+		err := db.View(func(tx *bbolt.Tx) error {
+			found = tx.Bucket([]byte(DatabaseBucketName)).Get(
+				pairKey(nodeFrom, nodeTo),
+			) != nil
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		return found
+	}
+
+	// Pairs registered before tracking was enabled have no submitter.
+	register(submitterContext("10.0.0.1"), nodeD, nodeC)
+
+	config.Server.StaleSubmitterPolicy = "unknown"
+	require.Error(t, server.StartStaleSubmitterPruning())
+	config.Server.StaleSubmitterPolicy = staleSubmitterDecay
+	require.NoError(t, server.StartStaleSubmitterPruning())
+
+	// The first submitter contributes two pairs, one of which is
+	// contributed by the second submitter as well.
+	register(submitterContext("10.0.0.1"), nodeA, nodeB, nodeB, nodeC)
+	register(submitterContext("10.0.0.2"), nodeB, nodeC)
+
+	// Only the second submitter stays active, but the pairs of the first
+	// one have not decayed yet.
+	clock.Advance(2 * time.Hour)
+	register(submitterContext("10.0.0.2"), nodeC, nodeD)
+	server.cleanupStaleData()
+	require.True(t, stored(nodeA, nodeB))
+
+	// Once they are older than the maximum age, the pairs contributed
+	// solely by the stale submitter are removed.
+	clock.Advance(2 * time.Hour)
+	register(submitterContext("10.0.0.2"), nodeC, nodeD)
+	server.cleanupStaleData()
+	require.False(t, stored(nodeA, nodeB))
+	require.True(t, stored(nodeB, nodeC))
+	require.True(t, stored(nodeC, nodeD))
+	require.True(t, stored(nodeD, nodeC))
+
+	// The purge policy removes the pairs right away once the second
+	// submitter is stale too, but keeps the pair without a submitter.
+	config.Server.StaleSubmitterPolicy = staleSubmitterPurge
+	require.NoError(t, server.StartStaleSubmitterPruning())
+	clock.Advance(2 * time.Hour)
+	server.cleanupStaleData()
+	require.False(t, stored(nodeB, nodeC))
+	require.False(t, stored(nodeC, nodeD))
+	require.True(t, stored(nodeD, nodeC))
+}
+
+// TestAppendPairSubmitter tests that the submitters of a pair are kept
+// distinct and ordered by recency with the least recent ones discarded
+// first.
+func TestAppendPairSubmitter(t *testing.T) {
+	var ids []byte
+	for id := uint64(1); id <= MaxPairSubmitters+2; id++ {
+		ids = appendPairSubmitter(ids, id)
+	}
+	require.Len(t, ids, MaxPairSubmitters*8)
+
+	ids = appendPairSubmitter(ids, 5)
+	require.Len(t, ids, MaxPairSubmitters*8)
+	require.EqualValues(t, 3, decodeUint64(ids[:8]))
+	require.EqualValues(t, 5, decodeUint64(ids[len(ids)-8:]))
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// activeSubscriptions reports the number of clients currently subscribed to
+// the changes of the mission control data.
+var activeSubscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: metricsNamespace,
+	Name:      "active_subscriptions",
+	Help: "Clients currently subscribed to the changes of the mission " +
+		"control data.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(activeSubscriptions)
+}
+
+// SubscribeMissionControl streams the pairs changed since the revision known
+// to the client, followed by the pairs changed by every later revision as soon
+// as the dataset changes. The first update is sent right away, even if nothing
+// changed, so that the client learns the current revision. The pairs are
+// filtered, transformed and accounted like the ones of a query.
+func (s *externalCoordinatorServer) SubscribeMissionControl(
+	req *ecrpc.SubscribeMissionControlRequest,
+	stream ecrpc.ExternalCoordinator_SubscribeMissionControlServer) error {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return err
+	}
+
+	// Subscriptions never end by themselves, so they are ended along
+	// with the coordinator.
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	stop := context.AfterFunc(s.subscriptions, cancel)
+	defer stop()
+
+	activeSubscriptions.Inc()
+	defer activeSubscriptions.Dec()
+
+	client := clientIdentity(ctx)
+	revision := req.GetAfterRevision()
+	logrus.Debugf("Subscription after revision %d started", revision)
+
+	for {
+		// End the subscription once the client reached its daily
+		// egress cap.
+		if err := s.checkEgressCap(ctx, client); err != nil {
+			logrus.Infof("Subscription ended: %v", err)
+			return err
+		}
+
+		resp, err := s.collectChanges(ctx, revision)
+		if err != nil {
+			msg := "subscription failed: %v"
+			st := status.Convert(err)
+			logrus.Errorf(msg, st.Message())
+			return status.Errorf(st.Code(), msg, st.Message())
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		s.recordEgress(client, int64(proto.Size(resp)))
+
+		logrus.Debugf("Subscription after revision %d received %d "+
+			"changed and %d removed pairs at revision %d", revision,
+			len(resp.Pairs), len(resp.RemovedPairs),
+			resp.Dataset.Revision)
+		revision = resp.Dataset.Revision
+
+		err = s.waitForRevision(ctx, revision, 0)
+		if s.subscriptions.Err() != nil {
+			return status.Error(codes.Unavailable, "coordinator "+
+				"is shutting down")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// subscriptionEndingServer ends all subscriptions before gracefully stopping
+// the gRPC server, which would otherwise wait for them forever.
+type subscriptionEndingServer struct {
+	GRPCServer
+	server *externalCoordinatorServer
+}
+
+// GracefulStop ends all subscriptions and gracefully stops the gRPC server.
+// The subscribers are told to reconnect, e.g. to the process taking over.
+func (s *subscriptionEndingServer) GracefulStop() {
+	s.server.endSubscriptions()
+	s.GRPCServer.GracefulStop()
+}
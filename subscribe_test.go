@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscriptionStream is a subscription stream of a client passing the updates
+// on to a channel.
+type subscriptionStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *ecrpc.PollMissionControlResponse
+}
+
+func (s *subscriptionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *subscriptionStream) Send(
+	resp *ecrpc.PollMissionControlResponse) error {
+
+	s.updates <- resp
+	return nil
+}
+
+// TestSubscribeMissionControl tests that subscriptions receive the pairs
+// changed since the revision of the client right away and every later change
+// as soon as it is stored, until the client or the coordinator ends them.
+func TestSubscribeMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RevisionLogRetention = 10
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	register := func(nodeTo []byte) {
+		_, err := server.RegisterMissionControl(
+			context.Background(), &ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeA,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    time.Now().Unix(),
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+	subscribe := func(ctx context.Context, revision uint64) (
+		chan *ecrpc.PollMissionControlResponse, chan error) {
+
+		stream := &subscriptionStream{
+			ctx:     ctx,
+			updates: make(chan *ecrpc.PollMissionControlResponse),
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- server.SubscribeMissionControl(
+				&ecrpc.SubscribeMissionControlRequest{
+					AfterRevision: revision,
+				}, stream,
+			)
+		}()
+
+		return stream.updates, done
+	}
+	var (
+		updates chan *ecrpc.PollMissionControlResponse
+		done    chan error
+	)
+	receive := func() *ecrpc.PollMissionControlResponse {
+		select {
+		case resp := <-updates:
+			return resp
+		case <-time.After(5 * time.Second):
+			t.Fatal("no update received")
+			return nil
+		}
+	}
+
+	// Clients without a revision receive the entire dataset first.
+	register(nodeB)
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, done = subscribe(ctx, 0)
+	resp := receive()
+	require.True(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+	require.Equal(t, nodeB, resp.Pairs[0].NodeTo)
+	revision := resp.Dataset.Revision
+
+	// Every later change is pushed as soon as it is stored.
+	register(nodeC)
+	resp = receive()
+	require.False(t, resp.Full)
+	require.Len(t, resp.Pairs, 1)
+	require.Equal(t, nodeC, resp.Pairs[0].NodeTo)
+	require.Greater(t, resp.Dataset.Revision, revision)
+	revision = resp.Dataset.Revision
+
+	// Subscriptions end with their request.
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-done))
+
+	// Clients up to date receive no pairs first, and are told to
+	// reconnect once the coordinator shuts down.
+	updates, done = subscribe(context.Background(), revision)
+	resp = receive()
+	require.Empty(t, resp.Pairs)
+	require.Equal(t, revision, resp.Dataset.Revision)
+
+	server.endSubscriptions()
+	require.Equal(t, codes.Unavailable, status.Code(<-done))
+}
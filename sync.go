@@ -0,0 +1,107 @@
+package main
+
+import (
+	"io"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SyncMissionControl synchronizes a client with the coordinator over a single
+// stream. Once the first request is received, the pairs changed since its
+// revision are sent like by PollMissionControl, without waiting for changes,
+// while the pairs of the requests are registered like by
+// RegisterMissionControlStream. The registration result is sent last, once the
+// client finished the upload and the changes were sent.
+func (s *externalCoordinatorServer) SyncMissionControl(
+	stream ecrpc.ExternalCoordinator_SyncMissionControlServer) error {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return err
+	}
+
+	// Reject clients which already reached their daily egress cap.
+	ctx := stream.Context()
+	client := clientIdentity(ctx)
+	if err := s.checkEgressCap(ctx, client); err != nil {
+		logrus.Infof("Sync rejected: %v", err)
+		return err
+	}
+
+	// Track the upload as a single registration for as long as it is
+	// being processed.
+	done := s.registrations.start()
+	defer done()
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "stream must "+
+			"include at least one request")
+	}
+	if err != nil {
+		return err
+	}
+	revision := req.GetAfterRevision()
+
+	// Send the changes while the rest of the upload is received.
+	sent := make(chan error, 1)
+	go func() {
+		resp, err := s.collectChanges(ctx, revision)
+		if err != nil {
+			msg := "sync failed: %v"
+			st := status.Convert(err)
+			logrus.Errorf(msg, st.Message())
+			sent <- status.Errorf(st.Code(), msg, st.Message())
+			return
+		}
+
+		err = stream.Send(&ecrpc.SyncMissionControlResponse{
+			Changes: resp,
+		})
+		if err == nil {
+			s.recordEgress(client, int64(proto.Size(resp)))
+		}
+		sent <- err
+	}()
+
+	// Register the pairs of the requests like the ones of a streamed
+	// upload.
+	registration := s.newStreamRegistration(ctx)
+	add := func(req *ecrpc.SyncMissionControlRequest) error {
+		return registration.add(&ecrpc.RegisterMissionControlRequest{
+			Pairs:   req.Pairs,
+			Network: req.Network,
+			Private: req.Private,
+		})
+	}
+	err = add(req)
+	for err == nil {
+		req, err = stream.Recv()
+		if err == io.EOF {
+			err = registration.flush()
+			break
+		}
+		if err == nil {
+			err = add(req)
+		}
+	}
+
+	// The stream must not end while the changes are still being sent.
+	if sendErr := <-sent; err == nil {
+		err = sendErr
+	}
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Sync after revision %d received %d pairs", revision,
+		registration.offset)
+
+	return stream.Send(&ecrpc.SyncMissionControlResponse{
+		Registration: s.registrationResponse(registration.result),
+	})
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// syncStream is a synchronization stream of a client uploading the given
+// requests. The requests after the first one are only uploaded once the
+// client received a response, so that the changes are always collected before
+// the upload is registered.
+type syncStream struct {
+	grpc.ServerStream
+	reqs []*ecrpc.SyncMissionControlRequest
+
+	mu       sync.Mutex
+	resps    []*ecrpc.SyncMissionControlResponse
+	received chan struct{}
+	started  bool
+}
+
+func newSyncStream(reqs ...*ecrpc.SyncMissionControlRequest) *syncStream {
+	return &syncStream{reqs: reqs, received: make(chan struct{})}
+}
+
+func (s *syncStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *syncStream) Recv() (*ecrpc.SyncMissionControlRequest, error) {
+	if s.started {
+		<-s.received
+	}
+	s.started = true
+
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func (s *syncStream) Send(resp *ecrpc.SyncMissionControlResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.resps) == 0 {
+		close(s.received)
+	}
+	s.resps = append(s.resps, resp)
+
+	return nil
+}
+
+// TestSyncMissionControl tests that a synchronization returns the changes
+// since the revision of the client followed by the result of registering its
+// upload.
+func TestSyncMissionControl(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.RevisionLogRetention = 10
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	newPair := func() *ecrpc.PairHistory {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  1,
+				SuccessAmtMsat: 1000,
+			},
+		}
+	}
+	stored := newPair()
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{stored},
+		},
+	)
+	require.NoError(t, err)
+
+	// The first sync receives the stored pair, but not the pairs it
+	// uploads meanwhile.
+	uploaded := []*ecrpc.PairHistory{newPair(), newPair()}
+	stream := newSyncStream(
+		&ecrpc.SyncMissionControlRequest{
+			Pairs: uploaded[:1],
+		},
+		&ecrpc.SyncMissionControlRequest{
+			Pairs: uploaded[1:],
+		},
+	)
+	require.NoError(t, server.SyncMissionControl(stream))
+	require.Len(t, stream.resps, 2)
+
+	changes := stream.resps[0].Changes
+	require.NotNil(t, changes)
+	require.Len(t, changes.Pairs, 1)
+	require.Equal(t, stored.NodeFrom, changes.Pairs[0].NodeFrom)
+	require.Equal(t, stored.NodeTo, changes.Pairs[0].NodeTo)
+
+	registration := stream.resps[1].Registration
+	require.NotNil(t, registration)
+	require.Equal(t, "Successfully registered 2 pairs",
+		registration.SuccessMessage)
+
+	// The next sync only receives the uploaded pairs, even without
+	// uploading any pairs itself.
+	stream = newSyncStream(&ecrpc.SyncMissionControlRequest{
+		AfterRevision: changes.Dataset.Revision,
+	})
+	require.NoError(t, server.SyncMissionControl(stream))
+	require.Len(t, stream.resps, 2)
+	require.False(t, stream.resps[0].Changes.Full)
+
+	var nodes [][]byte
+	for _, pair := range stream.resps[0].Changes.Pairs {
+		nodes = append(nodes, pair.NodeFrom)
+	}
+	require.ElementsMatch(t, [][]byte{
+		uploaded[0].NodeFrom, uploaded[1].NodeFrom,
+	}, nodes)
+	require.Equal(t, "Successfully registered 0 pairs",
+		stream.resps[1].Registration.SuccessMessage)
+
+	// Empty streams and streams mixing networks are rejected.
+	err = server.SyncMissionControl(newSyncStream())
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = server.SyncMissionControl(newSyncStream(
+		&ecrpc.SyncMissionControlRequest{Pairs: []*ecrpc.PairHistory{
+			newPair(),
+		}},
+		&ecrpc.SyncMissionControlRequest{Network: "testnet"},
+	))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+)
+
+var (
+	// genSyncConfig is the directory the configuration of the companion
+	// sync client is generated into.
+	genSyncConfig = flag.String("gen-sync-config", "", "The "+
+		"directory to write ready-to-use configuration files for the "+
+		"sync client of node operators into, a systemd service and "+
+		"timer syncing their lnd node with this coordinator together "+
+		"with the environment file they read. The coordinator exits "+
+		"once the files are written.")
+
+	// syncLndDir is the lnd directory of the node the generated sync
+	// client configuration is for.
+	syncLndDir = flag.String("sync-lnd-dir", "", "The lnd directory of "+
+		"the node the sync client configuration is generated for. "+
+		"Defaults to ~/.lnd.")
+
+	// syncLndHost is the gRPC address of the lnd node the generated sync
+	// client configuration is for.
+	syncLndHost = flag.String("sync-lnd-host", "localhost:10009", "The "+
+		"gRPC address (host:port) of the lnd node the sync client "+
+		"configuration is generated for.")
+
+	// syncECHost is the gRPC address of this coordinator as reached by the
+	// sync client.
+	syncECHost = flag.String("sync-ec-host", "", "The gRPC address "+
+		"(host:port) the sync client reaches this coordinator at. "+
+		"Defaults to the TLS domain name and the gRPC port.")
+
+	// syncClientPath is the path of the sync client script.
+	syncClientPath = flag.String("sync-client-path",
+		filepath.Join("client", "client_rpc.py"), "The path of "+
+			"the client_rpc.py sync client script run by the "+
+			"generated systemd service.")
+
+	// syncInterval is the interval on which the generated systemd timer
+	// runs the sync client.
+	syncInterval = flag.Duration("sync-interval", time.Hour, "The "+
+		"interval on which the generated systemd timer syncs the lnd "+
+		"node with this coordinator.")
+)
+
+const (
+	// syncEnvFilename is the filename of the generated environment file
+	// of the sync client.
+	syncEnvFilename = "ec-sync.env"
+
+	// syncServiceFilename is the filename of the generated systemd
+	// service running the sync client.
+	syncServiceFilename = "ec-sync.service"
+
+	// syncTimerFilename is the filename of the generated systemd timer
+	// running the sync service periodically.
+	syncTimerFilename = "ec-sync.timer"
+
+	// syncMacaroonFilename is the filename of the macaroon the sync client
+	// authenticates to lnd with.
+	syncMacaroonFilename = "ec-sync.macaroon"
+)
+
+// syncClientConfig holds the values the sync client configuration files are
+// generated from.
+type syncClientConfig struct {
+	// LndHost is the gRPC address of the lnd node.
+	LndHost string
+
+	// LndDir is the lnd directory of the node.
+	LndDir string
+
+	// LndTLSCert is the path of the TLS certificate of the lnd node.
+	LndTLSCert string
+
+	// LndMacaroon is the path of the macaroon the sync client
+	// authenticates to lnd with.
+	LndMacaroon string
+
+	// ECHost is the gRPC address of the coordinator.
+	ECHost string
+
+	// ECTLSCert is the path of the certificate verifying the coordinator,
+	// empty if it is verified using the system certificate pool.
+	ECTLSCert string
+
+	// Network is the network the coordinator collects data for.
+	Network string
+
+	// RequireAccessToken is whether the coordinator requires access
+	// tokens.
+	RequireAccessToken bool
+
+	// ClientPath is the absolute path of the sync client script.
+	ClientPath string
+
+	// WorkingDir is the directory the sync client script is run in, so
+	// that it finds its generated gRPC modules.
+	WorkingDir string
+
+	// Interval is the interval on which the sync client runs.
+	Interval time.Duration
+}
+
+// syncEnvTemplate is the template of the environment file read by the sync
+// client.
+var syncEnvTemplate = template.Must(template.New(syncEnvFilename).Parse(
+	`# Environment of the External Coordinator sync client.
+LND_GRPC_HOST={{.LndHost}}
+LND_DIR={{.LndDir}}
+LND_TLS_CERT={{.LndTLSCert}}
+LND_MACAROON_PATH={{.LndMacaroon}}
+EC_GRPC_HOST={{.ECHost}}
+{{- if .ECTLSCert}}
+EC_TLS_CERT={{.ECTLSCert}}
+{{- else}}
+# The coordinator is verified using the system certificate pool.
+EC_TLS_CERT=
+{{- end}}
+EC_NETWORK={{.Network}}
+{{- if .RequireAccessToken}}
+# The coordinator requires access tokens, set the token minted by its
+# operator.
+{{- end}}
+EC_ACCESS_TOKEN=
+`))
+
+// syncServiceTemplate is the template of the systemd service running the sync
+// client once.
+var syncServiceTemplate = template.Must(template.New(syncServiceFilename).
+	Parse(`[Unit]
+Description=Sync lnd mission control with the External Coordinator
+Wants=network-online.target
+After=network-online.target lnd.service
+
+[Service]
+Type=oneshot
+EnvironmentFile=/etc/ec-sync/` + syncEnvFilename + `
+WorkingDirectory={{.WorkingDir}}
+ExecStart=/usr/bin/env python3 {{.ClientPath}}
+`))
+
+// syncTimerTemplate is the template of the systemd timer running the sync
+// service periodically.
+var syncTimerTemplate = template.Must(template.New(syncTimerFilename).Parse(
+	`[Unit]
+Description=Sync lnd mission control with the External Coordinator periodically
+
+[Timer]
+OnBootSec=5min
+OnUnitActiveSec={{.Interval.Seconds}}s
+RandomizedDelaySec=60
+
+[Install]
+WantedBy=timers.target
+`))
+
+// newSyncClientConfig returns the sync client configuration for this
+// coordinator and the lnd node described by the command line flags.
+func newSyncClientConfig(config *Config, homeDir string) (*syncClientConfig,
+	error) {
+	network, err := parseNetwork(config.Server.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	lndDir := *syncLndDir
+	if lndDir == "" {
+		lndDir = filepath.Join(homeDir, ".lnd")
+	}
+
+	ecHost := *syncECHost
+	if ecHost == "" {
+		ecHost = joinHostPort(
+			config.TLS.TLSDomainName, config.Server.GRPCServerPort,
+		)
+	}
+
+	clientPath, err := filepath.Abs(*syncClientPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if *syncInterval <= 0 {
+		return nil, fmt.Errorf("sync interval must be positive")
+	}
+
+	return &syncClientConfig{
+		LndHost:    *syncLndHost,
+		LndDir:     lndDir,
+		LndTLSCert: filepath.Join(lndDir, "tls.cert"),
+		LndMacaroon: filepath.Join(
+			lndDir, "data", "chain", "bitcoin", network,
+			syncMacaroonFilename,
+		),
+		ECHost:             ecHost,
+		ECTLSCert:          clientTLSCertPath(config),
+		Network:            network,
+		RequireAccessToken: config.Server.RequireAccessToken,
+		ClientPath:         clientPath,
+		WorkingDir:         filepath.Dir(clientPath),
+		Interval:           *syncInterval,
+	}, nil
+}
+
+// clientTLSCertPath returns the path of the certificate clients verify the
+// coordinator with. It is empty if the coordinator serves a third-party
+// certificate, which clients verify using the system certificate pool.
+func clientTLSCertPath(config *Config) string {
+	if config.TLS.ThirdPartyTLSCertFile != "" &&
+		config.TLS.ThirdPartyTLSKeyFile != "" {
+		err := checkFilesExist(
+			filepath.Join(
+				config.TLS.ThirdPartyTLSDirPath,
+				config.TLS.ThirdPartyTLSCertFile,
+			),
+			filepath.Join(
+				config.TLS.ThirdPartyTLSDirPath,
+				config.TLS.ThirdPartyTLSKeyFile,
+			),
+		)
+		if err == nil {
+			return ""
+		}
+	}
+
+	return filepath.Join(
+		config.TLS.SelfSignedTLSDirPath,
+		config.TLS.SelfSignedTLSCertFile,
+	)
+}
+
+// writeSyncClientConfig writes the environment file, the systemd service and
+// the systemd timer of the sync client into the given directory.
+func writeSyncClientConfig(dir string, sync *syncClientConfig) error {
+	if err := os.MkdirAll(dir, AppDirPermissions); err != nil {
+		return err
+	}
+
+	files := []struct {
+		tmpl *template.Template
+		perm os.FileMode
+	}{
+		// The environment file is meant to hold the access token, so
+		// it is only readable by its owner.
+		{syncEnvTemplate, ConfigFilePermissions},
+		{syncServiceTemplate, 0644},
+		{syncTimerTemplate, 0644},
+	}
+	for _, file := range files {
+		path := filepath.Join(dir, file.tmpl.Name())
+		f, err := os.OpenFile(
+			path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, file.perm,
+		)
+		if err != nil {
+			return err
+		}
+		err = file.tmpl.Execute(f, sync)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+// syncClientInstructions returns the steps to install the generated sync
+// client configuration from the given directory.
+func syncClientInstructions(dir string, sync *syncClientConfig) string {
+	return fmt.Sprintf(`Sync client configuration written to %s.
+
+1. Bake a macaroon only allowing to read and import mission control:
+
+   lncli --lnddir=%s --network=%s bakemacaroon offchain:read offchain:write --save_to=%s
+
+2. Install the configuration and enable the timer:
+
+   sudo install -D -m 600 %s /etc/ec-sync/%s
+   sudo cp %s %s /etc/systemd/system/
+   sudo systemctl daemon-reload
+   sudo systemctl enable --now %s
+`, dir, sync.LndDir, sync.Network, sync.LndMacaroon,
+		filepath.Join(dir, syncEnvFilename), syncEnvFilename,
+		filepath.Join(dir, syncServiceFilename),
+		filepath.Join(dir, syncTimerFilename), syncTimerFilename)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteSyncClientConfig tests that the generated sync client
+// configuration points the client to the coordinator and the lnd node.
+func TestWriteSyncClientConfig(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.Network = "signet"
+	config.Server.GRPCServerPort = ":50050"
+	config.Server.RequireAccessToken = true
+	config.TLS.TLSDomainName = "ec.example.com"
+	config.TLS.SelfSignedTLSDirPath = "/var/lib/ec"
+	config.TLS.SelfSignedTLSCertFile = "tls.cert"
+
+	sync, err := newSyncClientConfig(config, "/home/alice")
+	require.NoError(t, err)
+	require.Equal(t, "ec.example.com:50050", sync.ECHost)
+	require.Equal(t, "/var/lib/ec/tls.cert", sync.ECTLSCert)
+	require.Equal(
+		t, "/home/alice/.lnd/data/chain/bitcoin/signet/ec-sync.macaroon",
+		sync.LndMacaroon,
+	)
+
+	dir := filepath.Join(t.TempDir(), "sync")
+	require.NoError(t, writeSyncClientConfig(dir, sync))
+
+	env, err := os.ReadFile(filepath.Join(dir, syncEnvFilename))
+	require.NoError(t, err)
+	require.Contains(
+		t, string(env), "EC_GRPC_HOST=ec.example.com:50050\n",
+	)
+	require.Contains(t, string(env), "EC_TLS_CERT=/var/lib/ec/tls.cert\n")
+	require.Contains(t, string(env), "EC_NETWORK=signet\n")
+	require.Contains(t, string(env), "requires access tokens")
+
+	// The environment file holds the access token, so it must not be
+	// readable by others.
+	info, err := os.Stat(filepath.Join(dir, syncEnvFilename))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(ConfigFilePermissions), info.Mode().Perm())
+
+	service, err := os.ReadFile(filepath.Join(dir, syncServiceFilename))
+	require.NoError(t, err)
+	require.Contains(t, string(service), "ExecStart=/usr/bin/env python3 "+
+		sync.ClientPath+"\n")
+
+	timer, err := os.ReadFile(filepath.Join(dir, syncTimerFilename))
+	require.NoError(t, err)
+	require.Contains(t, string(timer), "OnUnitActiveSec=3600s\n")
+}
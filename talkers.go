@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// talkerWindowHours is the number of hours the volumes of the clients
+	// are accounted for.
+	talkerWindowHours = 24
+
+	// defaultTopTalkers is the number of clients listed by ListTopTalkers
+	// if no limit is requested.
+	defaultTopTalkers = 10
+)
+
+var (
+	// grpcRequestSize observes the size of the messages received by RPCs.
+	grpcRequestSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "grpc",
+			Name:      "request_size_bytes",
+			Help:      "Size of messages received by RPCs in bytes.",
+			Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+		},
+		[]string{"method"},
+	)
+
+	// grpcResponseSize observes the size of the messages sent by RPCs.
+	grpcResponseSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "grpc",
+			Name:      "response_size_bytes",
+			Help:      "Size of messages sent by RPCs in bytes.",
+			Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+		},
+		[]string{"method"},
+	)
+)
+
+func init() {
+	metricsRegistry.MustRegister(grpcRequestSize, grpcResponseSize)
+}
+
+// talkerVolume is the volume of the requests of a client.
+type talkerVolume struct {
+	registrations  uint64
+	pairs          uint64
+	submittedBytes uint64
+	egressBytes    uint64
+}
+
+// add adds the given volume.
+func (v *talkerVolume) add(other talkerVolume) {
+	v.registrations += other.registrations
+	v.pairs += other.pairs
+	v.submittedBytes += other.submittedBytes
+	v.egressBytes += other.egressBytes
+}
+
+// talkerHistory holds the volumes of a client per hour of the window. Each
+// slot holds the volume of the hour it was last used for.
+type talkerHistory struct {
+	hours   [talkerWindowHours]int64
+	volumes [talkerWindowHours]talkerVolume
+}
+
+// total returns the volume within the window ending with the given hour.
+func (h *talkerHistory) total(hour int64) talkerVolume {
+	var total talkerVolume
+	for i, slotHour := range h.hours {
+		if hour-slotHour < talkerWindowHours {
+			total.add(h.volumes[i])
+		}
+	}
+
+	return total
+}
+
+// talkerTracker accounts the volumes of the requests of each client over the
+// last 24 hours in hourly slots. Like the egress accounting, it is only kept
+// in memory, so no client data is persisted.
+type talkerTracker struct {
+	mu        sync.Mutex
+	clients   map[string]*talkerHistory
+	lastPrune int64
+}
+
+// newTalkerTracker creates an empty talker tracker.
+func newTalkerTracker() *talkerTracker {
+	return &talkerTracker{clients: make(map[string]*talkerHistory)}
+}
+
+// unixHour returns the number of the hour of the given time.
+func unixHour(now time.Time) int64 {
+	return now.Unix() / int64(time.Hour/time.Second)
+}
+
+// add accounts the given volume to the client.
+func (t *talkerTracker) add(client string, now time.Time,
+	volume talkerVolume) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	hour := unixHour(now)
+	t.pruneLocked(hour)
+
+	history, ok := t.clients[client]
+	if !ok {
+		history = &talkerHistory{}
+		t.clients[client] = history
+	}
+
+	slot := hour % talkerWindowHours
+	if history.hours[slot] != hour {
+		history.hours[slot] = hour
+		history.volumes[slot] = talkerVolume{}
+	}
+	history.volumes[slot].add(volume)
+}
+
+// pruneLocked removes the clients without any volume within the window once
+// per hour, so that the memory held is bounded by the clients of the last 24
+// hours. The mutex must be held.
+func (t *talkerTracker) pruneLocked(hour int64) {
+	if hour == t.lastPrune {
+		return
+	}
+	t.lastPrune = hour
+
+	for client, history := range t.clients {
+		if history.total(hour) == (talkerVolume{}) {
+			delete(t.clients, client)
+		}
+	}
+}
+
+// top returns the volumes within the window of at most limit clients with the
+// highest volumes in the given order.
+func (t *talkerTracker) top(now time.Time, limit int,
+	order ecadminrpc.TalkerOrder) []*ecadminrpc.TalkerVolume {
+	t.mu.Lock()
+	hour := unixHour(now)
+	talkers := make([]*ecadminrpc.TalkerVolume, 0, len(t.clients))
+	for client, history := range t.clients {
+		total := history.total(hour)
+		if total == (talkerVolume{}) {
+			continue
+		}
+
+		talkers = append(talkers, &ecadminrpc.TalkerVolume{
+			Client:         client,
+			Registrations:  total.registrations,
+			PairsSubmitted: total.pairs,
+			SubmittedBytes: total.submittedBytes,
+			EgressBytes:    total.egressBytes,
+		})
+	}
+	t.mu.Unlock()
+
+	// Clients with the same volume are listed by their identity, so that
+	// the report is deterministic.
+	key := func(v *ecadminrpc.TalkerVolume) (uint64, uint64) {
+		if order == ecadminrpc.TalkerOrder_TALKER_ORDER_EGRESS {
+			return v.EgressBytes, v.PairsSubmitted
+		}
+
+		return v.PairsSubmitted, v.EgressBytes
+	}
+	sort.Slice(talkers, func(i, j int) bool {
+		firstI, secondI := key(talkers[i])
+		firstJ, secondJ := key(talkers[j])
+		if firstI != firstJ {
+			return firstI > firstJ
+		}
+		if secondI != secondJ {
+			return secondI > secondJ
+		}
+
+		return talkers[i].Client < talkers[j].Client
+	})
+
+	if len(talkers) > limit {
+		talkers = talkers[:limit]
+	}
+
+	return talkers
+}
+
+// observeMessage observes the size of a message received or sent by the given
+// method and accounts it to the volume of the client.
+func (s *externalCoordinatorServer) observeMessage(ctx context.Context,
+	method string, msg any, received bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	size := proto.Size(m)
+
+	var volume talkerVolume
+	if received {
+		grpcRequestSize.WithLabelValues(method).Observe(float64(size))
+
+		// Only registrations count as submissions. The attempts of
+		// Core Lightning nodes count with at most one pair per hop.
+		// Imported querymc outputs are only parsed by the handler, so
+		// they count as submissions without pairs.
+		var pairs int
+		switch req := m.(type) {
+		case *ecrpc.RegisterMissionControlRequest:
+			pairs = len(req.Pairs)
+
+		case *ecrpc.SyncMissionControlRequest:
+			pairs = len(req.Pairs)
+
+		case *ecrpc.RegisterCLNPayResultsRequest:
+			for _, attempt := range req.Attempts {
+				pairs += len(attempt.GetRoute())
+			}
+
+		case *ecrpc.ImportMissionControlRequest:
+
+		default:
+			return
+		}
+		volume = talkerVolume{
+			registrations:  1,
+			pairs:          uint64(pairs),
+			submittedBytes: uint64(size),
+		}
+	} else {
+		grpcResponseSize.WithLabelValues(method).Observe(float64(size))
+		volume = talkerVolume{egressBytes: uint64(size)}
+	}
+
+	s.talkers.add(clientIdentity(ctx), time.Now(), volume)
+}
+
+// messageSizeUnary is a unary interceptor observing the sizes of the request
+// and the response of RPCs.
+func (s *externalCoordinatorServer) messageSizeUnary(ctx context.Context,
+	req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+	s.observeMessage(ctx, info.FullMethod, req, true)
+
+	resp, err := handler(ctx, req)
+	if err == nil {
+		s.observeMessage(ctx, info.FullMethod, resp, false)
+	}
+
+	return resp, err
+}
+
+// sizedServerStream observes the sizes of the messages received and sent on
+// a server stream.
+type sizedServerStream struct {
+	grpc.ServerStream
+	server *externalCoordinatorServer
+	method string
+}
+
+// RecvMsg receives a message and observes its size.
+func (s *sizedServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.server.observeMessage(s.Context(), s.method, m, true)
+	}
+
+	return err
+}
+
+// SendMsg sends a message and observes its size.
+func (s *sizedServerStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.server.observeMessage(s.Context(), s.method, m, false)
+	}
+
+	return err
+}
+
+// messageSizeStream is a stream interceptor observing the sizes of the
+// messages received and sent by streaming RPCs.
+func (s *externalCoordinatorServer) messageSizeStream(srv any,
+	ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &sizedServerStream{
+		ServerStream: ss,
+		server:       s,
+		method:       info.FullMethod,
+	})
+}
+
+// ListTopTalkers lists the clients with the highest volumes over the last 24
+// hours in the requested order.
+func (a *adminServer) ListTopTalkers(ctx context.Context,
+	req *ecadminrpc.ListTopTalkersRequest) (
+	*ecadminrpc.ListTopTalkersResponse, error) {
+	if a.talkers == nil {
+		return nil, status.Error(codes.FailedPrecondition, "client "+
+			"volumes are not tracked")
+	}
+
+	order := req.GetOrder()
+	if _, ok := ecadminrpc.TalkerOrder_name[int32(order)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown "+
+			"order %d", order)
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultTopTalkers
+	}
+
+	now := time.Now()
+	windowStart := (unixHour(now) - talkerWindowHours + 1) *
+		int64(time.Hour/time.Second)
+
+	return &ecadminrpc.ListTopTalkersResponse{
+		Clients:     a.talkers.top(now, limit, order),
+		WindowStart: windowStart,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestListTopTalkers tests that the volumes of the clients are accounted by
+// the interceptors and listed in the requested order.
+func TestListTopTalkers(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	// Without a tracker there is nothing to report.
+	_, err = admin.ListTopTalkers(
+		context.Background(), &ecadminrpc.ListTopTalkersRequest{},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	admin.talkers = server.talkers
+
+	clientCtx := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 9735},
+		})
+	}
+	call := func(ctx context.Context, method string, req,
+		resp proto.Message) {
+		_, err := server.messageSizeUnary(
+			ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(context.Context, any) (any, error) {
+				return resp, nil
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// The submitter registers two pairs twice, while the reader only
+	// queries large responses.
+	nodeA, nodeB := generateTestKeys(t)
+	register := &ecrpc.RegisterMissionControlRequest{
+		Pairs: []*ecrpc.PairHistory{
+			{NodeFrom: nodeA, NodeTo: nodeB},
+			{NodeFrom: nodeB, NodeTo: nodeA},
+		},
+	}
+	registered := &ecrpc.RegisterMissionControlResponse{
+		SuccessMessage: "ok",
+	}
+	for i := 0; i < 2; i++ {
+		call(
+			clientCtx("192.0.2.1"), registerMethod, register,
+			registered,
+		)
+	}
+	info := &ecrpc.GetInfoResponse{Network: strings.Repeat("x", 1000)}
+	call(
+		clientCtx("192.0.2.2"),
+		ecrpc.ExternalCoordinator_GetInfo_FullMethodName,
+		&ecrpc.GetInfoRequest{}, info,
+	)
+
+	list := func(order ecadminrpc.TalkerOrder,
+		limit uint32) []*ecadminrpc.TalkerVolume {
+		resp, err := admin.ListTopTalkers(
+			context.Background(), &ecadminrpc.ListTopTalkersRequest{
+				Limit: limit,
+				Order: order,
+			},
+		)
+		require.NoError(t, err)
+		require.Less(t, resp.WindowStart, time.Now().Unix())
+
+		return resp.Clients
+	}
+
+	talkers := list(ecadminrpc.TalkerOrder_TALKER_ORDER_SUBMISSIONS, 0)
+	require.Len(t, talkers, 2)
+	require.Equal(t, "192.0.2.1", talkers[0].Client)
+	require.EqualValues(t, 2, talkers[0].Registrations)
+	require.EqualValues(t, 4, talkers[0].PairsSubmitted)
+	require.EqualValues(
+		t, 2*proto.Size(register), talkers[0].SubmittedBytes,
+	)
+	require.EqualValues(
+		t, 2*proto.Size(registered), talkers[0].EgressBytes,
+	)
+	require.Equal(t, "192.0.2.2", talkers[1].Client)
+	require.Zero(t, talkers[1].PairsSubmitted)
+	require.EqualValues(t, proto.Size(info), talkers[1].EgressBytes)
+
+	talkers = list(ecadminrpc.TalkerOrder_TALKER_ORDER_EGRESS, 1)
+	require.Len(t, talkers, 1)
+	require.Equal(t, "192.0.2.2", talkers[0].Client)
+}
+
+// TestTalkerTrackerWindow tests that volumes only count within the last 24
+// hours and that clients without recent volume are pruned.
+func TestTalkerTrackerWindow(t *testing.T) {
+	tracker := newTalkerTracker()
+	now := time.Unix(1700000000, 0)
+	order := ecadminrpc.TalkerOrder_TALKER_ORDER_SUBMISSIONS
+
+	tracker.add("old", now, talkerVolume{registrations: 1, pairs: 5})
+	tracker.add("new", now.Add(12*time.Hour), talkerVolume{
+		registrations: 1,
+		pairs:         1,
+	})
+	tracker.add("new", now.Add(20*time.Hour), talkerVolume{
+		registrations: 1,
+		pairs:         1,
+	})
+
+	talkers := tracker.top(now.Add(20*time.Hour), 10, order)
+	require.Len(t, talkers, 2)
+	require.Equal(t, "old", talkers[0].Client)
+	require.Equal(t, "new", talkers[1].Client)
+	require.EqualValues(t, 2, talkers[1].PairsSubmitted)
+
+	// Once its volume left the window, the old client is not listed and
+	// pruned with the next update.
+	later := now.Add(25 * time.Hour)
+	talkers = tracker.top(later, 10, order)
+	require.Len(t, talkers, 1)
+	require.Equal(t, "new", talkers[0].Client)
+
+	tracker.add("new", later, talkerVolume{registrations: 1})
+	require.NotContains(t, tracker.clients, "old")
+
+	// The slot of an hour is reset once it is reused a day later.
+	tracker.add("new", now.Add(36*time.Hour), talkerVolume{pairs: 3})
+	talkers = tracker.top(now.Add(36*time.Hour), 10, order)
+	require.Len(t, talkers, 1)
+	require.EqualValues(t, 4, talkers[0].PairsSubmitted)
+	require.EqualValues(t, 2, talkers[0].Registrations)
+}
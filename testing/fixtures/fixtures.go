@@ -0,0 +1,281 @@
+// Package fixtures provides deterministic mission control datasets for tests.
+// A fixture lists pairs with fixed node keys and timestamps and can be loaded
+// from a YAML or JSON file. Fixtures can be seeded directly into a bbolt store
+// without going through the RPC layer and dumped back, so aggregation
+// regressions can be captured as golden-file tests.
+package fixtures
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// pubKeySize is the size of a compressed node public key.
+const pubKeySize = 33
+
+// pairDataSchemaV1 is the schema version prefixing the protobuf encoded pair
+// data stored by the server.
+const pairDataSchemaV1 byte = 1
+
+// Fixture is a deterministic dataset of mission control pairs.
+type Fixture struct {
+	// Pairs holds the pairs of the dataset.
+	Pairs []Pair `json:"pairs" yaml:"pairs"`
+}
+
+// Pair is the history of a directed node pair.
+type Pair struct {
+	// NodeFrom is the hex encoded compressed public key of the node the
+	// pair starts at.
+	NodeFrom string `json:"node_from" yaml:"node_from"`
+
+	// NodeTo is the hex encoded compressed public key of the node the pair
+	// ends at.
+	NodeTo string `json:"node_to" yaml:"node_to"`
+
+	// History holds the history data of the pair.
+	History History `json:"history" yaml:"history"`
+}
+
+// History is the history data of a pair. Its fields correspond to the ones
+// of ecrpc.PairData.
+type History struct {
+	FailTime            int64  `json:"fail_time,omitempty" yaml:"fail_time,omitempty"`
+	FailAmtSat          int64  `json:"fail_amt_sat,omitempty" yaml:"fail_amt_sat,omitempty"`
+	FailAmtMsat         int64  `json:"fail_amt_msat,omitempty" yaml:"fail_amt_msat,omitempty"`
+	SuccessTime         int64  `json:"success_time,omitempty" yaml:"success_time,omitempty"`
+	SuccessAmtSat       int64  `json:"success_amt_sat,omitempty" yaml:"success_amt_sat,omitempty"`
+	SuccessAmtMsat      int64  `json:"success_amt_msat,omitempty" yaml:"success_amt_msat,omitempty"`
+	ResolutionLatencyMs uint32 `json:"resolution_latency_ms,omitempty" yaml:"resolution_latency_ms,omitempty"`
+	LatencyP50Ms        uint32 `json:"latency_p50_ms,omitempty" yaml:"latency_p50_ms,omitempty"`
+	LatencyP95Ms        uint32 `json:"latency_p95_ms,omitempty" yaml:"latency_p95_ms,omitempty"`
+	FailureStreak       uint32 `json:"failure_streak,omitempty" yaml:"failure_streak,omitempty"`
+	SuccessGapSeconds   int64  `json:"success_gap_seconds,omitempty" yaml:"success_gap_seconds,omitempty"`
+}
+
+// Load loads a fixture from the given file. Files with the .yaml or .yml
+// extension are decoded as YAML, files with the .json extension as JSON.
+func Load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fixture := &Fixture{}
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, fixture)
+	case ".json":
+		err = json.Unmarshal(data, fixture)
+	default:
+		return nil, fmt.Errorf("unsupported fixture format %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode fixture %s: %v", path,
+			err)
+	}
+
+	// Validate the node keys right away so that broken fixtures are
+	// reported where they are loaded.
+	if _, err := fixture.PairHistories(); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %v", path, err)
+	}
+
+	return fixture, nil
+}
+
+// Encode encodes the fixture as indented JSON suitable for golden files.
+func Encode(fixture *Fixture) ([]byte, error) {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// PairHistories converts the pairs of the fixture into their RPC
+// representation.
+func (f *Fixture) PairHistories() ([]*ecrpc.PairHistory, error) {
+	pairs := make([]*ecrpc.PairHistory, 0, len(f.Pairs))
+	for i, pair := range f.Pairs {
+		nodeFrom, err := decodePubKey(pair.NodeFrom)
+		if err != nil {
+			return nil, fmt.Errorf("pair %d: node_from: %v", i, err)
+		}
+		nodeTo, err := decodePubKey(pair.NodeTo)
+		if err != nil {
+			return nil, fmt.Errorf("pair %d: node_to: %v", i, err)
+		}
+
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History:  pair.History.pairData(),
+		})
+	}
+
+	return pairs, nil
+}
+
+// Seed stores the pairs of the fixture as they are in the given bucket of the
+// database, keyed by the concatenated node keys and encoded like the server
+// stores them. Pairs already stored under
+// the same key are replaced, and no aggregation takes place.
+func Seed(db *bbolt.DB, bucket string, fixture *Fixture) error {
+	pairs, err := fixture.PairHistories()
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range pairs {
+			data, err := encodePairData(pair.History)
+			if err != nil {
+				return err
+			}
+
+			key := append(pair.NodeFrom, pair.NodeTo...)
+			if err := b.Put(key, data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Dump returns the pairs stored in the given bucket of the database as a
+// fixture, in the order of their keys.
+func Dump(db *bbolt.DB, bucket string) (*Fixture, error) {
+	fixture := &Fixture{Pairs: []Pair{}}
+	err := db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return fmt.Errorf("bucket %s not found", bucket)
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			if len(k) != 2*pubKeySize {
+				return fmt.Errorf("invalid pair key %x", k)
+			}
+
+			history, err := decodePairData(v)
+			if err != nil {
+				return fmt.Errorf("pair %x: %v", k, err)
+			}
+
+			fixture.Pairs = append(fixture.Pairs, Pair{
+				NodeFrom: hex.EncodeToString(k[:pubKeySize]),
+				NodeTo:   hex.EncodeToString(k[pubKeySize:]),
+				History:  historyFromPairData(history),
+			})
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fixture, nil
+}
+
+// encodePairData encodes pair data like the server stores it, the schema
+// version followed by the protobuf encoded data.
+func encodePairData(history *ecrpc.PairData) ([]byte, error) {
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(
+		history,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{pairDataSchemaV1}, payload...), nil
+}
+
+// decodePairData decodes pair data stored by the server, either versioned or
+// as the plain JSON stored by older versions.
+func decodePairData(value []byte) (*ecrpc.PairData, error) {
+	history := &ecrpc.PairData{}
+	switch {
+	case len(value) == 0:
+		return nil, fmt.Errorf("empty pair data")
+
+	case value[0] == pairDataSchemaV1:
+		if err := proto.Unmarshal(value[1:], history); err != nil {
+			return nil, err
+		}
+
+	case value[0] == '{':
+		if err := json.Unmarshal(value, history); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown schema version %d", value[0])
+	}
+
+	return history, nil
+}
+
+// decodePubKey decodes a hex encoded compressed public key.
+func decodePubKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != pubKeySize {
+		return nil, fmt.Errorf("expected %d bytes, got %d", pubKeySize,
+			len(key))
+	}
+
+	return key, nil
+}
+
+// pairData converts the history into its RPC representation.
+func (h History) pairData() *ecrpc.PairData {
+	return &ecrpc.PairData{
+		FailTime:            h.FailTime,
+		FailAmtSat:          h.FailAmtSat,
+		FailAmtMsat:         h.FailAmtMsat,
+		SuccessTime:         h.SuccessTime,
+		SuccessAmtSat:       h.SuccessAmtSat,
+		SuccessAmtMsat:      h.SuccessAmtMsat,
+		ResolutionLatencyMs: h.ResolutionLatencyMs,
+		LatencyP50Ms:        h.LatencyP50Ms,
+		LatencyP95Ms:        h.LatencyP95Ms,
+		FailureStreak:       h.FailureStreak,
+		SuccessGapSeconds:   h.SuccessGapSeconds,
+	}
+}
+
+// historyFromPairData converts the RPC representation of history data.
+func historyFromPairData(data *ecrpc.PairData) History {
+	return History{
+		FailTime:            data.FailTime,
+		FailAmtSat:          data.FailAmtSat,
+		FailAmtMsat:         data.FailAmtMsat,
+		SuccessTime:         data.SuccessTime,
+		SuccessAmtSat:       data.SuccessAmtSat,
+		SuccessAmtMsat:      data.SuccessAmtMsat,
+		ResolutionLatencyMs: data.ResolutionLatencyMs,
+		LatencyP50Ms:        data.LatencyP50Ms,
+		LatencyP95Ms:        data.LatencyP95Ms,
+		FailureStreak:       data.FailureStreak,
+		SuccessGapSeconds:   data.SuccessGapSeconds,
+	}
+}
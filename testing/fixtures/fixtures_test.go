@@ -0,0 +1,127 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bbolt "go.etcd.io/bbolt"
+)
+
+var (
+	nodeA = "02" + strings.Repeat("a", 64)
+	nodeB = "03" + strings.Repeat("b", 64)
+)
+
+// writeFile writes the given content to a file in a temporary directory and
+// returns its path.
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	return path
+}
+
+// TestLoad tests loading fixtures from YAML and JSON files.
+func TestLoad(t *testing.T) {
+	yamlPath := writeFile(t, "fixture.yaml", "pairs:\n"+
+		"  - node_from: "+nodeA+"\n"+
+		"    node_to: "+nodeB+"\n"+
+		"    history:\n"+
+		"      success_time: 1700000000\n"+
+		"      success_amt_msat: 10000\n")
+	jsonPath := writeFile(t, "fixture.json", `{"pairs": [{`+
+		`"node_from": "`+nodeA+`", "node_to": "`+nodeB+`", `+
+		`"history": {"success_time": 1700000000, `+
+		`"success_amt_msat": 10000}}]}`)
+
+	for _, path := range []string{yamlPath, jsonPath} {
+		fixture, err := Load(path)
+		require.NoError(t, err)
+
+		pairs, err := fixture.PairHistories()
+		require.NoError(t, err)
+		require.Len(t, pairs, 1)
+		require.Len(t, pairs[0].NodeFrom, pubKeySize)
+		require.Len(t, pairs[0].NodeTo, pubKeySize)
+		require.EqualValues(t, 1700000000, pairs[0].History.SuccessTime)
+		require.EqualValues(t, 10000, pairs[0].History.SuccessAmtMsat)
+	}
+
+	// Unsupported formats and invalid node keys are rejected.
+	_, err := Load(writeFile(t, "fixture.txt", ""))
+	require.ErrorContains(t, err, "unsupported fixture format")
+
+	_, err = Load(writeFile(t, "fixture.yaml", "pairs:\n"+
+		"  - node_from: 02aa\n"+
+		"    node_to: "+nodeB+"\n"))
+	require.ErrorContains(t, err, "node_from")
+}
+
+// TestLoadMergeVector tests loading merge vectors and rejecting results out
+// of chronological order.
+func TestLoadMergeVector(t *testing.T) {
+	vector, err := LoadMergeVector(writeFile(t, "vector.yaml", ""+
+		"lnd_version: v0.18.3-beta\n"+
+		"results:\n"+
+		"  - time: 1700000000\n"+
+		"    success: true\n"+
+		"    amt_msat: 10000\n"+
+		"    expected:\n"+
+		"      success_time: 1700000000\n"+
+		"      success_amt_msat: 10000\n"+
+		"  - time: 1700000010\n"+
+		"    amt_msat: 20000\n"))
+	require.NoError(t, err)
+	require.Len(t, vector.Results, 2)
+	success := vector.Results[0].PairData()
+	require.EqualValues(t, 10000, success.SuccessAmtMsat)
+	require.EqualValues(t, 10, success.SuccessAmtSat)
+	failure := vector.Results[1].PairData()
+	require.EqualValues(t, 1700000010, failure.FailTime)
+	require.EqualValues(t, 20000, failure.FailAmtMsat)
+
+	_, err = LoadMergeVector(writeFile(t, "vector.json", `{`+
+		`"lnd_version": "v0.18.3-beta", "results": [`+
+		`{"time": 1700000010, "amt_msat": 1000}, `+
+		`{"time": 1700000000, "amt_msat": 1000}]}`))
+	require.ErrorContains(t, err, "result 1")
+
+	_, err = LoadMergeVector(writeFile(t, "vector.yaml", "results: []\n"))
+	require.ErrorContains(t, err, "lnd_version")
+}
+
+// TestSeedAndDump tests that seeded fixtures are dumped unchanged.
+func TestSeedAndDump(t *testing.T) {
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	fixture := &Fixture{Pairs: []Pair{
+		{
+			NodeFrom: nodeB,
+			NodeTo:   nodeA,
+			History:  History{FailTime: 1700000000, FailAmtMsat: 5000},
+		},
+		{
+			NodeFrom: nodeA,
+			NodeTo:   nodeB,
+			History: History{
+				SuccessTime:    1700000000,
+				SuccessAmtMsat: 10000,
+			},
+		},
+	}}
+	require.NoError(t, Seed(db, "pairs", fixture))
+
+	// The pairs are dumped in the order of their keys.
+	dumped, err := Dump(db, "pairs")
+	require.NoError(t, err)
+	require.Equal(t, []Pair{fixture.Pairs[1], fixture.Pairs[0]},
+		dumped.Pairs)
+
+	_, err = Dump(db, "unknown")
+	require.Error(t, err)
+}
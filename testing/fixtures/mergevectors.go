@@ -0,0 +1,127 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"gopkg.in/yaml.v3"
+)
+
+// MergeVector captures how the in-memory mission control of lnd merges a
+// sequence of payment results of a single pair. After each result it holds
+// the pair result lnd keeps, so that the aggregation of the coordinator can
+// be checked against lnd step by step.
+type MergeVector struct {
+	// LNDVersion is the release of lnd whose behavior the vector
+	// captures.
+	LNDVersion string `json:"lnd_version" yaml:"lnd_version"`
+
+	// Results holds the payment results of the pair in the order lnd
+	// recorded them.
+	Results []MergeStep `json:"results" yaml:"results"`
+}
+
+// MergeStep is a payment result of a pair together with the pair result lnd
+// keeps after recording it.
+type MergeStep struct {
+	// Time is the unix timestamp in seconds of the result.
+	Time int64 `json:"time" yaml:"time"`
+
+	// Success tells whether the pair forwarded the amount.
+	Success bool `json:"success" yaml:"success"`
+
+	// AmtMsat is the amount of the result in millisatoshis. A failure for
+	// zero is independent of the amount.
+	AmtMsat int64 `json:"amt_msat" yaml:"amt_msat"`
+
+	// Expected is the pair result lnd keeps after recording the result.
+	Expected PairResult `json:"expected" yaml:"expected"`
+}
+
+// PairResult is the last success and failure lnd keeps for a pair.
+type PairResult struct {
+	FailTime       int64 `json:"fail_time" yaml:"fail_time"`
+	FailAmtMsat    int64 `json:"fail_amt_msat" yaml:"fail_amt_msat"`
+	SuccessTime    int64 `json:"success_time" yaml:"success_time"`
+	SuccessAmtMsat int64 `json:"success_amt_msat" yaml:"success_amt_msat"`
+}
+
+// LoadMergeVector loads a merge vector from the given file. Files with the
+// .yaml or .yml extension are decoded as YAML, files with the .json extension
+// as JSON.
+func LoadMergeVector(path string) (*MergeVector, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	vector := &MergeVector{}
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, vector)
+	case ".json":
+		err = json.Unmarshal(data, vector)
+	default:
+		return nil, fmt.Errorf("unsupported vector format %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode vector %s: %v", path,
+			err)
+	}
+
+	if err := vector.validate(); err != nil {
+		return nil, fmt.Errorf("invalid vector %s: %v", path, err)
+	}
+
+	return vector, nil
+}
+
+// validate ensures that the vector holds results with positive timestamps in
+// chronological order. The coordinator orders results by their timestamps,
+// while lnd records them as they arrive, so only vectors in chronological
+// order describe the same sequence to both.
+func (v *MergeVector) validate() error {
+	if v.LNDVersion == "" {
+		return fmt.Errorf("lnd_version must be set")
+	}
+	if len(v.Results) == 0 {
+		return fmt.Errorf("at least one result must be listed")
+	}
+
+	var prev int64
+	for i, result := range v.Results {
+		switch {
+		case result.Time <= prev:
+			return fmt.Errorf("result %d: time must be positive "+
+				"and after the previous result", i)
+
+		case result.AmtMsat < 0:
+			return fmt.Errorf("result %d: amt_msat must not be "+
+				"negative", i)
+		}
+		prev = result.Time
+	}
+
+	return nil
+}
+
+// PairData converts the result into the pair data of a registration holding
+// only this result.
+func (s MergeStep) PairData() *ecrpc.PairData {
+	if s.Success {
+		return &ecrpc.PairData{
+			SuccessTime:    s.Time,
+			SuccessAmtSat:  s.AmtMsat / 1000,
+			SuccessAmtMsat: s.AmtMsat,
+		}
+	}
+
+	return &ecrpc.PairData{
+		FailTime:    s.Time,
+		FailAmtSat:  s.AmtMsat / 1000,
+		FailAmtMsat: s.AmtMsat,
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	logrus "github.com/sirupsen/logrus"
@@ -95,14 +97,92 @@ func loadTLSCredentials(config *Config) (*tls.Config, error) {
 		return nil, err
 	}
 
+	// Apply the configured minimum version and cipher suites, which are
+	// shared by all servers.
+	minVersion, err := parseTLSMinVersion(config.TLS.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	cipherSuites, err := parseCipherSuites(config.TLS.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+	if minVersion == tls.VersionTLS13 && len(cipherSuites) > 0 {
+		logrus.Warn("The configured cipher suites only apply to TLS " +
+			"1.2 and are ignored with a minimum version of 1.3")
+	}
+
 	// Return the TLS credentials for server-side TLS only.
 	return &tls.Config{
 		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
+		MinVersion:   minVersion,
+		CipherSuites: cipherSuites,
 		ClientAuth:   tls.NoClientCert,
 	}, nil
 }
 
+// parseTLSMinVersion parses the configured minimum TLS version. An empty
+// version defaults to TLS 1.2.
+func parseTLSMinVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+
+	case "1.3":
+		return tls.VersionTLS13, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported minimum TLS version %q, "+
+			"expected 1.2 or 1.3", version)
+	}
+}
+
+// parseCipherSuites parses the comma separated names of the configured cipher
+// suites. Only the TLS 1.2 suites Go considers secure are accepted, so that a
+// configuration cannot weaken the defaults by accident, and since the suites
+// of TLS 1.3 cannot be configured. An empty list keeps the default cipher
+// suites.
+func parseCipherSuites(names string) ([]uint16, error) {
+	secure := make(map[string]uint16)
+	tls13 := make(map[string]struct{})
+	for _, suite := range tls.CipherSuites() {
+		if slices.Contains(suite.SupportedVersions, tls.VersionTLS12) {
+			secure[suite.Name] = suite.ID
+		} else {
+			tls13[suite.Name] = struct{}{}
+		}
+	}
+	insecure := make(map[string]struct{})
+	for _, suite := range tls.InsecureCipherSuites() {
+		insecure[suite.Name] = struct{}{}
+	}
+
+	var suites []uint16
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := insecure[name]; ok {
+			return nil, fmt.Errorf("cipher suite %s is insecure",
+				name)
+		}
+		if _, ok := tls13[name]; ok {
+			return nil, fmt.Errorf("cipher suite %s belongs to "+
+				"TLS 1.3, whose suites cannot be configured",
+				name)
+		}
+		id, ok := secure[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %s", name)
+		}
+		suites = append(suites, id)
+	}
+
+	return suites, nil
+}
+
 // checkAndCreateSelfSignedTLS checks if local self-signed certificates exist and creates them if necessary.
 func checkAndCreateSelfSignedTLS(certFile, keyFile string) error {
 	err := checkFilesExist(certFile, keyFile)
@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -317,3 +318,75 @@ func TestCreateThirdPartyTLSDirIfNotExist(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+// TestTLSVersionAndCipherSuites tests that the configured minimum TLS version
+// and cipher suites are applied and that insecure or unknown ones are
+// rejected.
+func TestTLSVersionAndCipherSuites(t *testing.T) {
+	tempDir := t.TempDir()
+	logrus.SetOutput(io.Discard)
+
+	load := func(minVersion, cipherSuites string) (*tls.Config, error) {
+		return loadTLSCredentials(&Config{
+			TLS: TLSConfig{
+				SelfSignedTLSDirPath:  tempDir,
+				SelfSignedTLSCertFile: "tls.cert",
+				SelfSignedTLSKeyFile:  "tls.key",
+				MinVersion:            minVersion,
+				CipherSuites:          cipherSuites,
+			},
+		})
+	}
+
+	// TLS 1.2 is the default minimum version.
+	tlsConfig, err := load("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+	assert.Empty(t, tlsConfig.CipherSuites)
+
+	tlsConfig, err = load("1.3", "")
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+
+	tlsConfig, err = load(
+		"1.2", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384, "+
+			"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+	}, tlsConfig.CipherSuites)
+
+	for _, test := range []struct {
+		minVersion, cipherSuites string
+	}{
+		{"1.1", ""},
+		{"1.2", "TLS_RSA_WITH_RC4_128_SHA"},
+		{"1.2", "TLS_AES_128_GCM_SHA256"},
+		{"1.2", "TLS_UNKNOWN"},
+	} {
+		_, err := load(test.minVersion, test.cipherSuites)
+		assert.Error(t, err)
+	}
+
+	// Servers reject clients below the minimum version.
+	tlsConfig, err = load("1.3", "")
+	assert.NoError(t, err)
+	lis, err := tls.Listen("tcp", "localhost:0", tlsConfig)
+	assert.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		conn, err := lis.Accept()
+		if err == nil {
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	_, err = tls.Dial("tcp", lis.Addr().String(), &tls.Config{
+		InsecureSkipVerify: true,
+		MaxVersion:         tls.VersionTLS12,
+	})
+	assert.Error(t, err)
+}
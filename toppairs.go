@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultTopPairs is the number of pairs returned by a top pairs query
+	// without a limit.
+	defaultTopPairs = 100
+
+	// maxTopPairs is the maximum number of pairs returned by a top pairs
+	// query.
+	maxTopPairs = 1000
+)
+
+// topPair is a raw pair read from the database together with the amount and
+// time of its last success.
+type topPair struct {
+	key         []byte
+	value       []byte
+	successAmt  int64
+	successTime int64
+}
+
+// topPairs returns at most limit raw pairs accepted by the filter whose last
+// success happened at or after the cutoff, ordered by the amount of their last
+// success and then by its time, the highest and most recent first. If the node
+// is set, only the pairs from and to it are read. It returns the number of keys
+// scanned as well.
+func topPairs(ctx context.Context, tx *bbolt.Tx, node []byte,
+	cutoff time.Time, limit int,
+	filter func(nodeFrom, nodeTo []byte) bool) ([][]byte, [][]byte, int,
+	error) {
+
+	cursors := []pairCursor{newPairCursor(tx, nil, nil)}
+	if len(node) > 0 {
+		cursors = []pairCursor{
+			newPairCursor(tx, node, nil),
+			newPairCursor(tx, nil, node),
+		}
+	}
+
+	var pairs []topPair
+	scanned := 0
+	for _, c := range cursors {
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return nil, nil, scanned, err
+			}
+
+			nodeFrom, nodeTo := splitPairKey(k)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			history, err := decodePairData(v)
+			if err != nil {
+				return nil, nil, scanned, status.Errorf(
+					codes.DataLoss, "failed to decode "+
+						"history data: %v", err,
+				)
+			}
+			if history.SuccessTime == 0 ||
+				history.SuccessTime < cutoff.Unix() {
+
+				continue
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			pairs = append(pairs, topPair{
+				key:         bytes.Clone(k),
+				value:       bytes.Clone(v),
+				successAmt:  history.SuccessAmtMsat,
+				successTime: history.SuccessTime,
+			})
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if pairs[i].successAmt != pairs[j].successAmt {
+			return pairs[i].successAmt > pairs[j].successAmt
+		}
+
+		return pairs[i].successTime > pairs[j].successTime
+	})
+	pairs = pairs[:min(limit, len(pairs))]
+
+	keys := make([][]byte, 0, len(pairs))
+	values := make([][]byte, 0, len(pairs))
+	for _, pair := range pairs {
+		keys = append(keys, pair.key)
+		values = append(values, pair.value)
+	}
+
+	return keys, values, scanned, nil
+}
+
+// QueryTopPairs returns the pairs with the highest recent success amounts,
+// optionally limited to the pairs of a node. The pairs are filtered,
+// transformed and accounted like the ones of a query.
+func (s *externalCoordinatorServer) QueryTopPairs(ctx context.Context,
+	req *ecrpc.QueryTopPairsRequest) (*ecrpc.QueryTopPairsResponse,
+	error) {
+
+	// Coordinators publishing statistics only withhold the pairs.
+	if err := s.checkServesPairs(); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultTopPairs
+	}
+	limit = min(limit, maxTopPairs)
+
+	node := req.GetNode()
+	if len(node) > 0 {
+		if err := pairKeys.validateNode(node); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid Node: %v", err)
+		}
+	}
+
+	maxAge, err := s.queryMaxAge(req.GetMaxAgeSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject clients which already reached their daily egress cap.
+	client := clientIdentity(ctx)
+	if err := s.checkEgressCap(ctx, client); err != nil {
+		logrus.Infof("Top pairs query rejected: %v", err)
+		return nil, err
+	}
+
+	start := time.Now()
+	opCtx, cancel := s.operationContext(ctx)
+	defer cancel()
+
+	scope := accessScopeFromContext(ctx).filter(nil)
+	cutoff := s.clock.Now().Add(-maxAge)
+	resp := &ecrpc.QueryTopPairsResponse{}
+	var keys, values [][]byte
+	scanned := 0
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		resp.Dataset = currentDatasetInfo(tx)
+
+		var err error
+		keys, values, scanned, err = topPairs(
+			opCtx, tx, node, cutoff, limit, scope,
+		)
+		return err
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		err = s.abortedQueryError(err)
+		msg := "top pairs query failed: %v"
+		st := status.Convert(err)
+		logrus.Errorf(msg, st.Message())
+		return nil, status.Errorf(st.Code(), msg, st.Message())
+	}
+
+	// The pairs are decoded, run through the serve hook of the operator
+	// and watermarked like the pairs of a query.
+	collected := &collectedQueryStream{ctx: ctx}
+	_, err = s.sendPairBatches(
+		s.transforms.transformServed(s.watermarkServed(ctx, collected)),
+		keys, values,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp.Pairs = collected.pairs
+
+	s.recordEgress(client, int64(proto.Size(resp)))
+	logrus.Infof("Top pairs query returned %d pairs", len(resp.Pairs))
+
+	return resp, nil
+}
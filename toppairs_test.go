@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestQueryTopPairs tests that the pairs with the highest recent success
+// amounts are returned first, limited to the pairs of a node if requested.
+func TestQueryTopPairs(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	ctx := context.Background()
+
+	nodeA, nodeB := generateTestKeys(t)
+	nodeC, nodeD := generateTestKeys(t)
+	now := time.Now()
+	older, recent := now.Add(-30*time.Minute), now.Add(-time.Minute)
+	success := func(nodeFrom, nodeTo []byte, amtSat int64,
+		successTime time.Time) *ecrpc.PairHistory {
+
+		return &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    successTime.Unix(),
+				SuccessAmtSat:  amtSat,
+				SuccessAmtMsat: amtSat * mSatScale,
+			},
+		}
+	}
+	_, err = server.RegisterMissionControl(
+		ctx, &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{
+				success(nodeA, nodeB, 100, now),
+				success(nodeB, nodeC, 300, older),
+				success(nodeC, nodeD, 200, now),
+				success(nodeD, nodeA, 200, recent),
+				{
+					NodeFrom: nodeB,
+					NodeTo:   nodeD,
+					History: &ecrpc.PairData{
+						FailTime:    now.Unix(),
+						FailAmtSat:  1,
+						FailAmtMsat: 1000,
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(req *ecrpc.QueryTopPairsRequest) [][2][]byte {
+		resp, err := server.QueryTopPairs(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Dataset)
+
+		pairs := make([][2][]byte, 0, len(resp.Pairs))
+		for _, pair := range resp.Pairs {
+			pairs = append(pairs, [2][]byte{
+				pair.NodeFrom, pair.NodeTo,
+			})
+		}
+
+		return pairs
+	}
+
+	// Pairs without any success are not returned, and pairs with the same
+	// amount are ordered by the time of their last success.
+	require.Equal(t, [][2][]byte{
+		{nodeB, nodeC}, {nodeC, nodeD}, {nodeD, nodeA}, {nodeA, nodeB},
+	}, query(&ecrpc.QueryTopPairsRequest{}))
+
+	require.Equal(t, [][2][]byte{{nodeB, nodeC}, {nodeC, nodeD}},
+		query(&ecrpc.QueryTopPairsRequest{Limit: 2}))
+
+	require.Equal(t, [][2][]byte{{nodeC, nodeD}, {nodeD, nodeA}},
+		query(&ecrpc.QueryTopPairsRequest{
+			Limit:         2,
+			MaxAgeSeconds: 600,
+		}))
+
+	// Only the pairs from and to the node are returned.
+	require.Equal(t, [][2][]byte{{nodeD, nodeA}, {nodeA, nodeB}},
+		query(&ecrpc.QueryTopPairsRequest{Node: nodeA}))
+
+	for _, req := range []*ecrpc.QueryTopPairsRequest{
+		{Node: []byte{1, 2, 3}},
+		{MaxAgeSeconds: -1},
+	} {
+		_, err := server.QueryTopPairs(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
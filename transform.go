@@ -0,0 +1,264 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"go.starlark.net/starlark"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// transformIngestHook is the name of the function of the transform
+	// script invoked for every registered pair.
+	transformIngestHook = "ingest"
+
+	// transformServeHook is the name of the function of the transform
+	// script invoked for every queried pair.
+	transformServeHook = "serve"
+)
+
+// pairTransformer runs the hooks of the operator defined transform script on
+// the pairs registered and served. Each hook is a Starlark function receiving
+// a pair as a dict and returning the dict of the transformed pair, or None to
+// drop the pair.
+type pairTransformer struct {
+	// ingest is the hook invoked for every registered pair, nil if the
+	// script does not define it.
+	ingest starlark.Callable
+
+	// serve is the hook invoked for every queried pair, nil if the script
+	// does not define it.
+	serve starlark.Callable
+}
+
+// loadPairTransformer loads the hooks of the transform script at the given
+// path. The globals of the script are frozen once it was executed, so the
+// hooks can be invoked concurrently.
+func loadPairTransformer(path string) (*pairTransformer, error) {
+	thread := &starlark.Thread{Name: "transform"}
+	globals, err := starlark.ExecFile(thread, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transform script: %v",
+			err)
+	}
+	globals.Freeze()
+
+	t := &pairTransformer{}
+	hooks := []struct {
+		name string
+		hook *starlark.Callable
+	}{
+		{transformIngestHook, &t.ingest},
+		{transformServeHook, &t.serve},
+	}
+	for _, h := range hooks {
+		v, ok := globals[h.name]
+		if !ok {
+			continue
+		}
+		fn, ok := v.(starlark.Callable)
+		if !ok {
+			return nil, fmt.Errorf("%s of the transform script is "+
+				"not a function", h.name)
+		}
+		*h.hook = fn
+	}
+	if t.ingest == nil && t.serve == nil {
+		return nil, fmt.Errorf("transform script defines neither %s "+
+			"nor %s", transformIngestHook, transformServeHook)
+	}
+
+	return t, nil
+}
+
+// StartTransformHooks loads the configured transform script, whose hooks are
+// invoked on every pair registered and served from then on.
+func (s *externalCoordinatorServer) StartTransformHooks() error {
+	transformer, err := loadPairTransformer(
+		s.config.Server.TransformScript,
+	)
+	if err != nil {
+		return err
+	}
+	s.transforms = transformer
+
+	logrus.Infof("Transforming pairs with the hooks of %s",
+		s.config.Server.TransformScript)
+
+	return nil
+}
+
+// pairToStarlark returns the dict a hook receives for the pair.
+func pairToStarlark(pair *ecrpc.PairHistory) *starlark.Dict {
+	history := pair.GetHistory()
+	fields := []struct {
+		key   string
+		value starlark.Value
+	}{
+		{"node_from", hexString(pair.NodeFrom)},
+		{"node_to", hexString(pair.NodeTo)},
+		{"fail_time", starlark.MakeInt64(history.GetFailTime())},
+		{"fail_amt_msat", starlark.MakeInt64(history.GetFailAmtMsat())},
+		{"success_time", starlark.MakeInt64(history.GetSuccessTime())},
+		{"success_amt_msat", starlark.MakeInt64(
+			history.GetSuccessAmtMsat(),
+		)},
+	}
+
+	dict := starlark.NewDict(len(fields))
+	for _, f := range fields {
+		// Setting string keys of a new dict cannot fail.
+		_ = dict.SetKey(starlark.String(f.key), f.value)
+	}
+
+	return dict
+}
+
+// hexString returns the hex encoding of the pubkey as a Starlark string.
+func hexString(pubkey []byte) starlark.String {
+	return starlark.String(hex.EncodeToString(pubkey))
+}
+
+// pairFromStarlark applies the history fields of the dict returned by a hook
+// to a copy of the pair. The nodes of a pair cannot be changed.
+func pairFromStarlark(pair *ecrpc.PairHistory,
+	v starlark.Value) (*ecrpc.PairHistory, error) {
+
+	dict, ok := v.(*starlark.Dict)
+	if !ok {
+		return nil, fmt.Errorf("hook returned %s instead of a dict or "+
+			"None", v.Type())
+	}
+
+	transformed := proto.Clone(pair).(*ecrpc.PairHistory)
+	if transformed.History == nil {
+		transformed.History = &ecrpc.PairData{}
+	}
+	history := transformed.History
+	fields := []struct {
+		key   string
+		value *int64
+	}{
+		{"fail_time", &history.FailTime},
+		{"fail_amt_msat", &history.FailAmtMsat},
+		{"success_time", &history.SuccessTime},
+		{"success_amt_msat", &history.SuccessAmtMsat},
+	}
+	for _, f := range fields {
+		value, found, err := dict.Get(starlark.String(f.key))
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			*f.value = 0
+			continue
+		}
+		if err := starlark.AsInt(value, f.value); err != nil {
+			return nil, fmt.Errorf("%s: %v", f.key, err)
+		}
+		if *f.value < 0 {
+			return nil, fmt.Errorf("%s must not be negative", f.key)
+		}
+	}
+	history.FailAmtSat = history.FailAmtMsat / mSatScale
+	history.SuccessAmtSat = history.SuccessAmtMsat / mSatScale
+
+	return transformed, nil
+}
+
+// apply invokes the hook for each of the pairs and returns the transformed
+// pairs which were not dropped.
+func (t *pairTransformer) apply(hook starlark.Callable,
+	pairs []*ecrpc.PairHistory) ([]*ecrpc.PairHistory, error) {
+
+	transformed := make([]*ecrpc.PairHistory, 0, len(pairs))
+	for _, pair := range pairs {
+		thread := &starlark.Thread{Name: hook.Name()}
+		v, err := starlark.Call(
+			thread, hook, starlark.Tuple{pairToStarlark(pair)}, nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if v == starlark.None {
+			continue
+		}
+
+		pair, err := pairFromStarlark(pair, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", hook.Name(), err)
+		}
+		transformed = append(transformed, pair)
+	}
+
+	return transformed, nil
+}
+
+// transformIngested runs the ingest hook on the registered pairs if defined.
+// It returns the pairs to register.
+func (t *pairTransformer) transformIngested(
+	pairs []*ecrpc.PairHistory) ([]*ecrpc.PairHistory, error) {
+
+	if t == nil || t.ingest == nil {
+		return pairs, nil
+	}
+
+	transformed, err := t.apply(t.ingest, pairs)
+	if err != nil {
+		msg := "ingest hook failed: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(codes.Internal, msg, err)
+	}
+
+	return transformed, nil
+}
+
+// transformStream runs the serve hook on the pairs sent on a query stream.
+type transformStream struct {
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer
+
+	// transformer runs the serve hook.
+	transformer *pairTransformer
+}
+
+// Send runs the serve hook on the pairs of the response and sends the
+// transformed pairs.
+func (t *transformStream) Send(
+	resp *ecrpc.QueryAggregatedMissionControlResponse) error {
+
+	transformer := t.transformer
+	pairs, err := transformer.apply(transformer.serve, resp.Pairs)
+	if err != nil {
+		msg := "serve hook failed: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(codes.Internal, msg, err)
+	}
+
+	return t.ExternalCoordinator_QueryAggregatedMissionControlServer.Send(
+		&ecrpc.QueryAggregatedMissionControlResponse{
+			Pairs:   pairs,
+			Dataset: resp.Dataset,
+		},
+	)
+}
+
+// transformServed returns a stream running the serve hook on the pairs sent
+// on the given stream if defined, and else the given stream.
+func (t *pairTransformer) transformServed(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer) (
+	served ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer) {
+
+	if t == nil || t.serve == nil {
+		return stream
+	}
+
+	return &transformStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: stream,
+		transformer: t,
+	}
+}
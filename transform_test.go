@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writeTransformScript writes the given transform script to a temporary file
+// and returns its path.
+func writeTransformScript(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "transform.star")
+	require.NoError(t, os.WriteFile(path, []byte(script), 0600))
+
+	return path
+}
+
+// TestTransformHooks tests that the hooks of the transform script transform
+// and drop the pairs registered and served.
+func TestTransformHooks(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	nodeA, nodeB := generateTestKeys(t)
+	_, nodeC := generateTestKeys(t)
+	_, nodeD := generateTestKeys(t)
+
+	// The ingest hook drops the pairs to node C and the serve hook strips
+	// the amounts of the pairs to node D.
+	config.Server.TransformScript = writeTransformScript(t, `
+def ingest(pair):
+    if pair["node_to"] == "`+hex.EncodeToString(nodeC)+`":
+        return None
+    return pair
+
+def serve(pair):
+    if pair["node_to"] == "`+hex.EncodeToString(nodeD)+`":
+        pair["fail_amt_msat"] = 0
+    return pair
+`)
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartTransformHooks())
+
+	now := time.Now().Unix()
+	var pairs []*ecrpc.PairHistory
+	for _, nodeTo := range [][]byte{nodeB, nodeC, nodeD} {
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeA,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				FailTime:    now,
+				FailAmtSat:  2_000,
+				FailAmtMsat: 2_000_000,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: pairs,
+		},
+	)
+	require.NoError(t, err)
+
+	stream := &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{}, stream,
+	)
+	require.NoError(t, err)
+
+	served := make(map[string]*ecrpc.PairData)
+	for _, resp := range stream.Responses {
+		for _, pair := range resp.Pairs {
+			served[string(pair.NodeTo)] = pair.History
+		}
+	}
+	require.Len(t, served, 2)
+	require.EqualValues(t, 2_000_000, served[string(nodeB)].FailAmtMsat)
+	require.EqualValues(t, 2_000, served[string(nodeB)].FailAmtSat)
+	require.Zero(t, served[string(nodeD)].FailAmtMsat)
+	require.Zero(t, served[string(nodeD)].FailAmtSat)
+	require.Equal(t, now, served[string(nodeD)].FailTime)
+
+	// The stored pair of node D keeps its amount.
+	var history *ecrpc.PairData
+	err = db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket([]byte(DatabaseBucketName)).Get(
+			pairKey(nodeA, nodeD),
+		)
+		var err error
+		history, err = decodePairData(v)
+
+		return err
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2_000_000, history.FailAmtMsat)
+
+	// Failing hooks fail the registration.
+	config.Server.TransformScript = writeTransformScript(t, `
+def ingest(pair):
+    pair["fail_amt_msat"] = -1
+    return pair
+`)
+	require.NoError(t, server.StartTransformHooks())
+	_, err = server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: pairs[:1],
+		},
+	)
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+// TestLoadPairTransformer tests that transform scripts without valid hooks are
+// rejected.
+func TestLoadPairTransformer(t *testing.T) {
+	scripts := []string{
+		"def ingest(pair)\n",
+		"x = 1\n",
+		"ingest = 1\n",
+	}
+	for _, script := range scripts {
+		_, err := loadPairTransformer(writeTransformScript(t, script))
+		require.Error(t, err, script)
+	}
+
+	_, err := loadPairTransformer(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+
+	transformer, err := loadPairTransformer(writeTransformScript(
+		t, "def serve(pair):\n    return pair\n",
+	))
+	require.NoError(t, err)
+	require.Nil(t, transformer.ingest)
+	require.NotNil(t, transformer.serve)
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// updateTimeSize is the size of the big-endian update time prefixing the keys
+// of the update index.
+const updateTimeSize = 8
+
+// indexedPair is a raw pair read through the update index together with the
+// time of its last update.
+type indexedPair struct {
+	key     []byte
+	value   []byte
+	updated int64
+}
+
+// updateIndexKey returns the key of a pair within the update index. Keys are
+// ordered by the update time first, so that pairs updated within a time range
+// are found by a range scan.
+func updateIndexKey(updated int64, key []byte) []byte {
+	indexKey := make([]byte, 0, updateTimeSize+len(key))
+	indexKey = append(indexKey, encodeUint64(uint64(updated))...)
+
+	return append(indexKey, key...)
+}
+
+// pairUpdateTime returns the time of the last update of the stored pair data,
+// i.e. the time of its last success or failure, whichever is later.
+func pairUpdateTime(value []byte) (int64, error) {
+	history, err := decodePairData(value)
+	if err != nil {
+		return 0, status.Errorf(codes.DataLoss, "failed to decode "+
+			"history data: %v", err)
+	}
+
+	return mostRecentUnixTimestamp(history.FailTime, history.SuccessTime),
+		nil
+}
+
+// putPair stores the data of a pair and keeps the update, node and modified
+// indexes and the Merkle tree in sync. Every write to the mission control
+// bucket must go through it.
+func putPair(tx *bbolt.Tx, key, value []byte) error {
+	if err := logPairRevision(tx, key); err != nil {
+		return err
+	}
+
+	if tx.Bucket([]byte(DatabaseBucketName)).Get(key) == nil {
+		if err := addPairNodes(tx, key); err != nil {
+			return err
+		}
+	}
+
+	if err := deleteIndexEntry(tx, key); err != nil {
+		return err
+	}
+
+	updated, err := pairUpdateTime(value)
+	if err != nil {
+		return err
+	}
+	index := tx.Bucket([]byte(UpdateIndexBucketName))
+	if err := index.Put(updateIndexKey(updated, key), nil); err != nil {
+		return err
+	}
+	if err := markPairModified(tx, key, false); err != nil {
+		return err
+	}
+	if err := updateMerkleTree(tx, key, value); err != nil {
+		return err
+	}
+
+	return tx.Bucket([]byte(DatabaseBucketName)).Put(key, value)
+}
+
+// deletePair removes the data of a pair together with its update and node
+// index entries and its entry of the Merkle tree, and records its removal in
+// the modified index.
+func deletePair(tx *bbolt.Tx, key []byte) error {
+	if err := logPairRevision(tx, key); err != nil {
+		return err
+	}
+
+	if tx.Bucket([]byte(DatabaseBucketName)).Get(key) != nil {
+		if err := removePairNodes(tx, key); err != nil {
+			return err
+		}
+		if err := markPairModified(tx, key, true); err != nil {
+			return err
+		}
+		if err := updateMerkleTree(tx, key, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := deleteIndexEntry(tx, key); err != nil {
+		return err
+	}
+
+	return tx.Bucket([]byte(DatabaseBucketName)).Delete(key)
+}
+
+// deleteIndexEntry removes the update index entry of the stored pair, if any.
+func deleteIndexEntry(tx *bbolt.Tx, key []byte) error {
+	v := tx.Bucket([]byte(DatabaseBucketName)).Get(key)
+	if v == nil {
+		return nil
+	}
+
+	updated, err := pairUpdateTime(v)
+	if err != nil {
+		return err
+	}
+	index := tx.Bucket([]byte(UpdateIndexBucketName))
+
+	return index.Delete(updateIndexKey(updated, key))
+}
+
+// initUpdateIndex builds the update index from the stored pairs if the
+// database does not have one yet, e.g. because it was created by an older
+// version.
+func initUpdateIndex(tx *bbolt.Tx) error {
+	if tx.Bucket([]byte(UpdateIndexBucketName)) != nil {
+		return nil
+	}
+
+	index, err := tx.CreateBucket([]byte(UpdateIndexBucketName))
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket([]byte(DatabaseBucketName)).ForEach(
+		func(k, v []byte) error {
+			updated, err := pairUpdateTime(v)
+			if err != nil {
+				return err
+			}
+
+			return index.Put(updateIndexKey(updated, k), nil)
+		},
+	)
+}
+
+// stalePairIndexKeys returns the update index keys of all pairs last updated
+// before the cutoff. Only the stale pairs are scanned.
+func stalePairIndexKeys(tx *bbolt.Tx, cutoff time.Time) [][]byte {
+	var indexKeys [][]byte
+	c := tx.Bucket([]byte(UpdateIndexBucketName)).Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		updated := int64(decodeUint64(k[:updateTimeSize]))
+		if !time.Unix(updated, 0).Before(cutoff) {
+			break
+		}
+
+		// The key is only valid for the lifetime of the transaction,
+		// so it is copied.
+		indexKeys = append(indexKeys, bytes.Clone(k))
+	}
+
+	return indexKeys
+}
+
+// freshPairKeys returns the keys of all pairs last updated at or after the
+// cutoff. Only the fresh pairs are scanned.
+func freshPairKeys(tx *bbolt.Tx, cutoff time.Time) map[string]struct{} {
+	keys := make(map[string]struct{})
+	c := tx.Bucket([]byte(UpdateIndexBucketName)).Cursor()
+	seek := encodeUint64(uint64(max(cutoff.Unix(), 0)))
+	for k, _ := c.Seek(seek); k != nil; k, _ = c.Next() {
+		keys[string(k[updateTimeSize:])] = struct{}{}
+	}
+
+	return keys
+}
+
+// validateUpdateRange validates the minimum and maximum update time of a
+// query. Either of them may be unset.
+func validateUpdateRange(updatedSince, updatedUntil int64) error {
+	if updatedSince < 0 {
+		return status.Errorf(codes.InvalidArgument, "UpdatedSince "+
+			"must not be negative")
+	}
+	if updatedUntil < 0 {
+		return status.Errorf(codes.InvalidArgument, "UpdatedUntil "+
+			"must not be negative")
+	}
+	if updatedUntil != 0 && updatedUntil < updatedSince {
+		return status.Errorf(codes.InvalidArgument, "UpdatedUntil "+
+			"must not be before UpdatedSince")
+	}
+
+	return nil
+}
+
+// streamUpdatedPairs streams all pairs accepted by the filter that were last
+// updated at or after updatedSince and, unless it is zero, at or before
+// updatedUntil in the given sort order in chunks of the configured batch
+// size. The pairs are found by a range scan over the update index, so pairs
+// updated outside of the range are never read. The query is aborted once the
+// configured execution timeout elapses or the client goes away. It returns
+// the number of pairs sent.
+func (s *externalCoordinatorServer) streamUpdatedPairs(
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer,
+	updatedSince, updatedUntil int64, order ecrpc.SortOrder,
+	filter func(nodeFrom, nodeTo []byte) bool) (int, error) {
+	start := time.Now()
+	ctx, cancel := s.operationContext(stream.Context())
+	defer cancel()
+
+	var pairs []indexedPair
+	scanned := 0
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(DatabaseBucketName))
+		c := tx.Bucket([]byte(UpdateIndexBucketName)).Cursor()
+		seek := encodeUint64(uint64(updatedSince))
+		for k, _ := c.Seek(seek); k != nil; k, _ = c.Next() {
+			updated := int64(decodeUint64(k[:updateTimeSize]))
+			if updatedUntil != 0 && updated > updatedUntil {
+				break
+			}
+
+			scanned++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			key := k[updateTimeSize:]
+			nodeFrom, nodeTo := splitPairKey(key)
+			if filter != nil && !filter(nodeFrom, nodeTo) {
+				continue
+			}
+
+			// The keys and values are only valid for the lifetime
+			// of the transaction, so they are copied.
+			pairs = append(pairs, indexedPair{
+				key:     bytes.Clone(key),
+				value:   bytes.Clone(b.Get(key)),
+				updated: updated,
+			})
+		}
+
+		return nil
+	})
+	s.observeOperation(operationQuery, start, scanned)
+	if err != nil {
+		return 0, s.abortedQueryError(err)
+	}
+
+	// The pairs are read ordered by update time and then by key. Pairs
+	// updated at the same time keep their key order within each order.
+	if order == ecrpc.SortOrder_SORT_ORDER_FRESHNESS {
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return pairs[i].updated > pairs[j].updated
+		})
+	} else {
+		sort.Slice(pairs, func(i, j int) bool {
+			return bytes.Compare(pairs[i].key, pairs[j].key) < 0
+		})
+	}
+
+	keys := make([][]byte, 0, len(pairs))
+	values := make([][]byte, 0, len(pairs))
+	for _, pair := range pairs {
+		keys = append(keys, pair.key)
+		values = append(values, pair.value)
+	}
+	if order != ecrpc.SortOrder_SORT_ORDER_FRESHNESS {
+		if err := sortPairs(keys, values, order); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.sendPairBatches(stream, keys, values)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// indexedPairKeys returns the keys of the pairs in the update index in index
+// order.
+func indexedPairKeys(t *testing.T, db *bbolt.DB) []string {
+	t.Helper()
+
+	var keys []string
+	err := db.View(func(tx *bbolt.Tx) error {
+		index := tx.Bucket([]byte(UpdateIndexBucketName))
+		return index.ForEach(func(k, _ []byte) error {
+			keys = append(keys, string(k[updateTimeSize:]))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	return keys
+}
+
+// TestUpdateIndex tests that the update index follows the stored pairs, is
+// built for databases without one and serves recently updated queries.
+func TestUpdateIndex(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+
+	// Register an older and a newer pair.
+	now := time.Now().Unix()
+	oldFrom, oldTo := generateTestKeys(t)
+	newFrom, newTo := generateTestKeys(t)
+	register := func(nodeFrom, nodeTo []byte, successTime int64) {
+		_, err := server.RegisterMissionControl(
+			context.Background(),
+			&ecrpc.RegisterMissionControlRequest{
+				Pairs: []*ecrpc.PairHistory{{
+					NodeFrom: nodeFrom,
+					NodeTo:   nodeTo,
+					History: &ecrpc.PairData{
+						SuccessTime:    successTime,
+						SuccessAmtSat:  1,
+						SuccessAmtMsat: 1000,
+					},
+				}},
+			},
+		)
+		require.NoError(t, err)
+	}
+	register(oldFrom, oldTo, now-600)
+	register(newFrom, newTo, now-60)
+
+	oldKey := string(pairKey(oldFrom, oldTo))
+	newKey := string(pairKey(newFrom, newTo))
+	require.Equal(t, []string{oldKey, newKey}, indexedPairKeys(t, db))
+
+	// Updates move the pair within the index instead of adding an entry.
+	register(oldFrom, oldTo, now)
+	require.Equal(t, []string{newKey, oldKey}, indexedPairKeys(t, db))
+
+	// Only recently updated pairs are returned.
+	query := func(req *ecrpc.QueryAggregatedMissionControlRequest) (
+		[]string, error) {
+		stream := &mockQueryAggregatedMissionControlServer{}
+		err := server.QueryAggregatedMissionControl(req, stream)
+
+		var keys []string
+		for _, resp := range stream.Responses {
+			for _, pair := range resp.Pairs {
+				keys = append(keys, string(pairKey(
+					pair.NodeFrom, pair.NodeTo,
+				)))
+			}
+		}
+
+		return keys, err
+	}
+	keys, err := query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedSince: now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{oldKey}, keys)
+
+	keys, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedSince: now - 120,
+		SortOrder:    ecrpc.SortOrder_SORT_ORDER_FRESHNESS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{oldKey, newKey}, keys)
+
+	// Pairs updated after the end of a time range are left out.
+	keys, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedSince: now - 120,
+		UpdatedUntil: now - 60,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{newKey}, keys)
+
+	keys, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedUntil: now - 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{newKey}, keys)
+
+	// Invalid or incompatible times are rejected.
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedSince: -1,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedSince: now,
+		UpdatedUntil: now - 1,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = query(&ecrpc.QueryAggregatedMissionControlRequest{
+		UpdatedSince: now,
+		SampleSize:   1,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The index is built for databases created without one.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket([]byte(UpdateIndexBucketName))
+		if err != nil {
+			return err
+		}
+
+		return initUpdateIndex(tx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{newKey, oldKey}, indexedPairKeys(t, db))
+
+	// Stale pairs are removed together with their index entries.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return deletePair(tx, []byte(oldKey))
+	})
+	require.NoError(t, err)
+	register(oldFrom, oldTo, now-600)
+	config.Server.HistoryThresholdDuration = 5 * time.Minute
+	server.cleanupStaleData()
+	require.Equal(t, []string{newKey}, indexedPairKeys(t, db))
+	require.Equal(t, []string{newKey}, storedPairKeys(t, db))
+}
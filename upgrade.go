@@ -0,0 +1,272 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+)
+
+const (
+	// upgradeListenersEnv lists the names of the listeners handed over to
+	// a new process during a zero-downtime upgrade, in the order of their
+	// file descriptors.
+	upgradeListenersEnv = "EC_UPGRADE_LISTENERS"
+
+	// upgradeReadyFD is the file descriptor of the pipe through which a
+	// new process started for an upgrade reports that it is ready to take
+	// over. The file descriptors of the listeners follow it.
+	upgradeReadyFD = 3
+)
+
+const (
+	// grpcListenerName, adminListenerName, restListenerName and
+	// pprofListenerName are the names under which the listeners of the
+	// servers are handed over during an upgrade.
+	grpcListenerName  = "grpc"
+	adminListenerName = "admin"
+	restListenerName  = "rest"
+	pprofListenerName = "pprof"
+)
+
+// listeners holds the listeners of the process, so that they can be handed
+// over to a new process during a zero-downtime upgrade.
+var listeners = newListenerSet()
+
+// listenerSet tracks the listeners of the servers by name. Listeners handed
+// over by a previous process are reused instead of binding new ones, so that
+// no connection is refused while the processes switch.
+type listenerSet struct {
+	mu        sync.Mutex
+	inherited map[string]net.Listener
+	active    map[string]net.Listener
+	ready     *os.File
+}
+
+// newListenerSet creates an empty listener set.
+func newListenerSet() *listenerSet {
+	return &listenerSet{
+		inherited: make(map[string]net.Listener),
+		active:    make(map[string]net.Listener),
+	}
+}
+
+// listen returns the listener handed over under the given name if any and
+// binds a new one to the address otherwise. Changed addresses of handed over
+// listeners only take effect after a full restart.
+func (l *listenerSet) listen(name, addr string) (net.Listener, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lis, ok := l.inherited[name]
+	if ok {
+		delete(l.inherited, name)
+		logrus.Infof("Taking over %s listener on %s", name, lis.Addr())
+	} else {
+		var err error
+		lis, err = net.Listen("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	l.active[name] = lis
+
+	return lis, nil
+}
+
+// inherit takes over the listeners handed over by the previous process if the
+// process was started for an upgrade. It returns whether it was.
+func (l *listenerSet) inherit() (bool, error) {
+	names := os.Getenv(upgradeListenersEnv)
+	if names == "" {
+		return false, nil
+	}
+
+	// The variable must not be passed on to processes started later on.
+	if err := os.Unsetenv(upgradeListenersEnv); err != nil {
+		return false, err
+	}
+
+	var files []*os.File
+	for i, name := range strings.Split(names, ",") {
+		files = append(files, os.NewFile(
+			uintptr(upgradeReadyFD+1+i), name,
+		))
+	}
+
+	err := l.inheritFiles(strings.Split(names, ","), files)
+	if err != nil {
+		return false, err
+	}
+	l.ready = os.NewFile(upgradeReadyFD, "upgrade-ready")
+
+	return true, nil
+}
+
+// inheritFiles takes over the listeners of the given files under the given
+// names. The files are closed since the listeners hold their own duplicates.
+func (l *listenerSet) inheritFiles(names []string, files []*os.File) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, file := range files {
+		lis, err := net.FileListener(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to take over %s listener: %v",
+				names[i], err)
+		}
+		l.inherited[names[i]] = lis
+	}
+
+	return nil
+}
+
+// notifyReady reports to the previous process that this process is ready to
+// take over, which makes the previous process drain its connections and
+// release the database. It does nothing if the process was not started for
+// an upgrade.
+func (l *listenerSet) notifyReady() error {
+	if l.ready == nil {
+		return nil
+	}
+	defer func() {
+		l.ready.Close()
+		l.ready = nil
+	}()
+
+	_, err := l.ready.Write([]byte{1})
+
+	return err
+}
+
+// upgrade starts a new process of the current binary with the same arguments,
+// hands the active listeners over to it and waits until it is ready to take
+// over. The new process is killed if it is not ready within the timeout, so
+// that this process can keep serving.
+func (l *listenerSet) upgrade(timeout time.Duration) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	names := make([]string, 0, len(l.active))
+	for name := range l.active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var files []*os.File
+	defer func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}()
+	for _, name := range names {
+		lis, ok := l.active[name].(interface {
+			File() (*os.File, error)
+		})
+		if !ok {
+			l.mu.Unlock()
+			return fmt.Errorf("%s listener cannot be handed over",
+				name)
+		}
+
+		file, err := lis.File()
+		if err != nil {
+			l.mu.Unlock()
+			return fmt.Errorf("failed to hand over %s listener: %v",
+				name, err)
+		}
+		files = append(files, file)
+	}
+	l.mu.Unlock()
+
+	readyReader, readyWriter, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer readyReader.Close()
+
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(
+		os.Environ(),
+		upgradeListenersEnv+"="+strings.Join(names, ","),
+	)
+	cmd.ExtraFiles = append([]*os.File{readyWriter}, files...)
+	err = cmd.Start()
+
+	// The new process holds its own copy of the writer, so the reader sees
+	// the end of the pipe once the new process exits.
+	readyWriter.Close()
+	if err != nil {
+		return fmt.Errorf("failed to start new process: %v", err)
+	}
+
+	ready := make(chan error, 1)
+	go func() {
+		_, err := readyReader.Read(make([]byte, 1))
+		ready <- err
+	}()
+
+	select {
+	case err = <-ready:
+		if err != nil {
+			err = errors.New("new process exited before it was " +
+				"ready")
+		}
+
+	case <-time.After(timeout):
+		err = fmt.Errorf("new process was not ready within %v",
+			timeout)
+	}
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+
+		return err
+	}
+
+	return cmd.Process.Release()
+}
+
+// watchUpgradeSignal hands the listeners over to a new process of the binary
+// once the upgrade signal is received, and triggers the graceful shutdown of
+// this process through the given signal channel once the new process is
+// ready. If the upgrade fails, this process keeps serving.
+func watchUpgradeSignal(sigChan chan os.Signal, timeout time.Duration) {
+	if len(upgradeSignals) == 0 {
+		return
+	}
+
+	upgradeChan := make(chan os.Signal, 1)
+	signal.Notify(upgradeChan, upgradeSignals...)
+
+	go func() {
+		for range upgradeChan {
+			logrus.Info("Upgrading, starting new process...")
+			if err := listeners.upgrade(timeout); err != nil {
+				logrus.Errorf("Upgrade failed, continuing to "+
+					"serve: %v", err)
+				continue
+			}
+
+			logrus.Info("New process is ready, handing over")
+			signal.Stop(upgradeChan)
+			sigChan <- os.Interrupt
+
+			return
+		}
+	}()
+}
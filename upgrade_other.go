@@ -0,0 +1,9 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// upgradeSignals are the signals triggering a zero-downtime upgrade. Handing
+// listeners over is not supported on this platform.
+var upgradeSignals []os.Signal
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestListenerSetInherit tests that listeners handed over by a previous
+// process are taken over instead of binding new ones.
+func TestListenerSetInherit(t *testing.T) {
+	// Hand over the listener of the previous process.
+	previous, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	file, err := previous.(*net.TCPListener).File()
+	require.NoError(t, err)
+	addr := previous.Addr().String()
+	require.NoError(t, previous.Close())
+
+	set := newListenerSet()
+	require.NoError(t, set.inheritFiles(
+		[]string{grpcListenerName}, []*os.File{file},
+	))
+
+	// The handed over listener keeps accepting connections.
+	lis, err := set.listen(grpcListenerName, "localhost:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	require.Equal(t, addr, lis.Addr().String())
+
+	go func() {
+		conn, err := lis.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}()
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	conn.Close()
+
+	// Listeners are only taken over once, other ones are bound anew.
+	for _, name := range []string{grpcListenerName, restListenerName} {
+		lis, err := set.listen(name, "localhost:0")
+		require.NoError(t, err)
+		require.NotEqual(t, addr, lis.Addr().String())
+		lis.Close()
+	}
+}
+
+// TestListenerSetNotifyReady tests that the readiness of the new process is
+// reported to the previous process exactly once.
+func TestListenerSetNotifyReady(t *testing.T) {
+	// Processes not started for an upgrade have nothing to report.
+	set := newListenerSet()
+	require.NoError(t, set.notifyReady())
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	defer reader.Close()
+
+	set.ready = writer
+	require.NoError(t, set.notifyReady())
+	require.NoError(t, set.notifyReady())
+
+	// The pipe holds a single byte and is closed afterwards.
+	buf := make([]byte, 2)
+	n, err := reader.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	_, err = reader.Read(buf)
+	require.Error(t, err)
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// walRecordEntry is the type of a record holding a queued registration.
+	walRecordEntry byte = 1
+
+	// walRecordApplied is the type of a record marking a queued
+	// registration as applied.
+	walRecordApplied byte = 2
+
+	// walRecordSubmittedEntry is the type of a record holding a queued
+	// registration together with the identity of its submitter.
+	walRecordSubmittedEntry byte = 3
+
+	// walHeaderSize is the size of a record header consisting of the record
+	// type, the sequence number and the payload length.
+	walHeaderSize = 1 + 8 + 4
+
+	// walChecksumSize is the size of the CRC32 checksum trailing every
+	// record.
+	walChecksumSize = 4
+
+	// maxWALPayloadSize is the maximum payload size accepted when reading a
+	// record. It guards against allocating huge buffers for corrupt
+	// records.
+	maxWALPayloadSize = 64 << 20
+)
+
+// walEntry is a registration persisted to the write-ahead log.
+type walEntry struct {
+	seq       uint64
+	submitter string
+	pairs     []*ecrpc.PairHistory
+}
+
+// writeAheadLog is an append-only file persisting queued registrations until
+// they are applied to the database. Once all entries are applied the log is
+// truncated.
+type writeAheadLog struct {
+	mu      sync.Mutex
+	file    *os.File
+	nextSeq uint64
+	pending int
+}
+
+// openWriteAheadLog opens or creates the write-ahead log at the given path. It
+// returns the entries of the log which have not been applied yet. A torn
+// record at the end of the log, e.g. caused by a crash during a write, is
+// discarded. A corrupt record followed by valid ones is reported as an error
+// instead, as discarding it would drop the registrations after it.
+func openWriteAheadLog(path string) (*writeAheadLog, []*walEntry, error) {
+	file, err := os.OpenFile(
+		path, os.O_RDWR|os.O_CREATE|os.O_APPEND, DatabaseFilePermissions,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open write-ahead log: %v",
+			err)
+	}
+
+	entries, validSize, lastSeq, err := readWALRecords(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	// Drop any torn record at the end of the log so that new records are
+	// appended right after the last valid one.
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	if info.Size() != validSize {
+		logrus.Warnf("Discarding %d bytes of torn records at the end "+
+			"of the write-ahead log", info.Size()-validSize)
+
+		if err := file.Truncate(validSize); err != nil {
+			file.Close()
+			return nil, nil, err
+		}
+	}
+
+	wal := &writeAheadLog{
+		file:    file,
+		nextSeq: lastSeq + 1,
+		pending: len(entries),
+	}
+
+	return wal, entries, nil
+}
+
+// readWALRecords reads all records of the log and returns the unapplied
+// entries in order, the size of the valid part of the log and the highest
+// sequence number seen.
+func readWALRecords(file *os.File) ([]*walEntry, int64, uint64, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, 0, err
+	}
+	r := bufio.NewReader(file)
+
+	var (
+		entries   []*walEntry
+		validSize int64
+		lastSeq   uint64
+	)
+	applied := make(map[uint64]struct{})
+	for {
+		recordType, seq, payload, err := readWALRecord(r)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// A crash while appending can only tear the last
+			// record, so a valid record after the bad one means the
+			// log is corrupt in the middle.
+			follows, followErr := walRecordFollows(file, validSize)
+			if followErr != nil {
+				return nil, 0, 0, followErr
+			}
+			if follows {
+				return nil, 0, 0, fmt.Errorf("write-ahead log is "+
+					"corrupt at offset %d: %v", validSize, err)
+			}
+
+			logrus.Warnf("Stopped reading write-ahead log: %v", err)
+			break
+		}
+		validSize += int64(walHeaderSize + len(payload) + walChecksumSize)
+
+		if seq > lastSeq {
+			lastSeq = seq
+		}
+
+		switch recordType {
+		case walRecordEntry, walRecordSubmittedEntry:
+			entry, err := decodeWALEntry(recordType, seq, payload)
+			if err != nil {
+				return nil, 0, 0, fmt.Errorf("failed to decode "+
+					"write-ahead log entry %d: %v", seq, err)
+			}
+			entries = append(entries, entry)
+
+		case walRecordApplied:
+			applied[seq] = struct{}{}
+		}
+	}
+
+	// Only keep the entries which have not been marked as applied.
+	unapplied := entries[:0]
+	for _, entry := range entries {
+		if _, ok := applied[entry.seq]; !ok {
+			unapplied = append(unapplied, entry)
+		}
+	}
+
+	return unapplied, validSize, lastSeq, nil
+}
+
+// walRecordFollows reports whether a complete record with a valid checksum
+// starts anywhere in the log after the given offset.
+func walRecordFollows(file *os.File, offset int64) (bool, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if info.Size() <= offset+1 {
+		return false, nil
+	}
+
+	data := make([]byte, info.Size()-offset-1)
+	if _, err := file.ReadAt(data, offset+1); err != nil {
+		return false, err
+	}
+	for i := 0; i+walHeaderSize+walChecksumSize <= len(data); i++ {
+		if isValidWALRecord(data[i:]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isValidWALRecord reports whether the data starts with a complete record
+// whose checksum matches.
+func isValidWALRecord(data []byte) bool {
+	switch data[0] {
+	case walRecordEntry, walRecordApplied, walRecordSubmittedEntry:
+	default:
+		return false
+	}
+
+	end := walHeaderSize + int64(binary.BigEndian.Uint32(data[9:13]))
+	if int64(len(data)) < end+walChecksumSize {
+		return false
+	}
+
+	return crc32.ChecksumIEEE(data[:end]) ==
+		binary.BigEndian.Uint32(data[end:end+walChecksumSize])
+}
+
+// decodeWALEntry decodes the payload of a record holding a queued
+// registration. Records of the submitted entry type prefix the registration
+// with the uvarint length of the identity of the submitter and the identity.
+func decodeWALEntry(recordType byte, seq uint64,
+	payload []byte) (*walEntry, error) {
+
+	entry := &walEntry{seq: seq}
+	if recordType == walRecordSubmittedEntry {
+		length, n := binary.Uvarint(payload)
+		if n <= 0 || length > uint64(len(payload)-n) {
+			return nil, fmt.Errorf("invalid submitter length")
+		}
+		entry.submitter = string(payload[n : n+int(length)])
+		payload = payload[n+int(length):]
+	}
+
+	req := &ecrpc.RegisterMissionControlRequest{}
+	if err := proto.Unmarshal(payload, req); err != nil {
+		return nil, err
+	}
+	entry.pairs = req.Pairs
+
+	return entry, nil
+}
+
+// readWALRecord reads a single record. It returns io.EOF if the end of the log
+// is reached exactly at a record boundary.
+func readWALRecord(r io.Reader) (byte, uint64, []byte, error) {
+	header := make([]byte, walHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, 0, nil, fmt.Errorf("torn record header")
+		}
+		return 0, 0, nil, err
+	}
+
+	recordType := header[0]
+	switch recordType {
+	case walRecordEntry, walRecordApplied, walRecordSubmittedEntry:
+	default:
+		return 0, 0, nil, fmt.Errorf("unknown record type %d",
+			recordType)
+	}
+
+	seq := binary.BigEndian.Uint64(header[1:9])
+	length := binary.BigEndian.Uint32(header[9:13])
+	if length > maxWALPayloadSize {
+		return 0, 0, nil, fmt.Errorf("record payload of %d bytes "+
+			"exceeds limit", length)
+	}
+
+	rest := make([]byte, int(length)+walChecksumSize)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return 0, 0, nil, fmt.Errorf("torn record: %v", err)
+	}
+	payload := rest[:length]
+
+	checksum := crc32.NewIEEE()
+	checksum.Write(header)
+	checksum.Write(payload)
+	if checksum.Sum32() != binary.BigEndian.Uint32(rest[length:]) {
+		return 0, 0, nil, fmt.Errorf("checksum mismatch for record %d",
+			seq)
+	}
+
+	return recordType, seq, payload, nil
+}
+
+// encodeWALRecord encodes a record consisting of a header, the payload and a
+// trailing CRC32 checksum over both.
+func encodeWALRecord(recordType byte, seq uint64, payload []byte) []byte {
+	record := make([]byte, walHeaderSize, walHeaderSize+len(payload)+
+		walChecksumSize)
+	record[0] = recordType
+	binary.BigEndian.PutUint64(record[1:9], seq)
+	binary.BigEndian.PutUint32(record[9:13], uint32(len(payload)))
+	record = append(record, payload...)
+
+	return binary.BigEndian.AppendUint32(record, crc32.ChecksumIEEE(record))
+}
+
+// writeRecord appends the record to the log and syncs it to disk. The caller
+// must hold the mutex.
+func (w *writeAheadLog) writeRecord(recordType byte, seq uint64,
+	payload []byte) error {
+	_, err := w.file.Write(encodeWALRecord(recordType, seq, payload))
+	if err != nil {
+		return err
+	}
+
+	return w.file.Sync()
+}
+
+// append persists the given pairs of the submitter as a new entry of the log.
+func (w *writeAheadLog) append(submitter string,
+	pairs []*ecrpc.PairHistory) (*walEntry, error) {
+
+	data, err := proto.Marshal(
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	if err != nil {
+		return nil, err
+	}
+	payload := binary.AppendUvarint(nil, uint64(len(submitter)))
+	payload = append(payload, submitter...)
+	payload = append(payload, data...)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	seq := w.nextSeq
+	err = w.writeRecord(walRecordSubmittedEntry, seq, payload)
+	if err != nil {
+		return nil, err
+	}
+	w.nextSeq++
+	w.pending++
+
+	return &walEntry{seq: seq, submitter: submitter, pairs: pairs}, nil
+}
+
+// markApplied records that the entry with the given sequence number has been
+// applied. The log is truncated once no entries are pending anymore.
+func (w *writeAheadLog) markApplied(seq uint64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending--
+	if w.pending > 0 {
+		return w.writeRecord(walRecordApplied, seq, nil)
+	}
+
+	// All entries have been applied, so the log can be emptied. The
+	// sequence numbers keep increasing to avoid any ambiguity.
+	w.pending = 0
+	if err := w.file.Truncate(0); err != nil {
+		return err
+	}
+
+	return w.file.Sync()
+}
+
+// close closes the underlying file of the log.
+func (w *writeAheadLog) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.file.Close()
+}
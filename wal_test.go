@@ -0,0 +1,140 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestWriteAheadLog tests that unapplied entries survive reopening the log and
+// that applied ones are dropped.
+func TestWriteAheadLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	nodeFrom, nodeTo := generateTestKeys(t)
+	pairs := []*ecrpc.PairHistory{{
+		NodeFrom: nodeFrom,
+		NodeTo:   nodeTo,
+		History:  &ecrpc.PairData{SuccessTime: 100, SuccessAmtMsat: 1000},
+	}}
+
+	wal, unapplied, err := openWriteAheadLog(path)
+	require.NoError(t, err)
+	require.Empty(t, unapplied)
+
+	first, err := wal.append("", pairs)
+	require.NoError(t, err)
+	second, err := wal.append("203.0.113.7", pairs)
+	require.NoError(t, err)
+	require.Equal(t, first.seq+1, second.seq)
+
+	// Only the first entry is applied before the log is reopened.
+	require.NoError(t, wal.markApplied(first.seq))
+	require.NoError(t, wal.close())
+
+	wal, unapplied, err = openWriteAheadLog(path)
+	require.NoError(t, err)
+	require.Len(t, unapplied, 1)
+	require.Equal(t, second.seq, unapplied[0].seq)
+	require.Equal(t, "203.0.113.7", unapplied[0].submitter)
+	require.True(t, proto.Equal(pairs[0], unapplied[0].pairs[0]))
+
+	// New entries continue the sequence of the reopened log.
+	third, err := wal.append("", pairs)
+	require.NoError(t, err)
+	require.Greater(t, third.seq, second.seq)
+
+	// Once all entries are applied the log is truncated.
+	require.NoError(t, wal.markApplied(second.seq))
+	require.NoError(t, wal.markApplied(third.seq))
+	require.NoError(t, wal.close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Zero(t, info.Size())
+}
+
+// TestWriteAheadLogTornRecord tests that a torn record at the end of the log
+// is discarded while the preceding entries are kept.
+func TestWriteAheadLogTornRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+
+	wal, _, err := openWriteAheadLog(path)
+	require.NoError(t, err)
+	_, err = wal.append("", nil)
+	require.NoError(t, err)
+	require.NoError(t, wal.close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	validSize := info.Size()
+
+	// Simulate a crash in the middle of writing a record.
+	record := encodeWALRecord(walRecordEntry, 2, []byte("payload"))
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = file.Write(record[:len(record)-2])
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	wal, unapplied, err := openWriteAheadLog(path)
+	require.NoError(t, err)
+	require.Len(t, unapplied, 1)
+	require.NoError(t, wal.close())
+
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, validSize, info.Size())
+}
+
+// TestWriteAheadLogCorruptRecord tests that a corrupt record followed by valid
+// ones fails opening the log instead of discarding the valid records.
+func TestWriteAheadLogCorruptRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	first := encodeWALRecord(walRecordEntry, 1, nil)
+	second := encodeWALRecord(walRecordEntry, 2, nil)
+	third := encodeWALRecord(walRecordEntry, 3, nil)
+
+	// Flip a byte of the checksum of the record in the middle.
+	second[len(second)-1] ^= 0xff
+
+	var data []byte
+	data = append(data, first...)
+	data = append(data, second...)
+	data = append(data, third...)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	_, _, err := openWriteAheadLog(path)
+	require.ErrorContains(t, err, "corrupt")
+
+	// The log is left untouched for the operator to inspect.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), info.Size())
+}
+
+// TestWriteAheadLogEntryWithoutSubmitter tests that entries written before the
+// log recorded their submitters are still replayed.
+func TestWriteAheadLogEntryWithoutSubmitter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	nodeFrom, nodeTo := generateTestKeys(t)
+	payload, err := proto.Marshal(&ecrpc.RegisterMissionControlRequest{
+		Pairs: []*ecrpc.PairHistory{{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+		}},
+	})
+	require.NoError(t, err)
+	record := encodeWALRecord(walRecordEntry, 1, payload)
+	require.NoError(t, os.WriteFile(path, record, 0600))
+
+	wal, unapplied, err := openWriteAheadLog(path)
+	require.NoError(t, err)
+	defer wal.close()
+	require.Len(t, unapplied, 1)
+	require.Empty(t, unapplied[0].submitter)
+	require.Equal(t, nodeTo, unapplied[0].pairs[0].NodeTo)
+}
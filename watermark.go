@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logrus "github.com/sirupsen/logrus"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// watermarkKeySize is the size in bytes of the key the watermarks of
+	// the owners are derived from.
+	watermarkKeySize = 32
+
+	// watermarkRecordInterval is the interval at which serving an owner
+	// is recorded at most, so that the recipients bucket is not written
+	// on every query.
+	watermarkRecordInterval = time.Hour
+)
+
+// watermarkKeyKey is the key of the key the watermarks of the owners are
+// derived from within the metadata bucket.
+var watermarkKeyKey = []byte("watermark_key")
+
+// watermarkedPairs counts the pairs served with a watermark.
+var watermarkedPairs = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Name:      "watermarked_pairs_total",
+	Help:      "Pairs served with the watermark of their recipient.",
+})
+
+func init() {
+	metricsRegistry.MustRegister(watermarkedPairs)
+}
+
+// watermarkBit derives the bit the given amount of a pair carries in the
+// watermark of the owner. The bits of different owners are independent, so a
+// dataset carries the watermark of its recipient in all of its amounts but
+// the one of any other owner in only half of them.
+func watermarkBit(key []byte, owner string, nodeFrom, nodeTo []byte,
+	field string) int64 {
+
+	mac := hmac.New(sha256.New, key)
+	for _, part := range [][]byte{
+		[]byte(owner), nodeFrom, nodeTo, []byte(field),
+	} {
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(part)))
+		mac.Write(size[:])
+		mac.Write(part)
+	}
+
+	return int64(mac.Sum(nil)[0] & 1)
+}
+
+// markAmount returns the amount with its parity set to the bit, shifted by at
+// most one millisatoshi. The amount stays above the lower and, unless zero,
+// below the upper bound, and within the same satoshi so that the satoshi
+// amount is left unchanged. Zero amounts and amounts which cannot be shifted
+// are returned unchanged.
+func markAmount(amtMsat, bit, lower, upper int64) int64 {
+	if amtMsat <= 0 || amtMsat&1 == bit {
+		return amtMsat
+	}
+
+	for _, marked := range []int64{amtMsat + 1, amtMsat - 1} {
+		if marked <= lower || (upper != 0 && marked >= upper) ||
+			marked/mSatScale != amtMsat/mSatScale {
+
+			continue
+		}
+
+		return marked
+	}
+
+	return amtMsat
+}
+
+// watermarkPair returns a copy of the pair carrying the watermark of the
+// owner in its millisatoshi amounts. The success amount stays below the
+// failure amount.
+func watermarkPair(key []byte, owner string,
+	pair *ecrpc.PairHistory) *ecrpc.PairHistory {
+
+	if pair.History == nil {
+		return pair
+	}
+
+	marked := proto.Clone(pair).(*ecrpc.PairHistory)
+	history := marked.History
+	bit := func(field string) int64 {
+		return watermarkBit(
+			key, owner, pair.NodeFrom, pair.NodeTo, field,
+		)
+	}
+
+	history.SuccessAmtMsat = markAmount(
+		history.SuccessAmtMsat, bit("success"), 0, history.FailAmtMsat,
+	)
+	history.FailAmtMsat = markAmount(
+		history.FailAmtMsat, bit("fail"), history.SuccessAmtMsat, 0,
+	)
+
+	return marked
+}
+
+// watermarker embeds the watermark of the owner of the access token of a
+// request in the pairs served to it, so that a leaked dataset can be traced
+// back to the owner it was served to.
+type watermarker struct {
+	key []byte
+	db  *bbolt.DB
+
+	// clock tells the time the recipients are recorded at.
+	clock clock
+
+	// recorded holds the time each owner was last recorded as a
+	// recipient.
+	mu       sync.Mutex
+	recorded map[string]time.Time
+}
+
+// StartWatermarking starts embedding the watermark of the owner of the access
+// token of each request in the pairs served to it. The key the watermarks are
+// derived from is created on first use and kept in the database, so that
+// datasets served before a restart can still be traced.
+func (s *externalCoordinatorServer) StartWatermarking() error {
+	if !s.config.Server.RequireAccessToken {
+		return fmt.Errorf("watermarking served data requires access " +
+			"tokens")
+	}
+
+	var key []byte
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		if stored := b.Get(watermarkKeyKey); stored != nil {
+			key = append([]byte(nil), stored...)
+			return nil
+		}
+
+		key = make([]byte, watermarkKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return err
+		}
+
+		return b.Put(watermarkKeyKey, key)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.watermarks = &watermarker{
+		key:      key,
+		db:       s.db,
+		clock:    s.clock,
+		recorded: make(map[string]time.Time),
+	}
+
+	logrus.Infof("Watermarking the data served to each owner of an " +
+		"access token")
+
+	return nil
+}
+
+// record records the owner as a recipient of watermarked data unless it was
+// recorded within the record interval.
+func (w *watermarker) record(owner string) {
+	now := w.clock.Now()
+
+	w.mu.Lock()
+	last, ok := w.recorded[owner]
+	if ok && now.Sub(last) < watermarkRecordInterval {
+		w.mu.Unlock()
+		return
+	}
+	w.recorded[owner] = now
+	w.mu.Unlock()
+
+	err := w.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(WatermarkRecipientsBucketName))
+		return b.Put([]byte(owner), encodeUint64(uint64(now.Unix())))
+	})
+	if err != nil {
+		logrus.Errorf("Failed to record recipient of watermarked "+
+			"data: %v", err)
+	}
+}
+
+// watermarkStream embeds the watermark of the owner in the pairs sent on a
+// query stream.
+type watermarkStream struct {
+	ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer
+
+	key   []byte
+	owner string
+}
+
+// Send embeds the watermark of the owner in the pairs of the response and
+// sends the watermarked pairs.
+func (w *watermarkStream) Send(
+	resp *ecrpc.QueryAggregatedMissionControlResponse) error {
+
+	pairs := make([]*ecrpc.PairHistory, len(resp.Pairs))
+	for i, pair := range resp.Pairs {
+		pairs[i] = watermarkPair(w.key, w.owner, pair)
+	}
+	watermarkedPairs.Add(float64(len(pairs)))
+
+	return w.ExternalCoordinator_QueryAggregatedMissionControlServer.Send(
+		&ecrpc.QueryAggregatedMissionControlResponse{
+			Pairs:   pairs,
+			Dataset: resp.Dataset,
+		},
+	)
+}
+
+// watermarkServed returns a stream embedding the watermark of the owner of
+// the access token of the request in the pairs sent on the given stream if
+// watermarking is enabled, and else the given stream.
+func (s *externalCoordinatorServer) watermarkServed(ctx context.Context,
+	stream ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer) ecrpc.ExternalCoordinator_QueryAggregatedMissionControlServer {
+
+	scope := accessScopeFromContext(ctx)
+	if s.watermarks == nil || scope == nil {
+		return stream
+	}
+	s.watermarks.record(scope.owner)
+
+	return &watermarkStream{
+		ExternalCoordinator_QueryAggregatedMissionControlServer: stream,
+		key:   s.watermarks.key,
+		owner: scope.owner,
+	}
+}
+
+// DetectWatermark tests the pairs of a leaked dataset for the watermark of
+// every owner served watermarked data. Each nonzero amount matches the
+// watermark of an owner it was not served to with a probability of one half,
+// so the owners are ranked by how far their matches exceed that.
+func (a *adminServer) DetectWatermark(ctx context.Context,
+	req *ecadminrpc.DetectWatermarkRequest) (
+	*ecadminrpc.DetectWatermarkResponse, error) {
+
+	var (
+		key        []byte
+		recipients = make(map[string]int64)
+	)
+	err := a.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(MetadataBucketName))
+		key = append([]byte(nil), b.Get(watermarkKeyKey)...)
+
+		b = tx.Bucket([]byte(WatermarkRecipientsBucketName))
+		return b.ForEach(func(k, v []byte) error {
+			recipients[string(k)] = int64(decodeUint64(v))
+			return nil
+		})
+	})
+	if err != nil {
+		msg := "failed to load watermark recipients: %v"
+		logrus.Errorf(msg, err)
+		return nil, status.Errorf(storageErrorCode(err), msg, err)
+	}
+	if len(key) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no data "+
+			"was watermarked")
+	}
+
+	resp := &ecadminrpc.DetectWatermarkResponse{}
+	for owner, lastServed := range recipients {
+		match := &ecadminrpc.WatermarkMatch{
+			Owner:      owner,
+			LastServed: lastServed,
+		}
+		test := func(pair *ecadminrpc.WatermarkedPair, field string,
+			amtMsat int64) {
+
+			if amtMsat <= 0 {
+				return
+			}
+			match.TotalBits++
+			bit := watermarkBit(
+				key, owner, pair.NodeFrom, pair.NodeTo, field,
+			)
+			if amtMsat&1 == bit {
+				match.MatchingBits++
+			}
+		}
+		for _, pair := range req.Pairs {
+			test(pair, "success", pair.SuccessAmtMsat)
+			test(pair, "fail", pair.FailAmtMsat)
+		}
+
+		if match.TotalBits > 0 {
+			n := float64(match.TotalBits)
+			match.ZScore = (float64(match.MatchingBits) - n/2) /
+				math.Sqrt(n/4)
+		}
+		resp.Matches = append(resp.Matches, match)
+	}
+	sort.Slice(resp.Matches, func(i, j int) bool {
+		if resp.Matches[i].ZScore != resp.Matches[j].ZScore {
+			return resp.Matches[i].ZScore > resp.Matches[j].ZScore
+		}
+
+		return resp.Matches[i].Owner < resp.Matches[j].Owner
+	})
+
+	logrus.Infof("Tested %d pairs for the watermarks of %d owners",
+		len(req.Pairs), len(resp.Matches))
+
+	return resp, nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecadminrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecadminrpc"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestMarkAmount tests that amounts are shifted by at most one millisatoshi
+// within their bounds and satoshi to carry the bit.
+func TestMarkAmount(t *testing.T) {
+	tests := []struct {
+		name                       string
+		amtMsat, bit, lower, upper int64
+		expected                   int64
+	}{
+		{"parity matches", 1500, 0, 0, 0, 1500},
+		{"shifted up", 1500, 1, 0, 0, 1501},
+		{"shifted down within satoshi", 1999, 0, 0, 0, 1998},
+		{"shifted down below upper bound", 1501, 0, 0, 1502, 1500},
+		{"unshiftable", 1501, 0, 1500, 1502, 1501},
+		{"zero", 0, 1, 0, 0, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, markAmount(
+				test.amtMsat, test.bit, test.lower, test.upper,
+			))
+		})
+	}
+}
+
+// TestWatermarkServedData tests that the pairs served to each owner carry its
+// watermark and that a leaked dataset is traced back to the owner it was
+// served to.
+func TestWatermarkServedData(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	admin := NewAdminServer(config, db)
+
+	// Watermarks identify owners of access tokens, so they require them.
+	require.Error(t, server.StartWatermarking())
+	config.Server.RequireAccessToken = true
+
+	// Nothing can be detected before any data was watermarked.
+	_, err = admin.DetectWatermark(
+		context.Background(), &ecadminrpc.DetectWatermarkRequest{},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(t, server.StartWatermarking())
+
+	var pairs []*ecrpc.PairHistory
+	for i := int64(0); i < 40; i++ {
+		nodeFrom, nodeTo := generateTestKeys(t)
+		successAmtMsat := 100000 + i*1237
+		failAmtMsat := successAmtMsat + 50000 + i*311
+		pairs = append(pairs, &ecrpc.PairHistory{
+			NodeFrom: nodeFrom,
+			NodeTo:   nodeTo,
+			History: &ecrpc.PairData{
+				SuccessTime:    time.Now().Unix(),
+				SuccessAmtSat:  successAmtMsat / mSatScale,
+				SuccessAmtMsat: successAmtMsat,
+				FailTime:       time.Now().Unix(),
+				FailAmtSat:     failAmtMsat / mSatScale,
+				FailAmtMsat:    failAmtMsat,
+			},
+		})
+	}
+	_, err = server.RegisterMissionControl(
+		context.Background(),
+		&ecrpc.RegisterMissionControlRequest{Pairs: pairs},
+	)
+	require.NoError(t, err)
+
+	serve := func(owner string) map[string]*ecrpc.PairData {
+		ctx := context.Background()
+		if owner != "" {
+			ctx = context.WithValue(
+				ctx, accessScopeKey{}, &accessScope{owner: owner},
+			)
+		}
+		resp, err := server.collectChanges(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, resp.Pairs, len(pairs))
+
+		served := make(map[string]*ecrpc.PairData)
+		for _, pair := range resp.Pairs {
+			key := string(pairKey(pair.NodeFrom, pair.NodeTo))
+			served[key] = pair.History
+		}
+
+		return served
+	}
+	original := serve("")
+	alice := serve("tenant:alice")
+	bob := serve("tenant:bob")
+
+	// The watermarks shift the amounts by at most one millisatoshi, leave
+	// the satoshi amounts unchanged and keep the success amount below the
+	// failure amount.
+	require.NotEqual(t, alice, bob)
+	for key, history := range alice {
+		stored := original[key]
+		require.InDelta(t, stored.SuccessAmtMsat,
+			history.SuccessAmtMsat, 1)
+		require.InDelta(t, stored.FailAmtMsat, history.FailAmtMsat, 1)
+		require.Equal(t, stored.SuccessAmtSat, history.SuccessAmtSat)
+		require.Equal(t, stored.FailAmtSat, history.FailAmtSat)
+		require.Less(t, history.SuccessAmtMsat, history.FailAmtMsat)
+	}
+
+	// The dataset leaked by bob only carries the watermark of bob.
+	req := &ecadminrpc.DetectWatermarkRequest{}
+	for _, pair := range pairs {
+		history := bob[string(pairKey(pair.NodeFrom, pair.NodeTo))]
+		req.Pairs = append(req.Pairs, &ecadminrpc.WatermarkedPair{
+			NodeFrom:       pair.NodeFrom,
+			NodeTo:         pair.NodeTo,
+			SuccessAmtMsat: history.SuccessAmtMsat,
+			FailAmtMsat:    history.FailAmtMsat,
+		})
+	}
+	resp, err := admin.DetectWatermark(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Matches, 2)
+
+	leaker := resp.Matches[0]
+	require.Equal(t, "tenant:bob", leaker.Owner)
+	require.EqualValues(t, 2*len(pairs), leaker.TotalBits)
+	require.Equal(t, leaker.TotalBits, leaker.MatchingBits)
+	require.Greater(t, leaker.ZScore, 5.0)
+	require.NotZero(t, leaker.LastServed)
+
+	require.Equal(t, "tenant:alice", resp.Matches[1].Owner)
+	require.Less(t, resp.Matches[1].ZScore, 5.0)
+}
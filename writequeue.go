@@ -0,0 +1,208 @@
+package main
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writeQueue applies registered mission control pairs asynchronously. Every
+// queued registration is persisted to a write-ahead log before it is
+// acknowledged so that it isn't lost if the process crashes before it is
+// merged into the database.
+type writeQueue struct {
+	wal   *writeAheadLog
+	apply func(submitter string, pairs []*ecrpc.PairHistory) error
+
+	mu      sync.Mutex
+	closed  bool
+	entries chan *walEntry
+	wg      sync.WaitGroup
+}
+
+// newWriteQueue creates a write queue holding up to size registrations which
+// are applied using the given function.
+func newWriteQueue(wal *writeAheadLog, size int,
+	apply func(submitter string,
+		pairs []*ecrpc.PairHistory) error) *writeQueue {
+
+	return &writeQueue{
+		wal:     wal,
+		apply:   apply,
+		entries: make(chan *walEntry, size),
+	}
+}
+
+// start launches the goroutine applying the queued registrations.
+func (q *writeQueue) start() {
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+
+		for entry := range q.entries {
+			q.applyEntry(entry)
+		}
+	}()
+}
+
+// applyEntry applies a single queued registration and marks it as applied in
+// the write-ahead log. Entries which fail to apply are kept in the log.
+func (q *writeQueue) applyEntry(entry *walEntry) {
+	if err := q.apply(entry.submitter, entry.pairs); err != nil {
+		logrus.Errorf("Failed to apply queued registration %d, "+
+			"keeping it in the write-ahead log: %v", entry.seq, err)
+		return
+	}
+
+	if err := q.wal.markApplied(entry.seq); err != nil {
+		logrus.Errorf("Failed to mark queued registration %d as "+
+			"applied: %v", entry.seq, err)
+	}
+}
+
+// enqueue persists the given pairs of the submitter to the write-ahead log and
+// queues them to be applied. It fails if the queue is full or stopped.
+func (q *writeQueue) enqueue(submitter string,
+	pairs []*ecrpc.PairHistory) error {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return status.Errorf(codes.Unavailable, "write queue is "+
+			"shutting down")
+	}
+
+	// Only this method sends to the channel while holding the mutex, so
+	// checking the capacity here guarantees the send below won't block.
+	if len(q.entries) == cap(q.entries) {
+		return status.Errorf(codes.ResourceExhausted, "write queue is "+
+			"full, please retry later")
+	}
+
+	entry, err := q.wal.append(submitter, pairs)
+	if err != nil {
+		msg := "failed to persist registration to write-ahead log: %v"
+		logrus.Errorf(msg, err)
+		return status.Errorf(storageErrorCode(err), msg, err)
+	}
+	q.entries <- entry
+
+	return nil
+}
+
+// stop stops accepting new registrations, waits for the queued ones to be
+// applied and closes the write-ahead log.
+func (q *writeQueue) stop() error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.entries)
+	}
+	q.mu.Unlock()
+
+	q.wg.Wait()
+
+	return q.wal.close()
+}
+
+// StartWriteQueue opens the write-ahead log, replays the registrations left
+// unapplied by a previous run and starts applying registrations
+// asynchronously. It must be called before the server accepts traffic.
+func (s *externalCoordinatorServer) StartWriteQueue() error {
+	walPath := filepath.Join(
+		s.config.Database.DatabaseDirPath, s.config.Database.WALFile,
+	)
+	wal, unapplied, err := openWriteAheadLog(walPath)
+	if err != nil {
+		return err
+	}
+
+	// Replay the entries left unapplied by a previous run before any new
+	// registration is accepted.
+	s.replayWriteAheadLog(wal, unapplied)
+
+	s.writeQueue = newWriteQueue(
+		wal, s.config.Database.WriteQueueSize,
+		s.storeMissionControlPairs,
+	)
+	s.writeQueue.start()
+
+	logrus.Infof("Async writes enabled with a queue size of %d",
+		s.config.Database.WriteQueueSize)
+
+	return nil
+}
+
+// replayWriteAheadLog applies the given unapplied entries of the write-ahead
+// log in order and logs a summary of the recovery. Entries which fail to apply
+// are kept in the log to be retried on the next start.
+func (s *externalCoordinatorServer) replayWriteAheadLog(wal *writeAheadLog,
+	entries []*walEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	logrus.Infof("Replaying %d unapplied registrations from the "+
+		"write-ahead log", len(entries))
+	s.startup.begin(startupPhaseWALReplay, uint64(len(entries)))
+
+	var (
+		start        = time.Now()
+		applied      int
+		pairsApplied int
+		stalePairs   int
+	)
+	for i, entry := range entries {
+		s.startup.advance(uint64(i))
+
+		// The entries may have become stale while the coordinator was
+		// down, so they are sanitized the same way as new
+		// registrations.
+		req := &ecrpc.RegisterMissionControlRequest{Pairs: entry.pairs}
+		stalePairs += s.sanitizeRegisterMissionControlRequest(req)
+
+		err := s.storeMissionControlPairs(entry.submitter, req.Pairs)
+		if err != nil {
+			logrus.Errorf("Failed to replay registration %d: %v",
+				entry.seq, err)
+			continue
+		}
+
+		if err := wal.markApplied(entry.seq); err != nil {
+			logrus.Errorf("Failed to mark replayed registration %d "+
+				"as applied: %v", entry.seq, err)
+			continue
+		}
+
+		applied++
+		pairsApplied += len(req.Pairs)
+	}
+
+	s.startup.advance(uint64(len(entries)))
+
+	logrus.Infof("Write-ahead log recovery completed in %v: replayed "+
+		"%d of %d registrations with %d pairs, skipped %d stale pairs",
+		time.Since(start), applied, len(entries), pairsApplied,
+		stalePairs)
+
+	if failed := len(entries) - applied; failed > 0 {
+		logrus.Warnf("%d registrations could not be replayed and are "+
+			"kept in the write-ahead log", failed)
+	}
+}
+
+// StopWriteQueue applies the remaining queued registrations and stops the
+// write queue if it is running.
+func (s *externalCoordinatorServer) StopWriteQueue() error {
+	if s.writeQueue == nil {
+		return nil
+	}
+
+	return s.writeQueue.stop()
+}
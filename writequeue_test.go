@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ecrpc "github.com/ziggie1984/Distributed-Mission-Control-for-LND/ecrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRegisterMissionControlAsync tests that registrations are acknowledged
+// once queued and applied by the write queue.
+func TestRegisterMissionControlAsync(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 100
+	config.Database.AsyncWrites = true
+	config.Database.WriteQueueSize = 4
+	config.Database.WALFile = "test.wal"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartWriteQueue())
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	resp, err := server.RegisterMissionControl(
+		context.Background(), &ecrpc.RegisterMissionControlRequest{
+			Pairs: []*ecrpc.PairHistory{{
+				NodeFrom: nodeFrom,
+				NodeTo:   nodeTo,
+				History: &ecrpc.PairData{
+					SuccessTime:    time.Now().Unix(),
+					SuccessAmtMsat: 1000,
+				},
+			}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "Successfully queued 1 pairs", resp.SuccessMessage)
+
+	// Stopping the queue applies all remaining registrations.
+	require.NoError(t, server.StopWriteQueue())
+
+	mockStream := &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{}, mockStream,
+	)
+	require.NoError(t, err)
+	require.Len(t, mockStream.Responses, 1)
+	require.Len(t, mockStream.Responses[0].Pairs, 1)
+}
+
+// TestWriteQueue tests that the write queue rejects registrations when full or
+// stopped and keeps entries which fail to apply in the write-ahead log.
+func TestWriteQueue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	wal, _, err := openWriteAheadLog(path)
+	require.NoError(t, err)
+
+	// The queue is not started so that entries stay queued.
+	q := newWriteQueue(wal, 1, func(string, []*ecrpc.PairHistory) error {
+		return errors.New("apply failed")
+	})
+	require.NoError(t, q.enqueue("", nil))
+
+	err = q.enqueue("", nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Starting and stopping the queue drains it. The failed entry must be
+	// kept in the write-ahead log.
+	q.start()
+	require.NoError(t, q.stop())
+
+	err = q.enqueue("", nil)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	wal, unapplied, err := openWriteAheadLog(path)
+	require.NoError(t, err)
+	require.Len(t, unapplied, 1)
+	require.NoError(t, wal.close())
+}
+
+// TestWriteQueueRecovery tests that unapplied entries of the write-ahead log
+// are replayed when the write queue is started.
+func TestWriteQueueRecovery(t *testing.T) {
+	config := MockConfig(t.TempDir())
+	config.Server.HistoryThresholdDuration = time.Hour
+	config.Server.QueryMissionControlBatchSize = 100
+	config.Database.WriteQueueSize = 4
+	config.Database.WALFile = "test.wal"
+	db, err := setupDatabase(config)
+	require.NoError(t, err)
+	defer cleanupDB(db)
+
+	// Simulate a crash after a fresh and a stale registration were
+	// acknowledged but before they were applied.
+	walPath := filepath.Join(
+		config.Database.DatabaseDirPath, config.Database.WALFile,
+	)
+	wal, _, err := openWriteAheadLog(walPath)
+	require.NoError(t, err)
+
+	nodeFrom, nodeTo := generateTestKeys(t)
+	staleFrom, staleTo := generateTestKeys(t)
+	_, err = wal.append("", []*ecrpc.PairHistory{{
+		NodeFrom: nodeFrom,
+		NodeTo:   nodeTo,
+		History: &ecrpc.PairData{
+			SuccessTime:    time.Now().Unix(),
+			SuccessAmtMsat: 1000,
+		},
+	}})
+	require.NoError(t, err)
+	_, err = wal.append("", []*ecrpc.PairHistory{{
+		NodeFrom: staleFrom,
+		NodeTo:   staleTo,
+		History: &ecrpc.PairData{
+			SuccessTime:    time.Now().Add(-2 * time.Hour).Unix(),
+			SuccessAmtMsat: 1000,
+		},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, wal.close())
+
+	server := NewExternalCoordinatorServer(config, db)
+	require.NoError(t, server.StartWriteQueue())
+	require.NoError(t, server.StopWriteQueue())
+
+	// Only the fresh registration is expected to be applied.
+	mockStream := &mockQueryAggregatedMissionControlServer{}
+	err = server.QueryAggregatedMissionControl(
+		&ecrpc.QueryAggregatedMissionControlRequest{}, mockStream,
+	)
+	require.NoError(t, err)
+	require.Len(t, mockStream.Responses, 1)
+	require.Len(t, mockStream.Responses[0].Pairs, 1)
+	require.Equal(t, nodeFrom, mockStream.Responses[0].Pairs[0].NodeFrom)
+
+	// Both entries are applied, so the log must have been truncated.
+	info, err := os.Stat(walPath)
+	require.NoError(t, err)
+	require.Zero(t, info.Size())
+}